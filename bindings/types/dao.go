@@ -73,6 +73,8 @@ const (
 	ChallengeState_Paid
 )
 
+var ChallengeStates = []string{"Unchallenged", "Challenged", "Responded", "Paid"}
+
 // Info about a node's voting power
 type NodeVotingInfo struct {
 	NodeAddress common.Address `json:"nodeAddress"`
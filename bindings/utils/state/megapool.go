@@ -2,6 +2,7 @@ package state
 
 import (
 	"context"
+	"fmt"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
@@ -85,9 +86,47 @@ func GetAllMegapoolValidators(rp *rocketpool.RocketPool, contracts *NetworkContr
 	return validators, nil
 }
 
-func GetNodeMegapoolDetails(rp *rocketpool.RocketPool, nodeAccount common.Address) (NativeMegapoolDetails, error) {
+// Get the validators of a single megapool, in the same format as the global validator index
+func GetMegapoolValidators(rp *rocketpool.RocketPool, megapoolAddress common.Address, opts *bind.CallOpts) ([]megapool.ValidatorInfoFromGlobalIndex, error) {
+	mega, err := megapool.NewMegaPoolV1(rp, megapoolAddress, opts)
+	if err != nil {
+		return nil, err
+	}
+	validatorCount, err := mega.GetValidatorCount(opts)
+	if err != nil {
+		return nil, err
+	}
 
-	megapoolAddress, err := megapool.GetMegapoolExpectedAddress(rp, nodeAccount, nil)
+	// Sync
+	var wg errgroup.Group
+	wg.SetLimit(threadLimit)
+	validators := make([]megapool.ValidatorInfoFromGlobalIndex, validatorCount)
+	for i := uint32(0); i < validatorCount; i++ {
+		i := i
+		wg.Go(func() error {
+			validator, err := mega.GetValidatorInfoAndPubkey(i, opts)
+			if err != nil {
+				return fmt.Errorf("error getting info for megapool %s validator %d: %w", megapoolAddress.Hex(), i, err)
+			}
+			validators[i] = megapool.ValidatorInfoFromGlobalIndex{
+				Pubkey:          validator.Pubkey,
+				ValidatorInfo:   validator.ValidatorInfo,
+				MegapoolAddress: megapoolAddress,
+				ValidatorId:     i,
+			}
+			return nil
+		})
+	}
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+
+	return validators, nil
+}
+
+func GetNodeMegapoolDetails(rp *rocketpool.RocketPool, nodeAccount common.Address, opts *bind.CallOpts) (NativeMegapoolDetails, error) {
+
+	megapoolAddress, err := megapool.GetMegapoolExpectedAddress(rp, nodeAccount, opts)
 	if err != nil {
 		return NativeMegapoolDetails{}, err
 	}
@@ -97,7 +136,7 @@ func GetNodeMegapoolDetails(rp *rocketpool.RocketPool, nodeAccount common.Addres
 	details := NativeMegapoolDetails{Address: megapoolAddress}
 
 	// Return if megapool isn't deployed
-	details.Deployed, err = megapool.GetMegapoolDeployed(rp, nodeAccount, nil)
+	details.Deployed, err = megapool.GetMegapoolDeployed(rp, nodeAccount, opts)
 	if err != nil {
 		return NativeMegapoolDetails{}, err
 	}
@@ -106,22 +145,22 @@ func GetNodeMegapoolDetails(rp *rocketpool.RocketPool, nodeAccount common.Addres
 	}
 
 	// Load the megapool contract
-	mega, err := megapool.NewMegaPoolV1(rp, megapoolAddress, nil)
+	mega, err := megapool.NewMegaPoolV1(rp, megapoolAddress, opts)
 	if err != nil {
 		return NativeMegapoolDetails{}, err
 	}
 
-	details.EffectiveDelegateAddress, err = mega.GetEffectiveDelegate(nil)
+	details.EffectiveDelegateAddress, err = mega.GetEffectiveDelegate(opts)
 	if err != nil {
 		return NativeMegapoolDetails{}, err
 	}
-	details.DelegateAddress, err = mega.GetDelegate(nil)
+	details.DelegateAddress, err = mega.GetDelegate(opts)
 	if err != nil {
 		return NativeMegapoolDetails{}, err
 	}
 
 	// Return if delegate is expired
-	details.DelegateExpired, err = mega.GetDelegateExpired(rp, nil)
+	details.DelegateExpired, err = mega.GetDelegateExpired(rp, opts)
 	if err != nil {
 		return NativeMegapoolDetails{}, err
 	}
@@ -129,68 +168,72 @@ func GetNodeMegapoolDetails(rp *rocketpool.RocketPool, nodeAccount common.Addres
 		return details, nil
 	}
 
-	details.LastDistributionBlock, err = mega.GetLastDistributionBlock(nil)
+	details.LastDistributionBlock, err = mega.GetLastDistributionBlock(opts)
 	if err != nil {
 		return NativeMegapoolDetails{}, err
 	}
 	wg.Go(func() error {
 		var err error
-		details.NodeShare, err = network.GetCurrentNodeShare(rp, nil)
+		details.NodeShare, err = network.GetCurrentNodeShare(rp, opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		details.NodeDebt, err = mega.GetDebt(nil)
+		details.NodeDebt, err = mega.GetDebt(opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		details.RefundValue, err = mega.GetRefundValue(nil)
+		details.RefundValue, err = mega.GetRefundValue(opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		details.ValidatorCount, err = mega.GetValidatorCount(nil)
+		details.ValidatorCount, err = mega.GetValidatorCount(opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		details.ActiveValidatorCount, err = mega.GetActiveValidatorCount(nil)
+		details.ActiveValidatorCount, err = mega.GetActiveValidatorCount(opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		details.LockedValidatorCount, err = mega.GetLockedValidatorCount(nil)
+		details.LockedValidatorCount, err = mega.GetLockedValidatorCount(opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		details.UseLatestDelegate, err = mega.GetUseLatestDelegate(nil)
+		details.UseLatestDelegate, err = mega.GetUseLatestDelegate(opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		details.DelegateExpiry, err = megapool.GetMegapoolDelegateExpiry(rp, details.DelegateAddress, nil)
+		details.DelegateExpiry, err = megapool.GetMegapoolDelegateExpiry(rp, details.DelegateAddress, opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		details.AssignedValue, err = mega.GetAssignedValue(nil)
+		details.AssignedValue, err = mega.GetAssignedValue(opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		details.NodeBond, err = mega.GetNodeBond(nil)
+		details.NodeBond, err = mega.GetNodeBond(opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		details.UserCapital, err = mega.GetUserCapital(nil)
+		details.UserCapital, err = mega.GetUserCapital(opts)
 		return err
 	})
 	wg.Go(func() error {
 		var err error
-		details.EthBalance, err = rp.Client.BalanceAt(context.Background(), details.Address, nil)
+		var blockNumber *big.Int
+		if opts != nil {
+			blockNumber = opts.BlockNumber
+		}
+		details.EthBalance, err = rp.Client.BalanceAt(context.Background(), details.Address, blockNumber)
 		return err
 	})
 
@@ -199,7 +242,7 @@ func GetNodeMegapoolDetails(rp *rocketpool.RocketPool, nodeAccount common.Addres
 		return details, err
 	}
 
-	details.BondRequirement, err = node.GetBondRequirement(rp, big.NewInt(int64(details.ActiveValidatorCount)), nil)
+	details.BondRequirement, err = node.GetBondRequirement(rp, big.NewInt(int64(details.ActiveValidatorCount)), opts)
 	if err != nil {
 		return details, err
 	}
@@ -0,0 +1,118 @@
+// Package client is a typed Go client for the Smartnode's API server.
+//
+// It has no dependency on the CLI, docker or interactive prompts: every call takes a context, sends the command
+// through a Transport, and returns the decoded response. Commands that fail on the Smartnode's side return an
+// *APIError. The CLI's API methods are thin wrappers over this package, with a transport that runs the commands
+// through the API server or docker.
+package client
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/goccy/go-json"
+
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// Settings applied to the transactions created by API calls
+type Options struct {
+	// The max fee in gwei; 0 uses the Smartnode's configured value
+	MaxFee float64
+
+	// The max priority fee in gwei; 0 uses the Smartnode's configured value
+	MaxPriorityFee float64
+
+	// The gas limit; 0 uses the estimated limit
+	GasLimit uint64
+
+	// A custom nonce, used to replace a stuck transaction
+	Nonce *big.Int
+
+	// Skip the execution and consensus client sync checks
+	IgnoreSyncCheck bool
+
+	// Use the fallback clients instead of the primary ones
+	ForceFallbacks bool
+}
+
+// An error reported by the Smartnode while running an API command
+type APIError struct {
+	// The command that failed, e.g. "node status"
+	Command string
+
+	// The error message reported by the Smartnode
+	Message string
+}
+
+func (e *APIError) Error() string {
+	return e.Message
+}
+
+// A client for the Smartnode's API server
+type Client struct {
+	transport Transport
+	options   Options
+}
+
+// Create a new client that sends its calls through the provided transport
+func NewClient(transport Transport) *Client {
+	return &Client{
+		transport: transport,
+	}
+}
+
+// Get a copy of the client that applies the provided options to each call
+func (c *Client) WithOptions(options Options) *Client {
+	return &Client{
+		transport: c.transport,
+		options:   options,
+	}
+}
+
+// Run an API command and return the raw response; if the command failed, the error is an *APIError
+func (c *Client) Call(ctx context.Context, args ...string) ([]byte, error) {
+	request := api.ApiServerRequest{
+		Args:            args,
+		MaxFee:          c.options.MaxFee,
+		MaxPrioFee:      c.options.MaxPriorityFee,
+		GasLimit:        c.options.GasLimit,
+		IgnoreSyncCheck: c.options.IgnoreSyncCheck,
+		ForceFallbacks:  c.options.ForceFallbacks,
+	}
+	if c.options.Nonce != nil {
+		request.Nonce = c.options.Nonce.String()
+	}
+
+	responseBytes, err := c.transport.Call(ctx, &request)
+	if err != nil {
+		return nil, err
+	}
+
+	var response api.APIResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return nil, fmt.Errorf("error decoding API response: %w", err)
+	}
+	if response.Status == "error" || response.Error != "" {
+		return nil, &APIError{
+			Command: getCommandName(args),
+			Message: response.Error,
+		}
+	}
+	return responseBytes, nil
+}
+
+// Run an API command, with the same argument handling as the CLI's client
+func (c *Client) callAPI(ctx context.Context, args string, otherArgs ...string) ([]byte, error) {
+	return c.Call(ctx, append(strings.Fields(args), otherArgs...)...)
+}
+
+// Get the name of a command for error reporting, leaving out its arguments since they can include secrets
+func getCommandName(args []string) string {
+	if len(args) > 2 {
+		args = args[:2]
+	}
+	return strings.Join(args, " ")
+}
@@ -0,0 +1,81 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+type fakeTransport struct {
+	response string
+	requests []*api.ApiServerRequest
+}
+
+func (t *fakeTransport) Call(ctx context.Context, request *api.ApiServerRequest) ([]byte, error) {
+	t.requests = append(t.requests, request)
+	return []byte(t.response), nil
+}
+
+func TestTypedResponse(t *testing.T) {
+	transport := &fakeTransport{response: `{"status":"success","error":"","totalLength":3}`}
+	client := NewClient(transport)
+
+	response, err := client.QueueStatus(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if response.DepositPoolBalance == nil || response.DepositPoolBalance.Sign() != 0 {
+		t.Fatalf("expected missing balances to be zeroed, got %v", response.DepositPoolBalance)
+	}
+	if len(transport.requests) != 1 || len(transport.requests[0].Args) != 2 || transport.requests[0].Args[1] != "status" {
+		t.Fatalf("unexpected request: %+v", transport.requests)
+	}
+}
+
+func TestAPIError(t *testing.T) {
+	transport := &fakeTransport{response: `{"status":"error","error":"wallet is not initialized"}`}
+	client := NewClient(transport)
+
+	_, err := client.SetPassword(context.Background(), "hunter2")
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected an APIError, got %v", err)
+	}
+	if apiErr.Command != "wallet set-password" || apiErr.Message != "wallet is not initialized" {
+		t.Fatalf("unexpected error: %+v", apiErr)
+	}
+
+	// The CLI wraps this client, so the message reads the same as its errors always have
+	if err.Error() != "Could not set wallet password: wallet is not initialized" {
+		t.Fatalf("unexpected error message: %s", err.Error())
+	}
+}
+
+func TestOptions(t *testing.T) {
+	transport := &fakeTransport{response: `{"status":"success","error":""}`}
+	client := NewClient(transport).WithOptions(Options{
+		MaxFee:   20,
+		GasLimit: 100000,
+		Nonce:    big.NewInt(7),
+	})
+
+	_, err := client.SignMessage(context.Background(), "hello world")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	request := transport.requests[0]
+	if request.MaxFee != 20 || request.GasLimit != 100000 || request.Nonce != "7" || !request.IgnoreSyncCheck {
+		t.Fatalf("unexpected request: %+v", request)
+	}
+	if request.Args[len(request.Args)-1] != "hello world" {
+		t.Fatalf("expected the message to be passed as a single argument, got %v", request.Args)
+	}
+
+	// Per-call overrides don't leak into the client
+	if client.options.IgnoreSyncCheck {
+		t.Fatal("expected the client's options to be unchanged")
+	}
+}
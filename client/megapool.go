@@ -0,0 +1,417 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/goccy/go-json"
+
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// Get megapool status
+func (c *Client) MegapoolStatus(ctx context.Context) (api.MegapoolStatusResponse, error) {
+	responseBytes, err := c.callAPI(ctx, "megapool status")
+	if err != nil {
+		return api.MegapoolStatusResponse{}, fmt.Errorf("Could not get megapool status: %w", err)
+	}
+	var response api.MegapoolStatusResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.MegapoolStatusResponse{}, fmt.Errorf("Could not decode megapool status response: %w", err)
+	}
+
+	return response, nil
+}
+
+// Get a map of the node's validators and beacon balances
+func (c *Client) GetValidatorMapAndBalances(ctx context.Context) (api.MegapoolValidatorMapAndRewardsResponse, error) {
+	responseBytes, err := c.callAPI(ctx, "megapool validator-map-and-balances")
+	if err != nil {
+		return api.MegapoolValidatorMapAndRewardsResponse{}, fmt.Errorf("Could not get megapool validator-map-and-balances: %w", err)
+	}
+	var response api.MegapoolValidatorMapAndRewardsResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.MegapoolValidatorMapAndRewardsResponse{}, fmt.Errorf("Could not decode megapool validator-map-and-balances response: %w", err)
+	}
+	return response, nil
+}
+
+// Check whether the node can repay megapool debt
+func (c *Client) CanClaimMegapoolRefund(ctx context.Context) (api.CanClaimRefundResponse, error) {
+	responseBytes, err := c.callAPI(ctx, "megapool can-claim-refund")
+	if err != nil {
+		return api.CanClaimRefundResponse{}, fmt.Errorf("Could not get can claim refund status: %w", err)
+	}
+	var response api.CanClaimRefundResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.CanClaimRefundResponse{}, fmt.Errorf("Could not decode can claim refund response: %w", err)
+	}
+	return response, nil
+}
+
+// Repay megapool debt
+func (c *Client) ClaimMegapoolRefund(ctx context.Context) (api.ClaimRefundResponse, error) {
+	responseBytes, err := c.callAPI(ctx, "megapool claim-refund")
+	if err != nil {
+		return api.ClaimRefundResponse{}, fmt.Errorf("Could not claim refund: %w", err)
+	}
+	var response api.ClaimRefundResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.ClaimRefundResponse{}, fmt.Errorf("Could not decode claim refund response: %w", err)
+	}
+	return response, nil
+}
+
+// Check whether the node can repay megapool debt
+func (c *Client) CanRepayDebt(ctx context.Context, amountWei *big.Int) (api.CanRepayDebtResponse, error) {
+	responseBytes, err := c.callAPI(ctx, fmt.Sprintf("megapool can-repay-debt %s", amountWei.String()))
+	if err != nil {
+		return api.CanRepayDebtResponse{}, fmt.Errorf("Could not get can repay debt status: %w", err)
+	}
+	var response api.CanRepayDebtResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.CanRepayDebtResponse{}, fmt.Errorf("Could not decode can repay debt response: %w", err)
+	}
+	return response, nil
+}
+
+// Repay megapool debt
+func (c *Client) RepayDebt(ctx context.Context, amountWei *big.Int) (api.RepayDebtResponse, error) {
+	responseBytes, err := c.callAPI(ctx, fmt.Sprintf("megapool repay-debt %s", amountWei.String()))
+	if err != nil {
+		return api.RepayDebtResponse{}, fmt.Errorf("Could not repay megapool debt: %w", err)
+	}
+	var response api.RepayDebtResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.RepayDebtResponse{}, fmt.Errorf("Could not decode repay debt response: %w", err)
+	}
+	return response, nil
+}
+
+// Check whether the node can reduce the megapool bond
+func (c *Client) CanReduceBond(ctx context.Context, amountWei *big.Int) (api.CanReduceBondResponse, error) {
+	responseBytes, err := c.callAPI(ctx, fmt.Sprintf("megapool can-reduce-bond %s", amountWei.String()))
+	if err != nil {
+		return api.CanReduceBondResponse{}, fmt.Errorf("Could not get can reduce bond status: %w", err)
+	}
+	var response api.CanReduceBondResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.CanReduceBondResponse{}, fmt.Errorf("Could not decode can reduce bond response: %w", err)
+	}
+	return response, nil
+}
+
+// Reduce megapool bond
+func (c *Client) ReduceBond(ctx context.Context, amountWei *big.Int) (api.ReduceBondResponse, error) {
+	responseBytes, err := c.callAPI(ctx, fmt.Sprintf("megapool reduce-bond %s", amountWei.String()))
+	if err != nil {
+		return api.ReduceBondResponse{}, fmt.Errorf("Could not reduce bond: %w", err)
+	}
+	var response api.ReduceBondResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.ReduceBondResponse{}, fmt.Errorf("Could not decode reduce bond response: %w", err)
+	}
+	return response, nil
+}
+
+// Check whether the node can stake a megapool validator
+func (c *Client) CanStake(ctx context.Context, validatorId uint64) (api.CanStakeResponse, error) {
+	responseBytes, err := c.callAPI(ctx, fmt.Sprintf("megapool can-stake %d", validatorId))
+	if err != nil {
+		return api.CanStakeResponse{}, fmt.Errorf("Could not get can stake status: %w", err)
+	}
+	var response api.CanStakeResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.CanStakeResponse{}, fmt.Errorf("Could not decode can stake response: %w", err)
+	}
+	return response, nil
+}
+
+// Stake a megapool validator
+func (c *Client) Stake(ctx context.Context, validatorId uint64) (api.StakeResponse, error) {
+	responseBytes, err := c.callAPI(ctx, fmt.Sprintf("megapool stake %d", validatorId))
+	if err != nil {
+		return api.StakeResponse{}, fmt.Errorf("Could not stake megapool validator: %w", err)
+	}
+	var response api.StakeResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.StakeResponse{}, fmt.Errorf("Could not decode stake response: %w", err)
+	}
+	return response, nil
+}
+
+// Check whether the megapool validator can be disoolved
+func (c *Client) CanDissolveValidator(ctx context.Context, validatorId uint64) (api.CanDissolveValidatorResponse, error) {
+	responseBytes, err := c.callAPI(ctx, fmt.Sprintf("megapool can-dissolve-validator %d", validatorId))
+	if err != nil {
+		return api.CanDissolveValidatorResponse{}, fmt.Errorf("Could not get can dissolve validator status: %w", err)
+	}
+	var response api.CanDissolveValidatorResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.CanDissolveValidatorResponse{}, fmt.Errorf("Could not decode can dissolve-validator response: %w", err)
+	}
+	return response, nil
+}
+
+// Dissolve a megapool validator
+func (c *Client) DissolveValidator(ctx context.Context, validatorId uint64) (api.DissolveValidatorResponse, error) {
+	responseBytes, err := c.callAPI(ctx, fmt.Sprintf("megapool dissolve-validator %d", validatorId))
+	if err != nil {
+		return api.DissolveValidatorResponse{}, fmt.Errorf("Could not dissolve megapool validator: %w", err)
+	}
+	var response api.DissolveValidatorResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.DissolveValidatorResponse{}, fmt.Errorf("Could not decode dissolve response: %w", err)
+	}
+	return response, nil
+}
+
+// Check whether the megapool validator can be exited
+func (c *Client) CanExitValidator(ctx context.Context, validatorId uint64) (api.CanExitValidatorResponse, error) {
+	responseBytes, err := c.callAPI(ctx, fmt.Sprintf("megapool can-exit-validator %d", validatorId))
+	if err != nil {
+		return api.CanExitValidatorResponse{}, fmt.Errorf("Could not get can exit validator status: %w", err)
+	}
+	var response api.CanExitValidatorResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.CanExitValidatorResponse{}, fmt.Errorf("Could not decode can exit-validator response: %w", err)
+	}
+	return response, nil
+}
+
+// Exit a megapool validator
+func (c *Client) ExitValidator(ctx context.Context, validatorId uint64) (api.ExitValidatorResponse, error) {
+	responseBytes, err := c.callAPI(ctx, fmt.Sprintf("megapool exit-validator %d", validatorId))
+	if err != nil {
+		return api.ExitValidatorResponse{}, fmt.Errorf("Could not exit megapool validator: %w", err)
+	}
+	var response api.ExitValidatorResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.ExitValidatorResponse{}, fmt.Errorf("Could not decode exit response: %w", err)
+	}
+	return response, nil
+}
+
+// Check whether we can notify a validator exit
+func (c *Client) CanNotifyValidatorExit(ctx context.Context, validatorId uint64) (api.CanNotifyValidatorExitResponse, error) {
+	responseBytes, err := c.callAPI(ctx, fmt.Sprintf("megapool can-notify-validator-exit %d", validatorId))
+	if err != nil {
+		return api.CanNotifyValidatorExitResponse{}, fmt.Errorf("Could not get can notify validator exit status: %w", err)
+	}
+	var response api.CanNotifyValidatorExitResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.CanNotifyValidatorExitResponse{}, fmt.Errorf("Could not decode can notify-validator-exit response: %w", err)
+	}
+	return response, nil
+}
+
+// Notify exit of a megapool validator
+func (c *Client) NotifyValidatorExit(ctx context.Context, validatorId uint64) (api.NotifyValidatorExitResponse, error) {
+	responseBytes, err := c.callAPI(ctx, fmt.Sprintf("megapool notify-validator-exit %d", validatorId))
+	if err != nil {
+		return api.NotifyValidatorExitResponse{}, fmt.Errorf("Could not notify validator exit: %w", err)
+	}
+	var response api.NotifyValidatorExitResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NotifyValidatorExitResponse{}, fmt.Errorf("Could not decode notify-validator-exit response: %w", err)
+	}
+	return response, nil
+}
+
+// Check whether we can notify a validator's final balance
+func (c *Client) CanNotifyFinalBalance(ctx context.Context, validatorId uint64, slot uint64) (api.CanNotifyFinalBalanceResponse, error) {
+	responseBytes, err := c.callAPI(ctx, fmt.Sprintf("megapool can-notify-final-balance %d %d", validatorId, slot))
+	if err != nil {
+		return api.CanNotifyFinalBalanceResponse{}, fmt.Errorf("Could not get can notify validator final balance status: %w", err)
+	}
+	var response api.CanNotifyFinalBalanceResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.CanNotifyFinalBalanceResponse{}, fmt.Errorf("Could not decode can notify-final-balance response: %w", err)
+	}
+	return response, nil
+}
+
+// Notify final balance of a megapool validator
+func (c *Client) NotifyFinalBalance(ctx context.Context, validatorId uint64, slot uint64) (api.NotifyFinalBalanceResponse, error) {
+	responseBytes, err := c.callAPI(ctx, fmt.Sprintf("megapool notify-final-balance %d %d", validatorId, slot))
+	if err != nil {
+		return api.NotifyFinalBalanceResponse{}, fmt.Errorf("Could not notify final balance: %w", err)
+	}
+	var response api.NotifyFinalBalanceResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NotifyFinalBalanceResponse{}, fmt.Errorf("Could not decode notify-final-balance response: %w", err)
+	}
+	return response, nil
+}
+
+// Check whether the node can exit the megapool queue
+func (c *Client) CanExitQueue(ctx context.Context, validatorIndex uint32) (api.CanExitQueueResponse, error) {
+	responseBytes, err := c.callAPI(ctx, fmt.Sprintf("megapool can-exit-queue %d", validatorIndex))
+	if err != nil {
+		return api.CanExitQueueResponse{}, fmt.Errorf("Could not get can exit queue status: %w", err)
+	}
+	var response api.CanExitQueueResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.CanExitQueueResponse{}, fmt.Errorf("Could not decode can exit queue response: %w", err)
+	}
+	return response, nil
+}
+
+// Exit the megapool queue
+func (c *Client) ExitQueue(ctx context.Context, validatorIndex uint32) (api.ExitQueueResponse, error) {
+	responseBytes, err := c.callAPI(ctx, fmt.Sprintf("megapool exit-queue %d", validatorIndex))
+	if err != nil {
+		return api.ExitQueueResponse{}, fmt.Errorf("Could not exit queue: %w", err)
+	}
+	var response api.ExitQueueResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.ExitQueueResponse{}, fmt.Errorf("Could not decode exit queue response: %w", err)
+	}
+	return response, nil
+}
+
+// Get the gas info for a megapool delegate upgrade
+func (c *Client) CanDelegateUpgradeMegapool(ctx context.Context, address common.Address) (api.MegapoolCanDelegateUpgradeResponse, error) {
+	responseBytes, err := c.callAPI(ctx, fmt.Sprintf("megapool can-delegate-upgrade %s", address.Hex()))
+	if err != nil {
+		return api.MegapoolCanDelegateUpgradeResponse{}, fmt.Errorf("Could not get can delegate upgrade megapool status: %w", err)
+	}
+	var response api.MegapoolCanDelegateUpgradeResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.MegapoolCanDelegateUpgradeResponse{}, fmt.Errorf("Could not decode can delegate upgrade megapool response: %w", err)
+	}
+	return response, nil
+}
+
+// Upgrade the megapool delegate
+func (c *Client) DelegateUpgradeMegapool(ctx context.Context, address common.Address) (api.MegapoolDelegateUpgradeResponse, error) {
+	responseBytes, err := c.callAPI(ctx, fmt.Sprintf("megapool delegate-upgrade %s", address.Hex()))
+	if err != nil {
+		return api.MegapoolDelegateUpgradeResponse{}, fmt.Errorf("Could not upgrade megapool delegate: %w", err)
+	}
+	var response api.MegapoolDelegateUpgradeResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.MegapoolDelegateUpgradeResponse{}, fmt.Errorf("Could not decode megapool delegate upgrade response: %w", err)
+	}
+	return response, nil
+}
+
+// Get the megapool's auto-upgrade setting
+func (c *Client) GetUseLatestDelegate(ctx context.Context, address common.Address) (api.MegapoolGetUseLatestDelegateResponse, error) {
+	responseBytes, err := c.callAPI(ctx, fmt.Sprintf("megapool get-use-latest-delegate %s", address.Hex()))
+	if err != nil {
+		return api.MegapoolGetUseLatestDelegateResponse{}, fmt.Errorf("Could not get use latest delegate for megapool: %w", err)
+	}
+	var response api.MegapoolGetUseLatestDelegateResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.MegapoolGetUseLatestDelegateResponse{}, fmt.Errorf("Could not decode get use latest delegate for megapool response: %w", err)
+	}
+	return response, nil
+}
+
+// Check whether a megapool can have its auto-upgrade setting changed
+func (c *Client) CanSetUseLatestDelegateMegapool(ctx context.Context, address common.Address, setting bool) (api.MegapoolCanSetUseLatestDelegateResponse, error) {
+	responseBytes, err := c.callAPI(ctx, fmt.Sprintf("megapool can-set-use-latest-delegate %s %t", address.Hex(), setting))
+	if err != nil {
+		return api.MegapoolCanSetUseLatestDelegateResponse{}, fmt.Errorf("Could not get can set use latest delegate for megapool status: %w", err)
+	}
+	var response api.MegapoolCanSetUseLatestDelegateResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.MegapoolCanSetUseLatestDelegateResponse{}, fmt.Errorf("Could not decode can set use latest delegate for megapool response: %w", err)
+	}
+	return response, nil
+}
+
+// Change a megapool's auto-upgrade setting
+func (c *Client) SetUseLatestDelegateMegapool(ctx context.Context, address common.Address, setting bool) (api.MegapoolSetUseLatestDelegateResponse, error) {
+	responseBytes, err := c.callAPI(ctx, fmt.Sprintf("megapool set-use-latest-delegate %s %t", address.Hex(), setting))
+	if err != nil {
+		return api.MegapoolSetUseLatestDelegateResponse{}, fmt.Errorf("Could not set use latest delegate for megapool: %w", err)
+	}
+	var response api.MegapoolSetUseLatestDelegateResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.MegapoolSetUseLatestDelegateResponse{}, fmt.Errorf("Could not decode set use latest delegate for megapool response: %w", err)
+	}
+	return response, nil
+}
+
+// Get the megapool's delegate address
+func (c *Client) GetDelegate(ctx context.Context, address common.Address) (api.MegapoolGetDelegateResponse, error) {
+	responseBytes, err := c.callAPI(ctx, fmt.Sprintf("megapool get-delegate %s", address.Hex()))
+	if err != nil {
+		return api.MegapoolGetDelegateResponse{}, fmt.Errorf("Could get delegate for megapool: %w", err)
+	}
+	var response api.MegapoolGetDelegateResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.MegapoolGetDelegateResponse{}, fmt.Errorf("Could not decode get delegate for megapool response: %w", err)
+	}
+	return response, nil
+}
+
+// Get the megapool's effective delegate address
+func (c *Client) GetEffectiveDelegate(ctx context.Context, address common.Address) (api.MegapoolGetEffectiveDelegateResponse, error) {
+	responseBytes, err := c.callAPI(ctx, fmt.Sprintf("megapool get-effective-delegate %s", address.Hex()))
+	if err != nil {
+		return api.MegapoolGetEffectiveDelegateResponse{}, fmt.Errorf("Could get effective delegate for megapool: %w", err)
+	}
+	var response api.MegapoolGetEffectiveDelegateResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.MegapoolGetEffectiveDelegateResponse{}, fmt.Errorf("Could not decode get effective delegate for megapool response: %w", err)
+	}
+	return response, nil
+}
+
+// Calculate the megapool pending rewards
+func (c *Client) CalculatePendingRewards(ctx context.Context) (api.MegapoolRewardSplitResponse, error) {
+	responseBytes, err := c.callAPI(ctx, "megapool pending-rewards")
+	if err != nil {
+		return api.MegapoolRewardSplitResponse{}, fmt.Errorf("Could not get pending rewards: %w", err)
+	}
+	var response api.MegapoolRewardSplitResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.MegapoolRewardSplitResponse{}, fmt.Errorf("Could not decode pending rewards response: %w", err)
+	}
+	return response, nil
+}
+
+// Calculate Rewards split given an arbitrary amount
+func (c *Client) CalculateRewards(ctx context.Context, amountWei *big.Int) (api.MegapoolRewardSplitResponse, error) {
+	responseBytes, err := c.callAPI(ctx, fmt.Sprintf("megapool calculate-rewards %s", amountWei.String()))
+	if err != nil {
+		return api.MegapoolRewardSplitResponse{}, fmt.Errorf("Could not calculate rewards: %w", err)
+	}
+	var response api.MegapoolRewardSplitResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.MegapoolRewardSplitResponse{}, fmt.Errorf("Could not decode calculate rewards response: %w", err)
+	}
+
+	return response, nil
+}
+
+// Check if the node can distribute megapool rewards
+func (c *Client) CanDistributeMegapool(ctx context.Context) (api.CanDistributeMegapoolResponse, error) {
+	responseBytes, err := c.callAPI(ctx, "megapool can-distribute-megapool")
+	if err != nil {
+		return api.CanDistributeMegapoolResponse{}, fmt.Errorf("Could not get can-distribute-megapool response: %w", err)
+	}
+	var response api.CanDistributeMegapoolResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.CanDistributeMegapoolResponse{}, fmt.Errorf("Could not decode can-distribute-megapool response: %w", err)
+	}
+	return response, nil
+}
+
+// Distribute megapool rewards
+func (c *Client) DistributeMegapool(ctx context.Context) (api.DistributeMegapoolResponse, error) {
+	responseBytes, err := c.callAPI(ctx, "megapool distribute-megapool")
+	if err != nil {
+		return api.DistributeMegapoolResponse{}, fmt.Errorf("Could not get distribute-megapool response: %w", err)
+	}
+	var response api.DistributeMegapoolResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.DistributeMegapoolResponse{}, fmt.Errorf("Could not decode distribute-megapool response: %w", err)
+	}
+	return response, nil
+}
@@ -0,0 +1,144 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/goccy/go-json"
+
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// Get network node fee
+func (c *Client) NodeFee(ctx context.Context) (api.NodeFeeResponse, error) {
+	responseBytes, err := c.callAPI(ctx, "network node-fee")
+	if err != nil {
+		return api.NodeFeeResponse{}, fmt.Errorf("Could not get network node fee: %w", err)
+	}
+	var response api.NodeFeeResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NodeFeeResponse{}, fmt.Errorf("Could not decode network node fee response: %w", err)
+	}
+	return response, nil
+}
+
+// Get network RPL price
+func (c *Client) RplPrice(ctx context.Context) (api.RplPriceResponse, error) {
+	responseBytes, err := c.callAPI(ctx, "network rpl-price")
+	if err != nil {
+		return api.RplPriceResponse{}, fmt.Errorf("Could not get network RPL price: %w", err)
+	}
+	var response api.RplPriceResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.RplPriceResponse{}, fmt.Errorf("Could not decode network RPL price response: %w", err)
+	}
+	if response.RplPrice == nil {
+		response.RplPrice = big.NewInt(0)
+	}
+	return response, nil
+}
+
+// Get network stats
+func (c *Client) NetworkStats(ctx context.Context) (api.NetworkStatsResponse, error) {
+	responseBytes, err := c.callAPI(ctx, "network stats")
+	if err != nil {
+		return api.NetworkStatsResponse{}, fmt.Errorf("Could not get network stats: %w", err)
+	}
+	var response api.NetworkStatsResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NetworkStatsResponse{}, fmt.Errorf("Could not decode network stats response: %w", err)
+	}
+	return response, nil
+}
+
+// Get the timezone map
+func (c *Client) TimezoneMap(ctx context.Context) (api.NetworkTimezonesResponse, error) {
+	responseBytes, err := c.callAPI(ctx, "network timezone-map")
+	if err != nil {
+		return api.NetworkTimezonesResponse{}, fmt.Errorf("Could not get network timezone map: %w", err)
+	}
+	var response api.NetworkTimezonesResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NetworkTimezonesResponse{}, fmt.Errorf("Could not decode network timezone map response: %w", err)
+	}
+	return response, nil
+}
+
+// Check if the rewards tree for the provided interval can be generated
+func (c *Client) CanGenerateRewardsTree(ctx context.Context, index uint64) (api.CanNetworkGenerateRewardsTreeResponse, error) {
+	responseBytes, err := c.callAPI(ctx, fmt.Sprintf("network can-generate-rewards-tree %d", index))
+	if err != nil {
+		return api.CanNetworkGenerateRewardsTreeResponse{}, fmt.Errorf("Could not check rewards tree generation status: %w", err)
+	}
+	var response api.CanNetworkGenerateRewardsTreeResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.CanNetworkGenerateRewardsTreeResponse{}, fmt.Errorf("Could not decode rewards tree generation status response: %w", err)
+	}
+	return response, nil
+}
+
+// Set a request marker for the watchtower to generate the rewards tree for the given interval
+func (c *Client) GenerateRewardsTree(ctx context.Context, index uint64) (api.NetworkGenerateRewardsTreeResponse, error) {
+	responseBytes, err := c.callAPI(ctx, fmt.Sprintf("network generate-rewards-tree %d", index))
+	if err != nil {
+		return api.NetworkGenerateRewardsTreeResponse{}, fmt.Errorf("Could not initialize rewards tree generation: %w", err)
+	}
+	var response api.NetworkGenerateRewardsTreeResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NetworkGenerateRewardsTreeResponse{}, fmt.Errorf("Could not decode rewards tree generation response: %w", err)
+	}
+	return response, nil
+}
+
+// GetActiveDAOProposals fetches information about active DAO proposals
+func (c *Client) GetActiveDAOProposals(ctx context.Context) (api.NetworkDAOProposalsResponse, error) {
+	responseBytes, err := c.callAPI(ctx, "network dao-proposals")
+	if err != nil {
+		return api.NetworkDAOProposalsResponse{}, fmt.Errorf("could not request active DAO proposals: %w", err)
+	}
+	var response api.NetworkDAOProposalsResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NetworkDAOProposalsResponse{}, fmt.Errorf("could not decode dao proposals response: %w", err)
+	}
+	return response, nil
+}
+
+// Download a rewards info file from IPFS for the given interval
+func (c *Client) DownloadRewardsFile(ctx context.Context, interval uint64) (api.DownloadRewardsFileResponse, error) {
+	responseBytes, err := c.callAPI(ctx, fmt.Sprintf("network download-rewards-file %d", interval))
+	if err != nil {
+		return api.DownloadRewardsFileResponse{}, fmt.Errorf("could not download rewards file: %w", err)
+	}
+	var response api.DownloadRewardsFileResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.DownloadRewardsFileResponse{}, fmt.Errorf("could not decode download-rewards-file response: %w", err)
+	}
+	return response, nil
+}
+
+// Check if Saturn 1.4 has been deployed yet
+func (c *Client) IsSaturnDeployed(ctx context.Context) (api.IsSaturnDeployedResponse, error) {
+	responseBytes, err := c.callAPI(ctx, "network is-saturn-deployed")
+	if err != nil {
+		return api.IsSaturnDeployedResponse{}, fmt.Errorf("could not check if Saturn is deployed: %w", err)
+	}
+	var response api.IsSaturnDeployedResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.IsSaturnDeployedResponse{}, fmt.Errorf("could not decode is-saturn-deployed response: %w", err)
+	}
+	return response, nil
+}
+
+// Get the address of the latest minipool delegate contract
+func (c *Client) GetLatestDelegate(ctx context.Context) (api.GetLatestDelegateResponse, error) {
+	responseBytes, err := c.callAPI(ctx, "network latest-delegate")
+	if err != nil {
+		return api.GetLatestDelegateResponse{}, fmt.Errorf("could not get latest delegate: %w", err)
+	}
+	var response api.GetLatestDelegateResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.GetLatestDelegateResponse{}, fmt.Errorf("could not decode get-latest-delegate response: %w", err)
+	}
+	return response, nil
+}
@@ -0,0 +1,87 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/goccy/go-json"
+
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// The path API calls are sent to on the API server
+const callPath string = "/v1/api"
+
+// Sends API calls to the Smartnode and returns the raw responses
+type Transport interface {
+	Call(ctx context.Context, request *api.ApiServerRequest) ([]byte, error)
+}
+
+// A transport that talks to the API server over HTTP
+type httpTransport struct {
+	client *http.Client
+	url    string
+	token  string
+}
+
+// Create a transport that uses the API server's Unix socket, e.g. /path/to/data/api.sock
+func NewSocketTransport(socketPath string) Transport {
+	return &httpTransport{
+		client: &http.Client{
+			Transport: &http.Transport{
+				DialContext: func(ctx context.Context, _ string, _ string) (net.Conn, error) {
+					var dialer net.Dialer
+					return dialer.DialContext(ctx, "unix", socketPath)
+				},
+			},
+		},
+		// The host is ignored since every connection goes to the socket
+		url: "http://rocketpool" + callPath,
+	}
+}
+
+// Create a transport that uses the API server's TCP port, e.g. http://127.0.0.1:8280.
+// The token is the contents of the api-token file in the Smartnode's data folder.
+func NewHttpTransport(baseUrl string, token string) Transport {
+	return &httpTransport{
+		client: &http.Client{},
+		url:    strings.TrimSuffix(baseUrl, "/") + callPath,
+		token:  token,
+	}
+}
+
+func (t *httpTransport) Call(ctx context.Context, request *api.ApiServerRequest) ([]byte, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("error serializing request: %w", err)
+	}
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, t.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+	httpRequest.Header.Set("Content-Type", "application/json")
+	if t.token != "" {
+		httpRequest.Header.Set("Authorization", "Bearer "+t.token)
+	}
+
+	response, err := t.client.Do(httpRequest)
+	if err != nil {
+		return nil, fmt.Errorf("error calling the API server: %w", err)
+	}
+	defer response.Body.Close()
+
+	// Errors from the server itself (e.g. a bad token) use the same envelope as command errors, so they're passed through
+	responseBytes, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading API server response: %w", err)
+	}
+	if response.StatusCode != http.StatusOK && len(responseBytes) == 0 {
+		return nil, fmt.Errorf("the API server returned %s", response.Status)
+	}
+	return responseBytes, nil
+}
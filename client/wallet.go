@@ -0,0 +1,243 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/goccy/go-json"
+
+	"github.com/rocket-pool/smartnode/shared/services/wallet/offline"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// Get wallet status
+func (c *Client) WalletStatus(ctx context.Context) (api.WalletStatusResponse, error) {
+	responseBytes, err := c.callAPI(ctx, "wallet status")
+	if err != nil {
+		return api.WalletStatusResponse{}, fmt.Errorf("Could not get wallet status: %w", err)
+	}
+	var response api.WalletStatusResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.WalletStatusResponse{}, fmt.Errorf("Could not decode wallet status response: %w", err)
+	}
+	return response, nil
+}
+
+// Set wallet password
+func (c *Client) SetPassword(ctx context.Context, password string) (api.SetPasswordResponse, error) {
+	responseBytes, err := c.callAPI(ctx, "wallet set-password", password)
+	if err != nil {
+		return api.SetPasswordResponse{}, fmt.Errorf("Could not set wallet password: %w", err)
+	}
+	var response api.SetPasswordResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.SetPasswordResponse{}, fmt.Errorf("Could not decode set wallet password response: %w", err)
+	}
+	return response, nil
+}
+
+// Unlock the wallet when its password isn't stored
+func (c *Client) UnlockWallet(ctx context.Context, password string) (api.UnlockWalletResponse, error) {
+	responseBytes, err := c.callAPI(ctx, "wallet unlock", password)
+	if err != nil {
+		return api.UnlockWalletResponse{}, fmt.Errorf("Could not unlock wallet: %w", err)
+	}
+	var response api.UnlockWalletResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.UnlockWalletResponse{}, fmt.Errorf("Could not decode unlock wallet response: %w", err)
+	}
+	return response, nil
+}
+
+// Initialize wallet
+func (c *Client) InitWallet(ctx context.Context, derivationPath string) (api.InitWalletResponse, error) {
+	responseBytes, err := c.callAPI(ctx, "wallet init --derivation-path", derivationPath)
+	if err != nil {
+		return api.InitWalletResponse{}, fmt.Errorf("Could not initialize wallet: %w", err)
+	}
+	var response api.InitWalletResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.InitWalletResponse{}, fmt.Errorf("Could not decode initialize wallet response: %w", err)
+	}
+	return response, nil
+}
+
+// Recover wallet
+func (c *Client) RecoverWallet(ctx context.Context, mnemonic string, skipValidatorKeyRecovery bool, derivationPath string, walletIndex uint) (api.RecoverWalletResponse, error) {
+	command := "wallet recover "
+	if skipValidatorKeyRecovery {
+		command += "--skip-validator-key-recovery "
+	}
+	if walletIndex != 0 {
+		command += fmt.Sprintf("--wallet-index %d ", walletIndex)
+	}
+	command += "--derivation-path"
+
+	responseBytes, err := c.callAPI(ctx, command, derivationPath, mnemonic)
+	if err != nil {
+		return api.RecoverWalletResponse{}, fmt.Errorf("Could not recover wallet: %w", err)
+	}
+	var response api.RecoverWalletResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.RecoverWalletResponse{}, fmt.Errorf("Could not decode recover wallet response: %w", err)
+	}
+	return response, nil
+}
+
+// Search and recover wallet
+func (c *Client) SearchAndRecoverWallet(ctx context.Context, mnemonic string, address common.Address, skipValidatorKeyRecovery bool) (api.SearchAndRecoverWalletResponse, error) {
+	command := "wallet search-and-recover "
+	if skipValidatorKeyRecovery {
+		command += "--skip-validator-key-recovery "
+	}
+
+	responseBytes, err := c.callAPI(ctx, command, mnemonic, address.Hex())
+	if err != nil {
+		return api.SearchAndRecoverWalletResponse{}, fmt.Errorf("Could not search and recover wallet: %w", err)
+	}
+	var response api.SearchAndRecoverWalletResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.SearchAndRecoverWalletResponse{}, fmt.Errorf("Could not decode search-and-recover wallet response: %w", err)
+	}
+	return response, nil
+}
+
+// Recover wallet
+func (c *Client) TestRecoverWallet(ctx context.Context, mnemonic string, skipValidatorKeyRecovery bool, derivationPath string, walletIndex uint) (api.RecoverWalletResponse, error) {
+	command := "wallet test-recovery "
+	if skipValidatorKeyRecovery {
+		command += "--skip-validator-key-recovery "
+	}
+	if walletIndex != 0 {
+		command += fmt.Sprintf("--wallet-index %d ", walletIndex)
+	}
+	command += "--derivation-path"
+
+	responseBytes, err := c.callAPI(ctx, command, derivationPath, mnemonic)
+	if err != nil {
+		return api.RecoverWalletResponse{}, fmt.Errorf("Could not test recover wallet: %w", err)
+	}
+	var response api.RecoverWalletResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.RecoverWalletResponse{}, fmt.Errorf("Could not decode test recover wallet response: %w", err)
+	}
+	return response, nil
+}
+
+// Search and recover wallet
+func (c *Client) TestSearchAndRecoverWallet(ctx context.Context, mnemonic string, address common.Address, skipValidatorKeyRecovery bool) (api.SearchAndRecoverWalletResponse, error) {
+	command := "wallet test-search-and-recover "
+	if skipValidatorKeyRecovery {
+		command += "--skip-validator-key-recovery "
+	}
+
+	responseBytes, err := c.callAPI(ctx, command, mnemonic, address.Hex())
+	if err != nil {
+		return api.SearchAndRecoverWalletResponse{}, fmt.Errorf("Could not test search and recover wallet: %w", err)
+	}
+	var response api.SearchAndRecoverWalletResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.SearchAndRecoverWalletResponse{}, fmt.Errorf("Could not decode test-search-and-recover wallet response: %w", err)
+	}
+	return response, nil
+}
+
+// Rebuild wallet
+func (c *Client) RebuildWallet(ctx context.Context) (api.RebuildWalletResponse, error) {
+	responseBytes, err := c.callAPI(ctx, "wallet rebuild")
+	if err != nil {
+		return api.RebuildWalletResponse{}, fmt.Errorf("Could not rebuild wallet: %w", err)
+	}
+	var response api.RebuildWalletResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.RebuildWalletResponse{}, fmt.Errorf("Could not decode rebuild wallet response: %w", err)
+	}
+	return response, nil
+}
+
+// Estimate the gas required to set an ENS reverse record to a name
+func (c *Client) EstimateGasSetEnsName(ctx context.Context, name string) (api.SetEnsNameResponse, error) {
+	responseBytes, err := c.callAPI(ctx, fmt.Sprintf("wallet estimate-gas-set-ens-name %s", name))
+	if err != nil {
+		return api.SetEnsNameResponse{}, fmt.Errorf("Could not get estimate-gas-set-ens-name response: %w", err)
+	}
+	var response api.SetEnsNameResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.SetEnsNameResponse{}, fmt.Errorf("Could not decode estimate-gas-set-ens-name response: %w", err)
+	}
+	return response, nil
+}
+
+// Set an ENS reverse record to a name
+func (c *Client) SetEnsName(ctx context.Context, name string) (api.SetEnsNameResponse, error) {
+	responseBytes, err := c.callAPI(ctx, fmt.Sprintf("wallet set-ens-name %s", name))
+	if err != nil {
+		return api.SetEnsNameResponse{}, fmt.Errorf("Could not update ENS record: %w", err)
+	}
+	var response api.SetEnsNameResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.SetEnsNameResponse{}, fmt.Errorf("Could not decode set-ens-name response: %w", err)
+	}
+	return response, nil
+}
+
+// Export wallet
+func (c *Client) ExportWallet(ctx context.Context) (api.ExportWalletResponse, error) {
+	responseBytes, err := c.callAPI(ctx, "wallet export")
+	if err != nil {
+		return api.ExportWalletResponse{}, fmt.Errorf("Could not export wallet: %w", err)
+	}
+	var response api.ExportWalletResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.ExportWalletResponse{}, fmt.Errorf("Could not decode export wallet response: %w", err)
+	}
+	return response, nil
+}
+
+// Set the node address to an arbitrary address
+func (c *Client) Masquerade(ctx context.Context, address common.Address) (api.MasqueradeResponse, error) {
+	responseBytes, err := c.callAPI(ctx, "wallet masquerade", address.Hex())
+	if err != nil {
+		return api.MasqueradeResponse{}, fmt.Errorf("Could not masquerade wallet: %w", err)
+	}
+	var response api.MasqueradeResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.MasqueradeResponse{}, fmt.Errorf("Could not decode masquerade wallet response: %w", err)
+	}
+	return response, nil
+}
+
+// Delete the address file, ending a masquerade
+func (c *Client) EndMasquerade(ctx context.Context) (api.EndMasqueradeResponse, error) {
+	responseBytes, err := c.callAPI(ctx, "wallet end-masquerade")
+	if err != nil {
+		return api.EndMasqueradeResponse{}, fmt.Errorf("Could not end masquerade: %w", err)
+	}
+	var response api.EndMasqueradeResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.EndMasqueradeResponse{}, fmt.Errorf("Could not decode end masquerade response: %w", err)
+	}
+	return response, nil
+}
+
+// Sign a transaction that was prepared for offline signing
+func (c *Client) SignTransaction(ctx context.Context, tx offline.UnsignedTransaction) (api.SignTransactionResponse, error) {
+	unsignedTx, err := tx.ToTransaction()
+	if err != nil {
+		return api.SignTransactionResponse{}, fmt.Errorf("Could not sign transaction: %w", err)
+	}
+	txBytes, err := unsignedTx.MarshalBinary()
+	if err != nil {
+		return api.SignTransactionResponse{}, fmt.Errorf("Could not serialize transaction: %w", err)
+	}
+	responseBytes, err := c.callAPI(ctx, "wallet sign-tx", fmt.Sprintf("0x%x", txBytes))
+	if err != nil {
+		return api.SignTransactionResponse{}, fmt.Errorf("Could not sign transaction: %w", err)
+	}
+	var response api.SignTransactionResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.SignTransactionResponse{}, fmt.Errorf("Could not decode sign transaction response: %w", err)
+	}
+	return response, nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/big"
 	"sort"
+	"time"
 
 	"github.com/rocket-pool/smartnode/bindings/utils/eth"
 	"github.com/rocket-pool/smartnode/shared/types/api"
@@ -144,10 +145,48 @@ func getStatus(c *cli.Context) error {
 		fmt.Print("Upgrade your megapool delegate using 'rocketpool megapool delegate-upgrade' to view the balance and commission details.\n")
 	}
 
+	// Deadlines
+	if !status.Megapool.DelegateExpired {
+		fmt.Println()
+		fmt.Printf("%s=== Upcoming Deadlines ===%s\n", colorGreen, colorReset)
+		printDeadlines(status.Megapool.Validators)
+	}
+
 	return nil
 
 }
 
+// Print the validators that have a duty to perform before a deadline, soonest first
+func printDeadlines(validators []api.MegapoolValidatorDetails) {
+	pending := []api.MegapoolValidatorDetails{}
+	for _, validator := range validators {
+		if validator.Deadline != nil {
+			pending = append(pending, validator)
+		}
+	}
+	if len(pending) == 0 {
+		fmt.Println("None of the megapool's validators have an upcoming deadline.")
+		return
+	}
+	sort.Slice(pending, func(i, j int) bool {
+		return pending[i].Deadline.Deadline.Before(pending[j].Deadline.Deadline)
+	})
+
+	for _, validator := range pending {
+		remaining := time.Until(validator.Deadline.Deadline)
+		color := colorReset
+		if remaining < 24*time.Hour {
+			color = colorRed
+		}
+		fmt.Printf("Validator %d: %s by %s%s%s", validator.ValidatorId, validator.Deadline.Type.Description(), color, validator.Deadline.Deadline.Format(TimeFormat), colorReset)
+		if remaining > 0 {
+			fmt.Printf(" (in %s)\n", remaining.Round(time.Minute))
+		} else {
+			fmt.Printf(" %s(overdue)%s\n", colorRed, colorReset)
+		}
+	}
+}
+
 func getValidatorStatus(c *cli.Context) error {
 	// Get RP client
 	rp, err := rocketpool.NewClientFromCtx(c).WithReady()
@@ -301,6 +340,10 @@ func printValidatorDetails(validator api.MegapoolValidatorDetails, status string
 		fmt.Printf("Beacon status:                %s\n", validator.BeaconStatus.Status)
 	}
 
+	if validator.Deadline != nil {
+		fmt.Printf("Next deadline:                %s (%s)\n", validator.Deadline.Deadline.Format(TimeFormat), validator.Deadline.Type.Description())
+	}
+
 	// Main details
 	if validator.ExpressUsed {
 		fmt.Printf("Express Ticket Used:          yes\n")
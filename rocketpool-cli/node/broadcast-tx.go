@@ -0,0 +1,57 @@
+package node
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/services/wallet/offline"
+	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
+	"github.com/rocket-pool/smartnode/shared/utils/cli/prompt"
+)
+
+func broadcastTransactions(c *cli.Context, signedPath string) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c).WithReady()
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Load the transactions
+	file, err := offline.LoadSignedTransactions(signedPath)
+	if err != nil {
+		return err
+	}
+
+	// Show what's being submitted
+	for i, tx := range file.Transactions {
+		fmt.Printf("Transaction %d (%s, from %s with nonce %d):\n", i+1, tx.Hash.Hex(), tx.From.Hex(), uint64(tx.Nonce))
+		fmt.Print(tx.Summary.String())
+		fmt.Println()
+	}
+	if !(c.Bool("yes") || prompt.Confirm(fmt.Sprintf("Are you sure you want to submit %d transaction(s)?", len(file.Transactions)))) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Submit them in order, waiting for each one so the next can't be rejected for its nonce
+	for i, tx := range file.Transactions {
+		response, err := rp.BroadcastTransaction(tx)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Submitting transaction %d...\n", i+1)
+		cliutils.PrintTransactionHash(rp, response.TxHash)
+		if _, err = rp.WaitForTransaction(response.TxHash); err != nil {
+			return err
+		}
+	}
+
+	// Log & return
+	fmt.Printf("Successfully submitted %d transaction(s).\n", len(file.Transactions))
+	return nil
+
+}
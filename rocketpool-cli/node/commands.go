@@ -54,6 +54,30 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "tx-queue",
+				Aliases:   []string{"tq"},
+				Usage:     "Show the transactions the node daemon has submitted and is tracking",
+				UsageText: "rocketpool node tx-queue [options]",
+				Flags: []cli.Flag{
+					cli.BoolFlag{
+						Name:  "all, a",
+						Usage: "Include transactions that have already been mined, dropped or replaced",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					return getTxQueue(c)
+
+				},
+			},
+
 			{
 				Name:      "register",
 				Aliases:   []string{"r"},
@@ -815,6 +839,30 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "broadcast-tx",
+				Aliases:   []string{"btx"},
+				Usage:     "Submit transactions that were signed offline with `rocketpool wallet sign-tx`",
+				UsageText: "rocketpool node broadcast-tx [-y] signed-file",
+				Flags: []cli.Flag{
+					cli.BoolFlag{
+						Name:  "yes, y",
+						Usage: "Automatically confirm submitting the transactions",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+
+					// Run
+					return broadcastTransactions(c, c.Args().Get(0))
+
+				},
+			},
+
 			{
 				Name:      "sign-message",
 				Aliases:   []string{"sm"},
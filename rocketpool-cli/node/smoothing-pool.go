@@ -56,7 +56,7 @@ func joinSmoothingPool(c *cli.Context) error {
 		return err
 	}
 
-	fmt.Printf("%sNOTE: This process will restart your node's validator client.\nYou may miss an attestation if you are currently scheduled to produce one.%s\n\n", colorYellow, colorReset)
+	fmt.Printf("%sNOTE: This process will change your validator client's fee recipient. If its Keymanager API isn't available, it will be restarted instead.\nYou may miss an attestation if it restarts while you are scheduled to produce one.%s\n\n", colorYellow, colorReset)
 
 	// Prompt for confirmation
 	if !(c.Bool("yes") || prompt.Confirm("Are you sure you want to join the Smoothing Pool?")) {
@@ -73,7 +73,7 @@ func joinSmoothingPool(c *cli.Context) error {
 	fmt.Printf("Joining the Smoothing Pool...\n")
 	cliutils.PrintTransactionHash(rp, response.TxHash)
 	if _, err = rp.WaitForTransaction(response.TxHash); err != nil {
-		return fmt.Errorf("%w\nYour fee recipient will be automatically reset to your node's distributor in a few minutes, and your validator client will restart.", err)
+		return fmt.Errorf("%w\nYour fee recipient will be automatically reset to your node's distributor in a few minutes, and your validator client will be updated.", err)
 	}
 
 	// Log & return
@@ -147,7 +147,7 @@ func leaveSmoothingPool(c *cli.Context) error {
 
 	// Log & return
 	fmt.Println("Successfully left the Smoothing Pool.")
-	fmt.Printf("%sNOTE: Your validator client will restart to change its fee recipient back to your node's distributor once the next Epoch has been finalized.\nYou may miss an attestation when this happens (or multiple if you have Doppelganger Protection enabled); this is normal.%s\n", colorYellow, colorReset)
+	fmt.Printf("%sNOTE: Your validator client's fee recipient will be changed back to your node's distributor once the next Epoch has been finalized.\nIf its Keymanager API isn't available, it will be restarted to do this and you may miss an attestation (or multiple if you have Doppelganger Protection enabled); this is normal.%s\n", colorYellow, colorReset)
 	return nil
 
 }
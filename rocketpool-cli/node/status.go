@@ -407,6 +407,28 @@ func getStatus(c *cli.Context) error {
 		fmt.Println("The node is not registered with Rocket Pool.")
 	}
 
+	// Gas spent by automatic transactions
+	gasSpending := status.GasSpending
+	if len(gasSpending.Duties) > 0 || gasSpending.BudgetWei != nil {
+		fmt.Printf("\n%s=== Automatic Transaction Gas ===%s\n", colorGreen, colorReset)
+		if len(gasSpending.Duties) == 0 {
+			fmt.Printf("The node's automatic transactions haven't spent any gas in %s.\n", gasSpending.Month)
+		} else {
+			fmt.Printf("The node's automatic transactions have spent %.6f ETH on gas in %s:\n", math.RoundDown(eth.WeiToEth(gasSpending.TotalWei), 6), gasSpending.Month)
+			for _, duty := range gasSpending.Duties {
+				fmt.Printf("- %s: %.6f ETH across %d transaction(s)\n", duty.Duty, math.RoundDown(eth.WeiToEth(duty.SpentWei), 6), duty.Transactions)
+			}
+		}
+		if gasSpending.BudgetWei != nil {
+			remaining := new(big.Int).Sub(gasSpending.BudgetWei, gasSpending.TotalWei)
+			if remaining.Sign() > 0 {
+				fmt.Printf("%.6f ETH of the monthly gas budget of %.6f ETH is left.\n", math.RoundDown(eth.WeiToEth(remaining), 6), math.RoundDown(eth.WeiToEth(gasSpending.BudgetWei), 6))
+			} else {
+				fmt.Printf("%sThe monthly gas budget of %.6f ETH has been used up; automatic transactions will wait for their deadlines until next month.%s\n", colorYellow, math.RoundDown(eth.WeiToEth(gasSpending.BudgetWei), 6), colorReset)
+			}
+		}
+	}
+
 	// Alerts
 	if cfg.EnableMetrics.Value == true && len(status.Alerts) > 0 {
 		// only print alerts if enabled; to avoid misleading the user to thinking everything is fine (since we really don't know).
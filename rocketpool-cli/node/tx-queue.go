@@ -0,0 +1,76 @@
+package node
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/bindings/utils/eth"
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
+)
+
+func getTxQueue(c *cli.Context) error {
+
+	// Get RP client
+	rp := rocketpool.NewClientFromCtx(c)
+	defer rp.Close()
+
+	// Get the transaction queue
+	response, err := rp.NodeTxQueue()
+	if err != nil {
+		return err
+	}
+
+	// Filter out resolved transactions unless requested
+	showAll := c.Bool("all")
+	transactions := []*txmanager.ManagedTransaction{}
+	for _, tx := range response.Transactions {
+		if showAll || tx.Status == txmanager.TransactionStatus_Pending {
+			transactions = append(transactions, tx)
+		}
+	}
+
+	if len(transactions) == 0 {
+		if showAll {
+			fmt.Println("The node daemon hasn't submitted any transactions yet.")
+		} else {
+			fmt.Println("The node daemon doesn't have any pending transactions. Use `--all` to see the ones it has already resolved.")
+		}
+		return nil
+	}
+	if !response.UpdatedAt.IsZero() {
+		fmt.Printf("Last updated by the node daemon at %s.\n\n", response.UpdatedAt.Format(time.RFC822))
+	}
+
+	for _, tx := range transactions {
+		statusColor := colorYellow
+		switch tx.Status {
+		case txmanager.TransactionStatus_Confirmed:
+			statusColor = colorGreen
+		case txmanager.TransactionStatus_Reverted, txmanager.TransactionStatus_Dropped, txmanager.TransactionStatus_Replaced:
+			statusColor = colorRed
+		}
+
+		fmt.Printf("%s%s (nonce %d): %s%s\n", statusColor, tx.Task, tx.Nonce, tx.Status, colorReset)
+		fmt.Printf("Latest hash:    %s\n", tx.GetLatestHash().Hex())
+		fmt.Printf("Submitted at:   %s\n", tx.SubmittedAt.Format(time.RFC822))
+		if tx.GasFeeCap != nil && tx.GasTipCap != nil {
+			fmt.Printf("Max fee:        %.2f gwei (%.2f gwei priority fee)\n", eth.WeiToGwei(tx.GasFeeCap), eth.WeiToGwei(tx.GasTipCap))
+		}
+		fmt.Printf("Fee bumps:      %d\n", tx.Bumps)
+		if tx.AtFeeCap {
+			fmt.Printf("%sThe transaction's fees have reached the configured cap and won't be raised any further.%s\n", colorYellow, colorReset)
+		}
+		if tx.Status == txmanager.TransactionStatus_Confirmed || tx.Status == txmanager.TransactionStatus_Reverted {
+			fmt.Printf("Mined in block: %d (%s)\n", tx.BlockNumber, tx.MinedHash.Hex())
+		}
+		if tx.Error != "" {
+			fmt.Printf("Error:          %s\n", tx.Error)
+		}
+		fmt.Println()
+	}
+	return nil
+
+}
@@ -0,0 +1,134 @@
+package pdao
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/smartnode/bindings/types"
+	"github.com/rocket-pool/smartnode/bindings/utils/eth"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/proposals"
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+)
+
+const (
+	colorRed    string = "\033[31m"
+	colorYellow string = "\033[33m"
+)
+
+func auditProposal(c *cli.Context, proposalId uint64) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c).WithReady()
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Audit the proposal
+	fmt.Println("Comparing the proposal's root submissions against your voting trees. This may take a while if the trees need to be built...")
+	response, err := rp.PDAOAuditProposal(proposalId)
+	if err != nil {
+		return err
+	}
+	if response.DoesNotExist {
+		fmt.Printf("Proposal %d does not exist.\n", proposalId)
+		return nil
+	}
+
+	// Print the proposal
+	fmt.Printf("Proposal %d (%s)\n", proposalId, types.ProtocolDaoProposalStates[response.State])
+	fmt.Printf("Proposer:        %s\n", response.ProposerAddress.Hex())
+	fmt.Printf("Target block:    %d\n", response.TargetBlock)
+	fmt.Printf("Depth per round: %d\n\n", response.DepthPerRound)
+	if len(response.Submissions) == 0 {
+		fmt.Println("No root submissions were found for this proposal.")
+		return nil
+	}
+
+	// Print the submissions
+	disagreeing := 0
+	for _, submission := range response.Submissions {
+		fmt.Printf("Index %d (%s), submitted by %s at %s:\n", submission.Index, getTreeName(submission.NodeAddress), submission.Submitter.Hex(), submission.Time.Format(time.RFC822))
+		if len(submission.Mismatches) == 0 {
+			fmt.Printf("\t%sMatches your voting tree.%s\n\n", colorGreen, colorReset)
+			continue
+		}
+
+		disagreeing++
+		fmt.Printf("\t%s%d tree node(s) disagree with your voting tree:%s\n", colorRed, len(submission.Mismatches), colorReset)
+		for _, mismatch := range submission.Mismatches {
+			printTreeNodeMismatch(mismatch.Index, mismatch.NodeAddress, mismatch.Local, mismatch.Submitted)
+			fmt.Printf("\t\tChallenge state: %s\n", types.ChallengeStates[mismatch.ChallengeState])
+		}
+		fmt.Println()
+	}
+
+	if disagreeing == 0 {
+		fmt.Printf("%sAll %d root submissions match your voting trees.%s\n", colorGreen, len(response.Submissions), colorReset)
+	} else {
+		fmt.Printf("%s%d of %d root submissions disagree with your voting trees.%s\n", colorYellow, disagreeing, len(response.Submissions), colorReset)
+		fmt.Println("You can replay the challenge game offline against a proposer's voting info snapshot with the `--local-snapshot` and `--proposer-snapshot` flags.")
+		fmt.Printf("See %s for more information on challenges.\n", challengeLink)
+	}
+	return nil
+
+}
+
+func simulateChallengeGame(localPath string, proposerPath string, depthPerRound uint64) error {
+
+	// Load the snapshots
+	local, err := proposals.LoadVotingInfoSnapshotFile(localPath)
+	if err != nil {
+		return err
+	}
+	proposer, err := proposals.LoadVotingInfoSnapshotFile(proposerPath)
+	if err != nil {
+		return err
+	}
+
+	// Play the game
+	simulation, err := proposals.SimulateChallengeGame(local, proposer, depthPerRound)
+	if err != nil {
+		return fmt.Errorf("error simulating challenge game: %w", err)
+	}
+
+	fmt.Printf("Simulating a challenge game for block %d with %d nodes and a depth per round of %d.\n", simulation.BlockNumber, len(local.Info), simulation.DepthPerRound)
+	fmt.Printf("Local root:    %s (%.6f voting power)\n", simulation.LocalRoot.Hash.Hex(), eth.WeiToEth(simulation.LocalRoot.Sum))
+	fmt.Printf("Proposed root: %s (%.6f voting power)\n\n", simulation.ProposedRoot.Hash.Hex(), eth.WeiToEth(simulation.ProposedRoot.Sum))
+
+	for i, round := range simulation.Rounds {
+		fmt.Printf("Round %d: challenge index %d (%s)\n", i+1, round.ChallengedIndex, getTreeName(round.NodeAddress))
+		fmt.Printf("\tYours:    %.6f voting power, hash %s\n", eth.WeiToEth(round.Local.Sum), round.Local.Hash.Hex())
+		fmt.Printf("\tProposed: %.6f voting power, hash %s\n", eth.WeiToEth(round.Proposed.Sum), round.Proposed.Hash.Hex())
+		fmt.Printf("\tThe proposer's response has %d mismatching tree node(s):\n", len(round.Mismatches))
+		for _, mismatch := range round.Mismatches {
+			printTreeNodeMismatch(mismatch.Index, mismatch.NodeAddress, mismatch.Local, mismatch.Submitted)
+		}
+		fmt.Println()
+	}
+
+	switch simulation.Outcome {
+	case proposals.ChallengeOutcome_NoMismatch:
+		fmt.Printf("%sThe proposer's tree matches your snapshot, so a challenge would fail.%s\n", colorGreen, colorReset)
+	case proposals.ChallengeOutcome_ProposerDefeated:
+		fmt.Printf("%sThe game reached the leaves of a node tree after %d challenge(s). Their voting power is verified on-chain, so the proposer can't respond and the proposal would be defeated (assuming your snapshot matches the chain).%s\n", colorRed, len(simulation.Rounds), colorReset)
+	}
+	return nil
+
+}
+
+// Print a tree node that doesn't match the local voting tree
+func printTreeNodeMismatch(index uint64, nodeAddress *common.Address, local types.VotingTreeNode, submitted types.VotingTreeNode) {
+	fmt.Printf("\t\tIndex %d (%s): yours %.6f, submitted %.6f\n", index, getTreeName(nodeAddress), eth.WeiToEth(local.Sum), eth.WeiToEth(submitted.Sum))
+}
+
+// Get a description of the voting tree a tree node belongs to
+func getTreeName(nodeAddress *common.Address) string {
+	if nodeAddress == nil {
+		return "network tree"
+	}
+	return fmt.Sprintf("node tree of %s", nodeAddress.Hex())
+}
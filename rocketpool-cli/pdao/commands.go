@@ -2,6 +2,7 @@ package pdao
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/urfave/cli"
 
@@ -2722,6 +2723,86 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 						},
 					},
 
+					{
+						Name:      "watch",
+						Aliases:   []string{"w"},
+						Usage:     "Show the open proposals of every DAO and follow the governance timeline tracked by the node daemon",
+						UsageText: "rocketpool pdao proposals watch [options]",
+						Flags: []cli.Flag{
+							cli.DurationFlag{
+								Name:  "interval, i",
+								Usage: "How often to check for new activity",
+								Value: 30 * time.Second,
+							},
+						},
+						Action: func(c *cli.Context) error {
+
+							// Validate args
+							if err := cliutils.ValidateArgCount(c, 0); err != nil {
+								return err
+							}
+							interval := c.Duration("interval")
+							if interval <= 0 {
+								return fmt.Errorf("the interval must be greater than zero")
+							}
+
+							// Run
+							return watchProposals(c, interval)
+
+						},
+					},
+
+					{
+						Name:      "audit",
+						Aliases:   []string{"a"},
+						Usage:     "Verify every root submitted for a proposal against your voting trees, or simulate a challenge game offline",
+						UsageText: "rocketpool pdao proposals audit [options] [proposal-id]",
+						Flags: []cli.Flag{
+							cli.StringFlag{
+								Name:  "local-snapshot, l",
+								Usage: "The path of your voting info snapshot (vi-<block>.json.zst in the voting data folder) to simulate a challenge game offline",
+							},
+							cli.StringFlag{
+								Name:  "proposer-snapshot, p",
+								Usage: "The path of the proposer's voting info snapshot to simulate a challenge game offline",
+							},
+							cli.Uint64Flag{
+								Name:  "depth-per-round, d",
+								Usage: "The depth per round to use when simulating a challenge game",
+								Value: 5,
+							},
+						},
+						Action: func(c *cli.Context) error {
+
+							// Simulate a challenge game from snapshot files without the daemon
+							localSnapshot := c.String("local-snapshot")
+							proposerSnapshot := c.String("proposer-snapshot")
+							if localSnapshot != "" || proposerSnapshot != "" {
+								if localSnapshot == "" || proposerSnapshot == "" {
+									return fmt.Errorf("both --local-snapshot and --proposer-snapshot are required to simulate a challenge game")
+								}
+								if err := cliutils.ValidateArgCount(c, 0); err != nil {
+									return err
+								}
+								return simulateChallengeGame(localSnapshot, proposerSnapshot, c.Uint64("depth-per-round"))
+							}
+
+							// Validate args
+							var err error
+							if err = cliutils.ValidateArgCount(c, 1); err != nil {
+								return err
+							}
+							id, err := cliutils.ValidateUint("proposal-id", c.Args().Get(0))
+							if err != nil {
+								return err
+							}
+
+							// Run
+							return auditProposal(c, id)
+
+						},
+					},
+
 					{
 						Name:      "vote",
 						Aliases:   []string{"v"},
@@ -2849,6 +2930,38 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 					},
 				},
 			},
+
+			{
+				Name:    "policy",
+				Aliases: []string{"y"},
+				Usage:   "Manage the policy the node uses to vote on proposals automatically",
+				Subcommands: []cli.Command{
+
+					{
+						Name:      "test",
+						Aliases:   []string{"t"},
+						Usage:     "Show how your voting policy would vote on the open proposals (or a single proposal) without voting",
+						UsageText: "rocketpool pdao policy test [options]",
+						Flags: []cli.Flag{
+							cli.Uint64Flag{
+								Name:  "proposal, p",
+								Usage: "The ID of a single proposal to test the policy against",
+							},
+						},
+						Action: func(c *cli.Context) error {
+
+							// Validate args
+							if err := cliutils.ValidateArgCount(c, 0); err != nil {
+								return err
+							}
+
+							// Run
+							return testVotingPolicy(c, c.Uint64("proposal"))
+
+						},
+					},
+				},
+			},
 		},
 	})
 }
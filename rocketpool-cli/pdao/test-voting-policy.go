@@ -0,0 +1,63 @@
+package pdao
+
+import (
+	"fmt"
+
+	"github.com/rocket-pool/smartnode/bindings/types"
+	"github.com/rocket-pool/smartnode/bindings/utils/eth"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/governance"
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+)
+
+func testVotingPolicy(c *cli.Context, proposalId uint64) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c).WithReady()
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Test the policy
+	response, err := rp.PDAOTestVotingPolicy(proposalId)
+	if err != nil {
+		return err
+	}
+	if !response.PolicyExists {
+		fmt.Printf("You don't have a voting policy. Create one at %s to have the node vote on proposals automatically.\n", response.PolicyPath)
+		return nil
+	}
+	if response.DoesNotExist {
+		fmt.Printf("Proposal %d does not exist.\n", proposalId)
+		return nil
+	}
+	if response.Enabled {
+		fmt.Printf("Your voting policy at %s is enabled, so the node daemon will cast these votes automatically.\n\n", response.PolicyPath)
+	} else {
+		fmt.Printf("%sYour voting policy at %s is disabled; set `enabled: true` in it to have the node daemon cast these votes automatically.%s\n\n", colorYellow, response.PolicyPath, colorReset)
+	}
+	if len(response.Votes) == 0 {
+		fmt.Println("There are no open proposals to test the policy against.")
+		return nil
+	}
+
+	// Print the votes
+	for _, vote := range response.Votes {
+		fmt.Printf("Proposal %d (%s): %s\n", vote.ProposalID, vote.Phase, vote.Message)
+		fmt.Printf("\tSubjects: %v\n", vote.Decision.Subjects)
+		fmt.Printf("\tPolicy:   %s\n", vote.Decision.Reason)
+		switch vote.Action {
+		case governance.VoteAction_Vote:
+			fmt.Printf("\t%sWould vote %s with %.6f delegated voting power.%s\n", colorGreen, types.VoteDirections[vote.Decision.Direction], eth.WeiToEth(vote.VotingPower), colorReset)
+		case governance.VoteAction_Override:
+			fmt.Printf("\t%sWould override your delegate's vote with a vote %s, using %.6f voting power.%s\n", colorGreen, types.VoteDirections[vote.Decision.Direction], eth.WeiToEth(vote.VotingPower), colorReset)
+		default:
+			fmt.Printf("\tWould not vote: %s.\n", vote.SkipReason)
+		}
+		fmt.Println()
+	}
+	return nil
+
+}
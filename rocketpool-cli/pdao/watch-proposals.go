@@ -0,0 +1,114 @@
+package pdao
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/governance"
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+)
+
+// How many of the most recent events to show when the watch starts
+const recentEventsToShow int = 10
+
+func watchProposals(c *cli.Context, interval time.Duration) error {
+
+	// Get RP client
+	rp, err := rocketpool.NewClientFromCtx(c).WithReady()
+	if err != nil {
+		return err
+	}
+	defer rp.Close()
+
+	// Get the feed
+	response, err := rp.PDAOGovernanceFeed()
+	if err != nil {
+		return err
+	}
+	if response.UpdatedAt.IsZero() {
+		fmt.Println("The node daemon hasn't built the governance feed yet. Make sure it's running, then try again in a few minutes.")
+		return nil
+	}
+
+	// Print the open proposals
+	open := 0
+	for _, proposal := range response.Proposals {
+		if proposal.Phase == governance.Phase_Closed {
+			continue
+		}
+		if open == 0 {
+			fmt.Println("Open proposals:")
+		}
+		open++
+		printWatchedProposal(proposal)
+	}
+	if open == 0 {
+		fmt.Println("There are no open proposals.")
+	}
+	fmt.Println()
+
+	// Print the recent timeline
+	events := response.Events
+	if len(events) > recentEventsToShow {
+		events = events[len(events)-recentEventsToShow:]
+	}
+	if len(events) > 0 {
+		fmt.Println("Recent activity:")
+		for _, event := range events {
+			printGovernanceEvent(event)
+		}
+		fmt.Println()
+	}
+
+	// Poll for new events
+	var lastSeen time.Time
+	if len(response.Events) > 0 {
+		lastSeen = response.Events[len(response.Events)-1].Time
+	}
+	fmt.Printf("Watching for new activity every %s (last updated %s). Press Ctrl+C to stop.\n\n", interval, response.UpdatedAt.Format(time.RFC822))
+	for {
+		time.Sleep(interval)
+		response, err = rp.PDAOGovernanceFeed()
+		if err != nil {
+			return err
+		}
+		for _, event := range response.Events {
+			if !event.Time.After(lastSeen) {
+				continue
+			}
+			printGovernanceEvent(event)
+			lastSeen = event.Time
+		}
+	}
+
+}
+
+// Print an open proposal, highlighting it if the node needs to vote on it
+func printWatchedProposal(proposal governance.TrackedProposal) {
+	line := fmt.Sprintf("\t%s proposal %d (%s): %s", proposal.DAO.GetName(), proposal.ID, proposal.State, proposal.Message)
+	if !proposal.IsVoting() {
+		fmt.Printf("%s - voting starts %s\n", line, proposal.PhaseEnd.Format(time.RFC822))
+		return
+	}
+	if proposal.NeedsVote {
+		fmt.Printf("%s%s - you haven't voted, voting closes %s (use `rocketpool %s proposals vote`)%s\n", colorYellow, line, proposal.PhaseEnd.Format(time.RFC822), proposal.DAO.GetCommand(), colorReset)
+		return
+	}
+	fmt.Printf("%s - voting closes %s\n", line, proposal.PhaseEnd.Format(time.RFC822))
+}
+
+// Print a governance timeline event
+func printGovernanceEvent(event governance.Event) {
+	eventColor := colorReset
+	switch event.Type {
+	case governance.EventType_VoteNeeded, governance.EventType_ClosingSoon:
+		eventColor = colorYellow
+	case governance.EventType_NodeVoted, governance.EventType_DelegateVoted:
+		eventColor = colorGreen
+	case governance.EventType_Created:
+		eventColor = colorBlue
+	}
+	fmt.Printf("%s[%s] %s proposal %d: %s%s\n", eventColor, event.Time.Format(time.RFC822), event.DAO.GetName(), event.ProposalID, event.Details, colorReset)
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
 	"os"
@@ -20,6 +21,7 @@ import (
 	"github.com/rocket-pool/smartnode/rocketpool-cli/service"
 	"github.com/rocket-pool/smartnode/rocketpool-cli/wallet"
 	"github.com/rocket-pool/smartnode/shared"
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
 	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
 )
 
@@ -78,6 +80,10 @@ A special thanks to the Rocket Pool community for all their contributions.
 			Name:  "nonce",
 			Usage: "Use this flag to explicitly specify the nonce that this transaction should use, so it can override an existing 'stuck' transaction",
 		},
+		cli.StringFlag{
+			Name:  "unsigned-out",
+			Usage: "Instead of signing and submitting a transaction, save it unsigned to this `file` so it can be signed offline with `rocketpool wallet sign-tx` and submitted with `rocketpool node broadcast-tx`",
+		},
 		cli.BoolFlag{
 			Name:  "debug",
 			Usage: "Enable debug printing of API commands",
@@ -136,7 +142,8 @@ A special thanks to the Rocket Pool community for all their contributions.
 
 	// Run application
 	fmt.Println("")
-	if err := app.Run(os.Args); err != nil {
+	// Commands stop once a transaction has been saved for offline signing; that isn't an error
+	if err := app.Run(os.Args); err != nil && !errors.Is(err, rocketpool.ErrTransactionSavedForSigning) {
 		cliutils.PrettyPrintError(err)
 	}
 	fmt.Println("")
@@ -156,7 +156,7 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				Name:      "config",
 				Aliases:   []string{"c"},
 				Usage:     "Configure the Rocket Pool service",
-				UsageText: "rocketpool service config",
+				UsageText: "rocketpool service config [command]",
 				Flags:     configFlags,
 				Action: func(c *cli.Context) error {
 
@@ -169,6 +169,71 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 					return configureService(c)
 
 				},
+				Subcommands: []cli.Command{
+					{
+						Name:      "export",
+						Aliases:   []string{"e"},
+						Usage:     "Export the configuration as a commented YAML document",
+						UsageText: "rocketpool service config export [options]",
+						Flags: []cli.Flag{
+							cli.StringFlag{
+								Name:  "file, f",
+								Usage: "The file to write the document to; it's printed to the terminal if this isn't set",
+							},
+						},
+						Action: func(c *cli.Context) error {
+
+							// Validate args
+							if err := cliutils.ValidateArgCount(c, 0); err != nil {
+								return err
+							}
+
+							// Run command
+							return exportConfig(c, c.String("file"))
+
+						},
+					},
+					{
+						Name:      "diff",
+						Aliases:   []string{"d"},
+						Usage:     "Show the settings a config document would change and the containers that would be restarted",
+						UsageText: "rocketpool service config diff file",
+						Action: func(c *cli.Context) error {
+
+							// Validate args
+							if err := cliutils.ValidateArgCount(c, 1); err != nil {
+								return err
+							}
+
+							// Run command
+							return diffConfig(c, c.Args().Get(0))
+
+						},
+					},
+					{
+						Name:      "apply",
+						Aliases:   []string{"a"},
+						Usage:     "Validate a config document, migrate it to the current version and save it as the configuration",
+						UsageText: "rocketpool service config apply [options] file",
+						Flags: []cli.Flag{
+							cli.BoolFlag{
+								Name:  "yes, y",
+								Usage: "Automatically confirm the changes",
+							},
+						},
+						Action: func(c *cli.Context) error {
+
+							// Validate args
+							if err := cliutils.ValidateArgCount(c, 1); err != nil {
+								return err
+							}
+
+							// Run command
+							return applyConfig(c, c.Args().Get(0))
+
+						},
+					},
+				},
 			},
 
 			{
@@ -0,0 +1,177 @@
+package service
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/utils/cli/prompt"
+)
+
+// Write the current configuration to a file, or to stdout if the path is blank
+func exportConfig(c *cli.Context, path string) error {
+
+	// Get RP client
+	rp := rocketpool.NewClientFromCtx(c)
+	defer rp.Close()
+
+	// Load the config
+	cfg, isNew, err := rp.LoadConfig()
+	if err != nil {
+		return fmt.Errorf("error loading user settings: %w", err)
+	}
+	if isNew {
+		fmt.Fprintf(os.Stderr, "%sThe Smart Node hasn't been configured yet, so the default settings will be exported.%s\n", colorYellow, colorReset)
+	}
+
+	// Export it
+	document := config.ExportConfigDocument(cfg)
+	if path == "" {
+		fmt.Print(string(document))
+		return nil
+	}
+	err = os.WriteFile(path, document, 0600)
+	if err != nil {
+		return fmt.Errorf("error writing config document to [%s]: %w", path, err)
+	}
+	fmt.Printf("Exported the configuration to %s.\n", path)
+	return nil
+
+}
+
+// Show the changes a config document would make to the current configuration
+func diffConfig(c *cli.Context, path string) error {
+
+	// Get RP client
+	rp := rocketpool.NewClientFromCtx(c)
+	defer rp.Close()
+
+	// Load the configs
+	current, newCfg, err := loadConfigDocument(rp, path)
+	if err != nil || newCfg == nil {
+		return err
+	}
+
+	// Print the changes
+	printConfigDocumentChanges(c, current, newCfg)
+	return nil
+
+}
+
+// Validate a config document and save it as the current configuration
+func applyConfig(c *cli.Context, path string) error {
+
+	// Get RP client
+	rp := rocketpool.NewClientFromCtx(c)
+	defer rp.Close()
+
+	// Load the configs
+	current, newCfg, err := loadConfigDocument(rp, path)
+	if err != nil || newCfg == nil {
+		return err
+	}
+
+	// Print the changes
+	if !printConfigDocumentChanges(c, current, newCfg) {
+		return nil
+	}
+	if current.Smartnode.Network.Value != newCfg.Smartnode.Network.Value {
+		fmt.Printf("%sThe document changes the network. Changing networks removes your chain data, node wallet and validator keys, so it can only be done with `rocketpool service config`.%s\n", colorRed, colorReset)
+		return nil
+	}
+
+	// Prompt for confirmation
+	if !(c.Bool("yes") || prompt.Confirm("Are you sure you want to apply these changes?")) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Save the config
+	err = rp.SaveConfig(newCfg)
+	if err != nil {
+		return fmt.Errorf("error saving config: %w", err)
+	}
+	fmt.Println("Your changes have been saved!")
+	if c.GlobalIsSet("daemon-path") {
+		fmt.Println("Please restart your daemon service for them to take effect.")
+	} else {
+		fmt.Println("Please run `rocketpool service start` to restart the affected containers.")
+	}
+	return nil
+
+}
+
+// Load the current configuration and the configuration from a config document, printing the document's problems if it isn't valid
+func loadConfigDocument(rp *rocketpool.Client, path string) (*config.RocketPoolConfig, *config.RocketPoolConfig, error) {
+	current, _, err := rp.LoadConfig()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error loading user settings: %w", err)
+	}
+	document, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error reading config document [%s]: %w", path, err)
+	}
+	newCfg, problems, err := config.ParseConfigDocument(document, current)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(problems) > 0 {
+		fmt.Printf("%sThe config document at %s isn't valid:%s\n", colorRed, path, colorReset)
+		for _, problem := range problems {
+			fmt.Printf("\t- %s\n", problem)
+		}
+		return nil, nil, fmt.Errorf("the config document has %d problem(s)", len(problems))
+	}
+	printValidationIssues(newCfg.ValidateRules(), "apply it")
+	return current, newCfg, nil
+}
+
+// Print the settings a new configuration changes and the containers that have to be restarted; returns false if nothing changed
+func printConfigDocumentChanges(c *cli.Context, current *config.RocketPoolConfig, newCfg *config.RocketPoolConfig) bool {
+	changedSettings, containers, _ := newCfg.GetChanges(current)
+
+	// Print the settings
+	sections := make([]string, 0, len(changedSettings))
+	for section, settings := range changedSettings {
+		if len(settings) > 0 {
+			sections = append(sections, section)
+		}
+	}
+	if len(sections) == 0 {
+		fmt.Println("The config document doesn't change any settings.")
+		return false
+	}
+	sort.Strings(sections)
+	for _, section := range sections {
+		fmt.Printf("%s%s%s\n", colorGreen, section, colorReset)
+		for _, setting := range changedSettings[section] {
+			fmt.Printf("\t%s: %s => %s\n", setting.Name, setting.OldValue, setting.NewValue)
+		}
+	}
+	fmt.Println()
+
+	// Print the containers
+	if len(containers) == 0 {
+		fmt.Println("No containers need to be restarted.")
+		return true
+	}
+	containerNames := make([]string, 0, len(containers))
+	for container := range containers {
+		containerNames = append(containerNames, string(container))
+	}
+	sort.Strings(containerNames)
+	if c.GlobalIsSet("daemon-path") {
+		fmt.Println("The daemon service needs to be restarted for the changes to take effect.")
+		return true
+	}
+	prefix := fmt.Sprint(newCfg.Smartnode.ProjectName.Value)
+	fmt.Println("The following containers must be restarted for the changes to take effect:")
+	for _, container := range containerNames {
+		fmt.Printf("\t%s_%s\n", prefix, container)
+	}
+	return true
+}
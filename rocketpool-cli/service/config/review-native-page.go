@@ -33,7 +33,8 @@ func NewReviewNativePage(md *mainDisplay, oldConfig *config.RocketPoolConfig, ne
 	changeBox.SetBorderPadding(0, 0, 1, 1)
 
 	builder := strings.Builder{}
-	errors := newConfig.Validate()
+	issues := newConfig.ValidateRules()
+	errors := getValidationErrors(issues)
 	if len(errors) > 0 {
 		builder.WriteString("[orange]WARNING: Your configuration encountered errors. You must correct the following in order to save it:\n\n")
 		for _, err := range errors {
@@ -56,6 +57,7 @@ func NewReviewNativePage(md *mainDisplay, oldConfig *config.RocketPoolConfig, ne
 		if builder.String() == "" {
 			builder.WriteString("<No changes>")
 		}
+		writeValidationWarnings(&builder, issues)
 	}
 	changeBox.SetText(builder.String())
 
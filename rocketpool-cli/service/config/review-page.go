@@ -38,7 +38,8 @@ func NewReviewPage(md *mainDisplay, oldConfig *config.RocketPoolConfig, newConfi
 	changeBox.SetBorderPadding(0, 0, 1, 1)
 
 	builder := strings.Builder{}
-	errors := newConfig.Validate()
+	issues := newConfig.ValidateRules()
+	errors := getValidationErrors(issues)
 	if len(errors) > 0 {
 		builder.WriteString("[orange]WARNING: Your configuration encountered errors. You must correct the following in order to save it:\n\n")
 		for _, err := range errors {
@@ -78,6 +79,7 @@ func NewReviewPage(md *mainDisplay, oldConfig *config.RocketPoolConfig, newConfi
 				containersToRestart = append(containersToRestart, container)
 			}
 		}
+		writeValidationWarnings(&builder, issues)
 	}
 
 	changeBox.SetText(builder.String())
@@ -223,3 +225,28 @@ func NewReviewPage(md *mainDisplay, oldConfig *config.RocketPoolConfig, newConfi
 	}
 
 }
+
+// Get the errors found by the validation rules, with the parameters they involve
+func getValidationErrors(issues []config.ValidationIssue) []string {
+	errors := []string{}
+	for _, issue := range issues {
+		if issue.Severity == config.ValidationSeverity_Error {
+			errors = append(errors, issue.String())
+		}
+	}
+	return errors
+}
+
+// Write the warnings found by the validation rules below the changes
+func writeValidationWarnings(builder *strings.Builder, issues []config.ValidationIssue) {
+	warnings := config.GetValidationMessages(issues, config.ValidationSeverity_Warning)
+	if len(warnings) == 0 {
+		return
+	}
+	builder.WriteString("\n\n[yellow]Your configuration can be saved, but please check the following:[-]\n\n")
+	for _, issue := range issues {
+		if issue.Severity == config.ValidationSeverity_Warning {
+			builder.WriteString(fmt.Sprintf("%s\n\n", issue.String()))
+		}
+	}
+}
@@ -7,51 +7,42 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services/config"
 )
 
+// The fixed (non-toggle) alerting parameters shown in Native mode
 var alertingParametersNativeMode map[string]interface{} = map[string]interface{}{
-	"enableAlerting":                           nil,
-	"nativeModeHost":                           nil,
-	"nativeModePort":                           nil,
-	"discordWebhookURL":                        nil,
-	"pushoverToken":                            nil,
-	"pushoverUserKey":                          nil,
-	"alertEnabled_FeeRecipientChanged":         nil,
-	"alertEnabled_MinipoolBondReduced":         nil,
-	"alertEnabled_MinipoolBalanceDistributed":  nil,
-	"alertEnabled_MinipoolPromoted":            nil,
-	"alertEnabled_MinipoolStaked":              nil,
-	"alertEnabled_ExecutionClientSyncComplete": nil,
-	"alertEnabled_BeaconClientSyncComplete":    nil,
-	"alertEnabled_LowETHBalance":               nil,
-	"lowETHBalanceThreshold":                   nil,
+	"enableAlerting":               nil,
+	"nativeModeHost":               nil,
+	"nativeModePort":               nil,
+	"discordWebhookURL":            nil,
+	"pushoverToken":                nil,
+	"pushoverUserKey":              nil,
+	"lowETHBalanceThreshold":       nil,
+	"megapoolDeadlineWarningHours": nil,
+	"governanceVoteReminderHours":  nil,
 }
 
+// The fixed (non-toggle) alerting parameters shown in Docker mode
 var alertingParametersDockerMode map[string]interface{} = map[string]interface{}{
-	"enableAlerting":                           nil,
-	"port":                                     nil,
-	"openPort":                                 nil,
-	"containerTag":                             nil,
-	"discordWebhookURL":                        nil,
-	"pushoverToken":                            nil,
-	"pushoverUserKey":                          nil,
-	"alertEnabled_ClientSyncStatusBeacon":      nil,
-	"alertEnabled_ClientSyncStatusExecution":   nil,
-	"alertEnabled_UpcomingSyncCommittee":       nil,
-	"alertEnabled_ActiveSyncCommittee":         nil,
-	"alertEnabled_UpcomingProposal":            nil,
-	"alertEnabled_RecentProposal":              nil,
-	"alertEnabled_LowDiskSpaceWarning":         nil,
-	"alertEnabled_LowDiskSpaceCritical":        nil,
-	"alertEnabled_OSUpdatesAvailable":          nil,
-	"alertEnabled_RPUpdatesAvailable":          nil,
-	"alertEnabled_FeeRecipientChanged":         nil,
-	"alertEnabled_MinipoolBondReduced":         nil,
-	"alertEnabled_MinipoolBalanceDistributed":  nil,
-	"alertEnabled_MinipoolPromoted":            nil,
-	"alertEnabled_MinipoolStaked":              nil,
-	"alertEnabled_ExecutionClientSyncComplete": nil,
-	"alertEnabled_BeaconClientSyncComplete":    nil,
-	"alertEnabled_LowETHBalance":               nil,
-	"lowETHBalanceThreshold":                   nil,
+	"enableAlerting":               nil,
+	"port":                         nil,
+	"openPort":                     nil,
+	"containerTag":                 nil,
+	"discordWebhookURL":            nil,
+	"pushoverToken":                nil,
+	"pushoverUserKey":              nil,
+	"lowETHBalanceThreshold":       nil,
+	"megapoolDeadlineWarningHours": nil,
+	"governanceVoteReminderHours":  nil,
+}
+
+func init() {
+	// Add the toggles for every registered alert
+	for _, definition := range config.AlertDefinitions {
+		toggleID := config.GetAlertToggleID(definition.ID)
+		alertingParametersDockerMode[toggleID] = nil
+		if definition.NativeMode {
+			alertingParametersNativeMode[toggleID] = nil
+		}
+	}
 }
 
 // The page wrapper for the alerting config
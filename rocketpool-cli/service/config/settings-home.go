@@ -21,6 +21,7 @@ type settingsHome struct {
 	mevBoostPage     *MevBoostConfigPage
 	metricsPage      *MetricsConfigPage
 	alertingPage     *AlertingConfigPage
+	notifyPage       *NotificationsConfigPage
 	addonsPage       *AddonsPage
 	categoryList     *tview.List
 	settingsSubpages []settingsPage
@@ -47,6 +48,7 @@ func newSettingsHome(md *mainDisplay) *settingsHome {
 	home.mevBoostPage = NewMevBoostConfigPage(home)
 	home.metricsPage = NewMetricsConfigPage(home)
 	home.alertingPage = NewAlertingConfigPage(home)
+	home.notifyPage = NewNotificationsConfigPage(home)
 	home.addonsPage = NewAddonsPage(home)
 	settingsSubpages := []settingsPage{
 		home.smartnodePage,
@@ -56,6 +58,7 @@ func newSettingsHome(md *mainDisplay) *settingsHome {
 		home.mevBoostPage,
 		home.metricsPage,
 		home.alertingPage,
+		home.notifyPage,
 		home.addonsPage,
 	}
 	home.settingsSubpages = settingsSubpages
@@ -241,4 +244,8 @@ func (home *settingsHome) refresh() {
 	if home.alertingPage != nil {
 		home.alertingPage.layout.refresh()
 	}
+
+	if home.notifyPage != nil {
+		home.notifyPage.layout.refresh()
+	}
 }
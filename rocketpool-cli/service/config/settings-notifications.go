@@ -0,0 +1,86 @@
+package config
+
+import (
+	"github.com/rocket-pool/smartnode/shared/services/config"
+)
+
+// The page wrapper for the notification sinks config
+type NotificationsConfigPage struct {
+	mainDisplay       *mainDisplay
+	homePage          *page
+	page              *page
+	layout            *standardLayout
+	masterConfig      *config.RocketPoolConfig
+	notificationItems []*parameterizedFormItem
+}
+
+// Creates a new page for the notification settings
+func NewNotificationsConfigPage(home *settingsHome) *NotificationsConfigPage {
+	configPage := &NotificationsConfigPage{
+		mainDisplay:  home.md,
+		homePage:     home.homePage,
+		masterConfig: home.md.Config,
+	}
+
+	configPage.createContent()
+	configPage.initPage(false)
+
+	return configPage
+}
+
+// Creates a new page for the notification settings in Native mode
+func NewNotificationsConfigPageForNative(home *settingsNativeHome) *NotificationsConfigPage {
+	configPage := &NotificationsConfigPage{
+		mainDisplay:  home.md,
+		homePage:     home.homePage,
+		masterConfig: home.md.Config,
+	}
+
+	configPage.createContent()
+	configPage.initPage(true)
+
+	return configPage
+}
+
+func (configPage *NotificationsConfigPage) initPage(isNative bool) {
+	id := "settings-notifications"
+	if isNative {
+		id = "settings-notifications-native"
+	}
+	configPage.page = newPage(
+		configPage.homePage,
+		id,
+		"Notifications",
+		"Select this to send the Smartnode's alerts to a webhook, an email address, or an ntfy / Gotify push server. These work without Prometheus or Alertmanager.",
+		configPage.layout.grid,
+	)
+}
+
+func (configPage *NotificationsConfigPage) getPage() *page {
+	return configPage.page
+}
+
+// Creates the content for the notification settings page
+func (configPage *NotificationsConfigPage) createContent() {
+
+	// Create the layout
+	configPage.layout = newStandardLayout()
+	configPage.layout.createForm(&configPage.masterConfig.Smartnode.Network, "Notification Settings")
+	configPage.layout.setupEscapeReturnHomeHandler(configPage.mainDisplay, configPage.homePage)
+
+	// Set up the form items
+	configPage.notificationItems = createParameterizedFormItems(configPage.masterConfig.Notifications.GetParameters(), configPage.layout.descriptionBox)
+
+	// Map the parameters to the form items in the layout
+	configPage.layout.mapParameterizedFormItems(configPage.notificationItems...)
+
+	// Do the initial draw
+	configPage.handleLayoutChanged()
+}
+
+// Redraw the form
+func (configPage *NotificationsConfigPage) handleLayoutChanged() {
+	configPage.layout.form.Clear(true)
+	configPage.layout.addFormItems(configPage.notificationItems)
+	configPage.layout.refresh()
+}
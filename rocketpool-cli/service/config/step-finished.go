@@ -65,7 +65,8 @@ func createFinishedStep(wiz *wizard, currentStep int, totalSteps int) *choiceWiz
 
 // Processes a configuration after saving and exiting without looking at the review screen
 func processConfigAfterQuit(md *mainDisplay) {
-	errors := md.Config.Validate()
+	issues := md.Config.ValidateRules()
+	errors := getValidationErrors(issues)
 	if len(errors) > 0 {
 		builder := strings.Builder{}
 		builder.WriteString("[orange]WARNING: Your configuration encountered errors. You must correct the following in order to save it:\n\n")
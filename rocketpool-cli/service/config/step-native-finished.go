@@ -64,7 +64,8 @@ func createNativeFinishedStep(wiz *wizard, currentStep int, totalSteps int) *cho
 
 // Processes a configuration after saving and exiting without looking at the review screen
 func processConfigAfterQuitNative(md *mainDisplay) {
-	errors := md.Config.Validate()
+	issues := md.Config.ValidateRules()
+	errors := getValidationErrors(issues)
 	if len(errors) > 0 {
 		builder := strings.Builder{}
 		builder.WriteString("[orange]WARNING: Your configuration encountered errors. You must correct the following in order to save it:\n\n")
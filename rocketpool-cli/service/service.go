@@ -18,6 +18,7 @@ import (
 	"github.com/rocket-pool/smartnode/shared"
 	"github.com/rocket-pool/smartnode/shared/services/config"
 	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/types/api"
 	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
 	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
 	"github.com/rocket-pool/smartnode/shared/utils/cli/prompt"
@@ -180,8 +181,47 @@ func serviceStatus(c *cli.Context) error {
 	}
 
 	// Print service status
-	return rp.PrintServiceStatus(getComposeFiles(c))
+	err = rp.PrintServiceStatus(getComposeFiles(c))
+	if err != nil {
+		return err
+	}
+
+	// Print the health of the Beacon endpoints the Smartnode can use
+	status, err := rp.GetClientStatus()
+	if err != nil {
+		fmt.Printf("\nCould not get the status of your Beacon endpoints: %s\n", err.Error())
+		return nil
+	}
+	printBeaconEndpoints(status.BcManagerStatus.Endpoints)
+	return nil
+
+}
 
+// Print the health score of each Beacon endpoint
+func printBeaconEndpoints(endpoints []api.ClientEndpointStatus) {
+	if len(endpoints) == 0 {
+		return
+	}
+
+	fmt.Println()
+	fmt.Println("Beacon endpoints:")
+	for _, endpoint := range endpoints {
+		marker := " "
+		if endpoint.Selected {
+			marker = "*"
+		}
+		fmt.Printf("%s %-28s score %3.0f", marker, endpoint.Name, endpoint.Score)
+		if endpoint.Error != "" {
+			fmt.Printf("  %sunavailable (%s)%s\n", colorRed, endpoint.Error, colorReset)
+			continue
+		}
+		fmt.Printf("  sync distance %d, head lag %d, error rate %.0f%%, latency %s", endpoint.SyncDistance, endpoint.HeadLag, endpoint.ErrorRate*100, endpoint.Latency.Round(time.Millisecond))
+		if endpoint.Demoted {
+			fmt.Printf("  %sdemoted%s", colorYellow, colorReset)
+		}
+		fmt.Println()
+	}
+	fmt.Println("(* = currently in use)")
 }
 
 // Configure the service
@@ -234,6 +274,9 @@ func configureService(c *cli.Context) error {
 		if err != nil {
 			return fmt.Errorf("error updating config from provided arguments: %w", err)
 		}
+		if printValidationIssues(cfg.ValidateRules(), "save it") {
+			return fmt.Errorf("the configuration is not valid")
+		}
 		return rp.SaveConfig(cfg)
 	}
 
@@ -333,6 +376,28 @@ func configureService(c *cli.Context) error {
 	return err
 }
 
+// Print the errors and warnings found by the config validation rules; returns true if there are errors that prevent the action
+func printValidationIssues(issues []config.ValidationIssue, action string) bool {
+	errors := []config.ValidationIssue{}
+	for _, issue := range issues {
+		if issue.Severity == config.ValidationSeverity_Warning {
+			fmt.Printf("%sWARNING: %s%s\n\n", colorYellow, issue.String(), colorReset)
+		} else {
+			errors = append(errors, issue)
+		}
+	}
+	if len(errors) == 0 {
+		return false
+	}
+
+	fmt.Printf("%sYour configuration encountered errors. You must correct the following in order to %s:\n\n", colorRed, action)
+	for _, issue := range errors {
+		fmt.Printf("%s\n\n", issue.String())
+	}
+	fmt.Println(colorReset)
+	return true
+}
+
 // Updates a configuration from the provided CLI arguments headlessly
 func configureHeadless(c *cli.Context, cfg *config.RocketPoolConfig) error {
 
@@ -533,13 +598,7 @@ func startService(c *cli.Context, ignoreConfigSuggestion bool) error {
 	}
 
 	// Validate the config
-	errors := cfg.Validate()
-	if len(errors) > 0 {
-		fmt.Printf("%sYour configuration encountered errors. You must correct the following in order to start Rocket Pool:\n\n", colorRed)
-		for _, err := range errors {
-			fmt.Printf("%s\n\n", err)
-		}
-		fmt.Println(colorReset)
+	if printValidationIssues(cfg.ValidateRules(), "start Rocket Pool") {
 		return nil
 	}
 
@@ -34,6 +34,65 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "unlock",
+				Aliases:   []string{"u"},
+				Usage:     "Unlock the node wallet after the node daemon starts, if its password isn't stored",
+				UsageText: "rocketpool wallet unlock [options]",
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "password, p",
+						Usage: "The node wallet's password",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Validate flags
+					if c.String("password") != "" {
+						if _, err := cliutils.ValidateNodePassword("password", c.String("password")); err != nil {
+							return err
+						}
+					}
+
+					// Run
+					return unlockWallet(c)
+
+				},
+			},
+
+			{
+				Name:      "sign-tx",
+				Aliases:   []string{"stx"},
+				Usage:     "Sign transactions that were prepared with `--unsigned-out`. This doesn't need the Execution or Consensus clients, so it can be run on an offline machine.",
+				UsageText: "rocketpool wallet sign-tx [options] unsigned-file",
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "out, o",
+						Usage: "The `path` to save the signed transactions to (defaults to the unsigned file's name with '-signed' appended)",
+					},
+					cli.BoolFlag{
+						Name:  "yes, y",
+						Usage: "Automatically confirm signing the transactions",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+
+					// Run
+					return signTransactions(c, c.Args().Get(0))
+
+				},
+			},
+
 			{
 				Name:      "init",
 				Aliases:   []string{"i"},
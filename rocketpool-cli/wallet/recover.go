@@ -36,6 +36,10 @@ func recoverWallet(c *cli.Context) error {
 		fmt.Println("The node wallet is already initialized.")
 		return nil
 	}
+	if status.WalletLocked {
+		fmt.Println("The node wallet is already initialized, but it is locked. Please run 'rocketpool wallet unlock' to unlock it.")
+		return nil
+	}
 
 	// Prompt a notice about test recovery
 	fmt.Printf("%sNOTE:\nThis command will fully regenerate your node wallet's private key and (unless explicitly disabled) the validator keys for your minipools.\nIf you just want to test recovery to ensure it works without actually regenerating the files, please use `rocketpool wallet test-recovery` instead.%s\n\n", colorYellow, colorReset)
@@ -127,6 +131,9 @@ func recoverWallet(c *cli.Context) error {
 				for _, key := range response.ValidatorKeys {
 					fmt.Println(key.Hex())
 				}
+				if response.ValidatorKeysLoaded {
+					fmt.Println("Your Validator Client has loaded these keys.")
+				}
 			} else {
 				fmt.Println("No validator keys were found.")
 			}
@@ -169,6 +176,9 @@ func recoverWallet(c *cli.Context) error {
 				for _, key := range response.ValidatorKeys {
 					fmt.Println(key.Hex())
 				}
+				if response.ValidatorKeysLoaded {
+					fmt.Println("Your Validator Client has loaded these keys.")
+				}
 			} else {
 				fmt.Println("No validator keys were found.")
 			}
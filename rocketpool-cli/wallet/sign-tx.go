@@ -0,0 +1,83 @@
+package wallet
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/services/wallet/offline"
+	"github.com/rocket-pool/smartnode/shared/utils/cli/prompt"
+)
+
+func signTransactions(c *cli.Context, unsignedPath string) error {
+
+	// Get RP client; this doesn't need the Execution or Consensus clients, so it works on an offline machine
+	rp := rocketpool.NewClientFromCtx(c)
+	defer rp.Close()
+
+	// Get & check wallet status
+	status, err := rp.WalletStatus()
+	if err != nil {
+		return err
+	}
+	if status.WalletLocked {
+		fmt.Println("The node wallet is locked. Please run `rocketpool wallet unlock` first.")
+		return nil
+	}
+	if !status.WalletInitialized {
+		fmt.Println("The node wallet is not initialized.")
+		return nil
+	}
+
+	// Load the transactions
+	file, err := offline.LoadUnsignedTransactions(unsignedPath)
+	if err != nil {
+		return err
+	}
+	for i, tx := range file.Transactions {
+		if tx.From != status.NodeAddress {
+			return fmt.Errorf("transaction %d is from %s, but this node wallet's address is %s", i+1, tx.From.Hex(), status.NodeAddress.Hex())
+		}
+	}
+
+	// Show what's being signed
+	for i, tx := range file.Transactions {
+		fmt.Printf("Transaction %d (chain ID %s, nonce %d, gas limit %d, max fee %s wei, max priority fee %s wei):\n", i+1, tx.ChainID.ToInt().String(), uint64(tx.Nonce), uint64(tx.Gas), tx.MaxFeePerGas.ToInt().String(), tx.MaxPriorityFeePerGas.ToInt().String())
+		fmt.Print(tx.Summary.String())
+		fmt.Println()
+	}
+	if !(c.Bool("yes") || prompt.Confirm(fmt.Sprintf("Are you sure you want to sign %d transaction(s) with your node key?", len(file.Transactions)))) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Sign them
+	signedTxs := []offline.SignedTransaction{}
+	for i, tx := range file.Transactions {
+		response, err := rp.SignTransaction(tx)
+		if err != nil {
+			return err
+		}
+		signedTx, err := tx.VerifySigned(response.SignedTx)
+		if err != nil {
+			return fmt.Errorf("error verifying signed transaction %d: %w", i+1, err)
+		}
+		signedTxs = append(signedTxs, signedTx)
+	}
+
+	// Save them
+	signedPath := c.String("out")
+	if signedPath == "" {
+		signedPath = strings.TrimSuffix(unsignedPath, ".json") + "-signed.json"
+	}
+	if err := offline.SaveSignedTransactions(signedPath, signedTxs); err != nil {
+		return err
+	}
+
+	fmt.Printf("Signed %d transaction(s) and saved them to %s.\n", len(signedTxs), signedPath)
+	fmt.Println("Copy it back to your online node and run `rocketpool node broadcast-tx` to submit it.")
+	return nil
+
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/urfave/cli"
 
+	"github.com/rocket-pool/smartnode/shared/services/passwords"
 	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
 	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
 )
@@ -47,16 +48,29 @@ func getStatus(c *cli.Context) error {
 		}
 	} else {
 		// Not Masquerading
+		if status.WalletLocked {
+			fmt.Printf("%sThe node wallet is locked.%s Its password isn't stored, so the node won't perform any duties until you run 'rocketpool wallet unlock'.", colorYellow, colorReset)
+		}
 		if status.WalletInitialized {
 			fmt.Println("The node wallet is initialized")
 			fmt.Printf("Wallet Address: %s", status.AccountAddress)
 		}
-		if !status.WalletInitialized {
+		if !status.WalletInitialized && !status.WalletLocked {
 			fmt.Print("The node wallet has not been initialized.")
 		}
 	}
 
 	fmt.Println()
+
+	// Password storage
+	switch status.PasswordStorage {
+	case passwords.StorageMode_Sealed:
+		fmt.Println("The wallet password is stored sealed with a key encryption key.")
+	case passwords.StorageMode_Unlock:
+		if status.WalletInitialized {
+			fmt.Println("The node wallet is unlocked; its password isn't stored, so you'll need to unlock it again whenever the node daemon restarts.")
+		}
+	}
 	return nil
 
 }
@@ -0,0 +1,50 @@
+package wallet
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/passwords"
+	"github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	promptcli "github.com/rocket-pool/smartnode/shared/utils/cli/prompt"
+)
+
+func unlockWallet(c *cli.Context) error {
+
+	// Get RP client
+	rp := rocketpool.NewClientFromCtx(c)
+	defer rp.Close()
+
+	// Get & check wallet status
+	status, err := rp.WalletStatus()
+	if err != nil {
+		return err
+	}
+	if status.PasswordStorage != passwords.StorageMode_Unlock {
+		fmt.Println("The node wallet's password is stored, so it doesn't need to be unlocked.")
+		return nil
+	}
+	if !status.WalletLocked {
+		if status.WalletInitialized {
+			fmt.Println("The node wallet is already unlocked.")
+		} else {
+			fmt.Println("The node wallet has not been initialized. Please run 'rocketpool wallet init' or 'rocketpool wallet recover' first.")
+		}
+		return nil
+	}
+
+	// Get the password
+	password := c.String("password")
+	if password == "" {
+		password = promptcli.PromptPassword("Please enter the node wallet's password:", "^.*$", "")
+	}
+
+	// Unlock it
+	if _, err := rp.UnlockWallet(password); err != nil {
+		return err
+	}
+	fmt.Println("The node wallet was successfully unlocked. The node daemon will resume its duties shortly.")
+	return nil
+
+}
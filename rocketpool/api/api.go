@@ -97,7 +97,7 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 			}
 
 			// Run
-			api.PrintResponse(waitForTransaction(c, hash))
+			api.Output(c).PrintResponse(waitForTransaction(c, hash))
 			return nil
 		},
 	})
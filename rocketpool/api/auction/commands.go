@@ -28,7 +28,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getStatus(c))
+					api.Output(c).PrintResponse(getStatus(c))
 					return nil
 
 				},
@@ -47,7 +47,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getLots(c))
+					api.Output(c).PrintResponse(getLots(c))
 					return nil
 
 				},
@@ -65,7 +65,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canCreateLot(c))
+					api.Output(c).PrintResponse(canCreateLot(c))
 					return nil
 
 				},
@@ -83,7 +83,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(createLot(c))
+					api.Output(c).PrintResponse(createLot(c))
 					return nil
 
 				},
@@ -109,7 +109,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canBidOnLot(c, lotIndex, amountWei))
+					api.Output(c).PrintResponse(canBidOnLot(c, lotIndex, amountWei))
 					return nil
 
 				},
@@ -135,7 +135,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(bidOnLot(c, lotIndex, amountWei))
+					api.Output(c).PrintResponse(bidOnLot(c, lotIndex, amountWei))
 					return nil
 
 				},
@@ -157,7 +157,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canClaimFromLot(c, lotIndex))
+					api.Output(c).PrintResponse(canClaimFromLot(c, lotIndex))
 					return nil
 
 				},
@@ -179,7 +179,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(claimFromLot(c, lotIndex))
+					api.Output(c).PrintResponse(claimFromLot(c, lotIndex))
 					return nil
 
 				},
@@ -201,7 +201,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canRecoverRplFromLot(c, lotIndex))
+					api.Output(c).PrintResponse(canRecoverRplFromLot(c, lotIndex))
 					return nil
 
 				},
@@ -223,7 +223,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(recoverRplFromLot(c, lotIndex))
+					api.Output(c).PrintResponse(recoverRplFromLot(c, lotIndex))
 					return nil
 
 				},
@@ -5,6 +5,7 @@ import (
 
 	"github.com/urfave/cli"
 
+	"github.com/rocket-pool/smartnode/shared/utils/api"
 	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
 )
 
@@ -94,6 +95,65 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 
 				},
 			},
+			{
+				Name:      "daemon-state",
+				Aliases:   []string{"s"},
+				Usage:     "Returns the duty records the node and watchtower daemons have saved, optionally filtered to a single duty",
+				UsageText: "rocketpool api debug daemon-state [duty]",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if len(c.Args()) > 1 {
+						return cliutils.ValidateArgCount(c, 1)
+					}
+
+					// Run
+					api.Output(c).PrintResponse(getDaemonState(c, c.Args().Get(0)))
+					return nil
+
+				},
+			},
+			{
+				Name:      "state",
+				Usage:     "Returns the network state at a slot, served from a saved snapshot when there is one; with no slot, lists the saved snapshots",
+				UsageText: "rocketpool api debug state [--slot slot-number]",
+				Flags: []cli.Flag{
+					cli.Uint64Flag{
+						Name:  "slot",
+						Usage: "The Beacon slot to get the network state for",
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.Output(c).PrintResponse(getNetworkState(c, c.Uint64("slot")))
+					return nil
+
+				},
+			},
+			{
+				Name:      "clear-daemon-state",
+				Aliases:   []string{"c"},
+				Usage:     "Deletes saved duty records so the daemons will attempt those duties again; with no arguments, every record is deleted",
+				UsageText: "rocketpool api debug clear-daemon-state [duty [key]]",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if len(c.Args()) > 2 {
+						return cliutils.ValidateArgCount(c, 2)
+					}
+
+					// Run
+					api.Output(c).PrintResponse(clearDaemonState(c, c.Args().Get(0), c.Args().Get(1)))
+					return nil
+
+				},
+			},
 		},
 	})
 }
@@ -0,0 +1,53 @@
+package debug
+
+import (
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func getDaemonState(c *cli.Context, duty string) (*api.DaemonStateResponse, error) {
+
+	// Get services
+	dutyStore, err := services.GetDutyStore(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.DaemonStateResponse{}
+	response.Path = dutyStore.GetPath()
+
+	// Get the records
+	response.Records, err = dutyStore.List(duty)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+func clearDaemonState(c *cli.Context, duty string, key string) (*api.ClearDaemonStateResponse, error) {
+
+	// Get services
+	dutyStore, err := services.GetDutyStore(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.ClearDaemonStateResponse{}
+
+	// Delete the records
+	response.Deleted, err = dutyStore.Clear(duty, key)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return response
+	return &response, nil
+
+}
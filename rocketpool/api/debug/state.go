@@ -0,0 +1,66 @@
+package debug
+
+import (
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func getNetworkState(c *cli.Context, slot uint64) (*api.DebugStateResponse, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	snapshots, err := services.GetSnapshotStore(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.DebugStateResponse{}
+	response.Path = cfg.Smartnode.GetStateSnapshotsPath()
+	response.Snapshots, err = snapshots.List()
+	if err != nil {
+		return nil, err
+	}
+
+	// Without a slot, only list the saved snapshots
+	if slot == 0 {
+		return &response, nil
+	}
+
+	// Rebuilding the state needs synced clients, but a saved snapshot doesn't
+	if !snapshots.Has(slot) {
+		if err := services.RequireEthClientSynced(c); err != nil {
+			return nil, err
+		}
+		if err := services.RequireBeaconClientSynced(c); err != nil {
+			return nil, err
+		}
+	}
+
+	// Get the state, preferring a saved snapshot over rebuilding it
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+	m := state.NewNetworkStateManager(rp, cfg.Smartnode.GetStateManagerContracts(), bc, nil)
+	m.SetSnapshotStore(snapshots)
+	response.Slot = slot
+	response.State, response.FromSnapshot, err = m.GetStateForSlotWithSource(slot)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return response
+	return &response, nil
+
+}
@@ -26,7 +26,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canDeployMegapool(c))
+					api.Output(c).PrintResponse(canDeployMegapool(c))
 					return nil
 
 				},
@@ -44,7 +44,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(deployMegapool(c))
+					api.Output(c).PrintResponse(deployMegapool(c))
 					return nil
 
 				},
@@ -61,7 +61,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canDistributeMegapool(c))
+					api.Output(c).PrintResponse(canDistributeMegapool(c))
 					return nil
 
 				},
@@ -79,7 +79,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(distributeMegapool(c))
+					api.Output(c).PrintResponse(distributeMegapool(c))
 					return nil
 
 				},
@@ -97,7 +97,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getStatus(c))
+					api.Output(c).PrintResponse(getStatus(c))
 					return nil
 
 				},
@@ -115,7 +115,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getValidatorMapAndBalances(c))
+					api.Output(c).PrintResponse(getValidatorMapAndBalances(c))
 					return nil
 
 				},
@@ -138,7 +138,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canRepayDebt(c, amount))
+					api.Output(c).PrintResponse(canRepayDebt(c, amount))
 					return nil
 
 				},
@@ -162,7 +162,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(repayDebt(c, amount))
+					api.Output(c).PrintResponse(repayDebt(c, amount))
 					return nil
 
 				},
@@ -185,7 +185,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canReduceBond(c, amount))
+					api.Output(c).PrintResponse(canReduceBond(c, amount))
 					return nil
 
 				},
@@ -209,7 +209,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(reduceBond(c, amount))
+					api.Output(c).PrintResponse(reduceBond(c, amount))
 					return nil
 
 				},
@@ -226,7 +226,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canClaimRefund(c))
+					api.Output(c).PrintResponse(canClaimRefund(c))
 					return nil
 
 				},
@@ -244,7 +244,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(claimRefund(c))
+					api.Output(c).PrintResponse(claimRefund(c))
 					return nil
 
 				},
@@ -267,7 +267,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canStake(c, validatorId))
+					api.Output(c).PrintResponse(canStake(c, validatorId))
 					return nil
 
 				},
@@ -291,7 +291,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(stake(c, validatorId))
+					api.Output(c).PrintResponse(stake(c, validatorId))
 					return nil
 
 				},
@@ -314,7 +314,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canExitQueue(c, validatorId))
+					api.Output(c).PrintResponse(canExitQueue(c, validatorId))
 					return nil
 
 				},
@@ -337,7 +337,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(exitQueue(c, validatorId))
+					api.Output(c).PrintResponse(exitQueue(c, validatorId))
 					return nil
 
 				},
@@ -360,7 +360,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canDissolveValidator(c, validatorId))
+					api.Output(c).PrintResponse(canDissolveValidator(c, validatorId))
 					return nil
 
 				},
@@ -384,7 +384,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(dissolveValidator(c, validatorId))
+					api.Output(c).PrintResponse(dissolveValidator(c, validatorId))
 					return nil
 
 				},
@@ -407,7 +407,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canExitValidator(c, validatorId))
+					api.Output(c).PrintResponse(canExitValidator(c, validatorId))
 					return nil
 
 				},
@@ -431,7 +431,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(exitValidator(c, validatorId))
+					api.Output(c).PrintResponse(exitValidator(c, validatorId))
 					return nil
 
 				},
@@ -454,7 +454,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canNotifyValidatorExit(c, validatorId))
+					api.Output(c).PrintResponse(canNotifyValidatorExit(c, validatorId))
 					return nil
 
 				},
@@ -478,7 +478,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(notifyValidatorExit(c, validatorId))
+					api.Output(c).PrintResponse(notifyValidatorExit(c, validatorId))
 					return nil
 
 				},
@@ -506,7 +506,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 						return err
 					}
 					// Run
-					api.PrintResponse(canNotifyFinalBalance(c, validatorId, slot))
+					api.Output(c).PrintResponse(canNotifyFinalBalance(c, validatorId, slot))
 					return nil
 
 				},
@@ -536,7 +536,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(notifyFinalBalance(c, validatorId, slot))
+					api.Output(c).PrintResponse(notifyFinalBalance(c, validatorId, slot))
 					return nil
 
 				},
@@ -557,7 +557,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getUseLatestDelegate(c, megapoolAddress))
+					api.Output(c).PrintResponse(getUseLatestDelegate(c, megapoolAddress))
 					return nil
 
 				},
@@ -582,7 +582,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canSetUseLatestDelegate(c, megapoolAddress, setting))
+					api.Output(c).PrintResponse(canSetUseLatestDelegate(c, megapoolAddress, setting))
 					return nil
 
 				},
@@ -607,7 +607,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(setUseLatestDelegate(c, megapoolAddress, setting))
+					api.Output(c).PrintResponse(setUseLatestDelegate(c, megapoolAddress, setting))
 					return nil
 
 				},
@@ -628,7 +628,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getDelegate(c, megapoolAddress))
+					api.Output(c).PrintResponse(getDelegate(c, megapoolAddress))
 					return nil
 
 				},
@@ -649,7 +649,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getEffectiveDelegate(c, megapoolAddress))
+					api.Output(c).PrintResponse(getEffectiveDelegate(c, megapoolAddress))
 					return nil
 
 				},
@@ -670,7 +670,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canDelegateUpgrade(c, megapoolAddress))
+					api.Output(c).PrintResponse(canDelegateUpgrade(c, megapoolAddress))
 					return nil
 
 				},
@@ -691,7 +691,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(delegateUpgrade(c, megapoolAddress))
+					api.Output(c).PrintResponse(delegateUpgrade(c, megapoolAddress))
 					return nil
 
 				},
@@ -713,7 +713,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(calculateRewards(c, amount))
+					api.Output(c).PrintResponse(calculateRewards(c, amount))
 					return nil
 
 				},
@@ -729,7 +729,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 						return err
 					}
 					// Run
-					api.PrintResponse(calculatePendingRewards(c))
+					api.Output(c).PrintResponse(calculatePendingRewards(c))
 					return nil
 
 				},
@@ -5,7 +5,6 @@ import (
 	"github.com/rocket-pool/smartnode/bindings/types"
 	"github.com/rocket-pool/smartnode/shared/services"
 	"github.com/rocket-pool/smartnode/shared/types/api"
-	"github.com/rocket-pool/smartnode/shared/utils/validator"
 	"github.com/urfave/cli"
 	eth2types "github.com/wealdtech/go-eth2-types/v2"
 )
@@ -113,12 +112,6 @@ func exitValidator(c *cli.Context, validatorId uint32) (*api.ExitValidatorRespon
 
 	validatorPubkey := types.ValidatorPubkey(validatorInfo.Pubkey)
 
-	// Get validator private key
-	validatorKey, err := w.GetValidatorKeyByPubkey(validatorPubkey)
-	if err != nil {
-		return nil, err
-	}
-
 	// Get beacon head
 	head, err := bc.GetBeaconHead()
 	if err != nil {
@@ -138,7 +131,7 @@ func exitValidator(c *cli.Context, validatorId uint32) (*api.ExitValidatorRespon
 	}
 
 	// Get signed voluntary exit message
-	signature, err := validator.GetSignedExitMessage(validatorKey, validatorIndex, head.Epoch, signatureDomain)
+	signature, err := services.GetSignedExitMessage(c, validatorPubkey, validatorIndex, head.Epoch, signatureDomain)
 	if err != nil {
 		return nil, err
 	}
@@ -5,8 +5,11 @@ import (
 	"math/big"
 
 	"github.com/rocket-pool/smartnode/bindings/megapool"
+	"github.com/rocket-pool/smartnode/bindings/rocketpool"
 	"github.com/rocket-pool/smartnode/bindings/utils/eth"
 	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/state"
 	"github.com/rocket-pool/smartnode/shared/types/api"
 	"github.com/urfave/cli"
 )
@@ -48,6 +51,12 @@ func getStatus(c *cli.Context) (*api.MegapoolStatusResponse, error) {
 	}
 	response.Megapool = details
 
+	// Get the next deadline for each validator
+	err = addValidatorDeadlines(rp, bc, &response.Megapool)
+	if err != nil {
+		return nil, fmt.Errorf("Error getting megapool validator deadlines: %w", err)
+	}
+
 	// Get latest delegate address
 	delegate, err := rp.GetContract("rocketMegapoolDelegate", nil)
 	if err != nil {
@@ -59,6 +68,43 @@ func getStatus(c *cli.Context) (*api.MegapoolStatusResponse, error) {
 	return &response, nil
 }
 
+// Add the next deadline to each of the megapool's validators
+func addValidatorDeadlines(rp *rocketpool.RocketPool, bc beacon.Client, details *api.MegapoolDetails) error {
+	if len(details.Validators) == 0 {
+		return nil
+	}
+	settings, err := state.GetMegapoolDeadlineSettings(rp, nil)
+	if err != nil {
+		return err
+	}
+	beaconConfig, err := bc.GetEth2Config()
+	if err != nil {
+		return err
+	}
+
+	for i := range details.Validators {
+		validator := &details.Validators[i]
+		info := megapool.ValidatorInfo{
+			LastAssignmentTime: uint32(validator.LastAssignmentTime.Unix()),
+			Staked:             validator.Staked,
+			Exited:             validator.Exited,
+			InPrestake:         validator.InPrestake,
+			Dissolved:          validator.Dissolved,
+			Exiting:            validator.Exiting,
+			Locked:             validator.Locked,
+		}
+		withdrawableEpoch := uint64(0)
+		if validator.BeaconStatus.Exists {
+			withdrawableEpoch = validator.BeaconStatus.WithdrawableEpoch
+		}
+		deadline, exists := state.GetMegapoolValidatorDeadline(info, withdrawableEpoch, &beaconConfig, &settings)
+		if exists {
+			validator.Deadline = &deadline
+		}
+	}
+	return nil
+}
+
 func calculateRewards(c *cli.Context, amount *big.Int) (*api.MegapoolRewardSplitResponse, error) {
 
 	// Get services
@@ -210,7 +210,7 @@ func changeWithdrawalCreds(c *cli.Context, minipoolAddress common.Address, mnemo
 		return nil, err
 	}
 
-	// Get signed withdrawal creds change message
+	// Get signed withdrawal creds change message; the withdrawal key only comes from the mnemonic and is never imported into a remote signer, so this is always signed locally
 	signature, err := validator.GetSignedWithdrawalCredsChangeMessage(withdrawalKey, validatorIndex, minipoolAddress, signatureDomain)
 	if err != nil {
 		return nil, err
@@ -28,7 +28,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getStatus(c))
+					api.Output(c).PrintResponse(getStatus(c))
 					return nil
 
 				},
@@ -50,7 +50,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canStakeMinipool(c, minipoolAddress))
+					api.Output(c).PrintResponse(canStakeMinipool(c, minipoolAddress))
 					return nil
 
 				},
@@ -72,7 +72,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(stakeMinipool(c, minipoolAddress))
+					api.Output(c).PrintResponse(stakeMinipool(c, minipoolAddress))
 					return nil
 
 				},
@@ -94,7 +94,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canPromoteMinipool(c, minipoolAddress))
+					api.Output(c).PrintResponse(canPromoteMinipool(c, minipoolAddress))
 					return nil
 
 				},
@@ -115,7 +115,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(promoteMinipool(c, minipoolAddress))
+					api.Output(c).PrintResponse(promoteMinipool(c, minipoolAddress))
 					return nil
 
 				},
@@ -137,7 +137,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canRefundMinipool(c, minipoolAddress))
+					api.Output(c).PrintResponse(canRefundMinipool(c, minipoolAddress))
 					return nil
 
 				},
@@ -159,7 +159,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(refundMinipool(c, minipoolAddress))
+					api.Output(c).PrintResponse(refundMinipool(c, minipoolAddress))
 					return nil
 
 				},
@@ -181,7 +181,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canDissolveMinipool(c, minipoolAddress))
+					api.Output(c).PrintResponse(canDissolveMinipool(c, minipoolAddress))
 					return nil
 
 				},
@@ -203,7 +203,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(dissolveMinipool(c, minipoolAddress))
+					api.Output(c).PrintResponse(dissolveMinipool(c, minipoolAddress))
 					return nil
 
 				},
@@ -225,7 +225,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canExitMinipool(c, minipoolAddress))
+					api.Output(c).PrintResponse(canExitMinipool(c, minipoolAddress))
 					return nil
 
 				},
@@ -247,7 +247,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(exitMinipool(c, minipoolAddress))
+					api.Output(c).PrintResponse(exitMinipool(c, minipoolAddress))
 					return nil
 
 				},
@@ -265,7 +265,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getMinipoolCloseDetailsForNode(c))
+					api.Output(c).PrintResponse(getMinipoolCloseDetailsForNode(c))
 					return nil
 
 				},
@@ -287,7 +287,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(closeMinipool(c, minipoolAddress))
+					api.Output(c).PrintResponse(closeMinipool(c, minipoolAddress))
 					return nil
 
 				},
@@ -309,7 +309,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canDelegateUpgrade(c, minipoolAddress))
+					api.Output(c).PrintResponse(canDelegateUpgrade(c, minipoolAddress))
 					return nil
 
 				},
@@ -330,7 +330,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(delegateUpgrade(c, minipoolAddress))
+					api.Output(c).PrintResponse(delegateUpgrade(c, minipoolAddress))
 					return nil
 
 				},
@@ -352,7 +352,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canDelegateRollback(c, minipoolAddress))
+					api.Output(c).PrintResponse(canDelegateRollback(c, minipoolAddress))
 					return nil
 
 				},
@@ -373,7 +373,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(delegateRollback(c, minipoolAddress))
+					api.Output(c).PrintResponse(delegateRollback(c, minipoolAddress))
 					return nil
 
 				},
@@ -399,7 +399,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canSetUseLatestDelegate(c, minipoolAddress, setting))
+					api.Output(c).PrintResponse(canSetUseLatestDelegate(c, minipoolAddress, setting))
 					return nil
 
 				},
@@ -424,7 +424,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(setUseLatestDelegate(c, minipoolAddress, setting))
+					api.Output(c).PrintResponse(setUseLatestDelegate(c, minipoolAddress, setting))
 					return nil
 
 				},
@@ -446,7 +446,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getUseLatestDelegate(c, minipoolAddress))
+					api.Output(c).PrintResponse(getUseLatestDelegate(c, minipoolAddress))
 					return nil
 
 				},
@@ -468,7 +468,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getDelegate(c, minipoolAddress))
+					api.Output(c).PrintResponse(getDelegate(c, minipoolAddress))
 					return nil
 
 				},
@@ -490,7 +490,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getPreviousDelegate(c, minipoolAddress))
+					api.Output(c).PrintResponse(getPreviousDelegate(c, minipoolAddress))
 					return nil
 
 				},
@@ -512,7 +512,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getEffectiveDelegate(c, minipoolAddress))
+					api.Output(c).PrintResponse(getEffectiveDelegate(c, minipoolAddress))
 					return nil
 
 				},
@@ -536,7 +536,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					nodeAddressStr := c.Args().Get(1)
 
 					// Run
-					api.PrintResponse(getVanityArtifacts(c, depositAmount, nodeAddressStr))
+					api.Output(c).PrintResponse(getVanityArtifacts(c, depositAmount, nodeAddressStr))
 					return nil
 
 				},
@@ -562,7 +562,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canBeginReduceBondAmount(c, minipoolAddress, newBondAmountWei))
+					api.Output(c).PrintResponse(canBeginReduceBondAmount(c, minipoolAddress, newBondAmountWei))
 					return nil
 
 				},
@@ -587,7 +587,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(beginReduceBondAmount(c, minipoolAddress, newBondAmountWei))
+					api.Output(c).PrintResponse(beginReduceBondAmount(c, minipoolAddress, newBondAmountWei))
 					return nil
 
 				},
@@ -609,7 +609,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canReduceBondAmount(c, minipoolAddress))
+					api.Output(c).PrintResponse(canReduceBondAmount(c, minipoolAddress))
 					return nil
 
 				},
@@ -630,7 +630,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(reduceBondAmount(c, minipoolAddress))
+					api.Output(c).PrintResponse(reduceBondAmount(c, minipoolAddress))
 					return nil
 
 				},
@@ -648,7 +648,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getDistributeBalanceDetails(c))
+					api.Output(c).PrintResponse(getDistributeBalanceDetails(c))
 					return nil
 
 				},
@@ -669,7 +669,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(distributeBalance(c, minipoolAddress))
+					api.Output(c).PrintResponse(distributeBalance(c, minipoolAddress))
 					return nil
 
 				},
@@ -695,7 +695,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(importKey(c, minipoolAddress, mnemonic))
+					api.Output(c).PrintResponse(importKey(c, minipoolAddress, mnemonic))
 					return nil
 
 				},
@@ -721,7 +721,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canChangeWithdrawalCreds(c, minipoolAddress, mnemonic))
+					api.Output(c).PrintResponse(canChangeWithdrawalCreds(c, minipoolAddress, mnemonic))
 					return nil
 
 				},
@@ -746,7 +746,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(changeWithdrawalCreds(c, minipoolAddress, mnemonic))
+					api.Output(c).PrintResponse(changeWithdrawalCreds(c, minipoolAddress, mnemonic))
 					return nil
 
 				},
@@ -764,7 +764,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getMinipoolRescueDissolvedDetailsForNode(c))
+					api.Output(c).PrintResponse(getMinipoolRescueDissolvedDetailsForNode(c))
 					return nil
 
 				},
@@ -794,7 +794,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(rescueDissolvedMinipool(c, minipoolAddress, depositAmount, submit))
+					api.Output(c).PrintResponse(rescueDissolvedMinipool(c, minipoolAddress, depositAmount, submit))
 					return nil
 
 				},
@@ -811,7 +811,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getBondReductionEnabled(c))
+					api.Output(c).PrintResponse(getBondReductionEnabled(c))
 					return nil
 				},
 			},
@@ -9,7 +9,6 @@ import (
 
 	"github.com/rocket-pool/smartnode/shared/services"
 	"github.com/rocket-pool/smartnode/shared/types/api"
-	"github.com/rocket-pool/smartnode/shared/utils/validator"
 )
 
 func canExitMinipool(c *cli.Context, minipoolAddress common.Address) (*api.CanExitMinipoolResponse, error) {
@@ -67,10 +66,6 @@ func exitMinipool(c *cli.Context, minipoolAddress common.Address) (*api.ExitMini
 	if err := services.RequireBeaconClientSynced(c); err != nil {
 		return nil, err
 	}
-	w, err := services.GetWallet(c)
-	if err != nil {
-		return nil, err
-	}
 	rp, err := services.GetRocketPool(c)
 	if err != nil {
 		return nil, err
@@ -89,12 +84,6 @@ func exitMinipool(c *cli.Context, minipoolAddress common.Address) (*api.ExitMini
 		return nil, err
 	}
 
-	// Get validator private key
-	validatorKey, err := w.GetValidatorKeyByPubkey(validatorPubkey)
-	if err != nil {
-		return nil, err
-	}
-
 	// Get beacon head
 	head, err := bc.GetBeaconHead()
 	if err != nil {
@@ -114,7 +103,7 @@ func exitMinipool(c *cli.Context, minipoolAddress common.Address) (*api.ExitMini
 	}
 
 	// Get signed voluntary exit message
-	signature, err := validator.GetSignedExitMessage(validatorKey, validatorIndex, head.Epoch, signatureDomain)
+	signature, err := services.GetSignedExitMessage(c, validatorPubkey, validatorIndex, head.Epoch, signatureDomain)
 	if err != nil {
 		return nil, err
 	}
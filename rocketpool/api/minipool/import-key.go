@@ -32,6 +32,14 @@ func importKey(c *cli.Context, minipoolAddress common.Address, mnemonic string)
 	if err != nil {
 		return nil, err
 	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	km, err := services.GetKeymanagerClient(c)
+	if err != nil {
+		return nil, err
+	}
 
 	// Response
 	response := api.ImportKeyResponse{}
@@ -88,6 +96,9 @@ func importKey(c *cli.Context, minipoolAddress common.Address, mnemonic string)
 		return nil, fmt.Errorf("error saving keystore: %w", err)
 	}
 
+	// Try to load it into the VC without restarting it; if that doesn't work, the caller will need to restart the VC
+	response.KeyLoaded = (validator.ImportValidatorKeys(cfg, km, w, []types.ValidatorPubkey{pubkey}) == nil)
+
 	// Return response
 	return &response, nil
 }
@@ -19,6 +19,7 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services/contracts"
 	"github.com/rocket-pool/smartnode/shared/services/wallet"
 	"github.com/rocket-pool/smartnode/shared/types/api"
+	apiutils "github.com/rocket-pool/smartnode/shared/utils/api"
 	"github.com/rocket-pool/smartnode/shared/utils/eth1"
 	"github.com/rocket-pool/smartnode/shared/utils/validator"
 )
@@ -330,7 +331,7 @@ func rescueDissolvedMinipool(c *cli.Context, minipoolAddress common.Address, amo
 		if err != nil {
 			return nil, err
 		}
-		fmt.Printf("%x\n", b)
+		apiutils.Output(c).Printf("%x\n", b)
 	}
 
 	response.TxHash = tx.Hash()
@@ -28,7 +28,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getNodeFee(c))
+					api.Output(c).PrintResponse(getNodeFee(c))
 					return nil
 
 				},
@@ -47,7 +47,25 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getRplPrice(c))
+					api.Output(c).PrintResponse(getRplPrice(c))
+					return nil
+
+				},
+			},
+
+			{
+				Name:      "gas-suggestions",
+				Usage:     "Get max fee and priority fee suggestions from the Execution client's recent fee history",
+				UsageText: "rocketpool api network gas-suggestions",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.Output(c).PrintResponse(getGasSuggestions(c))
 					return nil
 
 				},
@@ -66,7 +84,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getStats(c))
+					api.Output(c).PrintResponse(getStats(c))
 					return nil
 
 				},
@@ -85,7 +103,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getTimezones(c))
+					api.Output(c).PrintResponse(getTimezones(c))
 					return nil
 
 				},
@@ -108,7 +126,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canGenerateRewardsTree(c, index))
+					api.Output(c).PrintResponse(canGenerateRewardsTree(c, index))
 					return nil
 
 				},
@@ -131,7 +149,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(generateRewardsTree(c, index))
+					api.Output(c).PrintResponse(generateRewardsTree(c, index))
 					return nil
 
 				},
@@ -150,7 +168,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getActiveDAOProposals(c))
+					api.Output(c).PrintResponse(getActiveDAOProposals(c))
 					return nil
 
 				},
@@ -174,7 +192,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(downloadRewardsFile(c, interval))
+					api.Output(c).PrintResponse(downloadRewardsFile(c, interval))
 					return nil
 
 				},
@@ -192,7 +210,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(isSaturnDeployed(c))
+					api.Output(c).PrintResponse(isSaturnDeployed(c))
 					return nil
 
 				},
@@ -210,7 +228,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getLatestDelegate(c))
+					api.Output(c).PrintResponse(getLatestDelegate(c))
 					return nil
 
 				},
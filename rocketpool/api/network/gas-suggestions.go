@@ -0,0 +1,38 @@
+package network
+
+import (
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/gas/feehistory"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func getGasSuggestions(c *cli.Context) (*api.GasSuggestionsResponse, error) {
+
+	// Get services
+	if err := services.RequireEthClientSynced(c); err != nil {
+		return nil, err
+	}
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.GasSuggestionsResponse{}
+
+	// Get the suggestions
+	suggestion, err := feehistory.GetGasPrices(ec)
+	if err != nil {
+		return nil, err
+	}
+	response.BaseFeeWei = suggestion.BaseFeeWei
+	response.Slow = api.GasSuggestion{MaxFeeWei: suggestion.Slow.MaxFeeWei, MaxPriorityFeeWei: suggestion.Slow.MaxPriorityFeeWei}
+	response.Standard = api.GasSuggestion{MaxFeeWei: suggestion.Standard.MaxFeeWei, MaxPriorityFeeWei: suggestion.Standard.MaxPriorityFeeWei}
+	response.Fast = api.GasSuggestion{MaxFeeWei: suggestion.Fast.MaxFeeWei, MaxPriorityFeeWei: suggestion.Fast.MaxPriorityFeeWei}
+
+	// Return response
+	return &response, nil
+
+}
@@ -0,0 +1,56 @@
+package node
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// Submit a transaction that was signed offline
+func broadcastTransaction(c *cli.Context, signedTx string) (*api.NodeBroadcastTransactionResponse, error) {
+
+	// Get services
+	if err := services.RequireEthClientSynced(c); err != nil {
+		return nil, err
+	}
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.NodeBroadcastTransactionResponse{}
+
+	// Decode the transaction
+	txBytes, err := hexutil.Decode(signedTx)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding transaction: %w", err)
+	}
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(txBytes); err != nil {
+		return nil, fmt.Errorf("error decoding transaction: %w", err)
+	}
+	chainID, err := ec.ChainID(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("error getting the chain ID: %w", err)
+	}
+	if tx.ChainId().Cmp(chainID) != 0 {
+		return nil, fmt.Errorf("The transaction is for chain ID %s, but the Execution client is on chain ID %s", tx.ChainId().String(), chainID.String())
+	}
+
+	// Submit it
+	if err := ec.SendTransaction(context.Background(), tx); err != nil {
+		return nil, fmt.Errorf("error submitting transaction: %w", err)
+	}
+	response.TxHash = tx.Hash()
+
+	// Return response
+	return &response, nil
+
+}
@@ -28,7 +28,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getStatus(c))
+					api.Output(c).PrintResponse(getStatus(c))
 					return nil
 
 				},
@@ -47,7 +47,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getSyncProgress(c))
+					api.Output(c).PrintResponse(getSyncProgress(c))
 					return nil
 
 				},
@@ -69,7 +69,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canRegisterNode(c, timezoneLocation))
+					api.Output(c).PrintResponse(canRegisterNode(c, timezoneLocation))
 					return nil
 
 				},
@@ -91,7 +91,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(registerNode(c, timezoneLocation))
+					api.Output(c).PrintResponse(registerNode(c, timezoneLocation))
 					return nil
 
 				},
@@ -118,7 +118,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canSetPrimaryWithdrawalAddress(c, withdrawalAddress, confirm))
+					api.Output(c).PrintResponse(canSetPrimaryWithdrawalAddress(c, withdrawalAddress, confirm))
 					return nil
 
 				},
@@ -144,7 +144,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(setPrimaryWithdrawalAddress(c, withdrawalAddress, confirm))
+					api.Output(c).PrintResponse(setPrimaryWithdrawalAddress(c, withdrawalAddress, confirm))
 					return nil
 
 				},
@@ -162,7 +162,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canConfirmPrimaryWithdrawalAddress(c))
+					api.Output(c).PrintResponse(canConfirmPrimaryWithdrawalAddress(c))
 					return nil
 
 				},
@@ -179,7 +179,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(confirmPrimaryWithdrawalAddress(c))
+					api.Output(c).PrintResponse(confirmPrimaryWithdrawalAddress(c))
 					return nil
 
 				},
@@ -206,7 +206,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canSetRPLWithdrawalAddress(c, withdrawalAddress, confirm))
+					api.Output(c).PrintResponse(canSetRPLWithdrawalAddress(c, withdrawalAddress, confirm))
 					return nil
 
 				},
@@ -232,7 +232,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(setRPLWithdrawalAddress(c, withdrawalAddress, confirm))
+					api.Output(c).PrintResponse(setRPLWithdrawalAddress(c, withdrawalAddress, confirm))
 					return nil
 
 				},
@@ -250,7 +250,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canConfirmRPLWithdrawalAddress(c))
+					api.Output(c).PrintResponse(canConfirmRPLWithdrawalAddress(c))
 					return nil
 
 				},
@@ -267,7 +267,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(confirmRPLWithdrawalAddress(c))
+					api.Output(c).PrintResponse(confirmRPLWithdrawalAddress(c))
 					return nil
 
 				},
@@ -289,7 +289,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canSetTimezoneLocation(c, timezoneLocation))
+					api.Output(c).PrintResponse(canSetTimezoneLocation(c, timezoneLocation))
 					return nil
 
 				},
@@ -311,7 +311,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(setTimezoneLocation(c, timezoneLocation))
+					api.Output(c).PrintResponse(setTimezoneLocation(c, timezoneLocation))
 					return nil
 
 				},
@@ -333,7 +333,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canNodeSwapRpl(c, amountWei))
+					api.Output(c).PrintResponse(canNodeSwapRpl(c, amountWei))
 					return nil
 
 				},
@@ -355,7 +355,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(approveFsRpl(c, amountWei))
+					api.Output(c).PrintResponse(approveFsRpl(c, amountWei))
 					return nil
 
 				},
@@ -381,7 +381,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(waitForApprovalAndSwapFsRpl(c, amountWei, hash))
+					api.Output(c).PrintResponse(waitForApprovalAndSwapFsRpl(c, amountWei, hash))
 					return nil
 
 				},
@@ -402,7 +402,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getSwapApprovalGas(c, amountWei))
+					api.Output(c).PrintResponse(getSwapApprovalGas(c, amountWei))
 					return nil
 
 				},
@@ -419,7 +419,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(allowanceFsRpl(c))
+					api.Output(c).PrintResponse(allowanceFsRpl(c))
 					return nil
 
 				},
@@ -441,7 +441,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(swapRpl(c, amountWei))
+					api.Output(c).PrintResponse(swapRpl(c, amountWei))
 					return nil
 
 				},
@@ -463,7 +463,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canNodeStakeRpl(c, amountWei))
+					api.Output(c).PrintResponse(canNodeStakeRpl(c, amountWei))
 					return nil
 
 				},
@@ -485,7 +485,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(approveRpl(c, amountWei))
+					api.Output(c).PrintResponse(approveRpl(c, amountWei))
 					return nil
 
 				},
@@ -511,7 +511,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(waitForApprovalAndStakeRpl(c, amountWei, hash))
+					api.Output(c).PrintResponse(waitForApprovalAndStakeRpl(c, amountWei, hash))
 					return nil
 
 				},
@@ -532,7 +532,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getStakeApprovalGas(c, amountWei))
+					api.Output(c).PrintResponse(getStakeApprovalGas(c, amountWei))
 					return nil
 
 				},
@@ -549,7 +549,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(allowanceRpl(c))
+					api.Output(c).PrintResponse(allowanceRpl(c))
 					return nil
 
 				},
@@ -571,7 +571,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(stakeRpl(c, amountWei))
+					api.Output(c).PrintResponse(stakeRpl(c, amountWei))
 					return nil
 
 				},
@@ -594,7 +594,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canSetRplLockAllowed(c, allowed))
+					api.Output(c).PrintResponse(canSetRplLockAllowed(c, allowed))
 					return nil
 
 				},
@@ -618,7 +618,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(setRplLockAllowed(c, allowed))
+					api.Output(c).PrintResponse(setRplLockAllowed(c, allowed))
 					return nil
 
 				},
@@ -648,7 +648,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canSetStakeRplForAllowed(c, callerAddress, allowed))
+					api.Output(c).PrintResponse(canSetStakeRplForAllowed(c, callerAddress, allowed))
 					return nil
 
 				},
@@ -678,7 +678,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(setStakeRplForAllowed(c, callerAddress, allowed))
+					api.Output(c).PrintResponse(setStakeRplForAllowed(c, callerAddress, allowed))
 
 					return nil
 				},
@@ -699,7 +699,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canNodeWithdrawCredit(c, amountWei))
+					api.Output(c).PrintResponse(canNodeWithdrawCredit(c, amountWei))
 					return nil
 
 				},
@@ -721,7 +721,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(nodeWithdrawCredit(c, amountWei))
+					api.Output(c).PrintResponse(nodeWithdrawCredit(c, amountWei))
 					return nil
 
 				},
@@ -742,7 +742,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canNodeWithdrawEth(c, amountWei))
+					api.Output(c).PrintResponse(canNodeWithdrawEth(c, amountWei))
 					return nil
 
 				},
@@ -764,7 +764,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(nodeWithdrawEth(c, amountWei))
+					api.Output(c).PrintResponse(nodeWithdrawEth(c, amountWei))
 					return nil
 
 				},
@@ -785,7 +785,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canNodeUnstakeLegacyRpl(c, amountWei))
+					api.Output(c).PrintResponse(canNodeUnstakeLegacyRpl(c, amountWei))
 					return nil
 
 				},
@@ -806,7 +806,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(nodeUnstakeLegacyRpl(c, amountWei))
+					api.Output(c).PrintResponse(nodeUnstakeLegacyRpl(c, amountWei))
 					return nil
 				},
 			},
@@ -822,7 +822,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canNodeWithdrawRpl(c))
+					api.Output(c).PrintResponse(canNodeWithdrawRpl(c))
 					return nil
 
 				},
@@ -840,7 +840,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(nodeWithdrawRpl(c))
+					api.Output(c).PrintResponse(nodeWithdrawRpl(c))
 					return nil
 
 				},
@@ -861,7 +861,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canNodeWithdrawRplv1_3_1(c, amountWei))
+					api.Output(c).PrintResponse(canNodeWithdrawRplv1_3_1(c, amountWei))
 					return nil
 
 				},
@@ -883,7 +883,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(nodeWithdrawRplv1_3_1(c, amountWei))
+					api.Output(c).PrintResponse(nodeWithdrawRplv1_3_1(c, amountWei))
 					return nil
 
 				},
@@ -905,7 +905,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canNodeUnstakeRpl(c, amountWei))
+					api.Output(c).PrintResponse(canNodeUnstakeRpl(c, amountWei))
 					return nil
 
 				},
@@ -928,7 +928,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(nodeUnstakeRpl(c, amountWei))
+					api.Output(c).PrintResponse(nodeUnstakeRpl(c, amountWei))
 					return nil
 
 				},
@@ -965,7 +965,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canNodeDeposit(c, amountWei, minNodeFee, salt, useExpressTicket))
+					api.Output(c).PrintResponse(canNodeDeposit(c, amountWei, minNodeFee, salt, useExpressTicket))
 					return nil
 
 				},
@@ -1019,7 +1019,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					// Run
 					response, err := nodeDeposit(c, amountWei, minNodeFee, salt, useCreditBalance, useExpressTicket, submit)
 					if submit {
-						api.PrintResponse(response, err)
+						api.Output(c).PrintResponse(response, err)
 					} // else nodeDeposit already printed the encoded transaction
 					return nil
 
@@ -1050,7 +1050,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canNodeSend(c, amountRaw, token, toAddress))
+					api.Output(c).PrintResponse(canNodeSend(c, amountRaw, token, toAddress))
 					return nil
 
 				},
@@ -1080,7 +1080,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(nodeSend(c, amountRaw, token, toAddress))
+					api.Output(c).PrintResponse(nodeSend(c, amountRaw, token, toAddress))
 					return nil
 
 				},
@@ -1106,7 +1106,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canNodeBurn(c, amountWei, token))
+					api.Output(c).PrintResponse(canNodeBurn(c, amountWei, token))
 					return nil
 
 				},
@@ -1132,7 +1132,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(nodeBurn(c, amountWei, token))
+					api.Output(c).PrintResponse(nodeBurn(c, amountWei, token))
 					return nil
 
 				},
@@ -1150,7 +1150,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canNodeClaimRpl(c))
+					api.Output(c).PrintResponse(canNodeClaimRpl(c))
 					return nil
 
 				},
@@ -1167,7 +1167,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(nodeClaimRpl(c))
+					api.Output(c).PrintResponse(nodeClaimRpl(c))
 					return nil
 
 				},
@@ -1185,7 +1185,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getRewards(c))
+					api.Output(c).PrintResponse(getRewards(c))
 					return nil
 
 				},
@@ -1203,7 +1203,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getDepositContractInfo(c))
+					api.Output(c).PrintResponse(getDepositContractInfo(c))
 					return nil
 
 				},
@@ -1223,7 +1223,25 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					data := c.Args().Get(0)
 
 					// Run
-					api.PrintResponse(sign(c, data))
+					api.Output(c).PrintResponse(sign(c, data))
+					return nil
+
+				},
+			},
+
+			{
+				Name:      "broadcast-tx",
+				Usage:     "Submit a transaction that was signed offline. The TX must be serialized as a hex string.",
+				UsageText: "rocketpool api node broadcast-tx tx",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+
+					// Run
+					api.Output(c).PrintResponse(broadcastTransaction(c, c.Args().Get(0)))
 					return nil
 
 				},
@@ -1243,7 +1261,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					message := c.Args().Get(0)
 
 					// Run
-					api.PrintResponse(signMessage(c, message))
+					api.Output(c).PrintResponse(signMessage(c, message))
 					return nil
 
 				},
@@ -1261,7 +1279,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(isFeeDistributorInitialized(c))
+					api.Output(c).PrintResponse(isFeeDistributorInitialized(c))
 					return nil
 
 				},
@@ -1278,7 +1296,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getInitializeFeeDistributorGas(c))
+					api.Output(c).PrintResponse(getInitializeFeeDistributorGas(c))
 					return nil
 				},
 			},
@@ -1295,7 +1313,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(initializeFeeDistributor(c))
+					api.Output(c).PrintResponse(initializeFeeDistributor(c))
 					return nil
 
 				},
@@ -1313,7 +1331,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canDistribute(c))
+					api.Output(c).PrintResponse(canDistribute(c))
 					return nil
 
 				},
@@ -1330,7 +1348,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(distribute(c))
+					api.Output(c).PrintResponse(distribute(c))
 					return nil
 
 				},
@@ -1347,7 +1365,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(nodeClaimRpl(c))
+					api.Output(c).PrintResponse(nodeClaimRpl(c))
 					return nil
 
 				},
@@ -1365,7 +1383,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getRewardsInfo(c))
+					api.Output(c).PrintResponse(getRewardsInfo(c))
 					return nil
 
 				},
@@ -1383,7 +1401,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					indicesString := c.Args().Get(0)
 
 					// Run
-					api.PrintResponse(canClaimRewards(c, indicesString))
+					api.Output(c).PrintResponse(canClaimRewards(c, indicesString))
 					return nil
 
 				},
@@ -1401,7 +1419,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					indicesString := c.Args().Get(0)
 
 					// Run
-					api.PrintResponse(claimRewards(c, indicesString))
+					api.Output(c).PrintResponse(claimRewards(c, indicesString))
 					return nil
 
 				},
@@ -1424,7 +1442,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canClaimAndStakeRewards(c, indicesString, stakeAmount))
+					api.Output(c).PrintResponse(canClaimAndStakeRewards(c, indicesString, stakeAmount))
 					return nil
 
 				},
@@ -1447,7 +1465,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(claimAndStakeRewards(c, indicesString, stakeAmount))
+					api.Output(c).PrintResponse(claimAndStakeRewards(c, indicesString, stakeAmount))
 					return nil
 
 				},
@@ -1465,7 +1483,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getSmoothingPoolRegistrationStatus(c))
+					api.Output(c).PrintResponse(getSmoothingPoolRegistrationStatus(c))
 					return nil
 
 				},
@@ -1486,7 +1504,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canSetSmoothingPoolStatus(c, status))
+					api.Output(c).PrintResponse(canSetSmoothingPoolStatus(c, status))
 					return nil
 
 				},
@@ -1507,7 +1525,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(setSmoothingPoolStatus(c, status))
+					api.Output(c).PrintResponse(setSmoothingPoolStatus(c, status))
 					return nil
 
 				},
@@ -1524,7 +1542,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(resolveEnsName(c, c.Args().Get(0)))
+					api.Output(c).PrintResponse(resolveEnsName(c, c.Args().Get(0)))
 					return nil
 
 				},
@@ -1545,7 +1563,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 						return err
 					}
 					// Run
-					api.PrintResponse(reverseResolveEnsName(c, address))
+					api.Output(c).PrintResponse(reverseResolveEnsName(c, address))
 					return nil
 
 				},
@@ -1579,7 +1597,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canCreateVacantMinipool(c, amountWei, minNodeFee, salt, pubkey))
+					api.Output(c).PrintResponse(canCreateVacantMinipool(c, amountWei, minNodeFee, salt, pubkey))
 					return nil
 
 				},
@@ -1612,7 +1630,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(createVacantMinipool(c, amountWei, minNodeFee, salt, pubkey))
+					api.Output(c).PrintResponse(createVacantMinipool(c, amountWei, minNodeFee, salt, pubkey))
 					return nil
 
 				},
@@ -1630,7 +1648,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(checkCollateral(c))
+					api.Output(c).PrintResponse(checkCollateral(c))
 					return nil
 
 				},
@@ -1648,7 +1666,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getNodeEthBalance(c))
+					api.Output(c).PrintResponse(getNodeEthBalance(c))
 					return nil
 
 				},
@@ -1674,7 +1692,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canSendMessage(c, address, message))
+					api.Output(c).PrintResponse(canSendMessage(c, address, message))
 					return nil
 
 				},
@@ -1699,7 +1717,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(sendMessage(c, address, message))
+					api.Output(c).PrintResponse(sendMessage(c, address, message))
 					return nil
 
 				},
@@ -1713,7 +1731,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					if err := cliutils.ValidateArgCount(c, 0); err != nil {
 						return err
 					}
-					api.PrintResponse(getExpressTicketCount(c))
+					api.Output(c).PrintResponse(getExpressTicketCount(c))
 					return nil
 				},
 			},
@@ -1733,7 +1751,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 						return err
 					}
 					// Run
-					api.PrintResponse(canClaimUnclaimedRewards(c, nodeAddress))
+					api.Output(c).PrintResponse(canClaimUnclaimedRewards(c, nodeAddress))
 					return nil
 
 				},
@@ -1754,7 +1772,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 						return err
 					}
 					// Run
-					api.PrintResponse(canClaimUnclaimedRewards(c, nodeAddress))
+					api.Output(c).PrintResponse(canClaimUnclaimedRewards(c, nodeAddress))
 					return nil
 
 				},
@@ -1771,7 +1789,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getExpressTicketsProvisioned(c))
+					api.Output(c).PrintResponse(getExpressTicketsProvisioned(c))
 					return nil
 
 				},
@@ -1788,7 +1806,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canProvisionExpressTickets(c))
+					api.Output(c).PrintResponse(canProvisionExpressTickets(c))
 					return nil
 
 				},
@@ -1805,7 +1823,24 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(provisionExpressTickets(c))
+					api.Output(c).PrintResponse(provisionExpressTickets(c))
+					return nil
+
+				},
+			},
+			{
+				Name:      "tx-queue",
+				Usage:     "Get the transactions the node daemon is tracking",
+				UsageText: "rocketpool api node tx-queue",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.Output(c).PrintResponse(getTxQueue(c))
 					return nil
 
 				},
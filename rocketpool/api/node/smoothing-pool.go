@@ -141,6 +141,10 @@ func setSmoothingPoolStatus(c *cli.Context, status bool) (*api.SetSmoothingPoolR
 	if err != nil {
 		return nil, err
 	}
+	km, err := services.GetKeymanagerClient(c)
+	if err != nil {
+		return nil, err
+	}
 
 	// Response
 	response := api.SetSmoothingPoolRegistrationStatusResponse{}
@@ -179,8 +183,8 @@ func setSmoothingPoolStatus(c *cli.Context, status bool) (*api.SetSmoothingPoolR
 			return nil, err
 		}
 
-		// Restart the VC
-		err = validator.RestartValidator(cfg, bc, nil, d)
+		// Update the VC
+		err = validator.UpdateFeeRecipient(cfg, bc, km, nil, d, *smoothingPoolContract.Address)
 		if err != nil {
 			// Set the fee recipient back to the node distributor
 			err2 := rocketpool.UpdateFeeRecipientFile(distributor, cfg)
@@ -188,8 +192,8 @@ func setSmoothingPoolStatus(c *cli.Context, status bool) (*api.SetSmoothingPoolR
 				return nil, fmt.Errorf("***WARNING***\nError restarting validator: [%s]\nError setting fee recipient back to your node's distributor: [%w]\nYour node now has the Smoothing Pool as its fee recipient, even though you aren't opted in!\nPlease visit the Rocket Pool Discord server for help with these errors, so it can be set back to your node's distributor.", err.Error(), err2)
 			}
 
-			// Restart the VC but don't pay attention to the errors, since a restart error got us here in the first place
-			validator.RestartValidator(cfg, bc, nil, d)
+			// Update the VC but don't pay attention to the errors, since an update error got us here in the first place
+			validator.UpdateFeeRecipient(cfg, bc, km, nil, d, distributor)
 
 			return nil, fmt.Errorf("Error restarting validator after updating the fee recipient to the Smoothing Pool: [%w]\nYour fee recipient has been set back to your node's distributor contract.\nYou have not been opted into the Smoothing Pool.", err)
 		}
@@ -30,6 +30,7 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services/alerting"
 	"github.com/rocket-pool/smartnode/shared/services/alerting/alertmanager/models"
 	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/gas/policy"
 	"github.com/rocket-pool/smartnode/shared/services/state"
 	"github.com/rocket-pool/smartnode/shared/types/api"
 	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
@@ -548,6 +549,16 @@ func getStatus(c *cli.Context) (*api.NodeStatusResponse, error) {
 		response.PendingBorrowedCollateralRatio = -1
 	}
 
+	// Get the gas spent by the node daemon's automatic transactions, which it records as they're mined
+	gasSpending, err := policy.NewLedger(cfg.Smartnode.GetGasLedgerPath()).GetMonthlySpending(time.Now())
+	if err != nil && response.Warning == "" {
+		response.Warning = fmt.Sprintf("Error reading the gas spent by automatic transactions: %s", err)
+	}
+	response.GasSpending = api.NodeGasSpending{
+		MonthlySpending: gasSpending,
+		BudgetWei:       policy.GetMonthlyBudget(cfg),
+	}
+
 	// Return response
 	return &response, nil
 
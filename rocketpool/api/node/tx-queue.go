@@ -0,0 +1,32 @@
+package node
+
+import (
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func getTxQueue(c *cli.Context) (*api.TxQueueResponse, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// The queue is owned by the node daemon, so read its latest saved copy
+	queue, err := txmanager.LoadTransactionQueue(cfg.Smartnode.GetTxQueuePath())
+	if err != nil {
+		return nil, err
+	}
+
+	// Return response
+	response := api.TxQueueResponse{
+		UpdatedAt:    queue.UpdatedAt,
+		Transactions: queue.Transactions,
+	}
+	return &response, nil
+
+}
@@ -28,7 +28,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getStatus(c))
+					api.Output(c).PrintResponse(getStatus(c))
 					return nil
 
 				},
@@ -47,7 +47,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getMembers(c))
+					api.Output(c).PrintResponse(getMembers(c))
 					return nil
 
 				},
@@ -66,7 +66,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getProposals(c))
+					api.Output(c).PrintResponse(getProposals(c))
 					return nil
 
 				},
@@ -100,7 +100,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canPenaliseMegapool(c, megapoolAddress, block, amount))
+					api.Output(c).PrintResponse(canPenaliseMegapool(c, megapoolAddress, block, amount))
 					return nil
 
 				},
@@ -134,7 +134,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(penaliseMegapool(c, megapoolAddress, block, amount))
+					api.Output(c).PrintResponse(penaliseMegapool(c, megapoolAddress, block, amount))
 					return nil
 
 				},
@@ -158,7 +158,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getProposal(c, id))
+					api.Output(c).PrintResponse(getProposal(c, id))
 					return nil
 
 				},
@@ -184,7 +184,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canProposeInvite(c, memberAddress, memberId, c.Args().Get(2)))
+					api.Output(c).PrintResponse(canProposeInvite(c, memberAddress, memberId, c.Args().Get(2)))
 					return nil
 
 				},
@@ -210,7 +210,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(proposeInvite(c, memberAddress, memberId, c.Args().Get(2)))
+					api.Output(c).PrintResponse(proposeInvite(c, memberAddress, memberId, c.Args().Get(2)))
 					return nil
 
 				},
@@ -228,7 +228,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canProposeLeave(c))
+					api.Output(c).PrintResponse(canProposeLeave(c))
 					return nil
 
 				},
@@ -246,7 +246,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(proposeLeave(c))
+					api.Output(c).PrintResponse(proposeLeave(c))
 					return nil
 
 				},
@@ -272,7 +272,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canProposeKick(c, memberAddress, fineAmountWei))
+					api.Output(c).PrintResponse(canProposeKick(c, memberAddress, fineAmountWei))
 					return nil
 
 				},
@@ -298,7 +298,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(proposeKick(c, memberAddress, fineAmountWei))
+					api.Output(c).PrintResponse(proposeKick(c, memberAddress, fineAmountWei))
 					return nil
 
 				},
@@ -320,7 +320,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canCancelProposal(c, proposalId))
+					api.Output(c).PrintResponse(canCancelProposal(c, proposalId))
 					return nil
 
 				},
@@ -342,7 +342,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(cancelProposal(c, proposalId))
+					api.Output(c).PrintResponse(cancelProposal(c, proposalId))
 					return nil
 
 				},
@@ -364,7 +364,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canVoteOnProposal(c, proposalId))
+					api.Output(c).PrintResponse(canVoteOnProposal(c, proposalId))
 					return nil
 
 				},
@@ -390,7 +390,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(voteOnProposal(c, proposalId, support))
+					api.Output(c).PrintResponse(voteOnProposal(c, proposalId, support))
 					return nil
 
 				},
@@ -412,7 +412,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canExecuteProposal(c, proposalId))
+					api.Output(c).PrintResponse(canExecuteProposal(c, proposalId))
 					return nil
 
 				},
@@ -434,7 +434,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(executeProposal(c, proposalId))
+					api.Output(c).PrintResponse(executeProposal(c, proposalId))
 					return nil
 
 				},
@@ -452,7 +452,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canJoin(c))
+					api.Output(c).PrintResponse(canJoin(c))
 					return nil
 
 				},
@@ -470,7 +470,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(approveRpl(c))
+					api.Output(c).PrintResponse(approveRpl(c))
 					return nil
 
 				},
@@ -492,7 +492,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(waitForApprovalAndJoin(c, hash))
+					api.Output(c).PrintResponse(waitForApprovalAndJoin(c, hash))
 					return nil
 
 				},
@@ -510,7 +510,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canLeave(c))
+					api.Output(c).PrintResponse(canLeave(c))
 					return nil
 
 				},
@@ -532,7 +532,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(leave(c, bondRefundAddress))
+					api.Output(c).PrintResponse(leave(c, bondRefundAddress))
 					return nil
 
 				},
@@ -554,7 +554,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canProposeSettingMembersQuorum(c, quorum))
+					api.Output(c).PrintResponse(canProposeSettingMembersQuorum(c, quorum))
 					return nil
 
 				},
@@ -575,7 +575,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(proposeSettingMembersQuorum(c, quorum))
+					api.Output(c).PrintResponse(proposeSettingMembersQuorum(c, quorum))
 					return nil
 
 				},
@@ -597,7 +597,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canProposeSettingMembersRplBond(c, bondAmountWei))
+					api.Output(c).PrintResponse(canProposeSettingMembersRplBond(c, bondAmountWei))
 					return nil
 
 				},
@@ -618,7 +618,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(proposeSettingMembersRplBond(c, bondAmountWei))
+					api.Output(c).PrintResponse(proposeSettingMembersRplBond(c, bondAmountWei))
 					return nil
 
 				},
@@ -640,7 +640,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canProposeSettingMinipoolUnbondedMax(c, unbondedMinipoolMax))
+					api.Output(c).PrintResponse(canProposeSettingMinipoolUnbondedMax(c, unbondedMinipoolMax))
 					return nil
 
 				},
@@ -661,7 +661,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(proposeSettingMinipoolUnbondedMax(c, unbondedMinipoolMax))
+					api.Output(c).PrintResponse(proposeSettingMinipoolUnbondedMax(c, unbondedMinipoolMax))
 					return nil
 
 				},
@@ -683,7 +683,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canProposeSettingProposalCooldown(c, proposalCooldownBlocks))
+					api.Output(c).PrintResponse(canProposeSettingProposalCooldown(c, proposalCooldownBlocks))
 					return nil
 
 				},
@@ -704,7 +704,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(proposeSettingProposalCooldown(c, proposalCooldownBlocks))
+					api.Output(c).PrintResponse(proposeSettingProposalCooldown(c, proposalCooldownBlocks))
 					return nil
 
 				},
@@ -726,7 +726,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canProposeSettingProposalVoteTimespan(c, proposalVoteTimespan))
+					api.Output(c).PrintResponse(canProposeSettingProposalVoteTimespan(c, proposalVoteTimespan))
 					return nil
 
 				},
@@ -747,7 +747,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(proposeSettingProposalVoteTimespan(c, proposalVoteTimespan))
+					api.Output(c).PrintResponse(proposeSettingProposalVoteTimespan(c, proposalVoteTimespan))
 					return nil
 
 				},
@@ -769,7 +769,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canProposeSettingProposalVoteDelayTimespan(c, proposalDelayTimespan))
+					api.Output(c).PrintResponse(canProposeSettingProposalVoteDelayTimespan(c, proposalDelayTimespan))
 					return nil
 
 				},
@@ -790,7 +790,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(proposeSettingProposalVoteDelayTimespan(c, proposalDelayTimespan))
+					api.Output(c).PrintResponse(proposeSettingProposalVoteDelayTimespan(c, proposalDelayTimespan))
 					return nil
 
 				},
@@ -812,7 +812,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canProposeSettingProposalExecuteTimespan(c, proposalExecuteTimespan))
+					api.Output(c).PrintResponse(canProposeSettingProposalExecuteTimespan(c, proposalExecuteTimespan))
 					return nil
 
 				},
@@ -833,7 +833,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(proposeSettingProposalExecuteTimespan(c, proposalExecuteTimespan))
+					api.Output(c).PrintResponse(proposeSettingProposalExecuteTimespan(c, proposalExecuteTimespan))
 					return nil
 
 				},
@@ -855,7 +855,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canProposeSettingProposalActionTimespan(c, proposalActionTimespan))
+					api.Output(c).PrintResponse(canProposeSettingProposalActionTimespan(c, proposalActionTimespan))
 					return nil
 
 				},
@@ -876,7 +876,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(proposeSettingProposalActionTimespan(c, proposalActionTimespan))
+					api.Output(c).PrintResponse(proposeSettingProposalActionTimespan(c, proposalActionTimespan))
 					return nil
 
 				},
@@ -898,7 +898,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canProposeSettingScrubPeriod(c, scrubPeriod))
+					api.Output(c).PrintResponse(canProposeSettingScrubPeriod(c, scrubPeriod))
 					return nil
 
 				},
@@ -919,7 +919,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(proposeSettingScrubPeriod(c, scrubPeriod))
+					api.Output(c).PrintResponse(proposeSettingScrubPeriod(c, scrubPeriod))
 					return nil
 
 				},
@@ -941,7 +941,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canProposeSettingPromotionScrubPeriod(c, scrubPeriod))
+					api.Output(c).PrintResponse(canProposeSettingPromotionScrubPeriod(c, scrubPeriod))
 					return nil
 
 				},
@@ -962,7 +962,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(proposeSettingPromotionScrubPeriod(c, scrubPeriod))
+					api.Output(c).PrintResponse(proposeSettingPromotionScrubPeriod(c, scrubPeriod))
 					return nil
 
 				},
@@ -984,7 +984,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canProposeSettingScrubPenaltyEnabled(c, enabled))
+					api.Output(c).PrintResponse(canProposeSettingScrubPenaltyEnabled(c, enabled))
 					return nil
 
 				},
@@ -1005,7 +1005,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(proposeSettingScrubPenaltyEnabled(c, enabled))
+					api.Output(c).PrintResponse(proposeSettingScrubPenaltyEnabled(c, enabled))
 					return nil
 
 				},
@@ -1027,7 +1027,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canProposeSettingBondReductionWindowStart(c, windowStart))
+					api.Output(c).PrintResponse(canProposeSettingBondReductionWindowStart(c, windowStart))
 					return nil
 
 				},
@@ -1048,7 +1048,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(proposeSettingBondReductionWindowStart(c, windowStart))
+					api.Output(c).PrintResponse(proposeSettingBondReductionWindowStart(c, windowStart))
 					return nil
 
 				},
@@ -1070,7 +1070,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canProposeSettingBondReductionWindowLength(c, windowLength))
+					api.Output(c).PrintResponse(canProposeSettingBondReductionWindowLength(c, windowLength))
 					return nil
 
 				},
@@ -1091,7 +1091,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(proposeSettingBondReductionWindowLength(c, windowLength))
+					api.Output(c).PrintResponse(proposeSettingBondReductionWindowLength(c, windowLength))
 					return nil
 
 				},
@@ -1104,7 +1104,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				Action: func(c *cli.Context) error {
 
 					// Run
-					api.PrintResponse(getMemberSettings(c))
+					api.Output(c).PrintResponse(getMemberSettings(c))
 					return nil
 
 				},
@@ -1116,7 +1116,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				Action: func(c *cli.Context) error {
 
 					// Run
-					api.PrintResponse(getProposalSettings(c))
+					api.Output(c).PrintResponse(getProposalSettings(c))
 					return nil
 
 				},
@@ -1128,7 +1128,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				Action: func(c *cli.Context) error {
 
 					// Run
-					api.PrintResponse(getMinipoolSettings(c))
+					api.Output(c).PrintResponse(getMinipoolSettings(c))
 					return nil
 
 				},
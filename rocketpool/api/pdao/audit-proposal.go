@@ -0,0 +1,122 @@
+package pdao
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/rocket-pool/smartnode/bindings/dao/protocol"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/proposals"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func auditProposal(c *cli.Context, proposalId uint64) (*api.PDAOAuditProposalResponse, error) {
+	// Get services
+	if err := services.RequireRocketStorage(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireBeaconClientSynced(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.PDAOAuditProposalResponse{}
+
+	// Check proposal exists
+	proposalCount, err := protocol.GetTotalProposalCount(rp, nil)
+	if err != nil {
+		return nil, err
+	}
+	if proposalId == 0 || proposalId > proposalCount {
+		response.DoesNotExist = true
+		return &response, nil
+	}
+
+	// Get the proposal details
+	prop, err := protocol.GetProposalDetails(rp, proposalId, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting proposal %d details: %w", proposalId, err)
+	}
+	response.ProposerAddress = prop.ProposerAddress
+	response.TargetBlock = prop.TargetBlock
+	response.State = prop.State
+	response.DepthPerRound, err = protocol.GetDepthPerRound(rp, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting depth per round: %w", err)
+	}
+
+	// Get all of the root submissions, starting from the target block since they can't come before it
+	latestBlock, err := rp.Client.BlockNumber(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("error getting latest block number: %w", err)
+	}
+	intervalSize := big.NewInt(int64(cfg.Geth.EventLogInterval))
+	startBlock := big.NewInt(int64(prop.TargetBlock))
+	endBlock := big.NewInt(0).SetUint64(latestBlock)
+	verifierAddresses := cfg.Smartnode.GetPreviousRocketDAOProtocolVerifierAddresses()
+	events, err := protocol.GetRootSubmittedEvents(rp, []uint64{proposalId}, intervalSize, startBlock, endBlock, verifierAddresses, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error scanning for proposal %d's RootSubmitted events: %w", proposalId, err)
+	}
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].Index.Cmp(events[j].Index) < 0
+	})
+
+	// Audit each submission against the local trees
+	propMgr, err := proposals.NewProposalManager(nil, cfg, rp, bc)
+	if err != nil {
+		return nil, err
+	}
+	response.Submissions = make([]api.PDAORootSubmissionAudit, len(events))
+	for i, event := range events {
+		audit, err := propMgr.AuditRootSubmission(event)
+		if err != nil {
+			return nil, fmt.Errorf("error auditing root submission for index %d: %w", event.Index.Uint64(), err)
+		}
+
+		// Get the challenge state of each mismatch
+		mismatches := make([]api.PDAOTreeNodeMismatch, len(audit.Mismatches))
+		for j, mismatch := range audit.Mismatches {
+			state, err := protocol.GetChallengeState(rp, proposalId, mismatch.Index, nil)
+			if err != nil {
+				return nil, fmt.Errorf("error getting challenge state for index %d: %w", mismatch.Index, err)
+			}
+			mismatches[j] = api.PDAOTreeNodeMismatch{
+				Index:          mismatch.Index,
+				NodeAddress:    mismatch.NodeAddress,
+				Local:          mismatch.Local,
+				Submitted:      mismatch.Submitted,
+				ChallengeState: state,
+			}
+		}
+
+		response.Submissions[i] = api.PDAORootSubmissionAudit{
+			Index:         audit.Index,
+			Submitter:     event.Proposer,
+			Time:          event.Timestamp,
+			NodeAddress:   audit.NodeAddress,
+			LocalRoot:     audit.LocalRoot,
+			SubmittedRoot: audit.SubmittedRoot,
+			Mismatches:    mismatches,
+		}
+	}
+
+	// Return response
+	return &response, nil
+}
@@ -32,7 +32,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getProposals(c))
+					api.Output(c).PrintResponse(getProposals(c))
 					return nil
 
 				},
@@ -56,7 +56,71 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getProposal(c, id))
+					api.Output(c).PrintResponse(getProposal(c, id))
+					return nil
+
+				},
+			},
+
+			{
+				Name:      "governance-feed",
+				Usage:     "Get the proposals and timeline tracked by the node daemon's governance feed",
+				UsageText: "rocketpool api pdao governance-feed",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.Output(c).PrintResponse(getGovernanceFeed(c))
+					return nil
+
+				},
+			},
+
+			{
+				Name:      "test-voting-policy",
+				Usage:     "Show how the voting policy would vote on a proposal, or on every open proposal if the ID is 0, without voting",
+				UsageText: "rocketpool api pdao test-voting-policy proposal-id",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					var err error
+					if err = cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					id, err := cliutils.ValidateUint("proposal-id", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.Output(c).PrintResponse(testVotingPolicy(c, id))
+					return nil
+
+				},
+			},
+
+			{
+				Name:      "audit-proposal",
+				Usage:     "Compare every root submitted for a proposal against the local voting trees",
+				UsageText: "rocketpool api pdao audit-proposal proposal-id",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					var err error
+					if err = cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					id, err := cliutils.ValidateUint("proposal-id", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.Output(c).PrintResponse(auditProposal(c, id))
 					return nil
 
 				},
@@ -82,7 +146,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canVoteOnProposal(c, proposalId, voteDir))
+					api.Output(c).PrintResponse(canVoteOnProposal(c, proposalId, voteDir))
 					return nil
 
 				},
@@ -108,7 +172,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(voteOnProposal(c, proposalId, voteDir))
+					api.Output(c).PrintResponse(voteOnProposal(c, proposalId, voteDir))
 					return nil
 
 				},
@@ -134,7 +198,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canOverrideVote(c, proposalId, voteDir))
+					api.Output(c).PrintResponse(canOverrideVote(c, proposalId, voteDir))
 					return nil
 
 				},
@@ -159,7 +223,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(overrideVote(c, proposalId, voteDir))
+					api.Output(c).PrintResponse(overrideVote(c, proposalId, voteDir))
 					return nil
 
 				},
@@ -181,7 +245,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canExecuteProposal(c, proposalId))
+					api.Output(c).PrintResponse(canExecuteProposal(c, proposalId))
 					return nil
 
 				},
@@ -203,7 +267,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(executeProposal(c, proposalId))
+					api.Output(c).PrintResponse(executeProposal(c, proposalId))
 					return nil
 
 				},
@@ -216,7 +280,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				Action: func(c *cli.Context) error {
 
 					// Run
-					api.PrintResponse(getSettings(c))
+					api.Output(c).PrintResponse(getSettings(c))
 					return nil
 
 				},
@@ -237,7 +301,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					value := c.Args().Get(2)
 
 					// Run
-					api.PrintResponse(canProposeSetting(c, contractName, settingName, value))
+					api.Output(c).PrintResponse(canProposeSetting(c, contractName, settingName, value))
 					return nil
 
 				},
@@ -261,7 +325,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(proposeSetting(c, contractName, settingName, value, blockNumber))
+					api.Output(c).PrintResponse(proposeSetting(c, contractName, settingName, value, blockNumber))
 					return nil
 
 				},
@@ -279,7 +343,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getRewardsPercentages(c))
+					api.Output(c).PrintResponse(getRewardsPercentages(c))
 					return nil
 
 				},
@@ -308,7 +372,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canProposeRewardsPercentages(c, node, odao, pdao))
+					api.Output(c).PrintResponse(canProposeRewardsPercentages(c, node, odao, pdao))
 					return nil
 
 				},
@@ -341,7 +405,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(proposeRewardsPercentages(c, node, odao, pdao, blockNumber))
+					api.Output(c).PrintResponse(proposeRewardsPercentages(c, node, odao, pdao, blockNumber))
 					return nil
 
 				},
@@ -368,7 +432,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canProposeOneTimeSpend(c, invoiceID, recipient, amount, c.Args().Get(3)))
+					api.Output(c).PrintResponse(canProposeOneTimeSpend(c, invoiceID, recipient, amount, c.Args().Get(3)))
 					return nil
 
 				},
@@ -398,7 +462,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(proposeOneTimeSpend(c, invoiceID, recipient, amount, blockNumber, c.Args().Get(4)))
+					api.Output(c).PrintResponse(proposeOneTimeSpend(c, invoiceID, recipient, amount, blockNumber, c.Args().Get(4)))
 					return nil
 
 				},
@@ -437,7 +501,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canProposeRecurringSpend(c, contractName, recipient, amountPerPeriod, periodLength, time.Unix(int64(startTime), 0), numberOfPeriods, c.Args().Get(6)))
+					api.Output(c).PrintResponse(canProposeRecurringSpend(c, contractName, recipient, amountPerPeriod, periodLength, time.Unix(int64(startTime), 0), numberOfPeriods, c.Args().Get(6)))
 					return nil
 
 				},
@@ -478,7 +542,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 						return err
 					}
 					// Run
-					api.PrintResponse(proposeRecurringSpend(c, contractName, recipient, amountPerPeriod, periodLength, time.Unix(int64(startTime), 0), numberOfPeriods, blockNumber, c.Args().Get(7)))
+					api.Output(c).PrintResponse(proposeRecurringSpend(c, contractName, recipient, amountPerPeriod, periodLength, time.Unix(int64(startTime), 0), numberOfPeriods, blockNumber, c.Args().Get(7)))
 					return nil
 
 				},
@@ -513,7 +577,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canProposeRecurringSpendUpdate(c, contractName, recipient, amountPerPeriod, periodLength, numberOfPeriods, c.Args().Get(5)))
+					api.Output(c).PrintResponse(canProposeRecurringSpendUpdate(c, contractName, recipient, amountPerPeriod, periodLength, numberOfPeriods, c.Args().Get(5)))
 					return nil
 
 				},
@@ -551,7 +615,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(proposeRecurringSpendUpdate(c, contractName, recipient, amountPerPeriod, periodLength, numberOfPeriods, blockNumber, c.Args().Get(6)))
+					api.Output(c).PrintResponse(proposeRecurringSpendUpdate(c, contractName, recipient, amountPerPeriod, periodLength, numberOfPeriods, blockNumber, c.Args().Get(6)))
 					return nil
 
 				},
@@ -574,7 +638,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canProposeInviteToSecurityCouncil(c, id, address))
+					api.Output(c).PrintResponse(canProposeInviteToSecurityCouncil(c, id, address))
 					return nil
 
 				},
@@ -600,7 +664,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(proposeInviteToSecurityCouncil(c, id, address, blockNumber))
+					api.Output(c).PrintResponse(proposeInviteToSecurityCouncil(c, id, address, blockNumber))
 					return nil
 
 				},
@@ -622,7 +686,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canProposeKickFromSecurityCouncil(c, address))
+					api.Output(c).PrintResponse(canProposeKickFromSecurityCouncil(c, address))
 					return nil
 
 				},
@@ -647,7 +711,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(proposeKickFromSecurityCouncil(c, address, blockNumber))
+					api.Output(c).PrintResponse(proposeKickFromSecurityCouncil(c, address, blockNumber))
 					return nil
 
 				},
@@ -669,7 +733,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canProposeKickMultiFromSecurityCouncil(c, addresses))
+					api.Output(c).PrintResponse(canProposeKickMultiFromSecurityCouncil(c, addresses))
 					return nil
 
 				},
@@ -694,7 +758,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(proposeKickMultiFromSecurityCouncil(c, addresses, blockNumber))
+					api.Output(c).PrintResponse(proposeKickMultiFromSecurityCouncil(c, addresses, blockNumber))
 					return nil
 
 				},
@@ -721,7 +785,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canProposeReplaceMemberOfSecurityCouncil(c, existingAddress, newID, newAddress))
+					api.Output(c).PrintResponse(canProposeReplaceMemberOfSecurityCouncil(c, existingAddress, newID, newAddress))
 					return nil
 
 				},
@@ -751,7 +815,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(proposeReplaceMemberOfSecurityCouncil(c, existingAddress, newID, newAddress, blockNumber))
+					api.Output(c).PrintResponse(proposeReplaceMemberOfSecurityCouncil(c, existingAddress, newID, newAddress, blockNumber))
 					return nil
 
 				},
@@ -769,7 +833,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getClaimableBonds(c))
+					api.Output(c).PrintResponse(getClaimableBonds(c))
 					return nil
 
 				},
@@ -795,7 +859,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canClaimBonds(c, proposalId, indices))
+					api.Output(c).PrintResponse(canClaimBonds(c, proposalId, indices))
 					return nil
 
 				},
@@ -824,7 +888,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(claimBonds(c, isProposer, proposalId, indices))
+					api.Output(c).PrintResponse(claimBonds(c, isProposer, proposalId, indices))
 					return nil
 
 				},
@@ -850,7 +914,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canDefeatProposal(c, proposalId, index))
+					api.Output(c).PrintResponse(canDefeatProposal(c, proposalId, index))
 					return nil
 
 				},
@@ -875,7 +939,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(defeatProposal(c, proposalId, index))
+					api.Output(c).PrintResponse(defeatProposal(c, proposalId, index))
 					return nil
 
 				},
@@ -897,7 +961,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canFinalizeProposal(c, proposalId))
+					api.Output(c).PrintResponse(canFinalizeProposal(c, proposalId))
 					return nil
 
 				},
@@ -918,7 +982,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(finalizeProposal(c, proposalId))
+					api.Output(c).PrintResponse(finalizeProposal(c, proposalId))
 					return nil
 
 				},
@@ -940,7 +1004,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(estimateSetVotingDelegateGas(c, delegate))
+					api.Output(c).PrintResponse(estimateSetVotingDelegateGas(c, delegate))
 					return nil
 
 				},
@@ -962,7 +1026,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(setVotingDelegate(c, delegate))
+					api.Output(c).PrintResponse(setVotingDelegate(c, delegate))
 					return nil
 
 				},
@@ -979,7 +1043,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getCurrentVotingDelegate(c))
+					api.Output(c).PrintResponse(getCurrentVotingDelegate(c))
 					return nil
 
 				},
@@ -996,7 +1060,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getStatus(c))
+					api.Output(c).PrintResponse(getStatus(c))
 					return nil
 
 				},
@@ -1021,7 +1085,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canSetSignallingAddress(c, signallingAddress, signature))
+					api.Output(c).PrintResponse(canSetSignallingAddress(c, signallingAddress, signature))
 					return nil
 
 				},
@@ -1046,7 +1110,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(setSignallingAddress(c, signallingAddress, signature))
+					api.Output(c).PrintResponse(setSignallingAddress(c, signallingAddress, signature))
 					return nil
 
 				},
@@ -1062,7 +1126,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 						return err
 					}
 					// Run
-					api.PrintResponse(canClearSignallingAddress(c))
+					api.Output(c).PrintResponse(canClearSignallingAddress(c))
 					return nil
 
 				},
@@ -1078,7 +1142,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 						return err
 					}
 					// Run
-					api.PrintResponse(clearSignallingAddress(c))
+					api.Output(c).PrintResponse(clearSignallingAddress(c))
 					return nil
 
 				},
@@ -1103,7 +1167,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 						}
 					}
 					// Run
-					api.PrintResponse(canProposeAllowListedControllers(c, addressList))
+					api.Output(c).PrintResponse(canProposeAllowListedControllers(c, addressList))
 					return nil
 
 				},
@@ -1141,7 +1205,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(proposeAllowListedControllers(c, addressList, blockNumber))
+					api.Output(c).PrintResponse(proposeAllowListedControllers(c, addressList, blockNumber))
 					return nil
 
 				},
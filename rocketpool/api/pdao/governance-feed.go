@@ -0,0 +1,32 @@
+package pdao
+
+import (
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/governance"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func getGovernanceFeed(c *cli.Context) (*api.PDAOGovernanceFeedResponse, error) {
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.PDAOGovernanceFeedResponse{}
+
+	// Read the feed written by the node daemon
+	snapshot, err := governance.NewFeed(cfg.Smartnode.GetGovernanceFeedPath()).Get()
+	if err != nil {
+		return nil, err
+	}
+	response.UpdatedAt = snapshot.UpdatedAt
+	response.Proposals = snapshot.GetSortedProposals()
+	response.Events = snapshot.Events
+
+	// Return response
+	return &response, nil
+}
@@ -0,0 +1,105 @@
+package pdao
+
+import (
+	"fmt"
+
+	"github.com/rocket-pool/smartnode/bindings/dao/protocol"
+	"github.com/rocket-pool/smartnode/bindings/types"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/governance"
+	"github.com/rocket-pool/smartnode/shared/services/proposals"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func testVotingPolicy(c *cli.Context, proposalId uint64) (*api.PDAOTestVotingPolicyResponse, error) {
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.PDAOTestVotingPolicyResponse{
+		PolicyPath: cfg.Smartnode.GetVotingPolicyPath(),
+	}
+
+	// Load the policy
+	policy, err := governance.LoadVotingPolicy(response.PolicyPath)
+	if err != nil {
+		return nil, err
+	}
+	if policy == nil {
+		return &response, nil
+	}
+	response.PolicyExists = true
+	response.Enabled = policy.Enabled
+
+	// Get node account
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the proposals to test against
+	var props []protocol.ProtocolDaoProposalDetails
+	if proposalId != 0 {
+		proposalCount, err := protocol.GetTotalProposalCount(rp, nil)
+		if err != nil {
+			return nil, err
+		}
+		if proposalId > proposalCount {
+			response.DoesNotExist = true
+			return &response, nil
+		}
+		prop, err := protocol.GetProposalDetails(rp, proposalId, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error getting proposal %d details: %w", proposalId, err)
+		}
+		props = []protocol.ProtocolDaoProposalDetails{prop}
+	} else {
+		allProps, err := protocol.GetProposals(rp, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error getting proposals: %w", err)
+		}
+		for _, prop := range allProps {
+			if prop.State == types.ProtocolDaoProposalState_Pending ||
+				prop.State == types.ProtocolDaoProposalState_ActivePhase1 ||
+				prop.State == types.ProtocolDaoProposalState_ActivePhase2 {
+				props = append(props, prop)
+			}
+		}
+	}
+
+	// Work out what the policy would do with each of them
+	propMgr, err := proposals.NewProposalManager(nil, cfg, rp, bc)
+	if err != nil {
+		return nil, err
+	}
+	response.Votes = make([]governance.AutomaticVote, len(props))
+	for i, prop := range props {
+		response.Votes[i], err = governance.PlanAutomaticVote(rp, propMgr, policy, prop, nodeAccount.Address, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Return response
+	return &response, nil
+}
@@ -28,7 +28,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getStatus(c))
+					api.Output(c).PrintResponse(getStatus(c))
 					return nil
 
 				},
@@ -51,7 +51,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(canProcessQueue(c, int64(max)))
+					api.Output(c).PrintResponse(canProcessQueue(c, int64(max)))
 					return nil
 
 				},
@@ -74,7 +74,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(processQueue(c, int64(max)))
+					api.Output(c).PrintResponse(processQueue(c, int64(max)))
 					return nil
 
 				},
@@ -91,7 +91,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getQueueDetails(c))
+					api.Output(c).PrintResponse(getQueueDetails(c))
 					return nil
 
 				},
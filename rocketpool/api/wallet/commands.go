@@ -28,7 +28,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(getStatus(c))
+					api.Output(c).PrintResponse(getStatus(c))
 					return nil
 
 				},
@@ -51,7 +51,48 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(setPassword(c, password))
+					api.Output(c).PrintResponse(setPassword(c, password))
+					return nil
+
+				},
+			},
+
+			{
+				Name:      "unlock",
+				Aliases:   []string{"u"},
+				Usage:     "Unlock the node wallet when its password isn't stored",
+				UsageText: "rocketpool api wallet unlock password",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					password, err := cliutils.ValidateNodePassword("wallet password", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.Output(c).PrintResponse(unlockWallet(c, password))
+					return nil
+
+				},
+			},
+
+			{
+				Name:      "sign-tx",
+				Usage:     "Sign a transaction that was prepared for offline signing. The TX must be serialized as a hex string.",
+				UsageText: "rocketpool api wallet sign-tx tx",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+
+					// Run
+					api.Output(c).PrintResponse(signTransaction(c, c.Args().Get(0)))
 					return nil
 
 				},
@@ -76,7 +117,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(initWallet(c))
+					api.Output(c).PrintResponse(initWallet(c))
 					return nil
 
 				},
@@ -114,7 +155,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(recoverWallet(c, mnemonic))
+					api.Output(c).PrintResponse(recoverWallet(c, mnemonic))
 					return nil
 
 				},
@@ -147,7 +188,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(searchAndRecoverWallet(c, mnemonic, address))
+					api.Output(c).PrintResponse(searchAndRecoverWallet(c, mnemonic, address))
 					return nil
 
 				},
@@ -166,7 +207,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(rebuildWallet(c))
+					api.Output(c).PrintResponse(rebuildWallet(c))
 					return nil
 
 				},
@@ -204,7 +245,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(testRecoverWallet(c, mnemonic))
+					api.Output(c).PrintResponse(testRecoverWallet(c, mnemonic))
 					return nil
 
 				},
@@ -237,7 +278,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(testSearchAndRecoverWallet(c, mnemonic, address))
+					api.Output(c).PrintResponse(testSearchAndRecoverWallet(c, mnemonic, address))
 					return nil
 
 				},
@@ -256,7 +297,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(exportWallet(c))
+					api.Output(c).PrintResponse(exportWallet(c))
 					return nil
 
 				},
@@ -274,7 +315,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(setEnsName(c, c.Args().Get(0), true))
+					api.Output(c).PrintResponse(setEnsName(c, c.Args().Get(0), true))
 					return nil
 
 				},
@@ -292,7 +333,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(setEnsName(c, c.Args().Get(0), false))
+					api.Output(c).PrintResponse(setEnsName(c, c.Args().Get(0), false))
 					return nil
 
 				},
@@ -314,7 +355,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(masquerade(c, address))
+					api.Output(c).PrintResponse(masquerade(c, address))
 					return nil
 
 				},
@@ -332,7 +373,7 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 					}
 
 					// Run
-					api.PrintResponse(endMasquerade(c))
+					api.Output(c).PrintResponse(endMasquerade(c))
 					return nil
 
 				},
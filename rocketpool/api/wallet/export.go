@@ -2,10 +2,12 @@ package wallet
 
 import (
 	"encoding/hex"
+	"errors"
 
 	"github.com/urfave/cli"
 
 	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
 	"github.com/rocket-pool/smartnode/shared/types/api"
 )
 
@@ -35,18 +37,21 @@ func exportWallet(c *cli.Context) (*api.ExportWalletResponse, error) {
 	response.Password = password
 
 	// Serialize wallet
-	wallet, err := w.String()
+	walletString, err := w.String()
 	if err != nil {
 		return nil, err
 	}
-	response.Wallet = wallet
+	response.Wallet = walletString
 
-	// Get account private key
+	// Get account private key; an external signer never gives it up
 	privateKey, err := w.GetNodePrivateKeyBytes()
-	if err != nil {
+	if errors.Is(err, wallet.ErrExternalSigner) {
+		response.AccountPrivateKey = ""
+	} else if err != nil {
 		return nil, err
+	} else {
+		response.AccountPrivateKey = hex.EncodeToString(privateKey)
 	}
-	response.AccountPrivateKey = hex.EncodeToString(privateKey)
 
 	// Return response
 	return &response, nil
@@ -13,6 +13,9 @@ import (
 func initWallet(c *cli.Context) (*api.InitWalletResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeWalletUnlocked(c); err != nil {
+		return nil, err
+	}
 	w, err := services.GetWallet(c)
 	if err != nil {
 		return nil, err
@@ -6,11 +6,13 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/rocket-pool/smartnode/bindings/rocketpool"
+	"github.com/rocket-pool/smartnode/bindings/types"
 	"github.com/urfave/cli"
 
 	"github.com/rocket-pool/smartnode/shared/services"
 	"github.com/rocket-pool/smartnode/shared/services/wallet"
 	"github.com/rocket-pool/smartnode/shared/types/api"
+	"github.com/rocket-pool/smartnode/shared/utils/validator"
 	walletutils "github.com/rocket-pool/smartnode/shared/utils/wallet"
 )
 
@@ -21,6 +23,9 @@ const (
 func recoverWallet(c *cli.Context, mnemonic string) (*api.RecoverWalletResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeWalletUnlocked(c); err != nil {
+		return nil, err
+	}
 	w, err := services.GetWallet(c)
 	if err != nil {
 		return nil, err
@@ -86,6 +91,9 @@ func recoverWallet(c *cli.Context, mnemonic string) (*api.RecoverWalletResponse,
 		return nil, err
 	}
 
+	// Try to load the validator keys into the VC without restarting it
+	response.ValidatorKeysLoaded = loadRecoveredKeys(c, w, response.ValidatorKeys)
+
 	// Return response
 	return &response, nil
 
@@ -94,6 +102,9 @@ func recoverWallet(c *cli.Context, mnemonic string) (*api.RecoverWalletResponse,
 func searchAndRecoverWallet(c *cli.Context, mnemonic string, address common.Address) (*api.SearchAndRecoverWalletResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeWalletUnlocked(c); err != nil {
+		return nil, err
+	}
 	w, err := services.GetWallet(c)
 	if err != nil {
 		return nil, err
@@ -185,7 +196,27 @@ func searchAndRecoverWallet(c *cli.Context, mnemonic string, address common.Addr
 		return nil, err
 	}
 
+	// Try to load the validator keys into the VC without restarting it
+	response.ValidatorKeysLoaded = loadRecoveredKeys(c, w, response.ValidatorKeys)
+
 	// Return response
 	return &response, nil
 
 }
+
+// Load recovered validator keys into the VC through its Keymanager API, returning false if they couldn't be loaded
+func loadRecoveredKeys(c *cli.Context, w wallet.Wallet, pubkeys []types.ValidatorPubkey) bool {
+	if len(pubkeys) == 0 {
+		return false
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return false
+	}
+	km, err := services.GetKeymanagerClient(c)
+	if err != nil {
+		return false
+	}
+
+	return validator.ImportValidatorKeys(cfg, km, w, pubkeys) == nil
+}
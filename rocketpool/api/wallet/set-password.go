@@ -12,6 +12,9 @@ import (
 func setPassword(c *cli.Context, password string) (*api.SetPasswordResponse, error) {
 
 	// Get services
+	if err := services.RequireNodeWalletUnlocked(c); err != nil {
+		return nil, err
+	}
 	pm, err := services.GetPasswordManager(c)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,63 @@
+package wallet
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// Sign a transaction that was prepared for offline signing.
+// This only uses the wallet, so it works on a machine without any Execution or Consensus client.
+func signTransaction(c *cli.Context, unsignedTx string) (*api.SignTransactionResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetHdWallet(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.SignTransactionResponse{}
+
+	// Decode the transaction
+	txBytes, err := hexutil.Decode(unsignedTx)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding transaction: %w", err)
+	}
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(txBytes); err != nil {
+		return nil, fmt.Errorf("error decoding transaction: %w", err)
+	}
+	if tx.ChainId().Cmp(w.GetChainID()) != 0 {
+		return nil, fmt.Errorf("The transaction is for chain ID %s, but this node is configured for chain ID %s. Please make sure the Smartnode is set to the same network on both machines.", tx.ChainId().String(), w.GetChainID().String())
+	}
+
+	// Sign it
+	account, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+	signedBytes, err := w.Sign(txBytes)
+	if err != nil {
+		return nil, fmt.Errorf("error signing transaction: %w", err)
+	}
+	signedTx := new(types.Transaction)
+	if err := signedTx.UnmarshalBinary(signedBytes); err != nil {
+		return nil, fmt.Errorf("error decoding signed transaction: %w", err)
+	}
+	response.SignedTx = signedBytes
+	response.TxHash = signedTx.Hash()
+	response.From = account.Address
+
+	// Return response
+	return &response, nil
+
+}
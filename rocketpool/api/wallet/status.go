@@ -25,6 +25,13 @@ func getStatus(c *cli.Context) (*api.WalletStatusResponse, error) {
 	// Get wallet type
 	response.IsMasquerading = w.IsNodeMasquerading()
 
+	// Get password storage status
+	response.PasswordStorage = pm.GetStorageMode()
+	response.WalletLocked, err = services.IsNodeWalletLocked(c)
+	if err != nil {
+		return nil, err
+	}
+
 	// Get wallet status
 	if response.IsMasquerading {
 		response.PasswordSet = true
@@ -0,0 +1,51 @@
+package wallet
+
+import (
+	"errors"
+	"os"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/passwords"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func unlockWallet(c *cli.Context, password string) (*api.UnlockWalletResponse, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	pm, err := services.GetPasswordManager(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.UnlockWalletResponse{}
+
+	// Check the wallet can be unlocked
+	if pm.GetStorageMode() != passwords.StorageMode_Unlock {
+		return nil, errors.New("The node wallet's password is stored, so it doesn't need to be unlocked")
+	}
+	if pm.IsPasswordSet() {
+		return nil, errors.New("The node wallet is already unlocked")
+	}
+
+	// Make sure the password is correct before giving it to the node daemon
+	if err := wallet.CheckPassword(os.ExpandEnv(cfg.Smartnode.GetWalletPath()), password); err != nil {
+		return nil, err
+	}
+
+	// Unlock it
+	if err := pm.SetPassword(password); err != nil {
+		return nil, err
+	}
+
+	// Return response
+	return &response, nil
+
+}
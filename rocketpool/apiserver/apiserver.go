@@ -0,0 +1,192 @@
+package apiserver
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/rocketpool/api"
+	"github.com/rocket-pool/smartnode/shared/services"
+	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+// Config
+const (
+	ApiServerColor = color.FgHiBlue
+	ErrorColor     = color.FgRed
+
+	// How long a client has to send its request headers
+	readHeaderTimeout = 10 * time.Second
+)
+
+// Register commands
+func RegisterCommands(app *cli.App, name string, aliases []string) {
+	app.Commands = append(app.Commands, cli.Command{
+		Name:    name,
+		Aliases: aliases,
+		Usage:   "Run the Rocket Pool API server, which serves the api commands on a Unix socket (and optionally an authenticated TCP port)",
+		Action: func(c *cli.Context) error {
+			return run(c)
+		},
+	})
+}
+
+// Run the server
+func run(c *cli.Context) error {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return err
+	}
+	if !cfg.Smartnode.EnableApiServer.Value.(bool) {
+		return fmt.Errorf("the API server is disabled in the Smartnode settings")
+	}
+
+	// Initialize loggers
+	logger := log.NewColorLogger(ApiServerColor)
+	errorLog := log.NewColorLogger(ErrorColor)
+
+	// Create a copy of the app for running API commands
+	apiApp := cli.NewApp()
+	apiApp.Name = c.App.Name
+	apiApp.Version = c.App.Version
+	apiApp.Flags = c.App.Flags
+	apiApp.Before = func(c *cli.Context) error {
+		services.PrepareForApiRequest(c)
+		return nil
+	}
+	api.RegisterCommands(apiApp, "api", []string{"a"})
+	server := NewServer(apiApp, c.GlobalString("settings"), &logger)
+
+	errs := make(chan error, 2)
+
+	// Serve on the Unix socket
+	socketPath := cfg.Smartnode.GetApiSocketPath()
+	socketListener, err := listenOnSocket(socketPath)
+	if err != nil {
+		return err
+	}
+	logger.Printlnf("Serving the API on %s.", socketPath)
+	go func() {
+		errs <- serve(socketListener, server.GetHandler(""))
+	}()
+
+	// Serve on the TCP port if it's been exposed
+	portMode := cfg.Smartnode.ApiServerOpenPort.Value.(cfgtypes.RPCMode)
+	if portMode.Open() {
+		token, err := loadOrCreateToken(cfg.Smartnode.GetApiTokenPath())
+		if err != nil {
+			return err
+		}
+
+		// In Docker mode the port mapping restricts access to localhost, so the server has to listen on every interface
+		host := "0.0.0.0"
+		if cfg.IsNativeMode {
+			host = "127.0.0.1"
+		}
+		address := fmt.Sprintf("%s:%d", host, cfg.Smartnode.ApiServerPort.Value.(uint16))
+		tcpListener, err := net.Listen("tcp", address)
+		if err != nil {
+			return fmt.Errorf("error listening on %s: %w", address, err)
+		}
+		logger.Printlnf("Serving the API on %s; requests must provide the token in %s.", address, cfg.Smartnode.GetApiTokenPath())
+		go func() {
+			errs <- serve(tcpListener, server.GetHandler(token))
+		}()
+	}
+
+	err = <-errs
+	errorLog.Println(err)
+	return err
+
+}
+
+// Serve HTTP requests until the listener fails
+func serve(listener net.Listener, handler http.Handler) error {
+	httpServer := &http.Server{
+		Handler:           handler,
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+	err := httpServer.Serve(listener)
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("error serving the API on %s: %w", listener.Addr().String(), err)
+	}
+	return nil
+}
+
+// Create the Unix socket, replacing any that was left behind by a previous run.
+func listenOnSocket(path string) (net.Listener, error) {
+	err := os.Remove(path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("error removing old API socket [%s]: %w", path, err)
+	}
+
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, fmt.Errorf("error listening on API socket [%s]: %w", path, err)
+	}
+	err = os.Chmod(path, 0660)
+	if err != nil {
+		listener.Close()
+		return nil, fmt.Errorf("error setting permissions on API socket [%s]: %w", path, err)
+	}
+
+	giveToFolderOwner(path)
+	return listener, nil
+}
+
+// Load the token TCP clients must provide, creating a new one if it doesn't exist yet
+func loadOrCreateToken(path string) (string, error) {
+	bytes, err := os.ReadFile(path)
+	if err == nil {
+		token := strings.TrimSpace(string(bytes))
+		if token != "" {
+			return token, nil
+		}
+	} else if !os.IsNotExist(err) {
+		return "", fmt.Errorf("error reading API token [%s]: %w", path, err)
+	}
+
+	tokenBytes := make([]byte, 32)
+	_, err = rand.Read(tokenBytes)
+	if err != nil {
+		return "", fmt.Errorf("error generating API token: %w", err)
+	}
+	token := hex.EncodeToString(tokenBytes)
+	err = os.WriteFile(path, []byte(token), 0600)
+	if err != nil {
+		return "", fmt.Errorf("error saving API token [%s]: %w", path, err)
+	}
+	giveToFolderOwner(path)
+	return token, nil
+}
+
+// Give a file to the owner of the folder it's in, so the node operator can use it without root access
+func giveToFolderOwner(path string) {
+	folder := filepath.Dir(path)
+	info, err := os.Stat(folder)
+	if err != nil {
+		return
+	}
+	stat, ok := info.Sys().(*syscall.Stat_t)
+	if !ok {
+		return
+	}
+	err = os.Lchown(path, int(stat.Uid), int(stat.Gid))
+	if err != nil {
+		fmt.Printf("WARNING: couldn't give %s to the owner of %s, so only root will be able to use it: %s\n", path, folder, err.Error())
+	}
+}
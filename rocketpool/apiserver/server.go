@@ -0,0 +1,350 @@
+package apiserver
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	apiutils "github.com/rocket-pool/smartnode/shared/utils/api"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+const (
+	// The route that runs API commands
+	apiRoute string = "/v1/api"
+
+	// The route used to check if the server is up
+	healthRoute string = "/v1/health"
+
+	// The largest request body the server will accept
+	maxRequestSize int64 = 1 << 20
+)
+
+// Prefixes of the commands that only read from the chain or the node's files
+var readOnlyCommandPrefixes = []string{"can-", "get-", "estimate-", "is-"}
+
+// Other commands that only read from the chain or the node's files, or just wait for something to happen
+var readOnlyCommands = map[string]bool{
+	"status":                     true,
+	"wait":                       true,
+	"sync":                       true,
+	"gas-suggestions":            true,
+	"rewards":                    true,
+	"pending-rewards":            true,
+	"proposals":                  true,
+	"proposal-details":           true,
+	"dao-proposals":              true,
+	"members":                    true,
+	"lots":                       true,
+	"stats":                      true,
+	"slot":                       true,
+	"node-fee":                   true,
+	"rpl-price":                  true,
+	"check-collateral":           true,
+	"deposit-contract-info":      true,
+	"daemon-state":               true,
+	"tx-queue":                   true,
+	"timezone-map":               true,
+	"governance-feed":            true,
+	"resolve-ens-name":           true,
+	"reverse-resolve-ens-name":   true,
+	"latest-delegate":            true,
+	"validator-map-and-balances": true,
+}
+
+// The command groups whose other commands change files that every command uses, such as the wallet
+var exclusiveCommandGroups = map[string]bool{
+	"wallet":  true,
+	"service": true,
+}
+
+// Serves the API commands over HTTP. Commands are run in-process with the same command tree as `rocketpool api`,
+// so the config, clients and contract bindings are shared between calls instead of being rebuilt for each one.
+type Server struct {
+	app          *cli.App
+	settingsPath string
+	log          *log.ColorLogger
+
+	// Requests that change the process-wide services (the protected RPC, the client sync flags, offline signing, or
+	// the wallet and service files) and config reloads take this exclusively; every other request shares it
+	services sync.RWMutex
+
+	// Commands that can send transactions run one at a time so they don't race each other for the node's nonce;
+	// read-only commands and `wait` don't take it, so they never wait behind a transaction
+	transactions sync.Mutex
+
+	// When the settings file was last loaded
+	configModTime time.Time
+	configLock    sync.Mutex
+}
+
+// How a request has to be run alongside the others
+type runMode int
+
+const (
+	runMode_ReadOnly runMode = iota
+	runMode_Transaction
+	runMode_Exclusive
+)
+
+// Create a new API server that runs commands with the provided app, which must have the `api` command registered
+func NewServer(app *cli.App, settingsPath string, logger *log.ColorLogger) *Server {
+	// Keep help text and usage errors out of the responses, and don't let the app exit the process
+	app.Writer = io.Discard
+	app.ErrWriter = io.Discard
+	app.ExitErrHandler = func(c *cli.Context, err error) {}
+
+	// Each request runs on its own copy of the app, so set it up once here instead of in every copy
+	app.Setup()
+
+	server := &Server{
+		app:          app,
+		settingsPath: settingsPath,
+		log:          logger,
+	}
+	if info, err := os.Stat(os.ExpandEnv(settingsPath)); err == nil {
+		server.configModTime = info.ModTime()
+	}
+	return server
+}
+
+// Get the handler for the server's routes. If token isn't blank, every request must provide it as a bearer token.
+func (s *Server) GetHandler(token string) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(healthRoute, s.handleHealth)
+	mux.HandleFunc(apiRoute, s.handleApiCall)
+	mux.HandleFunc(apiRoute+"/", s.handleApiCall)
+	if token == "" {
+		return mux
+	}
+
+	expected := []byte("Bearer " + token)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		provided := []byte(r.Header.Get("Authorization"))
+		if subtle.ConstantTimeCompare(provided, expected) != 1 {
+			writeError(w, http.StatusUnauthorized, fmt.Errorf("missing or invalid API token"))
+			return
+		}
+		mux.ServeHTTP(w, r)
+	})
+}
+
+// Report that the server is up
+func (s *Server) handleHealth(w http.ResponseWriter, r *http.Request) {
+	writeResponse(w, http.StatusOK, &api.APIResponse{})
+}
+
+// Run an API command and return its response
+func (s *Server) handleApiCall(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("unsupported method %s", r.Method))
+		return
+	}
+
+	// Parse the request
+	request := api.ApiServerRequest{}
+	if r.Method == http.MethodPost && r.ContentLength != 0 {
+		err := json.NewDecoder(io.LimitReader(r.Body, maxRequestSize)).Decode(&request)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("error decoding request: %w", err))
+			return
+		}
+	} else {
+		request.Args = r.URL.Query()["arg"]
+	}
+
+	// The command can be in the path, the request body, or split between them
+	command := []string{}
+	for _, segment := range strings.Split(strings.TrimPrefix(r.URL.Path, apiRoute), "/") {
+		if segment != "" {
+			command = append(command, segment)
+		}
+	}
+	command = append(command, request.Args...)
+	if len(command) == 0 {
+		writeError(w, http.StatusNotFound, fmt.Errorf("no command provided"))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(s.runCommand(command, &request))
+}
+
+// Run an API command and capture its response
+func (s *Server) runCommand(command []string, request *api.ApiServerRequest) []byte {
+	s.reloadConfigIfChanged()
+
+	switch s.getRunMode(command, request) {
+	case runMode_Exclusive:
+		s.services.Lock()
+		defer s.services.Unlock()
+		defer services.FinishApiRequest()
+	case runMode_Transaction:
+		s.services.RLock()
+		defer s.services.RUnlock()
+		s.transactions.Lock()
+		defer s.transactions.Unlock()
+	default:
+		s.services.RLock()
+		defer s.services.RUnlock()
+	}
+
+	// Give the request its own copy of the app so its response doesn't get mixed up with other requests
+	buffer := new(bytes.Buffer)
+	app := *s.app
+	app.Metadata = map[string]interface{}{}
+	apiutils.SetResponseWriter(&app, buffer)
+	printer := apiutils.NewResponsePrinter(buffer)
+
+	err := s.runApp(&app, s.getArgs(command, request))
+	if err != nil {
+		printer.PrintErrorResponse(err)
+	} else if buffer.Len() == 0 {
+		printer.PrintErrorResponse(fmt.Errorf("command [%s] did not produce a response", strings.Join(command, " ")))
+	}
+	return buffer.Bytes()
+}
+
+// Work out how a command has to be run alongside the others
+func (s *Server) getRunMode(command []string, request *api.ApiServerRequest) runMode {
+	if request.IgnoreSyncCheck || request.ForceFallbacks || request.UseProtectedApi || request.Unsigned {
+		return runMode_Exclusive
+	}
+
+	// Find the command in the tree, following aliases
+	var group string
+	var leaf *cli.Command
+	commands := s.app.Commands
+	for _, arg := range append([]string{"api"}, command...) {
+		var next *cli.Command
+		for i := range commands {
+			if commands[i].HasName(arg) {
+				next = &commands[i]
+				break
+			}
+		}
+		if next == nil {
+			break
+		}
+		if leaf != nil {
+			group = leaf.Name
+		}
+		leaf = next
+		commands = next.Subcommands
+	}
+	if leaf == nil || len(leaf.Subcommands) > 0 {
+		// Unknown commands just fail, so they don't need a lock of their own
+		return runMode_ReadOnly
+	}
+
+	if readOnlyCommands[leaf.Name] {
+		return runMode_ReadOnly
+	}
+	for _, prefix := range readOnlyCommandPrefixes {
+		if strings.HasPrefix(leaf.Name, prefix) {
+			return runMode_ReadOnly
+		}
+	}
+	if exclusiveCommandGroups[group] {
+		return runMode_Exclusive
+	}
+	return runMode_Transaction
+}
+
+// Drop the shared services if the settings file has changed since they were loaded, so they pick up the new settings
+func (s *Server) reloadConfigIfChanged() {
+	info, err := os.Stat(os.ExpandEnv(s.settingsPath))
+	if err != nil {
+		return
+	}
+
+	s.configLock.Lock()
+	changed := !info.ModTime().Equal(s.configModTime)
+	s.configModTime = info.ModTime()
+	s.configLock.Unlock()
+	if !changed {
+		return
+	}
+
+	s.services.Lock()
+	defer s.services.Unlock()
+	services.ReloadConfig()
+	s.log.Println("The settings file has changed, reloaded the config.")
+}
+
+// Run the app, turning panics into errors so one bad command can't take the server down
+func (s *Server) runApp(app *cli.App, args []string) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("command panicked: %v", r)
+			s.log.Printlnf("ERROR: [%s] panicked: %v", strings.Join(args, " "), r)
+		}
+	}()
+	return app.Run(args)
+}
+
+// Build the command line for a request, the same way the CLI builds it for `docker exec`
+func (s *Server) getArgs(command []string, request *api.ApiServerRequest) []string {
+	args := []string{s.app.Name, "--settings", s.settingsPath}
+	if request.MaxFee != 0 {
+		args = append(args, "--maxFee", fmt.Sprint(request.MaxFee))
+	}
+	if request.MaxPrioFee != 0 {
+		args = append(args, "--maxPrioFee", fmt.Sprint(request.MaxPrioFee))
+	}
+	if request.GasLimit != 0 {
+		args = append(args, "--gasLimit", fmt.Sprint(request.GasLimit))
+	}
+	if request.Nonce != "" {
+		args = append(args, "--nonce", request.Nonce)
+	}
+	if request.IgnoreSyncCheck {
+		args = append(args, "--ignore-sync-check")
+	}
+	if request.ForceFallbacks {
+		args = append(args, "--force-fallbacks")
+	}
+	if request.UseProtectedApi {
+		args = append(args, "--use-protected-api")
+	}
+	if request.Unsigned {
+		args = append(args, "--unsigned")
+	}
+	args = append(args, "api")
+	return append(args, command...)
+}
+
+// Write a response that failed before it could reach a command
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeResponse(w, status, &api.APIResponse{
+		Status: "error",
+		Error:  err.Error(),
+	})
+}
+
+// Write a response directly
+func writeResponse(w http.ResponseWriter, status int, response *api.APIResponse) {
+	if response.Status == "" {
+		response.Status = "success"
+	}
+	bytes, err := json.Marshal(response)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(bytes)
+}
@@ -0,0 +1,204 @@
+package apiserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	apiutils "github.com/rocket-pool/smartnode/shared/utils/api"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+type echoResponse struct {
+	Status string   `json:"status"`
+	Error  string   `json:"error"`
+	Args   []string `json:"args"`
+	MaxFee float64  `json:"maxFee"`
+}
+
+// Create a server with a small stand-in for the api command tree.
+// The wait command blocks until the provided channel is closed.
+func newTestServer() *Server {
+	return newBlockingTestServer(nil, nil)
+}
+
+func newBlockingTestServer(waiting chan struct{}, release chan struct{}) *Server {
+	app := cli.NewApp()
+	app.Name = "rocketpool"
+	app.Flags = []cli.Flag{
+		cli.StringFlag{Name: "settings, s"},
+		cli.Float64Flag{Name: "maxFee"},
+	}
+	app.Commands = []cli.Command{{
+		Name: "api",
+		Subcommands: []cli.Command{
+			{
+				Name: "echo",
+				Action: func(c *cli.Context) error {
+					apiutils.Output(c).PrintResponse(&echoResponse{Args: c.Args(), MaxFee: c.GlobalFloat64("maxFee")}, nil)
+					return nil
+				},
+			},
+			{
+				Name: "wait",
+				Action: func(c *cli.Context) error {
+					waiting <- struct{}{}
+					<-release
+					apiutils.Output(c).PrintResponse(&api.APIResponse{}, nil)
+					return nil
+				},
+			},
+			{
+				Name:    "wallet",
+				Aliases: []string{"w"},
+				Subcommands: []cli.Command{
+					{Name: "status"},
+					{Name: "init"},
+				},
+			},
+			{
+				Name: "node",
+				Subcommands: []cli.Command{
+					{Name: "can-stake-rpl"},
+					{Name: "stake-rpl"},
+				},
+			},
+			{
+				Name: "panic",
+				Action: func(c *cli.Context) error {
+					panic("oops")
+				},
+			},
+		},
+	}}
+	logger := log.NewColorLogger(0)
+	return NewServer(app, "/.rocketpool/user-settings.yml", &logger)
+}
+
+func call(t *testing.T, handler http.Handler, request *http.Request, response interface{}) int {
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	err := json.Unmarshal(recorder.Body.Bytes(), response)
+	if err != nil {
+		t.Fatalf("error decoding response [%s]: %s", recorder.Body.String(), err.Error())
+	}
+	return recorder.Code
+}
+
+func TestApiCall(t *testing.T) {
+	handler := newTestServer().GetHandler("")
+
+	// Command and arguments in the body, like the CLI sends them
+	response := echoResponse{}
+	body := `{"args": ["echo", "a", "b c"], "maxFee": 12.5}`
+	call(t, handler, httptest.NewRequest(http.MethodPost, "/v1/api", strings.NewReader(body)), &response)
+	if response.Status != "success" || len(response.Args) != 2 || response.Args[1] != "b c" || response.MaxFee != 12.5 {
+		t.Fatalf("unexpected response: %+v", response)
+	}
+
+	// Command in the path, run a second time on the same app
+	response = echoResponse{}
+	call(t, handler, httptest.NewRequest(http.MethodGet, "/v1/api/echo?arg=x", nil), &response)
+	if response.Status != "success" || len(response.Args) != 1 || response.Args[0] != "x" || response.MaxFee != 0 {
+		t.Fatalf("unexpected response: %+v", response)
+	}
+}
+
+func TestApiCallErrors(t *testing.T) {
+	handler := newTestServer().GetHandler("")
+
+	response := api.APIResponse{}
+	call(t, handler, httptest.NewRequest(http.MethodGet, "/v1/api/missing", nil), &response)
+	if response.Status != "error" {
+		t.Fatalf("expected an unknown command to fail, got %+v", response)
+	}
+
+	response = api.APIResponse{}
+	call(t, handler, httptest.NewRequest(http.MethodGet, "/v1/api/panic", nil), &response)
+	if response.Status != "error" || !strings.Contains(response.Error, "oops") {
+		t.Fatalf("expected a panicking command to fail, got %+v", response)
+	}
+
+	// The server is still usable afterwards
+	echo := echoResponse{}
+	call(t, handler, httptest.NewRequest(http.MethodGet, "/v1/api/echo", nil), &echo)
+	if echo.Status != "success" {
+		t.Fatalf("unexpected response: %+v", echo)
+	}
+}
+
+func TestRunMode(t *testing.T) {
+	server := newTestServer()
+	tests := []struct {
+		command  []string
+		request  api.ApiServerRequest
+		expected runMode
+	}{
+		{command: []string{"wait", "0x01"}, expected: runMode_ReadOnly},
+		{command: []string{"wallet", "status"}, expected: runMode_ReadOnly},
+		{command: []string{"w", "init"}, expected: runMode_Exclusive},
+		{command: []string{"node", "can-stake-rpl", "1"}, expected: runMode_ReadOnly},
+		{command: []string{"node", "stake-rpl", "1"}, expected: runMode_Transaction},
+		{command: []string{"node", "stake-rpl", "1"}, request: api.ApiServerRequest{MaxFee: 10}, expected: runMode_Transaction},
+		{command: []string{"node", "stake-rpl", "1"}, request: api.ApiServerRequest{Unsigned: true}, expected: runMode_Exclusive},
+		{command: []string{"node", "can-stake-rpl", "1"}, request: api.ApiServerRequest{ForceFallbacks: true}, expected: runMode_Exclusive},
+		{command: []string{"missing"}, expected: runMode_ReadOnly},
+	}
+	for _, test := range tests {
+		mode := server.getRunMode(test.command, &test.request)
+		if mode != test.expected {
+			t.Errorf("expected %v to run in mode %d, got %d", test.command, test.expected, mode)
+		}
+	}
+}
+
+func TestConcurrentCalls(t *testing.T) {
+	waiting := make(chan struct{})
+	release := make(chan struct{})
+	handler := newBlockingTestServer(waiting, release).GetHandler("")
+
+	// Start a long-running command
+	done := make(chan api.APIResponse)
+	go func() {
+		response := api.APIResponse{}
+		call(t, handler, httptest.NewRequest(http.MethodGet, "/v1/api/wait", nil), &response)
+		done <- response
+	}()
+	<-waiting
+
+	// Other commands can run while it's waiting, and get their own responses
+	response := echoResponse{}
+	call(t, handler, httptest.NewRequest(http.MethodGet, "/v1/api/echo?arg=x", nil), &response)
+	if response.Status != "success" || len(response.Args) != 1 || response.Args[0] != "x" {
+		t.Fatalf("unexpected response: %+v", response)
+	}
+
+	close(release)
+	waitResponse := <-done
+	if waitResponse.Status != "success" {
+		t.Fatalf("unexpected response: %+v", waitResponse)
+	}
+}
+
+func TestToken(t *testing.T) {
+	handler := newTestServer().GetHandler("secret")
+
+	response := api.APIResponse{}
+	code := call(t, handler, httptest.NewRequest(http.MethodGet, "/v1/health", nil), &response)
+	if code != http.StatusUnauthorized || response.Status != "error" {
+		t.Fatalf("expected a request without a token to be rejected, got %d %+v", code, response)
+	}
+
+	request := httptest.NewRequest(http.MethodGet, "/v1/health", nil)
+	request.Header.Set("Authorization", "Bearer secret")
+	response = api.APIResponse{}
+	code = call(t, handler, request, &response)
+	if code != http.StatusOK || response.Status != "success" {
+		t.Fatalf("expected a request with the token to succeed, got %d %+v", code, response)
+	}
+}
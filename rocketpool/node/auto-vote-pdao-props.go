@@ -0,0 +1,266 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/smartnode/bindings/dao/protocol"
+	"github.com/rocket-pool/smartnode/bindings/rocketpool"
+	"github.com/rocket-pool/smartnode/bindings/types"
+	"github.com/rocket-pool/smartnode/bindings/utils/eth"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/alerting"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	rpgas "github.com/rocket-pool/smartnode/shared/services/gas"
+	"github.com/rocket-pool/smartnode/shared/services/governance"
+	"github.com/rocket-pool/smartnode/shared/services/proposals"
+	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/services/store"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+// The name of the duty in the daemon state store
+const autoVotePdaoPropsDuty string = "auto-vote-pdao-props"
+
+// How long before a voting phase ends that its vote has to go through regardless of the gas policy
+const autoVoteDeadlineMargin time.Duration = 12 * time.Hour
+
+// Auto vote on pDAO proposals task
+type autoVotePdaoProps struct {
+	c              *cli.Context
+	log            log.ColorLogger
+	cfg            *config.RocketPoolConfig
+	w              wallet.Wallet
+	txMgr          *txmanager.TransactionManager
+	rp             *rocketpool.RocketPool
+	store          *store.DutyStore
+	propMgr        *proposals.ProposalManager
+	maxFee         *big.Int
+	maxPriorityFee *big.Int
+	gasLimit       uint64
+
+	// The last reason each proposal was skipped for, so it's only logged when it changes
+	skipReasons map[string]string
+}
+
+// Create auto vote on pDAO proposals task
+func newAutoVotePdaoProps(c *cli.Context, logger log.ColorLogger) (*autoVotePdaoProps, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetHdWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	txMgr, err := services.GetTransactionManager(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+	dutyStore, err := services.GetDutyStore(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the user-requested max fee
+	maxFeeGwei := cfg.Smartnode.ManualMaxFee.Value.(float64)
+	var maxFee *big.Int
+	if maxFeeGwei == 0 {
+		maxFee = nil
+	} else {
+		maxFee = eth.GweiToWei(maxFeeGwei)
+	}
+
+	// Get the user-requested priority fee
+	priorityFeeGwei := cfg.Smartnode.PriorityFee.Value.(float64)
+	var priorityFee *big.Int
+	if priorityFeeGwei == 0 {
+		logger.Println("WARNING: priority fee was missing or 0, setting a default of 2.")
+		priorityFee = eth.GweiToWei(2)
+	} else {
+		priorityFee = eth.GweiToWei(priorityFeeGwei)
+	}
+
+	// Make a proposal manager
+	propMgr, err := proposals.NewProposalManager(&logger, cfg, rp, bc)
+	if err != nil {
+		return nil, fmt.Errorf("error creating proposal manager: %w", err)
+	}
+
+	// Return task
+	return &autoVotePdaoProps{
+		c:              c,
+		log:            logger,
+		cfg:            cfg,
+		w:              w,
+		txMgr:          txMgr,
+		rp:             rp,
+		store:          dutyStore,
+		propMgr:        propMgr,
+		maxFee:         maxFee,
+		maxPriorityFee: priorityFee,
+		gasLimit:       0,
+		skipReasons:    map[string]string{},
+	}, nil
+
+}
+
+// Vote on the open pDAO proposals according to the node operator's voting policy
+func (t *autoVotePdaoProps) run(ctx context.Context, state *state.NetworkState) error {
+
+	// Load the policy; voting automatically is opt-in
+	policy, err := governance.LoadVotingPolicy(t.cfg.Smartnode.GetVotingPolicyPath())
+	if err != nil {
+		return err
+	}
+	if policy == nil || !policy.Enabled {
+		return nil
+	}
+
+	// Get node account
+	nodeAccount, err := t.w.GetNodeAccount()
+	if err != nil {
+		return err
+	}
+	if _, exists := state.NodeDetailsByAddress[nodeAccount.Address]; !exists {
+		return nil
+	}
+	opts := &bind.CallOpts{
+		BlockNumber: big.NewInt(0).SetUint64(state.ElBlockNumber),
+	}
+
+	// Vote on the proposals in a voting phase
+	for _, details := range state.ProtocolDaoProposalDetails {
+		if details.State != types.ProtocolDaoProposalState_ActivePhase1 && details.State != types.ProtocolDaoProposalState_ActivePhase2 {
+			continue
+		}
+		vote, err := governance.PlanAutomaticVote(t.rp, t.propMgr, policy, details, nodeAccount.Address, opts)
+		if err != nil {
+			return fmt.Errorf("error evaluating the voting policy for proposal %d: %w", details.ID, err)
+		}
+		dutyKey := fmt.Sprintf("%d-%s", vote.ProposalID, vote.Phase)
+		if vote.Action == governance.VoteAction_None {
+			if t.skipReasons[dutyKey] != vote.SkipReason {
+				t.log.Printlnf("Not voting on proposal %d in %s: %s.", vote.ProposalID, vote.Phase, vote.SkipReason)
+				t.skipReasons[dutyKey] = vote.SkipReason
+			}
+			continue
+		}
+
+		// Don't vote again if a vote from a previous run is still in flight
+		pending, err := t.store.IsPending(t.rp.Client, autoVotePdaoPropsDuty, dutyKey)
+		if err != nil {
+			t.log.Printlnf("WARNING: couldn't check previous votes on proposal %d: %s", vote.ProposalID, err.Error())
+		} else if pending {
+			t.log.Printlnf("The vote on proposal %d already has a pending transaction, skipping it.", vote.ProposalID)
+			continue
+		}
+
+		deadline := details.Phase1EndTime
+		if vote.Phase == governance.Phase_Phase2 {
+			deadline = details.Phase2EndTime
+		}
+		err = t.castVote(ctx, vote, dutyKey, deadline.Add(-autoVoteDeadlineMargin))
+		if err != nil {
+			return fmt.Errorf("error voting on proposal %d: %w", vote.ProposalID, err)
+		}
+	}
+
+	return nil
+
+}
+
+// Submit an automatic vote
+func (t *autoVotePdaoProps) castVote(ctx context.Context, vote governance.AutomaticVote, dutyKey string, deadline time.Time) error {
+	direction := types.VoteDirections[vote.Decision.Direction]
+	t.log.Printlnf("Voting %s on proposal %d in %s with %.6f voting power, because %s.", direction, vote.ProposalID, vote.Phase, eth.WeiToEth(vote.VotingPower), vote.Decision.Reason)
+
+	// Get transactor
+	opts, err := t.txMgr.GetTransactor()
+	if err != nil {
+		return err
+	}
+	defer t.txMgr.ReleaseTransactor(opts)
+
+	// Get the gas limit
+	var gasInfo rocketpool.GasInfo
+	if vote.Action == governance.VoteAction_Vote {
+		gasInfo, err = protocol.EstimateVoteOnProposalGas(t.rp, vote.ProposalID, vote.Decision.Direction, vote.VotingPower, vote.NodeIndex, vote.Proof, opts)
+	} else {
+		gasInfo, err = protocol.EstimateOverrideVoteGas(t.rp, vote.ProposalID, vote.Decision.Direction, opts)
+	}
+	if err != nil {
+		return fmt.Errorf("error estimating the gas required to vote: %w", err)
+	}
+	var gas *big.Int
+	if t.gasLimit != 0 {
+		gas = new(big.Int).SetUint64(t.gasLimit)
+	} else {
+		gas = new(big.Int).SetUint64(gasInfo.SafeGasLimit)
+	}
+
+	// Get the max fee
+	maxFee := t.maxFee
+	if maxFee == nil || maxFee.Uint64() == 0 {
+		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg, t.rp.Client)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Check the gas policy
+	proceed, err := checkGasPolicy(t.cfg, t.txMgr, &t.log, autoVotePdaoPropsDuty, gasInfo, maxFee, t.gasLimit, deadline)
+	if err != nil {
+		return err
+	}
+	if !proceed {
+		return nil
+	}
+
+	opts.GasFeeCap = maxFee
+	opts.GasTipCap = GetPriorityFee(t.maxPriorityFee, maxFee)
+	opts.GasLimit = gas.Uint64()
+
+	// Vote
+	var hash common.Hash
+	if vote.Action == governance.VoteAction_Vote {
+		hash, err = protocol.VoteOnProposal(t.rp, vote.ProposalID, vote.Decision.Direction, vote.VotingPower, vote.NodeIndex, vote.Proof, opts)
+	} else {
+		hash, err = protocol.OverrideVote(t.rp, vote.ProposalID, vote.Decision.Direction, opts)
+	}
+	if err != nil {
+		return err
+	}
+	t.store.TryRecordSubmission(&t.log, autoVotePdaoPropsDuty, dutyKey, hash)
+	alerting.AlertPDAOAutomaticVote(t.cfg, vote, hash)
+
+	// Print TX info and wait for it to be included in a block
+	err = t.txMgr.TrackAndWaitContext(ctx, t.cfg, autoVotePdaoPropsDuty, opts, hash, &t.log)
+	t.store.TryRecordOutcome(&t.log, autoVotePdaoPropsDuty, dutyKey, err)
+	if err != nil {
+		return err
+	}
+
+	// Log
+	t.log.Printlnf("Successfully voted %s on proposal %d.", direction, vote.ProposalID)
+	return nil
+
+}
@@ -0,0 +1,99 @@
+package collectors
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rocket-pool/smartnode/bindings/utils/eth"
+
+	"github.com/rocket-pool/smartnode/shared/services/gas/policy"
+)
+
+// Represents the collector for the gas spent by the node's automatic transactions
+type GasSpendingCollector struct {
+	// The ETH spent on gas by each duty this month
+	spent *prometheus.Desc
+
+	// The number of transactions each duty has had mined this month
+	transactions *prometheus.Desc
+
+	// The monthly gas budget
+	budget *prometheus.Desc
+
+	// The ETH left in this month's gas budget
+	budgetRemaining *prometheus.Desc
+
+	// The ledger the spending is recorded in
+	ledger *policy.Ledger
+
+	// The monthly gas budget, or nil if there isn't one
+	budgetWei *big.Int
+
+	// Prefix for logging
+	logPrefix string
+}
+
+// Create a new GasSpendingCollector instance
+func NewGasSpendingCollector(ledger *policy.Ledger, budgetWei *big.Int) *GasSpendingCollector {
+	subsystem := "gas"
+	return &GasSpendingCollector{
+		spent: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "spent_eth"),
+			"The ETH spent on gas by each of the node's automatic duties this month",
+			[]string{"duty"}, nil,
+		),
+		transactions: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "transactions"),
+			"The number of transactions each of the node's automatic duties has had mined this month",
+			[]string{"duty"}, nil,
+		),
+		budget: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "budget_eth"),
+			"The monthly gas budget for the node's automatic transactions",
+			nil, nil,
+		),
+		budgetRemaining: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "budget_remaining_eth"),
+			"The ETH left in this month's gas budget",
+			nil, nil,
+		),
+		ledger:    ledger,
+		budgetWei: budgetWei,
+		logPrefix: "Gas Spending Collector",
+	}
+}
+
+// Write metric descriptions to the Prometheus channel
+func (collector *GasSpendingCollector) Describe(channel chan<- *prometheus.Desc) {
+	channel <- collector.spent
+	channel <- collector.transactions
+	channel <- collector.budget
+	channel <- collector.budgetRemaining
+}
+
+// Collect the latest metric values and pass them to Prometheus
+func (collector *GasSpendingCollector) Collect(channel chan<- prometheus.Metric) {
+	spending, err := collector.ledger.GetMonthlySpending(time.Now())
+	if err != nil {
+		collector.logError(err)
+		return
+	}
+
+	for _, duty := range spending.Duties {
+		channel <- prometheus.MustNewConstMetric(
+			collector.spent, prometheus.GaugeValue, eth.WeiToEth(duty.SpentWei), duty.Duty)
+		channel <- prometheus.MustNewConstMetric(
+			collector.transactions, prometheus.GaugeValue, float64(duty.Transactions), duty.Duty)
+	}
+
+	if collector.budgetWei != nil {
+		remaining := new(big.Int).Sub(collector.budgetWei, spending.TotalWei)
+		channel <- prometheus.MustNewConstMetric(
+			collector.budget, prometheus.GaugeValue, eth.WeiToEth(collector.budgetWei))
+		channel <- prometheus.MustNewConstMetric(
+			collector.budgetRemaining, prometheus.GaugeValue, eth.WeiToEth(remaining))
+	}
+}
+
+// Log error messages
+func (collector *GasSpendingCollector) logError(err error) {
+	fmt.Printf("[%s] %s\n", collector.logPrefix, err.Error())
+}
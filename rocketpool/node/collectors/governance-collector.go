@@ -2,14 +2,23 @@ package collectors
 
 import (
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/rocket-pool/smartnode/bindings/dao/protocol"
 	"github.com/rocket-pool/smartnode/bindings/rocketpool"
 	"github.com/rocket-pool/smartnode/bindings/types"
 	"golang.org/x/sync/errgroup"
+
+	"github.com/rocket-pool/smartnode/shared/services/governance"
 )
 
+// Provides the proposals tracked by the governance feed
+type GovernanceProposalProvider interface {
+	GetGovernanceProposals() []governance.TrackedProposal
+}
+
 // Represents the collector for onchain governance metrics
 type GovernanceCollector struct {
 	// the number of active onchain proposals pending
@@ -24,6 +33,15 @@ type GovernanceCollector struct {
 	// the number of closed onchain proposals
 	onchainClosed *prometheus.Desc
 
+	// the number of open proposals the node needs to vote on, per DAO
+	votesNeeded *prometheus.Desc
+
+	// the number of seconds until the current voting phase of each proposal the node needs to vote on closes
+	voteDeadlineRemaining *prometheus.Desc
+
+	// The source of the tracked proposals
+	provider GovernanceProposalProvider
+
 	// The Rocket Pool Contract manager
 	rp *rocketpool.RocketPool
 
@@ -32,7 +50,7 @@ type GovernanceCollector struct {
 }
 
 // Create a new SnapshotCollector instance
-func NewGovernanceCollector(rp *rocketpool.RocketPool) *GovernanceCollector {
+func NewGovernanceCollector(rp *rocketpool.RocketPool, provider GovernanceProposalProvider) *GovernanceCollector {
 	subsystem := "governance"
 	return &GovernanceCollector{
 		onchainPending: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "onchain_pending"),
@@ -51,6 +69,15 @@ func NewGovernanceCollector(rp *rocketpool.RocketPool) *GovernanceCollector {
 			"The number of closed onchain proposals",
 			nil, nil,
 		),
+		votesNeeded: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "votes_needed"),
+			"The number of open proposals the node needs to vote on",
+			[]string{"dao"}, nil,
+		),
+		voteDeadlineRemaining: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "vote_deadline_remaining_seconds"),
+			"The number of seconds until voting closes on a proposal the node needs to vote on",
+			[]string{"dao", "proposal_id"}, nil,
+		),
+		provider:  provider,
 		rp:        rp,
 		logPrefix: "Governance Collector",
 	}
@@ -62,6 +89,8 @@ func (collector *GovernanceCollector) Describe(channel chan<- *prometheus.Desc)
 	channel <- collector.onchainPhase1
 	channel <- collector.onchainPhase2
 	channel <- collector.onchainClosed
+	channel <- collector.votesNeeded
+	channel <- collector.voteDeadlineRemaining
 }
 
 // Collect the latest metric values and pass them to Prometheus
@@ -110,6 +139,25 @@ func (collector *GovernanceCollector) Collect(channel chan<- prometheus.Metric)
 		collector.onchainPhase2, prometheus.GaugeValue, onchainPhase2)
 	channel <- prometheus.MustNewConstMetric(
 		collector.onchainClosed, prometheus.GaugeValue, onchainClosed)
+
+	// Get the proposals the node needs to vote on
+	votesNeeded := map[governance.DAO]float64{
+		governance.DAO_Protocol:        0,
+		governance.DAO_OracleDAO:       0,
+		governance.DAO_SecurityCouncil: 0,
+	}
+	for _, proposal := range collector.provider.GetGovernanceProposals() {
+		if !proposal.IsVoting() || !proposal.NeedsVote {
+			continue
+		}
+		votesNeeded[proposal.DAO]++
+		channel <- prometheus.MustNewConstMetric(
+			collector.voteDeadlineRemaining, prometheus.GaugeValue, time.Until(proposal.PhaseEnd).Seconds(), string(proposal.DAO), strconv.FormatUint(proposal.ID, 10))
+	}
+	for daoType, count := range votesNeeded {
+		channel <- prometheus.MustNewConstMetric(
+			collector.votesNeeded, prometheus.GaugeValue, count, string(daoType))
+	}
 }
 
 // Log error messages
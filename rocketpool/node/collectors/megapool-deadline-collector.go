@@ -0,0 +1,62 @@
+package collectors
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/rocket-pool/smartnode/shared/services/state"
+)
+
+// Provides the upcoming deadlines of the node's megapool validators
+type MegapoolDeadlineProvider interface {
+	GetMegapoolDeadlines() []state.MegapoolValidatorDeadline
+}
+
+// Represents the collector for the node's megapool validator deadlines
+type MegapoolDeadlineCollector struct {
+	// The time of each validator's next deadline
+	deadline *prometheus.Desc
+
+	// The number of seconds until each validator's next deadline
+	remaining *prometheus.Desc
+
+	// The source of the deadlines
+	provider MegapoolDeadlineProvider
+}
+
+// Create a new MegapoolDeadlineCollector instance
+func NewMegapoolDeadlineCollector(provider MegapoolDeadlineProvider) *MegapoolDeadlineCollector {
+	subsystem := "megapool"
+	labels := []string{"validator_id", "type"}
+	return &MegapoolDeadlineCollector{
+		deadline: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "deadline_timestamp_seconds"),
+			"The time of the validator's next deadline",
+			labels, nil,
+		),
+		remaining: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "deadline_remaining_seconds"),
+			"The number of seconds until the validator's next deadline; negative if it has passed",
+			labels, nil,
+		),
+		provider: provider,
+	}
+}
+
+// Write metric descriptions to the Prometheus channel
+func (collector *MegapoolDeadlineCollector) Describe(channel chan<- *prometheus.Desc) {
+	channel <- collector.deadline
+	channel <- collector.remaining
+}
+
+// Collect the latest metric values and pass them to Prometheus
+func (collector *MegapoolDeadlineCollector) Collect(channel chan<- prometheus.Metric) {
+	for _, deadline := range collector.provider.GetMegapoolDeadlines() {
+		validatorId := fmt.Sprint(deadline.ValidatorId)
+		deadlineType := string(deadline.Type)
+		channel <- prometheus.MustNewConstMetric(
+			collector.deadline, prometheus.GaugeValue, float64(deadline.Deadline.Unix()), validatorId, deadlineType)
+		channel <- prometheus.MustNewConstMetric(
+			collector.remaining, prometheus.GaugeValue, time.Until(deadline.Deadline).Seconds(), validatorId, deadlineType)
+	}
+}
@@ -0,0 +1,114 @@
+package collectors
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rocket-pool/smartnode/shared/services/scheduler"
+)
+
+// Represents the collector for the daemon's task scheduler
+type TaskCollector struct {
+	// Whether or not each task is currently running
+	running *prometheus.Desc
+
+	// The time each task last started
+	lastRun *prometheus.Desc
+
+	// How long each task took the last time it ran
+	lastDuration *prometheus.Desc
+
+	// Whether or not the last run of each task failed
+	lastFailed *prometheus.Desc
+
+	// The number of times each task has run
+	runs *prometheus.Desc
+
+	// The number of times each task has failed
+	errors *prometheus.Desc
+
+	// The number of times each task has exceeded its timeout
+	timeouts *prometheus.Desc
+
+	// The task scheduler
+	scheduler *scheduler.Scheduler
+}
+
+// Create a new TaskCollector instance
+func NewTaskCollector(s *scheduler.Scheduler) *TaskCollector {
+	subsystem := "task"
+	labels := []string{"task"}
+	return &TaskCollector{
+		running: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "running"),
+			"Whether or not the task is currently running",
+			labels, nil,
+		),
+		lastRun: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "last_run_timestamp_seconds"),
+			"The time the task last started",
+			labels, nil,
+		),
+		lastDuration: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "last_duration_seconds"),
+			"How long the task took the last time it ran",
+			labels, nil,
+		),
+		lastFailed: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "last_failed"),
+			"Whether or not the last run of the task returned an error",
+			labels, nil,
+		),
+		runs: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "runs_total"),
+			"The number of times the task has run",
+			labels, nil,
+		),
+		errors: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "errors_total"),
+			"The number of times the task has returned an error",
+			labels, nil,
+		),
+		timeouts: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "timeouts_total"),
+			"The number of times the task has exceeded its timeout",
+			labels, nil,
+		),
+		scheduler: s,
+	}
+}
+
+// Write metric descriptions to the Prometheus channel
+func (collector *TaskCollector) Describe(channel chan<- *prometheus.Desc) {
+	channel <- collector.running
+	channel <- collector.lastRun
+	channel <- collector.lastDuration
+	channel <- collector.lastFailed
+	channel <- collector.runs
+	channel <- collector.errors
+	channel <- collector.timeouts
+}
+
+// Collect the latest metric values and pass them to Prometheus
+func (collector *TaskCollector) Collect(channel chan<- prometheus.Metric) {
+	for _, status := range collector.scheduler.GetTaskStatuses() {
+		running := float64(0)
+		if status.Running {
+			running = 1
+		}
+		lastRun := float64(0)
+		if !status.LastRun.IsZero() {
+			lastRun = float64(status.LastRun.Unix())
+		}
+		lastFailed := float64(0)
+		if status.LastError != "" {
+			lastFailed = 1
+		}
+
+		channel <- prometheus.MustNewConstMetric(
+			collector.running, prometheus.GaugeValue, running, status.Name)
+		channel <- prometheus.MustNewConstMetric(
+			collector.lastRun, prometheus.GaugeValue, lastRun, status.Name)
+		channel <- prometheus.MustNewConstMetric(
+			collector.lastDuration, prometheus.GaugeValue, status.LastDuration.Seconds(), status.Name)
+		channel <- prometheus.MustNewConstMetric(
+			collector.lastFailed, prometheus.GaugeValue, lastFailed, status.Name)
+		channel <- prometheus.MustNewConstMetric(
+			collector.runs, prometheus.CounterValue, float64(status.RunCount), status.Name)
+		channel <- prometheus.MustNewConstMetric(
+			collector.errors, prometheus.CounterValue, float64(status.ErrorCount), status.Name)
+		channel <- prometheus.MustNewConstMetric(
+			collector.timeouts, prometheus.CounterValue, float64(status.TimeoutCount), status.Name)
+	}
+}
@@ -1,10 +1,13 @@
 package node
 
 import (
+	"context"
+	"fmt"
 	"math/big"
 
 	"github.com/docker/docker/client"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
 	coretypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/rocket-pool/smartnode/bindings/megapool"
 	"github.com/rocket-pool/smartnode/bindings/rocketpool"
@@ -13,24 +16,38 @@ import (
 	"github.com/urfave/cli"
 
 	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/alerting"
 	"github.com/rocket-pool/smartnode/shared/services/beacon"
 	"github.com/rocket-pool/smartnode/shared/services/config"
 	rpgas "github.com/rocket-pool/smartnode/shared/services/gas"
 	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/services/store"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
 	"github.com/rocket-pool/smartnode/shared/services/wallet"
 	"github.com/rocket-pool/smartnode/shared/utils/api"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
 )
 
+// The names of the duties in the daemon state store
+const (
+	defendChallengeExitDuty string = "defend-challenge-exit"
+
+	// Alerts that have been sent, so they aren't repeated every run or after a restart
+	alertExitChallengeDuty      string = "alert-exit-challenge"
+	alertValidatorDissolvedDuty string = "alert-validator-dissolved"
+)
+
 // Stake megapool validator task
 type defendChallengeExit struct {
 	c              *cli.Context
 	log            log.ColorLogger
 	cfg            *config.RocketPoolConfig
 	w              wallet.Wallet
+	txMgr          *txmanager.TransactionManager
 	rp             *rocketpool.RocketPool
 	bc             beacon.Client
 	d              *client.Client
+	store          *store.DutyStore
 	gasThreshold   float64
 	maxFee         *big.Int
 	maxPriorityFee *big.Int
@@ -53,6 +70,10 @@ func newDefendChallengeExit(c *cli.Context, logger log.ColorLogger) (*defendChal
 	if err != nil {
 		return nil, err
 	}
+	txMgr, err := services.GetTransactionManager(c)
+	if err != nil {
+		return nil, err
+	}
 	d, err := services.GetDocker(c)
 	if err != nil {
 		return nil, err
@@ -61,6 +82,10 @@ func newDefendChallengeExit(c *cli.Context, logger log.ColorLogger) (*defendChal
 	if err != nil {
 		return nil, err
 	}
+	dutyStore, err := services.GetDutyStore(c)
+	if err != nil {
+		return nil, err
+	}
 
 	gasThreshold := cfg.Smartnode.AutoTxGasThreshold.Value.(float64)
 
@@ -89,9 +114,11 @@ func newDefendChallengeExit(c *cli.Context, logger log.ColorLogger) (*defendChal
 		log:            logger,
 		cfg:            cfg,
 		w:              w,
+		txMgr:          txMgr,
 		rp:             rp,
 		bc:             bc,
 		d:              d,
+		store:          dutyStore,
 		gasThreshold:   gasThreshold,
 		maxFee:         maxFee,
 		maxPriorityFee: priorityFee,
@@ -101,7 +128,7 @@ func newDefendChallengeExit(c *cli.Context, logger log.ColorLogger) (*defendChal
 }
 
 // Prestake megapool validator
-func (t *defendChallengeExit) run(state *state.NetworkState) error {
+func (t *defendChallengeExit) run(ctx context.Context, state *state.NetworkState) error {
 	if !state.IsSaturnDeployed {
 		return nil
 	}
@@ -152,8 +179,24 @@ func (t *defendChallengeExit) run(state *state.NetworkState) error {
 	}
 
 	for i := uint32(0); i < uint32(validatorCount); i++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		validatorId := validatorInfo[i].ValidatorId
+		pubkey := types.ValidatorPubkey(validatorInfo[i].PubKey)
+		alertKey := getChallengeDutyKey(megapoolAddress, validatorId)
+		if validatorInfo[i].Dissolved {
+			t.alertOnce(alertValidatorDissolvedDuty, alertKey, func() error {
+				t.log.Printlnf("The validator %d has been dissolved.", validatorId)
+				return alerting.AlertMegapoolValidatorDissolved(t.cfg, megapoolAddress, validatorId, pubkey)
+			})
+		}
+
 		exiting := false
 		if validatorInfo[i].Locked {
+			t.alertOnce(alertExitChallengeDuty, alertKey, func() error {
+				return alerting.AlertExitChallengeReceived(t.cfg, megapoolAddress, validatorId, pubkey)
+			})
 			if validatorInfo[i].BeaconStatus.WithdrawableEpoch != FarFutureEpoch {
 				exiting = true
 				t.log.Printlnf("The validator %d was correctly challenged and needs an exit proof", validatorInfo[i].ValidatorId)
@@ -161,7 +204,19 @@ func (t *defendChallengeExit) run(state *state.NetworkState) error {
 				t.log.Printlnf("The validator %d was incorrectly challenged and needs a not-exiting proof", validatorInfo[i].ValidatorId)
 			}
 
-			t.defendChallenge(t.rp, mp, validatorInfo[i].ValidatorId, state, types.ValidatorPubkey(validatorInfo[i].PubKey), exiting, opts)
+			// Don't respond again if a response from a previous run is still in flight
+			pending, err := t.store.IsPending(t.rp.Client, defendChallengeExitDuty, getChallengeDutyKey(megapoolAddress, validatorId))
+			if err != nil {
+				t.log.Printlnf("WARNING: couldn't check previous responses for validator %d: %s", validatorId, err.Error())
+			} else if pending {
+				t.log.Printlnf("The validator %d already has a pending challenge response, skipping it.", validatorId)
+				continue
+			}
+
+			t.defendChallenge(ctx, t.rp, mp, validatorId, state, pubkey, exiting, opts)
+		} else {
+			// Alert again if the validator is challenged again later
+			t.resetAlert(alertExitChallengeDuty, alertKey)
 		}
 
 	}
@@ -171,13 +226,14 @@ func (t *defendChallengeExit) run(state *state.NetworkState) error {
 
 }
 
-func (t *defendChallengeExit) defendChallenge(rp *rocketpool.RocketPool, mp megapool.Megapool, validatorId uint32, state *state.NetworkState, validatorPubkey types.ValidatorPubkey, exiting bool, callopts *bind.CallOpts) error {
+func (t *defendChallengeExit) defendChallenge(ctx context.Context, rp *rocketpool.RocketPool, mp megapool.Megapool, validatorId uint32, state *state.NetworkState, validatorPubkey types.ValidatorPubkey, exiting bool, callopts *bind.CallOpts) error {
 
 	// Get transactor
-	opts, err := t.w.GetNodeAccountTransactor()
+	opts, err := t.txMgr.GetTransactor()
 	if err != nil {
 		return err
 	}
+	defer t.txMgr.ReleaseTransactor(opts)
 
 	t.log.Printlnf("[STARTED] Crafting a validator proof. This process can take several seconds and is CPU and memory intensive. If you don't see a [FINISHED] log entry your system may not have enough resources to perform this operation.")
 
@@ -207,7 +263,7 @@ func (t *defendChallengeExit) defendChallenge(rp *rocketpool.RocketPool, mp mega
 	// Get the max fee
 	maxFee := t.maxFee
 	if maxFee == nil || maxFee.Uint64() == 0 {
-		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg)
+		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg, t.rp.Client)
 		if err != nil {
 			return err
 		}
@@ -239,9 +295,12 @@ func (t *defendChallengeExit) defendChallenge(rp *rocketpool.RocketPool, mp mega
 	if err != nil {
 		return err
 	}
+	dutyKey := getChallengeDutyKey(mp.GetAddress(), validatorId)
+	t.store.TryRecordSubmission(&t.log, defendChallengeExitDuty, dutyKey, tx.Hash())
 
 	// Print TX info and wait for it to be included in a block
-	err = api.PrintAndWaitForTransaction(t.cfg, tx.Hash(), t.rp.Client, &t.log)
+	err = t.txMgr.TrackAndWaitContext(ctx, t.cfg, "defend-challenge-exit", opts, tx.Hash(), &t.log)
+	t.store.TryRecordOutcome(&t.log, defendChallengeExitDuty, dutyKey, err)
 	if err != nil {
 		return err
 	}
@@ -252,3 +311,38 @@ func (t *defendChallengeExit) defendChallenge(rp *rocketpool.RocketPool, mp mega
 	// Return
 	return nil
 }
+
+// Get the key of a challenge response in the daemon state store
+// Send an alert unless it was already sent, remembering it in the duty store so restarts don't repeat it
+func (t *defendChallengeExit) alertOnce(duty string, key string, send func() error) {
+	record, err := t.store.Get(duty, key)
+	if err != nil {
+		t.log.Printlnf("WARNING: couldn't check previous alerts for %s: %s", key, err.Error())
+		return
+	}
+	if record != nil && record.Outcome == store.Outcome_Succeeded {
+		return
+	}
+	err = send()
+	if err != nil {
+		t.log.Printlnf("WARNING: couldn't send the %s alert for %s: %s", duty, key, err.Error())
+		return
+	}
+	t.store.TryRecordOutcome(&t.log, duty, key, nil)
+}
+
+// Forget that an alert was sent so it's sent again the next time its condition comes up
+func (t *defendChallengeExit) resetAlert(duty string, key string) {
+	record, err := t.store.Get(duty, key)
+	if err != nil || record == nil {
+		return
+	}
+	_, err = t.store.Clear(duty, key)
+	if err != nil {
+		t.log.Printlnf("WARNING: couldn't reset the %s alert for %s: %s", duty, key, err.Error())
+	}
+}
+
+func getChallengeDutyKey(megapoolAddress common.Address, validatorId uint32) string {
+	return fmt.Sprintf("%s-%d", megapoolAddress.Hex(), validatorId)
+}
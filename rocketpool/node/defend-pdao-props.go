@@ -1,6 +1,7 @@
 package node
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"time"
@@ -12,11 +13,14 @@ import (
 	"github.com/rocket-pool/smartnode/bindings/types"
 	"github.com/rocket-pool/smartnode/bindings/utils/eth"
 	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/alerting"
 	"github.com/rocket-pool/smartnode/shared/services/beacon"
 	"github.com/rocket-pool/smartnode/shared/services/config"
 	rpgas "github.com/rocket-pool/smartnode/shared/services/gas"
 	"github.com/rocket-pool/smartnode/shared/services/proposals"
 	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/services/store"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
 	"github.com/rocket-pool/smartnode/shared/services/wallet"
 	"github.com/rocket-pool/smartnode/shared/utils/api"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
@@ -28,13 +32,18 @@ type defendableProposal struct {
 	proposal       *protocol.ProtocolDaoProposalDetails
 }
 
+// The name of the duty in the daemon state store
+const defendPdaoPropsDuty string = "defend-pdao-props"
+
 type defendPdaoProps struct {
 	c                *cli.Context
 	log              *log.ColorLogger
 	cfg              *config.RocketPoolConfig
 	w                wallet.Wallet
+	txMgr            *txmanager.TransactionManager
 	rp               *rocketpool.RocketPool
 	bc               beacon.Client
+	store            *store.DutyStore
 	gasThreshold     float64
 	maxFee           *big.Int
 	maxPriorityFee   *big.Int
@@ -43,6 +52,9 @@ type defendPdaoProps struct {
 	propMgr          *proposals.ProposalManager
 	lastScannedBlock *big.Int
 
+	// Challenges that have already been alerted on, keyed by proposal ID and index
+	alertedChallenges map[string]bool
+
 	// Smartnode parameters
 	intervalSize *big.Int
 }
@@ -61,10 +73,18 @@ func newDefendPdaoProps(c *cli.Context, logger log.ColorLogger) (*defendPdaoProp
 	if err != nil {
 		return nil, err
 	}
+	txMgr, err := services.GetTransactionManager(c)
+	if err != nil {
+		return nil, err
+	}
 	bc, err := services.GetBeaconClient(c)
 	if err != nil {
 		return nil, err
 	}
+	dutyStore, err := services.GetDutyStore(c)
+	if err != nil {
+		return nil, err
+	}
 
 	gasThreshold := cfg.Smartnode.AutoTxGasThreshold.Value.(float64)
 
@@ -105,8 +125,10 @@ func newDefendPdaoProps(c *cli.Context, logger log.ColorLogger) (*defendPdaoProp
 		log:              &logger,
 		cfg:              cfg,
 		w:                w,
+		txMgr:            txMgr,
 		rp:               rp,
 		bc:               bc,
+		store:            dutyStore,
 		gasThreshold:     gasThreshold,
 		maxFee:           maxFee,
 		maxPriorityFee:   priorityFee,
@@ -115,12 +137,14 @@ func newDefendPdaoProps(c *cli.Context, logger log.ColorLogger) (*defendPdaoProp
 		propMgr:          propMgr,
 		lastScannedBlock: nil,
 
+		alertedChallenges: map[string]bool{},
+
 		intervalSize: intervalSize,
 	}, nil
 }
 
 // Defend pDAO proposals
-func (t *defendPdaoProps) run(state *state.NetworkState) error {
+func (t *defendPdaoProps) run(ctx context.Context, state *state.NetworkState) error {
 	// Log
 	t.log.Println("Checking for Protocol DAO proposal challenges to defend...")
 
@@ -129,8 +153,11 @@ func (t *defendPdaoProps) run(state *state.NetworkState) error {
 		BlockNumber: big.NewInt(0).SetUint64(state.ElBlockNumber),
 	}
 
+	// Keep every Beacon call in this run on the same endpoint
+	bc := services.PinBeaconClient(ctx, t.bc)
+
 	// Get any proposals that need to be defended
-	defendableProps, err := t.getDefendableProposals(state, opts)
+	defendableProps, err := t.getDefendableProposals(bc, state, opts)
 	if err != nil {
 		return fmt.Errorf("error checking for defendable proposals: %w", err)
 	}
@@ -140,7 +167,22 @@ func (t *defendPdaoProps) run(state *state.NetworkState) error {
 
 	// Defend props
 	for _, prop := range defendableProps {
-		err := t.defendProposal(prop)
+		challengeKey := fmt.Sprintf("%d-%d", prop.proposal.ID, prop.challengeEvent.Index.Uint64())
+		if !t.alertedChallenges[challengeKey] {
+			alerting.AlertPDAOProposalChallenged(t.cfg, prop.proposal.ID, prop.challengeEvent.Index.Uint64())
+			t.alertedChallenges[challengeKey] = true
+		}
+
+		// Don't respond again if a response from a previous run is still in flight
+		pending, err := t.store.IsPending(t.rp.Client, defendPdaoPropsDuty, challengeKey)
+		if err != nil {
+			t.log.Printlnf("WARNING: couldn't check previous responses for proposal %d, index %d: %s", prop.proposal.ID, prop.challengeEvent.Index.Uint64(), err.Error())
+		} else if pending {
+			t.log.Printlnf("The challenge against proposal %d, index %d already has a pending response, skipping it.", prop.proposal.ID, prop.challengeEvent.Index.Uint64())
+			continue
+		}
+
+		err = t.defendProposal(ctx, prop, challengeKey)
 		if err != nil {
 			return fmt.Errorf("error submitting response for proposal %d, challenged index %d: %w", prop.proposal.ID, prop.challengeEvent.Index.Uint64(), err)
 		}
@@ -151,7 +193,7 @@ func (t *defendPdaoProps) run(state *state.NetworkState) error {
 }
 
 // Get a list of this node's proposals with open challenges against them
-func (t *defendPdaoProps) getDefendableProposals(state *state.NetworkState, opts *bind.CallOpts) ([]defendableProposal, error) {
+func (t *defendPdaoProps) getDefendableProposals(bc beacon.Client, state *state.NetworkState, opts *bind.CallOpts) ([]defendableProposal, error) {
 	// Get proposals made by this node that are still in the challenge phase (Pending)
 	eligibleProps := []protocol.ProtocolDaoProposalDetails{}
 	for _, prop := range state.ProtocolDaoProposalDetails {
@@ -175,7 +217,7 @@ func (t *defendPdaoProps) getDefendableProposals(state *state.NetworkState, opts
 		startSlot := uint64(startTime.Sub(genesisTime) / secondsPerSlot)
 
 		// Get the Beacon block for the slot
-		block, exists, err := t.bc.GetBeaconBlock(fmt.Sprint(startSlot))
+		block, exists, err := bc.GetBeaconBlock(fmt.Sprint(startSlot))
 		if err != nil {
 			return nil, fmt.Errorf("error getting Beacon block at slot %d: %w", startSlot, err)
 		}
@@ -229,7 +271,7 @@ func (t *defendPdaoProps) getDefendableProposals(state *state.NetworkState, opts
 }
 
 // Submit a response to a challenge against one of this node's proposals
-func (t *defendPdaoProps) defendProposal(prop defendableProposal) error {
+func (t *defendPdaoProps) defendProposal(ctx context.Context, prop defendableProposal, dutyKey string) error {
 	propID := prop.proposal.ID
 	challengedIndex := prop.challengeEvent.Index.Uint64()
 	t.log.Printlnf("Responding to challenge against proposal %d, index %d...", propID, challengedIndex)
@@ -241,10 +283,11 @@ func (t *defendPdaoProps) defendProposal(prop defendableProposal) error {
 	}
 
 	// Get transactor
-	opts, err := t.w.GetNodeAccountTransactor()
+	opts, err := t.txMgr.GetTransactor()
 	if err != nil {
 		return err
 	}
+	defer t.txMgr.ReleaseTransactor(opts)
 
 	// Get the gas limit
 	gasInfo, err := protocol.EstimateSubmitRootGas(t.rp, propID, challengedIndex, pollard, opts)
@@ -256,7 +299,7 @@ func (t *defendPdaoProps) defendProposal(prop defendableProposal) error {
 	// Get the max fee
 	maxFee := t.maxFee
 	if maxFee == nil || maxFee.Uint64() == 0 {
-		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg)
+		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg, t.rp.Client)
 		if err != nil {
 			return err
 		}
@@ -276,9 +319,11 @@ func (t *defendPdaoProps) defendProposal(prop defendableProposal) error {
 	if err != nil {
 		return err
 	}
+	t.store.TryRecordSubmission(t.log, defendPdaoPropsDuty, dutyKey, hash)
 
 	// Print TX info and wait for it to be included in a block
-	err = api.PrintAndWaitForTransaction(t.cfg, hash, t.rp.Client, t.log)
+	err = t.txMgr.TrackAndWaitContext(ctx, t.cfg, "defend-pdao-props", opts, hash, t.log)
+	t.store.TryRecordOutcome(t.log, defendPdaoPropsDuty, dutyKey, err)
 	if err != nil {
 		return err
 	}
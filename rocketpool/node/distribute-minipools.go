@@ -1,8 +1,10 @@
 package node
 
 import (
+	"context"
 	"fmt"
 	"math/big"
+	"time"
 
 	"github.com/docker/docker/client"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
@@ -19,22 +21,28 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services/beacon"
 	"github.com/rocket-pool/smartnode/shared/services/config"
 	rpgas "github.com/rocket-pool/smartnode/shared/services/gas"
+	"github.com/rocket-pool/smartnode/shared/services/gas/policy"
 	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/services/store"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
 	"github.com/rocket-pool/smartnode/shared/services/wallet"
-	"github.com/rocket-pool/smartnode/shared/utils/api"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
 )
 
+// The name of the duty in the daemon state store
+const distributeMinipoolsDuty string = "distribute-minipools"
+
 // Distribute minipools task
 type distributeMinipools struct {
 	c                   *cli.Context
 	log                 log.ColorLogger
 	cfg                 *config.RocketPoolConfig
 	w                   wallet.Wallet
+	txMgr               *txmanager.TransactionManager
 	rp                  *rocketpool.RocketPool
 	bc                  beacon.Client
 	d                   *client.Client
-	gasThreshold        float64
+	store               *store.DutyStore
 	distributeThreshold *big.Int
 	disabled            bool
 	eight               *big.Int
@@ -59,6 +67,14 @@ func newDistributeMinipools(c *cli.Context, logger log.ColorLogger) (*distribute
 	if err != nil {
 		return nil, err
 	}
+	txMgr, err := services.GetTransactionManager(c)
+	if err != nil {
+		return nil, err
+	}
+	dutyStore, err := services.GetDutyStore(c)
+	if err != nil {
+		return nil, err
+	}
 	bc, err := services.GetBeaconClient(c)
 	if err != nil {
 		return nil, err
@@ -69,10 +85,13 @@ func newDistributeMinipools(c *cli.Context, logger log.ColorLogger) (*distribute
 	}
 
 	// Check if auto-distributing is disabled
-	gasThreshold := cfg.Smartnode.AutoTxGasThreshold.Value.(float64)
+	gasPolicy, err := policy.GetPolicy(cfg, distributeMinipoolsDuty)
+	if err != nil {
+		return nil, err
+	}
 	distributeThreshold := cfg.Smartnode.DistributeThreshold.Value.(float64)
 	disabled := false
-	if gasThreshold == 0 {
+	if gasPolicy.ThresholdGwei == 0 {
 		logger.Println("Automatic tx gas threshold is 0, disabling auto-distribute.")
 		disabled = true
 	} else {
@@ -111,10 +130,11 @@ func newDistributeMinipools(c *cli.Context, logger log.ColorLogger) (*distribute
 		log:                 logger,
 		cfg:                 cfg,
 		w:                   w,
+		txMgr:               txMgr,
 		rp:                  rp,
 		bc:                  bc,
 		d:                   d,
-		gasThreshold:        gasThreshold,
+		store:               dutyStore,
 		distributeThreshold: eth.EthToWei(distributeThreshold),
 		disabled:            disabled,
 		eight:               eth.EthToWei(8),
@@ -126,7 +146,7 @@ func newDistributeMinipools(c *cli.Context, logger log.ColorLogger) (*distribute
 }
 
 // Distribute minipools
-func (t *distributeMinipools) run(state *state.NetworkState) error {
+func (t *distributeMinipools) run(ctx context.Context, state *state.NetworkState) error {
 
 	// Check if auto-distribute is disabled
 	if t.disabled {
@@ -162,7 +182,22 @@ func (t *distributeMinipools) run(state *state.NetworkState) error {
 	// Distribute minipools
 	successCount := 0
 	for _, mpd := range minipools {
-		success, err := t.distributeMinipool(mpd, opts)
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		// Skip minipools that still have a distribution in flight from a previous run
+		pending, err := t.store.IsPending(t.rp.Client, distributeMinipoolsDuty, mpd.MinipoolAddress.Hex())
+		if err != nil {
+			t.log.Printlnf("WARNING: couldn't check previous distributions of minipool %s: %s", mpd.MinipoolAddress.Hex(), err.Error())
+		} else if pending {
+			t.log.Printlnf("Minipool %s already has a pending distribution, skipping it.", mpd.MinipoolAddress.Hex())
+			continue
+		}
+
+		success, err := t.distributeMinipool(ctx, mpd, opts)
+		if success || err != nil {
+			t.store.TryRecordOutcome(&t.log, distributeMinipoolsDuty, mpd.MinipoolAddress.Hex(), err)
+		}
 		alerting.AlertMinipoolBalanceDistributed(t.cfg, mpd.MinipoolAddress, err == nil)
 		if err != nil {
 			t.log.Println(fmt.Errorf("Could not distribute balance of minipool %s: %w", mpd.MinipoolAddress.Hex(), err))
@@ -207,7 +242,7 @@ func (t *distributeMinipools) getDistributableMinipools(nodeAddress common.Addre
 }
 
 // Distribute a minipool
-func (t *distributeMinipools) distributeMinipool(mpd *rpstate.NativeMinipoolDetails, callOpts *bind.CallOpts) (bool, error) {
+func (t *distributeMinipools) distributeMinipool(ctx context.Context, mpd *rpstate.NativeMinipoolDetails, callOpts *bind.CallOpts) (bool, error) {
 
 	// Log
 	t.log.Printlnf("Distributing minipool %s (total balance of %.6f ETH)...", mpd.MinipoolAddress.Hex(), eth.WeiToEth(mpd.Balance))
@@ -218,10 +253,11 @@ func (t *distributeMinipools) distributeMinipool(mpd *rpstate.NativeMinipoolDeta
 	}
 
 	// Get transactor
-	opts, err := t.w.GetNodeAccountTransactor()
+	opts, err := t.txMgr.GetTransactor()
 	if err != nil {
 		return false, err
 	}
+	defer t.txMgr.ReleaseTransactor(opts)
 
 	// Get the gas limit
 	mpv3, success := minipool.GetMinipoolAsV3(mp)
@@ -242,14 +278,18 @@ func (t *distributeMinipools) distributeMinipool(mpd *rpstate.NativeMinipoolDeta
 	// Get the max fee
 	maxFee := t.maxFee
 	if maxFee == nil || maxFee.Uint64() == 0 {
-		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg)
+		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg, t.rp.Client)
 		if err != nil {
 			return false, err
 		}
 	}
 
-	// Print the gas info
-	if !api.PrintAndCheckGasInfo(gasInfo, true, t.gasThreshold, &t.log, maxFee, t.gasLimit) {
+	// Check the gas policy
+	proceed, err := checkGasPolicy(t.cfg, t.txMgr, &t.log, distributeMinipoolsDuty, gasInfo, maxFee, t.gasLimit, time.Time{})
+	if err != nil {
+		return false, err
+	}
+	if !proceed {
 		return false, nil
 	}
 
@@ -262,9 +302,10 @@ func (t *distributeMinipools) distributeMinipool(mpd *rpstate.NativeMinipoolDeta
 	if err != nil {
 		return false, err
 	}
+	t.store.TryRecordSubmission(&t.log, distributeMinipoolsDuty, mpd.MinipoolAddress.Hex(), hash)
 
 	// Print TX info and wait for it to be included in a block
-	err = api.PrintAndWaitForTransaction(t.cfg, hash, t.rp.Client, &t.log)
+	err = t.txMgr.TrackAndWaitContext(ctx, t.cfg, distributeMinipoolsDuty, opts, hash, &t.log)
 	if err != nil {
 		return false, err
 	}
@@ -1,6 +1,7 @@
 package node
 
 import (
+	"context"
 	"fmt"
 	"os"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/urfave/cli"
 
 	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/alerting"
 	"github.com/rocket-pool/smartnode/shared/services/beacon"
 	"github.com/rocket-pool/smartnode/shared/services/config"
 	rprewards "github.com/rocket-pool/smartnode/shared/services/rewards"
@@ -27,6 +29,9 @@ type downloadRewardsTrees struct {
 	rp  *rocketpool.RocketPool
 	d   *client.Client
 	bc  beacon.Client
+
+	// Intervals whose download failure has already been alerted on
+	alertedIntervals map[uint64]bool
 }
 
 // Create manage fee recipient task
@@ -63,12 +68,14 @@ func newDownloadRewardsTrees(c *cli.Context, logger log.ColorLogger) (*downloadR
 		rp:  rp,
 		d:   d,
 		bc:  bc,
+
+		alertedIntervals: map[uint64]bool{},
 	}, nil
 
 }
 
 // Manage fee recipient
-func (d *downloadRewardsTrees) run(state *state.NetworkState) error {
+func (d *downloadRewardsTrees) run(_ context.Context, state *state.NetworkState) error {
 
 	// Wait for eth client to sync
 	if err := services.WaitEthClientSynced(d.c, true); err != nil {
@@ -120,6 +127,10 @@ func (d *downloadRewardsTrees) run(state *state.NetworkState) error {
 		err = intervalInfo.DownloadRewardsFile(d.cfg, true)
 		if err != nil {
 			fmt.Println()
+			if !d.alertedIntervals[missingInterval] {
+				alerting.AlertRewardsTreeDownloadFailed(d.cfg, missingInterval, err)
+				d.alertedIntervals[missingInterval] = true
+			}
 			return err
 		}
 		fmt.Println("done!")
@@ -12,11 +12,13 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rocket-pool/smartnode/rocketpool/node/collectors"
 	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/gas/policy"
+	"github.com/rocket-pool/smartnode/shared/services/scheduler"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
 	"github.com/urfave/cli"
 )
 
-func runMetricsServer(c *cli.Context, logger log.ColorLogger, stateLocker *collectors.StateLocker) error {
+func runMetricsServer(c *cli.Context, logger log.ColorLogger, stateLocker *collectors.StateLocker, taskScheduler *scheduler.Scheduler, megapoolDeadlines collectors.MegapoolDeadlineProvider, governanceProposals collectors.GovernanceProposalProvider) error {
 
 	// Get services
 	cfg, err := services.GetConfig(c)
@@ -43,6 +45,10 @@ func runMetricsServer(c *cli.Context, logger log.ColorLogger, stateLocker *colle
 	if err != nil {
 		return err
 	}
+	txManager, err := services.GetTransactionManager(c)
+	if err != nil {
+		return err
+	}
 
 	// Return if metrics are disabled
 	if cfg.EnableMetrics.Value == false {
@@ -68,7 +74,10 @@ func runMetricsServer(c *cli.Context, logger log.ColorLogger, stateLocker *colle
 	trustedNodeCollector := collectors.NewTrustedNodeCollector(rp, bc, nodeAccount.Address, cfg, stateLocker)
 	beaconCollector := collectors.NewBeaconCollector(rp, bc, ec, nodeAccount.Address, stateLocker)
 	smoothingPoolCollector := collectors.NewSmoothingPoolCollector(rp, ec, stateLocker)
-	governanceCollector := collectors.NewGovernanceCollector(rp)
+	governanceCollector := collectors.NewGovernanceCollector(rp, governanceProposals)
+	taskCollector := collectors.NewTaskCollector(taskScheduler)
+	megapoolDeadlineCollector := collectors.NewMegapoolDeadlineCollector(megapoolDeadlines)
+	gasSpendingCollector := collectors.NewGasSpendingCollector(txManager.GetLedger(), policy.GetMonthlyBudget(cfg))
 
 	// Set up Prometheus
 	registry := prometheus.NewRegistry()
@@ -82,6 +91,9 @@ func runMetricsServer(c *cli.Context, logger log.ColorLogger, stateLocker *colle
 	registry.MustRegister(beaconCollector)
 	registry.MustRegister(smoothingPoolCollector)
 	registry.MustRegister(governanceCollector)
+	registry.MustRegister(taskCollector)
+	registry.MustRegister(megapoolDeadlineCollector)
+	registry.MustRegister(gasSpendingCollector)
 
 	// Set up snapshot checking if enabled
 	if cfg.Smartnode.GetRocketSignerRegistryAddress() != "" {
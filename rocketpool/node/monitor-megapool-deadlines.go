@@ -0,0 +1,118 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/rocket-pool/smartnode/bindings/rocketpool"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/alerting"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+// Monitor megapool deadlines task
+type monitorMegapoolDeadlines struct {
+	c   *cli.Context
+	log log.ColorLogger
+	cfg *config.RocketPoolConfig
+	rp  *rocketpool.RocketPool
+
+	// The deadlines from the last run, used by the metrics exporter
+	deadlines []state.MegapoolValidatorDeadline
+	lock      sync.RWMutex
+
+	// Deadlines that have already been alerted on, so alerts aren't repeated every run
+	alerted map[string]bool
+}
+
+// Create monitor megapool deadlines task
+func newMonitorMegapoolDeadlines(c *cli.Context, logger log.ColorLogger) (*monitorMegapoolDeadlines, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return task
+	return &monitorMegapoolDeadlines{
+		c:         c,
+		log:       logger,
+		cfg:       cfg,
+		rp:        rp,
+		deadlines: []state.MegapoolValidatorDeadline{},
+		alerted:   map[string]bool{},
+	}, nil
+
+}
+
+// Check the deadlines of the node's megapool validators and alert on the ones coming up
+func (t *monitorMegapoolDeadlines) run(_ context.Context, state *state.NetworkState) error {
+	if !state.IsSaturnDeployed || len(state.MegapoolDetails) == 0 {
+		return nil
+	}
+
+	// Get the deadlines
+	opts := &bind.CallOpts{
+		BlockNumber: big.NewInt(0).SetUint64(state.ElBlockNumber),
+	}
+	settings, err := t.getSettings(opts)
+	if err != nil {
+		return fmt.Errorf("error getting megapool settings: %w", err)
+	}
+	deadlines := state.GetMegapoolDeadlines(&settings)
+
+	t.lock.Lock()
+	t.deadlines = deadlines
+	t.lock.Unlock()
+
+	// Alert on the deadlines inside the warning window
+	warningWindow := time.Duration(t.cfg.Alertmanager.MegapoolDeadlineWarningHours.Value.(uint64)) * time.Hour
+	pending := map[string]bool{}
+	for _, deadline := range deadlines {
+		key := fmt.Sprintf("%s-%d-%s-%d", deadline.MegapoolAddress.Hex(), deadline.ValidatorId, deadline.Type, deadline.Deadline.Unix())
+		pending[key] = true
+
+		remaining := time.Until(deadline.Deadline)
+		if remaining > warningWindow || t.alerted[key] {
+			continue
+		}
+		t.log.Printlnf("WARNING: validator %d %s by %s (%s).", deadline.ValidatorId, deadline.Type.Description(), deadline.Deadline.Format(time.RFC1123), remaining.Round(time.Minute))
+		alerting.AlertMegapoolDeadlineApproaching(t.cfg, deadline)
+		t.alerted[key] = true
+	}
+
+	// Forget about the deadlines that have passed or were met
+	for key := range t.alerted {
+		if !pending[key] {
+			delete(t.alerted, key)
+		}
+	}
+
+	return nil
+
+}
+
+// Get the megapool settings used to calculate deadlines
+func (t *monitorMegapoolDeadlines) getSettings(opts *bind.CallOpts) (state.MegapoolDeadlineSettings, error) {
+	return state.GetMegapoolDeadlineSettings(t.rp, opts)
+}
+
+// Get the deadlines from the last run
+func (t *monitorMegapoolDeadlines) GetMegapoolDeadlines() []state.MegapoolValidatorDeadline {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.deadlines
+}
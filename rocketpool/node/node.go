@@ -13,24 +13,40 @@ import (
 	"github.com/fatih/color"
 	"github.com/urfave/cli"
 
+	"github.com/rocket-pool/smartnode/bindings/rocketpool"
+
 	"github.com/rocket-pool/smartnode/rocketpool/node/collectors"
 	"github.com/rocket-pool/smartnode/shared/services"
 	"github.com/rocket-pool/smartnode/shared/services/alerting"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/gas/policy"
+	"github.com/rocket-pool/smartnode/shared/services/passwords"
+	"github.com/rocket-pool/smartnode/shared/services/scheduler"
 	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
 	"github.com/rocket-pool/smartnode/shared/services/wallet/keystore/lighthouse"
 	"github.com/rocket-pool/smartnode/shared/services/wallet/keystore/nimbus"
 	"github.com/rocket-pool/smartnode/shared/services/wallet/keystore/prysm"
 	"github.com/rocket-pool/smartnode/shared/services/wallet/keystore/teku"
+	"github.com/rocket-pool/smartnode/shared/utils/api"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
 )
 
 // Config
 var tasksInterval, _ = time.ParseDuration("5m")
 var taskCooldown, _ = time.ParseDuration("10s")
+var headPollInterval, _ = time.ParseDuration("12s")
 
 const (
 	MaxConcurrentEth1Requests = 200
 
+	// Tasks that submit transactions from the node account are kept in this group so they don't race on the nonce
+	txTaskGroup = "node-transactions"
+
+	// Exit challenges have to be answered before their deadline, so their defense doesn't wait behind the other transaction tasks.
+	// The transaction manager reserves a separate nonce for every transactor, so both groups can submit at the same time.
+	challengeTaskGroup = "challenge-transactions"
+
 	StakePrelaunchMinipoolsColor   = color.FgBlue
 	DownloadRewardsTreesColor      = color.FgGreen
 	MetricsColor                   = color.FgHiYellow
@@ -47,6 +63,11 @@ const (
 	StakeMegapoolValidatorColor    = color.FgHiBlue
 	NotifyValidatorExitColor       = color.FgHiYellow
 	DefendChallengeExitColor       = color.FgHiGreen
+	MonitorMegapoolDeadlinesColor  = color.FgHiMagenta
+	SaveStateSnapshotColor         = color.FgCyan
+	UpdateRewardsCheckpointColor   = color.FgHiCyan
+	TrackGovernanceColor           = color.FgHiBlue
+	AutoVotePdaoPropsColor         = color.FgHiMagenta
 )
 
 // Register node command
@@ -79,6 +100,11 @@ func run(c *cli.Context) error {
 	// Configure
 	configureHTTP()
 
+	// In unlock-on-start mode, hold the wallet password in memory for the other Smartnode processes
+	if err := startPasswordAgent(c); err != nil {
+		return err
+	}
+
 	// Wait until the node wallet stored on disk is registered
 	if err := services.WaitNodeRegistered(c, true); err != nil {
 		return err
@@ -101,6 +127,10 @@ func run(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	txManager, err := services.GetTransactionManager(c)
+	if err != nil {
+		return err
+	}
 
 	// Print the current mode
 	if cfg.IsNativeMode {
@@ -159,6 +189,18 @@ func run(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	monitorMegapoolDeadlines, err := newMonitorMegapoolDeadlines(c, log.NewColorLogger(MonitorMegapoolDeadlinesColor))
+	if err != nil {
+		return err
+	}
+	trackGovernance, err := newTrackGovernance(c, log.NewColorLogger(TrackGovernanceColor))
+	if err != nil {
+		return err
+	}
+	autoVotePdaoProps, err := newAutoVotePdaoProps(c, log.NewColorLogger(AutoVotePdaoPropsColor))
+	if err != nil {
+		return err
+	}
 	defendPdaoProps, err := newDefendPdaoProps(c, log.NewColorLogger(DefendPdaoPropsColor))
 	if err != nil {
 		return err
@@ -173,18 +215,82 @@ func run(c *cli.Context) error {
 		}
 	}
 
+	var saveStateSnapshot *saveStateSnapshot
+	// Snapshots are opt-in since building the full network state is expensive
+	if cfg.Smartnode.EnableStateSnapshots.Value.(bool) {
+		saveStateSnapshot, err = newSaveStateSnapshot(c, log.NewColorLogger(SaveStateSnapshotColor))
+		if err != nil {
+			return err
+		}
+	}
+
+	var updateRewardsCheckpoint *updateRewardsCheckpoint
+	// The running rewards tree needs checkpoints to be enabled
+	if cfg.Smartnode.EnableRunningRewardsTree.Value.(bool) && cfg.Smartnode.RewardsTreeCheckpointInterval.Value.(uint64) > 0 {
+		updateRewardsCheckpoint, err = newUpdateRewardsCheckpoint(c, log.NewColorLogger(UpdateRewardsCheckpointColor))
+		if err != nil {
+			return err
+		}
+	}
+
 	var prestakeMegapoolValidator *prestakeMegapoolValidator
 	prestakeMegapoolValidator, err = newPrestakeMegapoolValidator(c, log.NewColorLogger(PrestakeMegapoolValidatorColor))
 	if err != nil {
 		return err
 	}
 
+	// Create the task scheduler; tasks share the network state snapshot it keeps
+	intervalSize := big.NewInt(int64(cfg.Geth.EventLogInterval))
+	taskScheduler := scheduler.NewScheduler(rp, func() (*state.NetworkState, error) {
+		state, err := updateNetworkState(m, &updateLog, nodeAccount.Address)
+		if err != nil {
+			return nil, err
+		}
+		stateLocker.UpdateState(state)
+		return state, nil
+	}, intervalSize, &updateLog, &errorLog)
+
+	// Time-critical duties run every epoch and whenever the relevant contracts emit events
+	taskScheduler.AddTask(scheduler.Task{Name: "manage-fee-recipient", Trigger: scheduler.EveryEpochs(1), Run: manageFeeRecipient.run})
+	taskScheduler.AddTask(scheduler.Task{Name: "defend-challenge-exit", Trigger: scheduler.Any(scheduler.EveryEpochs(1), scheduler.OnEvents("rocketMegapoolManager")), Group: challengeTaskGroup, Run: defendChallengeExit.run})
+	taskScheduler.AddTask(scheduler.Task{Name: "monitor-megapool-deadlines", Trigger: scheduler.EveryEpochs(1), Run: monitorMegapoolDeadlines.run})
+	taskScheduler.AddTask(scheduler.Task{Name: "track-governance", Trigger: scheduler.Every(tasksInterval), Run: trackGovernance.run})
+	taskScheduler.AddTask(scheduler.Task{Name: "download-rewards-trees", Trigger: scheduler.Every(tasksInterval), Run: downloadRewardsTrees.run})
+	taskScheduler.AddTask(scheduler.Task{Name: "auto-vote-pdao-props", Trigger: scheduler.Every(tasksInterval), Group: txTaskGroup, Run: autoVotePdaoProps.run})
+	taskScheduler.AddTask(scheduler.Task{Name: "defend-pdao-props", Trigger: scheduler.Any(scheduler.Every(tasksInterval), scheduler.OnEvents("rocketDAOProtocolVerifier")), Group: txTaskGroup, Run: defendPdaoProps.run})
+	if verifyPdaoProps != nil {
+		taskScheduler.AddTask(scheduler.Task{Name: "verify-pdao-props", Trigger: scheduler.Any(scheduler.Every(tasksInterval), scheduler.OnEvents("rocketDAOProtocolVerifier")), Group: txTaskGroup, Run: verifyPdaoProps.run})
+	}
+	if prestakeMegapoolValidator != nil {
+		taskScheduler.AddTask(scheduler.Task{Name: "prestake-megapool-validator", Trigger: scheduler.EveryEpochs(1), Group: txTaskGroup, Run: prestakeMegapoolValidator.run})
+	}
+	if saveStateSnapshot != nil {
+		snapshotInterval := cfg.Smartnode.StateSnapshotInterval.Value.(uint64)
+		taskScheduler.AddTask(scheduler.Task{Name: "save-state-snapshot", Trigger: scheduler.EveryEpochs(snapshotInterval), Timeout: time.Hour, Run: saveStateSnapshot.run})
+	}
+	if updateRewardsCheckpoint != nil {
+		checkpointInterval := cfg.Smartnode.RewardsTreeCheckpointInterval.Value.(uint64)
+		taskScheduler.AddTask(scheduler.Task{Name: "update-rewards-checkpoint", Trigger: scheduler.EveryEpochs(checkpointInterval), Timeout: 6 * time.Hour, Run: updateRewardsCheckpoint.run})
+	}
+	taskScheduler.AddTask(scheduler.Task{Name: "stake-prelaunch-minipools", Trigger: scheduler.EveryEpochs(1), Group: txTaskGroup, Run: stakePrelaunchMinipools.run})
+	taskScheduler.AddTask(scheduler.Task{Name: "stake-megapool-validators", Trigger: scheduler.EveryEpochs(1), Group: txTaskGroup, Run: stakeMegapoolValidators.run})
+	taskScheduler.AddTask(scheduler.Task{Name: "notify-validator-exit", Trigger: scheduler.EveryEpochs(1), Group: txTaskGroup, Run: notifyValidatorExit.run})
+	taskScheduler.AddTask(scheduler.Task{Name: "distribute-minipools", Trigger: scheduler.Every(tasksInterval), Group: txTaskGroup, Run: distributeMinipools.run})
+	taskScheduler.AddTask(scheduler.Task{Name: "reduce-bonds", Trigger: scheduler.Every(tasksInterval), Group: txTaskGroup, Run: reduceBonds.run})
+	taskScheduler.AddTask(scheduler.Task{Name: "promote-minipools", Trigger: scheduler.Every(tasksInterval), Group: txTaskGroup, Run: promoteMinipools.run})
+
+	if err := taskScheduler.ResolveEventTriggers(); err != nil {
+		return err
+	}
+
 	// Wait group to handle the various threads
 	wg := new(sync.WaitGroup)
 	wg.Add(2)
 
-	// Run task loop
+	// Run the scheduler loop
 	go func() {
+		defer wg.Done()
+
 		// we assume clients are synced on startup so that we don't send unnecessary alerts
 		wasExecutionClientSynced := true
 		wasBeaconClientSynced := true
@@ -220,101 +326,27 @@ func run(c *cli.Context) error {
 				alerting.AlertBeaconClientSyncComplete(cfg)
 			}
 
-			// Update the network state
-			state, err := updateNetworkState(m, &updateLog, nodeAccount.Address)
-			if err != nil {
-				errorLog.Println(err)
-				time.Sleep(taskCooldown)
-				continue
-			}
-			stateLocker.UpdateState(state)
-
-			// Manage the fee recipient for the node
-			if err := manageFeeRecipient.run(state); err != nil {
-				errorLog.Println(err)
-			}
-			time.Sleep(taskCooldown)
-
-			// Run the defend challenge exit task
-			if err := defendChallengeExit.run(state); err != nil {
-				errorLog.Println(err)
-			}
-
-			// Run the rewards download check
-			if err := downloadRewardsTrees.run(state); err != nil {
-				errorLog.Println(err)
-			}
-			time.Sleep(taskCooldown)
-
-			// Run the pDAO proposal defender
-			if err := defendPdaoProps.run(state); err != nil {
-				errorLog.Println(err)
-			}
-			time.Sleep(taskCooldown)
-
-			// Run the pDAO proposal verifier
-			if verifyPdaoProps != nil {
-				if err := verifyPdaoProps.run(state); err != nil {
-					errorLog.Println(err)
-				}
-				time.Sleep(taskCooldown)
-			}
-
-			// Run the megapool prestake check
-			if prestakeMegapoolValidator != nil {
-				if err := prestakeMegapoolValidator.run(state); err != nil {
-					errorLog.Println(err)
-				}
-				time.Sleep(taskCooldown)
-			}
-
-			// Run the minipool stake check
-			if err := stakePrelaunchMinipools.run(state); err != nil {
-				errorLog.Println(err)
-			}
-			time.Sleep(taskCooldown)
-
-			// Run the megapool stake check
-			if err := stakeMegapoolValidators.run(state); err != nil {
-				errorLog.Println(err)
-			}
-			time.Sleep(taskCooldown)
-
-			// Run the megapool notify validator exit check
-			if err := notifyValidatorExit.run(state); err != nil {
-				errorLog.Println(err)
-			}
-			time.Sleep(taskCooldown)
-
-			// Run the balance distribution check
-			if err := distributeMinipools.run(state); err != nil {
-				errorLog.Println(err)
-			}
-			time.Sleep(taskCooldown)
-
-			// Run the reduce bond check
-			if err := reduceBonds.run(state); err != nil {
+			// Check on transactions that aren't being waited on, such as ones left over from before a restart
+			if err := txManager.ProcessQueue(); err != nil {
 				errorLog.Println(err)
 			}
-			time.Sleep(taskCooldown)
 
-			// Run the minipool promotion check
-			if err := promoteMinipools.run(state); err != nil {
+			// Start any tasks that are due
+			if err := taskScheduler.Tick(); err != nil {
 				errorLog.Println(err)
 			}
 
-			time.Sleep(tasksInterval)
+			time.Sleep(headPollInterval)
 		}
-		wg.Done()
 	}()
 
 	// Run metrics loop
 	go func() {
-		err := runMetricsServer(c, log.NewColorLogger(MetricsColor), stateLocker)
+		defer wg.Done()
+		err := runMetricsServer(c, log.NewColorLogger(MetricsColor), stateLocker, taskScheduler, monitorMegapoolDeadlines, trackGovernance)
 		if err != nil {
 			errorLog.Println(err)
 		}
-		wg.Done()
 	}()
 
 	// Wait for both threads to stop
@@ -428,3 +460,58 @@ func GetPriorityFee(priorityFee *big.Int, maxFee *big.Int) *big.Int {
 		return quarterMaxFee
 	}
 }
+
+// Check a duty's gas policy before it submits a transaction, printing the transaction's cost if it can go ahead.
+// The deadline is when the duty has to go through regardless of its threshold and the gas budget, or zero if it doesn't have one.
+func checkGasPolicy(cfg *config.RocketPoolConfig, txMgr *txmanager.TransactionManager, logger *log.ColorLogger, duty string, gasInfo rocketpool.GasInfo, maxFee *big.Int, gasLimit uint64, deadline time.Time) (bool, error) {
+	gasPolicy, err := policy.GetPolicy(cfg, duty)
+	if err != nil {
+		return false, err
+	}
+	spent, err := txMgr.GetLedger().GetTotalSpent(time.Now())
+	if err != nil {
+		return false, fmt.Errorf("error checking this month's gas spending: %w", err)
+	}
+
+	// The budget is checked against the most the transaction could cost
+	limit := gasLimit
+	if limit == 0 {
+		limit = gasInfo.SafeGasLimit
+	}
+	decision := gasPolicy.Evaluate(maxFee, limit, deadline, spent, time.Now())
+	if decision.Reason != "" {
+		if decision.PastDeadline {
+			logger.Printlnf("NOTICE: %s", decision.Reason)
+		} else {
+			logger.Println(decision.Reason)
+		}
+	}
+	if !decision.Proceed {
+		return false, nil
+	}
+
+	api.PrintGasInfo(gasInfo, logger, maxFee, gasLimit)
+	return true, nil
+}
+
+// Start the password agent if the wallet password isn't stored, so it can be unlocked
+func startPasswordAgent(c *cli.Context) error {
+	pm, err := services.GetPasswordManager(c)
+	if err != nil {
+		return err
+	}
+	if pm.GetStorageMode() != passwords.StorageMode_Unlock {
+		return nil
+	}
+
+	if pm.IsPasswordFileStored() {
+		fmt.Println("WARNING: The Smartnode is set to unlock the node wallet on start, but a wallet password file is still stored on disk. Please delete it.")
+	}
+	go func() {
+		err := pm.ServeAgent()
+		fmt.Fprintf(os.Stderr, "The wallet password agent stopped: %s\n", err.Error())
+		os.Exit(1)
+	}()
+	fmt.Println("The node wallet is locked until you run 'rocketpool wallet unlock'.")
+	return nil
+}
@@ -1,6 +1,7 @@
 package node
 
 import (
+	"context"
 	"math/big"
 
 	"github.com/docker/docker/client"
@@ -16,6 +17,7 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services/config"
 	rpgas "github.com/rocket-pool/smartnode/shared/services/gas"
 	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
 	"github.com/rocket-pool/smartnode/shared/services/wallet"
 	"github.com/rocket-pool/smartnode/shared/utils/api"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
@@ -29,6 +31,7 @@ type notifyValidatorExit struct {
 	log            log.ColorLogger
 	cfg            *config.RocketPoolConfig
 	w              wallet.Wallet
+	txMgr          *txmanager.TransactionManager
 	rp             *rocketpool.RocketPool
 	bc             beacon.Client
 	d              *client.Client
@@ -54,6 +57,10 @@ func newNotifyValidatorExit(c *cli.Context, logger log.ColorLogger) (*notifyVali
 	if err != nil {
 		return nil, err
 	}
+	txMgr, err := services.GetTransactionManager(c)
+	if err != nil {
+		return nil, err
+	}
 	d, err := services.GetDocker(c)
 	if err != nil {
 		return nil, err
@@ -90,6 +97,7 @@ func newNotifyValidatorExit(c *cli.Context, logger log.ColorLogger) (*notifyVali
 		log:            logger,
 		cfg:            cfg,
 		w:              w,
+		txMgr:          txMgr,
 		rp:             rp,
 		bc:             bc,
 		d:              d,
@@ -102,7 +110,7 @@ func newNotifyValidatorExit(c *cli.Context, logger log.ColorLogger) (*notifyVali
 }
 
 // Prestake megapool validator
-func (t *notifyValidatorExit) run(state *state.NetworkState) error {
+func (t *notifyValidatorExit) run(ctx context.Context, state *state.NetworkState) error {
 	if !state.IsSaturnDeployed {
 		return nil
 	}
@@ -153,12 +161,15 @@ func (t *notifyValidatorExit) run(state *state.NetworkState) error {
 	}
 
 	for i := uint32(0); i < uint32(validatorCount); i++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
 		if validatorInfo[i].Activated && validatorInfo[i].WithdrawableEpoch < FarFutureEpoch && validatorInfo[i].Staked && !validatorInfo[i].Exited && !validatorInfo[i].Exiting {
 			// Log
 			t.log.Printlnf("The validator ID %d needs an exit proof", validatorInfo[i].ValidatorId)
 
 			// Call Stake
-			t.createExitProof(t.rp, mp, validatorInfo[i].ValidatorId, state, types.ValidatorPubkey(validatorInfo[i].PubKey), opts)
+			t.createExitProof(ctx, t.rp, mp, validatorInfo[i].ValidatorId, state, types.ValidatorPubkey(validatorInfo[i].PubKey), opts)
 		}
 	}
 
@@ -167,13 +178,14 @@ func (t *notifyValidatorExit) run(state *state.NetworkState) error {
 
 }
 
-func (t *notifyValidatorExit) createExitProof(rp *rocketpool.RocketPool, mp megapool.Megapool, validatorId uint32, state *state.NetworkState, validatorPubkey types.ValidatorPubkey, callopts *bind.CallOpts) error {
+func (t *notifyValidatorExit) createExitProof(ctx context.Context, rp *rocketpool.RocketPool, mp megapool.Megapool, validatorId uint32, state *state.NetworkState, validatorPubkey types.ValidatorPubkey, callopts *bind.CallOpts) error {
 
 	// Get transactor
-	opts, err := t.w.GetNodeAccountTransactor()
+	opts, err := t.txMgr.GetTransactor()
 	if err != nil {
 		return err
 	}
+	defer t.txMgr.ReleaseTransactor(opts)
 
 	t.log.Printlnf("[STARTED] Crafting an exit proof. This process can take several seconds and is CPU and memory intensive. If you don't see a [FINISHED] log entry your system may not have enough resources to perform this operation.")
 
@@ -194,7 +206,7 @@ func (t *notifyValidatorExit) createExitProof(rp *rocketpool.RocketPool, mp mega
 	// Get the max fee
 	maxFee := t.maxFee
 	if maxFee == nil || maxFee.Uint64() == 0 {
-		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg)
+		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg, t.rp.Client)
 		if err != nil {
 			return err
 		}
@@ -216,7 +228,7 @@ func (t *notifyValidatorExit) createExitProof(rp *rocketpool.RocketPool, mp mega
 	}
 
 	// Print TX info and wait for it to be included in a block
-	err = api.PrintAndWaitForTransaction(t.cfg, tx.Hash(), t.rp.Client, &t.log)
+	err = t.txMgr.TrackAndWaitContext(ctx, t.cfg, "notify-validator-exit", opts, tx.Hash(), &t.log)
 	if err != nil {
 		return err
 	}
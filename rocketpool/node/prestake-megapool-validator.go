@@ -18,20 +18,23 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services/config"
 	rpgas "github.com/rocket-pool/smartnode/shared/services/gas"
 	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
 	"github.com/rocket-pool/smartnode/shared/services/wallet"
-	"github.com/rocket-pool/smartnode/shared/utils/api"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
 )
 
+// The name of the duty that the task's transactions and gas policy are tracked under
+const prestakeMegapoolValidatorDuty string = "prestake-megapool-validator"
+
 // Prestake megapool validator task
 type prestakeMegapoolValidator struct {
 	c                   *cli.Context
 	log                 log.ColorLogger
 	cfg                 *config.RocketPoolConfig
 	w                   wallet.Wallet
+	txMgr               *txmanager.TransactionManager
 	rp                  *rocketpool.RocketPool
 	d                   *client.Client
-	gasThreshold        float64
 	maxFee              *big.Int
 	maxPriorityFee      *big.Int
 	gasLimit            uint64
@@ -54,13 +57,15 @@ func newPrestakeMegapoolValidator(c *cli.Context, logger log.ColorLogger) (*pres
 	if err != nil {
 		return nil, err
 	}
+	txMgr, err := services.GetTransactionManager(c)
+	if err != nil {
+		return nil, err
+	}
 	d, err := services.GetDocker(c)
 	if err != nil {
 		return nil, err
 	}
 
-	gasThreshold := cfg.Smartnode.AutoTxGasThreshold.Value.(float64)
-
 	// Get the user-requested max fee
 	maxFeeGwei := cfg.Smartnode.ManualMaxFee.Value.(float64)
 	var maxFee *big.Int
@@ -88,9 +93,9 @@ func newPrestakeMegapoolValidator(c *cli.Context, logger log.ColorLogger) (*pres
 		log:                 logger,
 		cfg:                 cfg,
 		w:                   w,
+		txMgr:               txMgr,
 		rp:                  rp,
 		d:                   d,
-		gasThreshold:        gasThreshold,
 		maxFee:              maxFee,
 		maxPriorityFee:      priorityFee,
 		gasLimit:            0,
@@ -100,7 +105,7 @@ func newPrestakeMegapoolValidator(c *cli.Context, logger log.ColorLogger) (*pres
 }
 
 // Prestake megapool validator
-func (t *prestakeMegapoolValidator) run(state *state.NetworkState) error {
+func (t *prestakeMegapoolValidator) run(ctx context.Context, state *state.NetworkState) error {
 	if !state.IsSaturnDeployed {
 		return nil
 	}
@@ -172,7 +177,7 @@ func (t *prestakeMegapoolValidator) run(state *state.NetworkState) error {
 			return nil
 		}
 		// Call assign
-		t.assignDeposit(opts)
+		t.assignDeposit(ctx, opts)
 	} else {
 		t.log.Printlnf("Time left until the automatic stake %s", remainingTime)
 	}
@@ -182,13 +187,14 @@ func (t *prestakeMegapoolValidator) run(state *state.NetworkState) error {
 
 }
 
-func (t *prestakeMegapoolValidator) assignDeposit(callopts *bind.CallOpts) error {
+func (t *prestakeMegapoolValidator) assignDeposit(ctx context.Context, callopts *bind.CallOpts) error {
 
 	// Get transactor
-	opts, err := t.w.GetNodeAccountTransactor()
+	opts, err := t.txMgr.GetTransactor()
 	if err != nil {
 		return err
 	}
+	defer t.txMgr.ReleaseTransactor(opts)
 
 	// Get the gas limit
 	gasInfo, err := deposit.EstimateAssignDepositsGas(t.rp, big.NewInt(1), opts)
@@ -200,14 +206,18 @@ func (t *prestakeMegapoolValidator) assignDeposit(callopts *bind.CallOpts) error
 	// Get the max fee
 	maxFee := t.maxFee
 	if maxFee == nil || maxFee.Uint64() == 0 {
-		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg)
+		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg, t.rp.Client)
 		if err != nil {
 			return err
 		}
 	}
 
-	// Print the gas info
-	if !api.PrintAndCheckGasInfo(gasInfo, true, t.gasThreshold, &t.log, maxFee, t.gasLimit) {
+	// Check the gas policy
+	proceed, err := checkGasPolicy(t.cfg, t.txMgr, &t.log, prestakeMegapoolValidatorDuty, gasInfo, maxFee, t.gasLimit, time.Time{})
+	if err != nil {
+		return err
+	}
+	if !proceed {
 		return nil
 	}
 
@@ -222,7 +232,7 @@ func (t *prestakeMegapoolValidator) assignDeposit(callopts *bind.CallOpts) error
 	}
 
 	// Print TX info and wait for it to be included in a block
-	err = api.PrintAndWaitForTransaction(t.cfg, hash, t.rp.Client, &t.log)
+	err = t.txMgr.TrackAndWaitContext(ctx, t.cfg, prestakeMegapoolValidatorDuty, opts, hash, &t.log)
 	if err != nil {
 		return err
 	}
@@ -21,20 +21,24 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services/config"
 	rpgas "github.com/rocket-pool/smartnode/shared/services/gas"
 	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
 	"github.com/rocket-pool/smartnode/shared/services/wallet"
 	"github.com/rocket-pool/smartnode/shared/utils/api"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
 )
 
+// The name of the duty that the task's transactions and gas policy are tracked under
+const promoteMinipoolsDuty string = "promote-minipools"
+
 // Promote minipools task
 type promoteMinipools struct {
 	c              *cli.Context
 	log            log.ColorLogger
 	cfg            *config.RocketPoolConfig
 	w              wallet.Wallet
+	txMgr          *txmanager.TransactionManager
 	rp             *rocketpool.RocketPool
 	d              *client.Client
-	gasThreshold   float64
 	maxFee         *big.Int
 	maxPriorityFee *big.Int
 	gasLimit       uint64
@@ -56,13 +60,15 @@ func newPromoteMinipools(c *cli.Context, logger log.ColorLogger) (*promoteMinipo
 	if err != nil {
 		return nil, err
 	}
+	txMgr, err := services.GetTransactionManager(c)
+	if err != nil {
+		return nil, err
+	}
 	d, err := services.GetDocker(c)
 	if err != nil {
 		return nil, err
 	}
 
-	gasThreshold := cfg.Smartnode.AutoTxGasThreshold.Value.(float64)
-
 	// Get the user-requested max fee
 	maxFeeGwei := cfg.Smartnode.ManualMaxFee.Value.(float64)
 	var maxFee *big.Int
@@ -88,9 +94,9 @@ func newPromoteMinipools(c *cli.Context, logger log.ColorLogger) (*promoteMinipo
 		log:            logger,
 		cfg:            cfg,
 		w:              w,
+		txMgr:          txMgr,
 		rp:             rp,
 		d:              d,
-		gasThreshold:   gasThreshold,
 		maxFee:         maxFee,
 		maxPriorityFee: priorityFee,
 		gasLimit:       0,
@@ -99,7 +105,7 @@ func newPromoteMinipools(c *cli.Context, logger log.ColorLogger) (*promoteMinipo
 }
 
 // Stake prelaunch minipools
-func (t *promoteMinipools) run(state *state.NetworkState) error {
+func (t *promoteMinipools) run(ctx context.Context, state *state.NetworkState) error {
 
 	// Log
 	t.log.Println("Checking for minipools to promote...")
@@ -129,7 +135,10 @@ func (t *promoteMinipools) run(state *state.NetworkState) error {
 
 	// Promote minipools
 	for _, mpd := range minipools {
-		_, err := t.promoteMinipool(mpd, opts)
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		_, err := t.promoteMinipool(ctx, mpd, opts)
 		alerting.AlertMinipoolPromoted(t.cfg, mpd.MinipoolAddress, err == nil)
 		if err != nil {
 			t.log.Println(fmt.Errorf("Could not promote minipool %s: %w", mpd.MinipoolAddress.Hex(), err))
@@ -177,7 +186,7 @@ func (t *promoteMinipools) getVacantMinipools(nodeAddress common.Address, state
 }
 
 // Promote a minipool
-func (t *promoteMinipools) promoteMinipool(mpd *rpstate.NativeMinipoolDetails, callOpts *bind.CallOpts) (bool, error) {
+func (t *promoteMinipools) promoteMinipool(ctx context.Context, mpd *rpstate.NativeMinipoolDetails, callOpts *bind.CallOpts) (bool, error) {
 
 	// Log
 	t.log.Printlnf("Promoting minipool %s...", mpd.MinipoolAddress.Hex())
@@ -193,10 +202,11 @@ func (t *promoteMinipools) promoteMinipool(mpd *rpstate.NativeMinipoolDetails, c
 	}
 
 	// Get transactor
-	opts, err := t.w.GetNodeAccountTransactor()
+	opts, err := t.txMgr.GetTransactor()
 	if err != nil {
 		return false, err
 	}
+	defer t.txMgr.ReleaseTransactor(opts)
 
 	// Get the gas limit
 	gasInfo, err := mpv3.EstimatePromoteGas(opts)
@@ -213,26 +223,27 @@ func (t *promoteMinipools) promoteMinipool(mpd *rpstate.NativeMinipoolDetails, c
 	// Get the max fee
 	maxFee := t.maxFee
 	if maxFee == nil || maxFee.Uint64() == 0 {
-		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg)
+		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg, t.rp.Client)
 		if err != nil {
 			return false, err
 		}
 	}
 
-	// Print the gas info
-	if !api.PrintAndCheckGasInfo(gasInfo, true, t.gasThreshold, &t.log, maxFee, t.gasLimit) {
-		// Check for the timeout buffer
-		creationTime := time.Unix(mpd.StatusTime.Int64(), 0)
-		isDue, timeUntilDue, err := api.IsTransactionDue(t.rp, creationTime)
-		if err != nil {
-			t.log.Printlnf("Error checking if minipool is due: %s\nPromoting now for safety...", err.Error())
-		}
-		if !isDue {
-			t.log.Printlnf("Time until promoting will be forced for safety: %s", timeUntilDue)
-			return false, nil
-		}
+	// Get the deadline, after which the minipool has to be promoted before it times out
+	creationTime := time.Unix(mpd.StatusTime.Int64(), 0)
+	deadline, err := api.GetTransactionDeadline(t.rp, creationTime)
+	if err != nil {
+		t.log.Printlnf("Error checking if minipool is due: %s\nPromoting now for safety...", err.Error())
+		deadline = time.Now()
+	}
 
-		t.log.Println("NOTICE: The minipool has exceeded half of the timeout period, so it will be force-promoted at the current gas price.")
+	// Check the gas policy
+	proceed, err := checkGasPolicy(t.cfg, t.txMgr, &t.log, promoteMinipoolsDuty, gasInfo, maxFee, t.gasLimit, deadline)
+	if err != nil {
+		return false, err
+	}
+	if !proceed {
+		return false, nil
 	}
 
 	opts.GasFeeCap = maxFee
@@ -246,7 +257,7 @@ func (t *promoteMinipools) promoteMinipool(mpd *rpstate.NativeMinipoolDetails, c
 	}
 
 	// Print TX info and wait for it to be included in a block
-	err = api.PrintAndWaitForTransaction(t.cfg, hash, t.rp.Client, &t.log)
+	err = t.txMgr.TrackAndWaitContext(ctx, t.cfg, promoteMinipoolsDuty, opts, hash, &t.log)
 	if err != nil {
 		return false, err
 	}
@@ -22,21 +22,25 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services/alerting"
 	"github.com/rocket-pool/smartnode/shared/services/config"
 	rpgas "github.com/rocket-pool/smartnode/shared/services/gas"
+	"github.com/rocket-pool/smartnode/shared/services/gas/policy"
 	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
 	"github.com/rocket-pool/smartnode/shared/services/wallet"
-	"github.com/rocket-pool/smartnode/shared/utils/api"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
 )
 
+// The name of the duty that the task's transactions and gas policy are tracked under
+const reduceBondsDuty string = "reduce-bonds"
+
 // Reduce bonds task
 type reduceBonds struct {
 	c              *cli.Context
 	log            log.ColorLogger
 	cfg            *config.RocketPoolConfig
 	w              wallet.Wallet
+	txMgr          *txmanager.TransactionManager
 	rp             *rocketpool.RocketPool
 	d              *client.Client
-	gasThreshold   float64
 	disabled       bool
 	maxFee         *big.Int
 	maxPriorityFee *big.Int
@@ -68,15 +72,22 @@ func newReduceBonds(c *cli.Context, logger log.ColorLogger) (*reduceBonds, error
 	if err != nil {
 		return nil, err
 	}
+	txMgr, err := services.GetTransactionManager(c)
+	if err != nil {
+		return nil, err
+	}
 	d, err := services.GetDocker(c)
 	if err != nil {
 		return nil, err
 	}
 
 	// Check if auto-bond-reduction is disabled
-	gasThreshold := cfg.Smartnode.AutoTxGasThreshold.Value.(float64)
+	gasPolicy, err := policy.GetPolicy(cfg, reduceBondsDuty)
+	if err != nil {
+		return nil, err
+	}
 	disabled := false
-	if gasThreshold == 0 {
+	if gasPolicy.ThresholdGwei == 0 {
 		logger.Println("Automatic tx gas threshold is 0, disabling auto-reduce.")
 		disabled = true
 	}
@@ -106,9 +117,9 @@ func newReduceBonds(c *cli.Context, logger log.ColorLogger) (*reduceBonds, error
 		log:            logger,
 		cfg:            cfg,
 		w:              w,
+		txMgr:          txMgr,
 		rp:             rp,
 		d:              d,
-		gasThreshold:   gasThreshold,
 		disabled:       disabled,
 		maxFee:         maxFee,
 		maxPriorityFee: priorityFee,
@@ -118,7 +129,7 @@ func newReduceBonds(c *cli.Context, logger log.ColorLogger) (*reduceBonds, error
 }
 
 // Reduce bonds
-func (t *reduceBonds) run(state *state.NetworkState) error {
+func (t *reduceBonds) run(ctx context.Context, state *state.NetworkState) error {
 
 	// Check if auto-reduce is disabled
 	if t.disabled {
@@ -163,7 +174,7 @@ func (t *reduceBonds) run(state *state.NetworkState) error {
 	t.log.Printlnf("%d minipool(s) are ready for bond reduction...", len(minipools))
 
 	// Workaround for the fee distribution issue
-	success, err := t.forceFeeDistribution()
+	success, err := t.forceFeeDistribution(ctx)
 	if err != nil {
 		return err
 	}
@@ -174,7 +185,10 @@ func (t *reduceBonds) run(state *state.NetworkState) error {
 	// Reduce bonds
 	successCount := 0
 	for _, mp := range minipools {
-		success, err := t.reduceBond(mp, windowStart, windowLength, latestBlockTime, opts)
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		success, err := t.reduceBond(ctx, mp, windowStart, windowLength, latestBlockTime, opts)
 		alerting.AlertMinipoolBondReduced(t.cfg, mp.MinipoolAddress, err == nil)
 		if err != nil {
 			t.log.Println(fmt.Errorf("could not reduce bond for minipool %s: %w", mp.MinipoolAddress.Hex(), err))
@@ -191,7 +205,7 @@ func (t *reduceBonds) run(state *state.NetworkState) error {
 }
 
 // Temp mitigation for the
-func (t *reduceBonds) forceFeeDistribution() (bool, error) {
+func (t *reduceBonds) forceFeeDistribution(ctx context.Context) (bool, error) {
 
 	// Get node account
 	nodeAccount, err := t.w.GetNodeAccount()
@@ -249,10 +263,11 @@ func (t *reduceBonds) forceFeeDistribution() (bool, error) {
 	t.log.Printlnf("\tYour withdrawal address will receive %.6f ETH.", nodeShare)
 	t.log.Printlnf("\trETH pool stakers will receive %.6f ETH.\n", rEthShare)
 
-	opts, err := t.w.GetNodeAccountTransactor()
+	opts, err := t.txMgr.GetTransactor()
 	if err != nil {
 		return false, err
 	}
+	defer t.txMgr.ReleaseTransactor(opts)
 
 	// Get the gas limit
 	gasInfo, err := distributor.EstimateDistributeGas(opts)
@@ -269,14 +284,18 @@ func (t *reduceBonds) forceFeeDistribution() (bool, error) {
 	// Get the max fee
 	maxFee := t.maxFee
 	if maxFee == nil || maxFee.Uint64() == 0 {
-		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg)
+		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg, t.rp.Client)
 		if err != nil {
 			return false, err
 		}
 	}
 
-	// Print the gas info
-	if !api.PrintAndCheckGasInfo(gasInfo, true, t.gasThreshold, &t.log, maxFee, t.gasLimit) {
+	// Check the gas policy
+	proceed, err := checkGasPolicy(t.cfg, t.txMgr, &t.log, reduceBondsDuty, gasInfo, maxFee, t.gasLimit, time.Time{})
+	if err != nil {
+		return false, err
+	}
+	if !proceed {
 		return false, nil
 	}
 
@@ -292,7 +311,7 @@ func (t *reduceBonds) forceFeeDistribution() (bool, error) {
 	}
 
 	// Print TX info and wait for it to be included in a block
-	err = api.PrintAndWaitForTransaction(t.cfg, hash, t.rp.Client, &t.log)
+	err = t.txMgr.TrackAndWaitContext(ctx, t.cfg, reduceBondsDuty, opts, hash, &t.log)
 	if err != nil {
 		return false, err
 	}
@@ -341,16 +360,17 @@ func (t *reduceBonds) getReduceableMinipools(nodeAddress common.Address, windowS
 }
 
 // Reduce a minipool's bond
-func (t *reduceBonds) reduceBond(mpd *rpstate.NativeMinipoolDetails, windowStart time.Duration, windowLength time.Duration, latestBlockTime time.Time, callOpts *bind.CallOpts) (bool, error) {
+func (t *reduceBonds) reduceBond(ctx context.Context, mpd *rpstate.NativeMinipoolDetails, windowStart time.Duration, windowLength time.Duration, latestBlockTime time.Time, callOpts *bind.CallOpts) (bool, error) {
 
 	// Log
 	t.log.Printlnf("Reducing bond for minipool %s...", mpd.MinipoolAddress.Hex())
 
 	// Get transactor
-	opts, err := t.w.GetNodeAccountTransactor()
+	opts, err := t.txMgr.GetTransactor()
 	if err != nil {
 		return false, err
 	}
+	defer t.txMgr.ReleaseTransactor(opts)
 
 	// Make the minipool binding
 	mpBinding, err := minipool.NewMinipoolFromVersion(t.rp, mpd.MinipoolAddress, mpd.Version, callOpts)
@@ -379,7 +399,7 @@ func (t *reduceBonds) reduceBond(mpd *rpstate.NativeMinipoolDetails, windowStart
 	// Get the max fee
 	maxFee := t.maxFee
 	if maxFee == nil || maxFee.Uint64() == 0 {
-		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg)
+		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg, t.rp.Client)
 		if err != nil {
 			return false, err
 		}
@@ -391,8 +411,12 @@ func (t *reduceBonds) reduceBond(mpd *rpstate.NativeMinipoolDetails, windowStart
 		return false, fmt.Errorf("error getting reduce bond time for minipool %s: %w", mpd.MinipoolAddress.Hex(), err)
 	}
 
-	// Print the gas info
-	if !api.PrintAndCheckGasInfo(gasInfo, true, t.gasThreshold, &t.log, maxFee, t.gasLimit) {
+	// Check the gas policy
+	proceed, err := checkGasPolicy(t.cfg, t.txMgr, &t.log, reduceBondsDuty, gasInfo, maxFee, t.gasLimit, time.Time{})
+	if err != nil {
+		return false, err
+	}
+	if !proceed {
 		timeSinceReductionStart := latestBlockTime.Sub(reduceBondTime)
 		remainingTime := (windowStart + windowLength) - timeSinceReductionStart
 		t.log.Printlnf("Time until bond reduction times out: %s", remainingTime)
@@ -410,7 +434,7 @@ func (t *reduceBonds) reduceBond(mpd *rpstate.NativeMinipoolDetails, windowStart
 	}
 
 	// Print TX info and wait for it to be included in a block
-	err = api.PrintAndWaitForTransaction(t.cfg, hash, t.rp.Client, &t.log)
+	err = t.txMgr.TrackAndWaitContext(ctx, t.cfg, reduceBondsDuty, opts, hash, &t.log)
 	if err != nil {
 		return false, err
 	}
@@ -0,0 +1,84 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+// Save state snapshot task
+type saveStateSnapshot struct {
+	c         *cli.Context
+	log       log.ColorLogger
+	m         *state.NetworkStateManager
+	snapshots *state.SnapshotStore
+}
+
+// Create save state snapshot task
+func newSaveStateSnapshot(c *cli.Context, logger log.ColorLogger) (*saveStateSnapshot, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+	snapshots, err := services.GetSnapshotStore(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// The task gets its own state manager, since it builds full network states alongside the daemon's node states
+	m := state.NewNetworkStateManager(rp, cfg.Smartnode.GetStateManagerContracts(), bc, &logger)
+
+	// Return task
+	return &saveStateSnapshot{
+		c:         c,
+		log:       logger,
+		m:         m,
+		snapshots: snapshots,
+	}, nil
+
+}
+
+// Save a snapshot of the network state at the latest finalized slot
+func (t *saveStateSnapshot) run(_ context.Context, _ *state.NetworkState) error {
+
+	// Get the latest finalized slot
+	block, err := t.m.GetLatestFinalizedBeaconBlock()
+	if err != nil {
+		return fmt.Errorf("error getting latest finalized block: %w", err)
+	}
+	if t.snapshots.Has(block.Slot) {
+		return nil
+	}
+
+	// Build and save the state
+	t.log.Printlnf("Saving a snapshot of the network state at slot %d...", block.Slot)
+	start := time.Now()
+	networkState, err := t.m.GetStateForSlot(block.Slot)
+	if err != nil {
+		return fmt.Errorf("error getting network state for slot %d: %w", block.Slot, err)
+	}
+	err = t.snapshots.Save(networkState)
+	if err != nil {
+		return err
+	}
+	t.log.Printlnf("Saved the network state snapshot for slot %d (%s).", block.Slot, time.Since(start).Round(time.Second))
+
+	return nil
+
+}
@@ -1,12 +1,15 @@
 package node
 
 import (
+	"context"
 	"math/big"
+	"time"
 
 	"github.com/docker/docker/client"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/rocket-pool/smartnode/bindings/megapool"
 	"github.com/rocket-pool/smartnode/bindings/rocketpool"
+	"github.com/rocket-pool/smartnode/bindings/settings/protocol"
 	"github.com/rocket-pool/smartnode/bindings/types"
 	"github.com/rocket-pool/smartnode/bindings/utils/eth"
 	"github.com/urfave/cli"
@@ -15,22 +18,29 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services/beacon"
 	"github.com/rocket-pool/smartnode/shared/services/config"
 	rpgas "github.com/rocket-pool/smartnode/shared/services/gas"
+	"github.com/rocket-pool/smartnode/shared/services/keymanager"
 	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
 	"github.com/rocket-pool/smartnode/shared/services/wallet"
 	"github.com/rocket-pool/smartnode/shared/utils/api"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
+	"github.com/rocket-pool/smartnode/shared/utils/validator"
 )
 
+// The name of the duty that the task's transactions and gas policy are tracked under
+const stakeMegapoolValidatorDuty string = "stake-megapool-validators"
+
 // Stake megapool validator task
 type stakeMegapoolValidator struct {
 	c              *cli.Context
 	log            log.ColorLogger
 	cfg            *config.RocketPoolConfig
 	w              wallet.Wallet
+	txMgr          *txmanager.TransactionManager
 	rp             *rocketpool.RocketPool
 	bc             beacon.Client
 	d              *client.Client
-	gasThreshold   float64
+	km             *keymanager.Client
 	maxFee         *big.Int
 	maxPriorityFee *big.Int
 	gasLimit       uint64
@@ -52,6 +62,10 @@ func newStakeMegapoolValidator(c *cli.Context, logger log.ColorLogger) (*stakeMe
 	if err != nil {
 		return nil, err
 	}
+	txMgr, err := services.GetTransactionManager(c)
+	if err != nil {
+		return nil, err
+	}
 	d, err := services.GetDocker(c)
 	if err != nil {
 		return nil, err
@@ -60,8 +74,10 @@ func newStakeMegapoolValidator(c *cli.Context, logger log.ColorLogger) (*stakeMe
 	if err != nil {
 		return nil, err
 	}
-
-	gasThreshold := cfg.Smartnode.AutoTxGasThreshold.Value.(float64)
+	km, err := services.GetKeymanagerClient(c)
+	if err != nil {
+		return nil, err
+	}
 
 	// Get the user-requested max fee
 	maxFeeGwei := cfg.Smartnode.ManualMaxFee.Value.(float64)
@@ -88,10 +104,11 @@ func newStakeMegapoolValidator(c *cli.Context, logger log.ColorLogger) (*stakeMe
 		log:            logger,
 		cfg:            cfg,
 		w:              w,
+		txMgr:          txMgr,
 		rp:             rp,
 		bc:             bc,
 		d:              d,
-		gasThreshold:   gasThreshold,
+		km:             km,
 		maxFee:         maxFee,
 		maxPriorityFee: priorityFee,
 		gasLimit:       0,
@@ -100,7 +117,7 @@ func newStakeMegapoolValidator(c *cli.Context, logger log.ColorLogger) (*stakeMe
 }
 
 // Prestake megapool validator
-func (t *stakeMegapoolValidator) run(state *state.NetworkState) error {
+func (t *stakeMegapoolValidator) run(ctx context.Context, state *state.NetworkState) error {
 	if !state.IsSaturnDeployed {
 		return nil
 	}
@@ -150,13 +167,23 @@ func (t *stakeMegapoolValidator) run(state *state.NetworkState) error {
 		return err
 	}
 
+	// Get the time before prestaked validators can be dissolved
+	timeBeforeDissolve, err := protocol.GetMegapoolTimeBeforeDissolve(t.rp, opts)
+	if err != nil {
+		return err
+	}
+
 	for i := uint32(0); i < uint32(validatorCount); i++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
 		if validatorInfo[i].InPrestake && validatorInfo[i].BeaconStatus.Index != "" {
 			// Log
 			t.log.Printlnf("The validator %d needs to be staked", validatorInfo[i].ValidatorId)
 
-			// Call Stake
-			t.stakeValidator(t.rp, mp, validatorInfo[i].ValidatorId, state, types.ValidatorPubkey(validatorInfo[i].PubKey), opts)
+			// Call Stake, which is due once half of the time before the validator can be dissolved has passed
+			deadline := api.GetSafeDeadline(validatorInfo[i].LastAssignmentTime, time.Duration(timeBeforeDissolve)*time.Second)
+			t.stakeValidator(ctx, t.rp, mp, validatorInfo[i].ValidatorId, state, types.ValidatorPubkey(validatorInfo[i].PubKey), deadline, opts)
 		}
 	}
 
@@ -165,13 +192,14 @@ func (t *stakeMegapoolValidator) run(state *state.NetworkState) error {
 
 }
 
-func (t *stakeMegapoolValidator) stakeValidator(rp *rocketpool.RocketPool, mp megapool.Megapool, validatorId uint32, state *state.NetworkState, validatorPubkey types.ValidatorPubkey, callopts *bind.CallOpts) error {
+func (t *stakeMegapoolValidator) stakeValidator(ctx context.Context, rp *rocketpool.RocketPool, mp megapool.Megapool, validatorId uint32, state *state.NetworkState, validatorPubkey types.ValidatorPubkey, deadline time.Time, callopts *bind.CallOpts) error {
 
 	// Get transactor
-	opts, err := t.w.GetNodeAccountTransactor()
+	opts, err := t.txMgr.GetTransactor()
 	if err != nil {
 		return err
 	}
+	defer t.txMgr.ReleaseTransactor(opts)
 
 	t.log.Printlnf("[STARTED] Crafting a proof that the correct credentials were used on the first beacon chain deposit. This process can take several seconds and is CPU and memory intensive. If you don't see a [FINISHED] log entry your system may not have enough resources to perform this operation.")
 
@@ -192,14 +220,18 @@ func (t *stakeMegapoolValidator) stakeValidator(rp *rocketpool.RocketPool, mp me
 	// Get the max fee
 	maxFee := t.maxFee
 	if maxFee == nil || maxFee.Uint64() == 0 {
-		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg)
+		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg, t.rp.Client)
 		if err != nil {
 			return err
 		}
 	}
 
-	// Print the gas info
-	if !api.PrintAndCheckGasInfo(gasInfo, true, t.gasThreshold, &t.log, maxFee, t.gasLimit) {
+	// Check the gas policy
+	proceed, err := checkGasPolicy(t.cfg, t.txMgr, &t.log, stakeMegapoolValidatorDuty, gasInfo, maxFee, t.gasLimit, deadline)
+	if err != nil {
+		return err
+	}
+	if !proceed {
 		return nil
 	}
 
@@ -214,7 +246,7 @@ func (t *stakeMegapoolValidator) stakeValidator(rp *rocketpool.RocketPool, mp me
 	}
 
 	// Print TX info and wait for it to be included in a block
-	err = api.PrintAndWaitForTransaction(t.cfg, tx.Hash(), t.rp.Client, &t.log)
+	err = t.txMgr.TrackAndWaitContext(ctx, t.cfg, stakeMegapoolValidatorDuty, opts, tx.Hash(), &t.log)
 	if err != nil {
 		return err
 	}
@@ -222,6 +254,10 @@ func (t *stakeMegapoolValidator) stakeValidator(rp *rocketpool.RocketPool, mp me
 	// Log
 	t.log.Printlnf("Successfully staked validator %d.", validatorId)
 
-	// Return
-	return nil
+	// Load the validator's key into the validator client if it can be done without restarting it
+	if t.km == nil {
+		t.log.Printlnf("WARNING: The Keymanager API isn't enabled, so the key for validator %d will only be loaded the next time your Validator Client restarts.", validatorId)
+		return nil
+	}
+	return validator.LoadValidatorKeys(t.cfg, t.bc, t.km, &t.log, t.d, t.w, []types.ValidatorPubkey{validatorPubkey})
 }
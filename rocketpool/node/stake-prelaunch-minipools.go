@@ -21,23 +21,29 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services/beacon"
 	"github.com/rocket-pool/smartnode/shared/services/config"
 	rpgas "github.com/rocket-pool/smartnode/shared/services/gas"
+	"github.com/rocket-pool/smartnode/shared/services/keymanager"
 	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
 	"github.com/rocket-pool/smartnode/shared/services/wallet"
 	"github.com/rocket-pool/smartnode/shared/utils/api"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
 	"github.com/rocket-pool/smartnode/shared/utils/validator"
 )
 
+// The name of the duty that the task's transactions and gas policy are tracked under
+const stakePrelaunchMinipoolsDuty string = "stake-prelaunch-minipools"
+
 // Stake prelaunch minipools task
 type stakePrelaunchMinipools struct {
 	c              *cli.Context
 	log            log.ColorLogger
 	cfg            *config.RocketPoolConfig
 	w              wallet.Wallet
+	txMgr          *txmanager.TransactionManager
 	rp             *rocketpool.RocketPool
 	bc             beacon.Client
 	d              *client.Client
-	gasThreshold   float64
+	km             *keymanager.Client
 	maxFee         *big.Int
 	maxPriorityFee *big.Int
 	gasLimit       uint64
@@ -59,6 +65,10 @@ func newStakePrelaunchMinipools(c *cli.Context, logger log.ColorLogger) (*stakeP
 	if err != nil {
 		return nil, err
 	}
+	txMgr, err := services.GetTransactionManager(c)
+	if err != nil {
+		return nil, err
+	}
 	bc, err := services.GetBeaconClient(c)
 	if err != nil {
 		return nil, err
@@ -67,8 +77,10 @@ func newStakePrelaunchMinipools(c *cli.Context, logger log.ColorLogger) (*stakeP
 	if err != nil {
 		return nil, err
 	}
-
-	gasThreshold := cfg.Smartnode.AutoTxGasThreshold.Value.(float64)
+	km, err := services.GetKeymanagerClient(c)
+	if err != nil {
+		return nil, err
+	}
 
 	// Get the user-requested max fee
 	maxFeeGwei := cfg.Smartnode.ManualMaxFee.Value.(float64)
@@ -95,10 +107,11 @@ func newStakePrelaunchMinipools(c *cli.Context, logger log.ColorLogger) (*stakeP
 		log:            logger,
 		cfg:            cfg,
 		w:              w,
+		txMgr:          txMgr,
 		rp:             rp,
 		bc:             bc,
 		d:              d,
-		gasThreshold:   gasThreshold,
+		km:             km,
 		maxFee:         maxFee,
 		maxPriorityFee: priorityFee,
 		gasLimit:       0,
@@ -107,7 +120,7 @@ func newStakePrelaunchMinipools(c *cli.Context, logger log.ColorLogger) (*stakeP
 }
 
 // Stake prelaunch minipools
-func (t *stakePrelaunchMinipools) run(state *state.NetworkState) error {
+func (t *stakePrelaunchMinipools) run(ctx context.Context, state *state.NetworkState) error {
 
 	// Reload the wallet (in case a call to `node deposit` changed it)
 	if err := t.w.Reload(); err != nil {
@@ -141,22 +154,25 @@ func (t *stakePrelaunchMinipools) run(state *state.NetworkState) error {
 	t.log.Printlnf("%d minipool(s) are ready for staking...", len(minipools))
 
 	// Stake minipools
-	successCount := 0
+	stakedPubkeys := []rptypes.ValidatorPubkey{}
 	for _, mpd := range minipools {
-		success, err := t.stakeMinipool(mpd, state, opts)
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		success, err := t.stakeMinipool(ctx, mpd, state, opts)
 		alerting.AlertMinipoolStaked(t.cfg, mpd.MinipoolAddress, success && err == nil)
 		if err != nil {
 			t.log.Println(fmt.Errorf("Could not stake minipool %s: %w", mpd.MinipoolAddress.Hex(), err))
 			return err
 		}
 		if success {
-			successCount++
+			stakedPubkeys = append(stakedPubkeys, mpd.Pubkey)
 		}
 	}
 
-	// Restart validator process if any minipools were staked successfully
-	if successCount > 0 {
-		if err := validator.RestartValidator(t.cfg, t.bc, &t.log, t.d); err != nil {
+	// Load the keys of any minipools that were staked successfully into the validator client
+	if len(stakedPubkeys) > 0 {
+		if err := validator.LoadValidatorKeys(t.cfg, t.bc, t.km, &t.log, t.d, t.w, stakedPubkeys); err != nil {
 			return err
 		}
 	}
@@ -203,7 +219,7 @@ func (t *stakePrelaunchMinipools) getPrelaunchMinipools(nodeAddress common.Addre
 }
 
 // Stake a minipool
-func (t *stakePrelaunchMinipools) stakeMinipool(mpd *rpstate.NativeMinipoolDetails, state *state.NetworkState, callOpts *bind.CallOpts) (bool, error) {
+func (t *stakePrelaunchMinipools) stakeMinipool(ctx context.Context, mpd *rpstate.NativeMinipoolDetails, state *state.NetworkState, callOpts *bind.CallOpts) (bool, error) {
 
 	// Log
 	t.log.Printlnf("Staking minipool %s...", mpd.MinipoolAddress.Hex())
@@ -243,10 +259,11 @@ func (t *stakePrelaunchMinipools) stakeMinipool(mpd *rpstate.NativeMinipoolDetai
 	}
 
 	// Get transactor
-	opts, err := t.w.GetNodeAccountTransactor()
+	opts, err := t.txMgr.GetTransactor()
 	if err != nil {
 		return false, err
 	}
+	defer t.txMgr.ReleaseTransactor(opts)
 
 	// Get the gas limit
 	signature := rptypes.BytesToValidatorSignature(depositData.Signature)
@@ -264,26 +281,27 @@ func (t *stakePrelaunchMinipools) stakeMinipool(mpd *rpstate.NativeMinipoolDetai
 	// Get the max fee
 	maxFee := t.maxFee
 	if maxFee == nil || maxFee.Uint64() == 0 {
-		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg)
+		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg, t.rp.Client)
 		if err != nil {
 			return false, err
 		}
 	}
 
-	// Print the gas info
-	if !api.PrintAndCheckGasInfo(gasInfo, true, t.gasThreshold, &t.log, maxFee, t.gasLimit) {
-		// Check for the timeout buffer
-		prelaunchTime := time.Unix(mpd.StatusTime.Int64(), 0)
-		isDue, timeUntilDue, err := api.IsTransactionDue(t.rp, prelaunchTime)
-		if err != nil {
-			t.log.Printlnf("Error checking if minipool is due: %s\nStaking now for safety...", err.Error())
-		}
-		if !isDue {
-			t.log.Printlnf("Time until staking will be forced for safety: %s", timeUntilDue)
-			return false, nil
-		}
+	// Get the deadline, after which the minipool has to be staked before it times out
+	prelaunchTime := time.Unix(mpd.StatusTime.Int64(), 0)
+	deadline, err := api.GetTransactionDeadline(t.rp, prelaunchTime)
+	if err != nil {
+		t.log.Printlnf("Error checking if minipool is due: %s\nStaking now for safety...", err.Error())
+		deadline = time.Now()
+	}
 
-		t.log.Println("NOTICE: The minipool has exceeded half of the timeout period, so it will be force-staked at the current gas price.")
+	// Check the gas policy
+	proceed, err := checkGasPolicy(t.cfg, t.txMgr, &t.log, stakePrelaunchMinipoolsDuty, gasInfo, maxFee, t.gasLimit, deadline)
+	if err != nil {
+		return false, err
+	}
+	if !proceed {
+		return false, nil
 	}
 
 	opts.GasFeeCap = maxFee
@@ -301,7 +319,7 @@ func (t *stakePrelaunchMinipools) stakeMinipool(mpd *rpstate.NativeMinipoolDetai
 	}
 
 	// Print TX info and wait for it to be included in a block
-	err = api.PrintAndWaitForTransaction(t.cfg, hash, t.rp.Client, &t.log)
+	err = t.txMgr.TrackAndWaitContext(ctx, t.cfg, stakePrelaunchMinipoolsDuty, opts, hash, &t.log)
 	if err != nil {
 		return false, err
 	}
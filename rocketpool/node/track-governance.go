@@ -0,0 +1,257 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/smartnode/bindings/dao"
+	"github.com/rocket-pool/smartnode/bindings/dao/protocol"
+	"github.com/rocket-pool/smartnode/bindings/dao/security"
+	"github.com/rocket-pool/smartnode/bindings/dao/trustednode"
+	"github.com/rocket-pool/smartnode/bindings/network"
+	"github.com/rocket-pool/smartnode/bindings/rocketpool"
+	"github.com/rocket-pool/smartnode/bindings/types"
+	"github.com/urfave/cli"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/alerting"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/governance"
+	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+// Track governance task
+type trackGovernance struct {
+	c    *cli.Context
+	log  log.ColorLogger
+	cfg  *config.RocketPoolConfig
+	w    wallet.Wallet
+	rp   *rocketpool.RocketPool
+	feed *governance.Feed
+
+	// The tracked proposals from the last run, used by the metrics exporter
+	proposals []governance.TrackedProposal
+	lock      sync.RWMutex
+}
+
+// Create track governance task
+func newTrackGovernance(c *cli.Context, logger log.ColorLogger) (*trackGovernance, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return task
+	return &trackGovernance{
+		c:         c,
+		log:       logger,
+		cfg:       cfg,
+		w:         w,
+		rp:        rp,
+		feed:      governance.NewFeed(cfg.Smartnode.GetGovernanceFeedPath()),
+		proposals: []governance.TrackedProposal{},
+	}, nil
+
+}
+
+// Update the governance feed with the latest state of every DAO's proposals and remind the node operator about the ones they need to vote on
+func (t *trackGovernance) run(_ context.Context, state *state.NetworkState) error {
+
+	// Get node account
+	nodeAccount, err := t.w.GetNodeAccount()
+	if err != nil {
+		return err
+	}
+	if _, exists := state.NodeDetailsByAddress[nodeAccount.Address]; !exists {
+		return nil
+	}
+
+	// Get the current feed
+	snapshot, err := t.feed.Get()
+	if err != nil {
+		return err
+	}
+	opts := &bind.CallOpts{
+		BlockNumber: big.NewInt(0).SetUint64(state.ElBlockNumber),
+	}
+
+	// Get the latest view of the proposals
+	proposals, err := t.getProtocolDaoProposals(state, snapshot, nodeAccount.Address, opts)
+	if err != nil {
+		return fmt.Errorf("error getting Protocol DAO proposals: %w", err)
+	}
+	daoProposals, lastDaoProposalID, err := t.getDaoProposals(snapshot, nodeAccount.Address, opts)
+	if err != nil {
+		return fmt.Errorf("error getting Oracle DAO and security council proposals: %w", err)
+	}
+	proposals = append(proposals, daoProposals...)
+
+	// Update the feed
+	reminderWindow := time.Duration(t.cfg.Alertmanager.GovernanceVoteReminderHours.Value.(uint64)) * time.Hour
+	events, err := t.feed.Update(proposals, lastDaoProposalID, time.Now(), reminderWindow)
+	if err != nil {
+		return err
+	}
+
+	// Log the events and alert on the ones that need attention
+	proposalMap := map[string]governance.Proposal{}
+	for _, proposal := range proposals {
+		proposalMap[proposal.GetKey()] = proposal
+	}
+	for _, event := range events {
+		t.log.Printlnf("%s proposal %d: %s", event.DAO.GetName(), event.ProposalID, event.Details)
+		proposal := proposalMap[governance.Proposal{DAO: event.DAO, ID: event.ProposalID}.GetKey()]
+		switch event.Type {
+		case governance.EventType_VoteNeeded:
+			alerting.AlertGovernanceVoteNeeded(t.cfg, proposal)
+		case governance.EventType_ClosingSoon:
+			alerting.AlertGovernanceVoteClosing(t.cfg, proposal)
+		}
+	}
+
+	// Save the proposals for the metrics exporter
+	snapshot, err = t.feed.Get()
+	if err != nil {
+		return err
+	}
+	t.lock.Lock()
+	t.proposals = snapshot.GetSortedProposals()
+	t.lock.Unlock()
+
+	return nil
+
+}
+
+// Get the Protocol DAO proposals that are new or still open, along with the votes of the node and its delegate
+func (t *trackGovernance) getProtocolDaoProposals(state *state.NetworkState, snapshot *governance.FeedSnapshot, nodeAddress common.Address, opts *bind.CallOpts) ([]governance.Proposal, error) {
+	proposals := []governance.Proposal{}
+	for _, details := range state.ProtocolDaoProposalDetails {
+		isOpen := details.State == types.ProtocolDaoProposalState_Pending ||
+			details.State == types.ProtocolDaoProposalState_ActivePhase1 ||
+			details.State == types.ProtocolDaoProposalState_ActivePhase2
+
+		// Closed proposals only need to be checked once
+		tracked, exists := snapshot.Proposals[governance.Proposal{DAO: governance.DAO_Protocol, ID: details.ID}.GetKey()]
+		if exists && tracked.Phase == governance.Phase_Closed {
+			continue
+		}
+		if !exists && !isOpen {
+			proposals = append(proposals, governance.NewProtocolDaoProposal(details, types.VoteDirection_NoVote, types.VoteDirection_NoVote, false))
+			continue
+		}
+
+		// Get the votes; the delegate is the one the node had at the proposal's target block
+		nodeVote, err := protocol.GetAddressVoteDirection(t.rp, details.ID, nodeAddress, opts)
+		if err != nil {
+			return nil, fmt.Errorf("error getting node vote for proposal %d: %w", details.ID, err)
+		}
+		delegate, err := network.GetVotingDelegate(t.rp, nodeAddress, details.TargetBlock, opts)
+		if err != nil {
+			return nil, fmt.Errorf("error getting voting delegate for proposal %d: %w", details.ID, err)
+		}
+		isOwnDelegate := delegate == nodeAddress
+		delegateVote := nodeVote
+		if !isOwnDelegate {
+			delegateVote, err = protocol.GetAddressVoteDirection(t.rp, details.ID, delegate, opts)
+			if err != nil {
+				return nil, fmt.Errorf("error getting delegate vote for proposal %d: %w", details.ID, err)
+			}
+		}
+		proposals = append(proposals, governance.NewProtocolDaoProposal(details, nodeVote, delegateVote, isOwnDelegate))
+	}
+	return proposals, nil
+}
+
+// Get the Oracle DAO and security council proposals that are new or still open, along with the node's votes.
+// Returns the proposals and the highest proposal ID that was checked.
+func (t *trackGovernance) getDaoProposals(snapshot *governance.FeedSnapshot, nodeAddress common.Address, opts *bind.CallOpts) ([]governance.Proposal, uint64, error) {
+
+	// Get the DAOs of the proposals created since the last run
+	proposalCount, err := dao.GetProposalCount(t.rp, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+	daoTypes := map[uint64]governance.DAO{}
+	for _, proposal := range snapshot.Proposals {
+		if proposal.DAO != governance.DAO_Protocol && proposal.Phase != governance.Phase_Closed {
+			daoTypes[proposal.ID] = proposal.DAO
+		}
+	}
+	for bsi := snapshot.LastDaoProposalID + 1; bsi <= proposalCount; bsi += dao.ProposalDAONamesBatchSize {
+		pei := bsi + dao.ProposalDAONamesBatchSize
+		if pei > proposalCount+1 {
+			pei = proposalCount + 1
+		}
+		daoNames := make([]string, pei-bsi)
+		var wg errgroup.Group
+		for pi := bsi; pi < pei; pi++ {
+			pi := pi
+			wg.Go(func() error {
+				daoName, err := dao.GetProposalDAO(t.rp, pi, opts)
+				if err == nil {
+					daoNames[pi-bsi] = daoName
+				}
+				return err
+			})
+		}
+		if err := wg.Wait(); err != nil {
+			return nil, 0, err
+		}
+		for i, daoName := range daoNames {
+			if daoType, exists := governance.GetDAOForContractName(daoName); exists {
+				daoTypes[bsi+uint64(i)] = daoType
+			}
+		}
+	}
+	if len(daoTypes) == 0 {
+		return []governance.Proposal{}, proposalCount, nil
+	}
+
+	// Check which DAOs the node can vote in
+	isOdaoMember, err := trustednode.GetMemberExists(t.rp, nodeAddress, opts)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error checking Oracle DAO membership: %w", err)
+	}
+	isSecurityMember, err := security.GetMemberExists(t.rp, nodeAddress, opts)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error checking security council membership: %w", err)
+	}
+
+	// Get the proposal details
+	proposals := []governance.Proposal{}
+	for id, daoType := range daoTypes {
+		details, err := dao.GetProposalDetailsWithMember(t.rp, id, nodeAddress, opts)
+		if err != nil {
+			return nil, 0, fmt.Errorf("error getting proposal %d details: %w", id, err)
+		}
+		isMember := (daoType == governance.DAO_OracleDAO && isOdaoMember) || (daoType == governance.DAO_SecurityCouncil && isSecurityMember)
+		proposals = append(proposals, governance.NewDaoProposal(daoType, details, isMember))
+	}
+	return proposals, proposalCount, nil
+
+}
+
+// Get the tracked proposals from the last run
+func (t *trackGovernance) GetGovernanceProposals() []governance.TrackedProposal {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.proposals
+}
@@ -0,0 +1,146 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"os"
+	"time"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/bindings/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	rprewards "github.com/rocket-pool/smartnode/shared/services/rewards"
+	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+// Update rewards checkpoint task
+type updateRewardsCheckpoint struct {
+	c         *cli.Context
+	log       log.ColorLogger
+	cfg       *config.RocketPoolConfig
+	rp        *rocketpool.RocketPool
+	ec        rocketpool.ExecutionClient
+	bc        beacon.Client
+	m         *state.NetworkStateManager
+	snapshots *state.SnapshotStore
+}
+
+// Create update rewards checkpoint task
+func newUpdateRewardsCheckpoint(c *cli.Context, logger log.ColorLogger) (*updateRewardsCheckpoint, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+	snapshots, err := services.GetSnapshotStore(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// The task gets its own state manager, since it builds full network states alongside the daemon's node states
+	m := state.NewNetworkStateManager(rp, cfg.Smartnode.GetStateManagerContracts(), bc, &logger)
+
+	// Return task
+	return &updateRewardsCheckpoint{
+		c:         c,
+		log:       logger,
+		cfg:       cfg,
+		rp:        rp,
+		ec:        ec,
+		bc:        bc,
+		m:         m,
+		snapshots: snapshots,
+	}, nil
+
+}
+
+// Process the epochs of the current rewards interval that have been finalized since the last checkpoint
+func (t *updateRewardsCheckpoint) run(ctx context.Context, _ *state.NetworkState) error {
+
+	// Get the latest finalized slot
+	block, err := t.m.GetLatestFinalizedBeaconBlock()
+	if err != nil {
+		return fmt.Errorf("error getting latest finalized block: %w", err)
+	}
+
+	// Use the saved snapshot of the network state if there is one, since building it is slow
+	networkState, exists, err := t.snapshots.Load(block.Slot)
+	if err == nil && exists {
+		err = state.ValidateSnapshot(networkState, block)
+	}
+	if err != nil {
+		t.log.Printlnf("WARNING: couldn't use the network state snapshot for slot %d, rebuilding it: %s", block.Slot, err.Error())
+	}
+	if err != nil || !exists {
+		networkState, err = t.m.GetStateForSlot(block.Slot)
+		if err != nil {
+			return fmt.Errorf("error getting network state for slot %d: %w", block.Slot, err)
+		}
+	}
+
+	// Leave the end of the interval to the tree generator
+	index := networkState.NetworkDetails.RewardIndex
+	startTime := networkState.NetworkDetails.IntervalStart
+	endTime := startTime.Add(networkState.NetworkDetails.IntervalDuration)
+	if index == 0 || !networkState.BeaconConfig.GetSlotTime(block.Slot).Before(endTime) {
+		return nil
+	}
+
+	// Get the EL header for the snapshot
+	header, err := t.ec.HeaderByNumber(context.Background(), big.NewInt(0).SetUint64(block.ExecutionBlockNumber))
+	if err != nil {
+		return fmt.Errorf("error getting EL header for block %d: %w", block.ExecutionBlockNumber, err)
+	}
+	snapshotEnd := &rprewards.SnapshotEnd{
+		Slot:           block.Slot,
+		ConsensusBlock: block.Slot,
+		ExecutionBlock: block.ExecutionBlockNumber,
+	}
+
+	// Keep every Beacon call in this run on the same endpoint
+	bc := services.PinBeaconClient(ctx, t.bc)
+
+	// Process the new epochs
+	t.log.Printlnf("Updating the rewards checkpoint for interval %d up to slot %d...", index, block.Slot)
+	start := time.Now()
+	generationPrefix := fmt.Sprintf("[Interval %d Checkpoint]", index)
+	treegen, err := rprewards.NewTreeGenerator(&t.log, generationPrefix, rprewards.NewRewardsExecutionClient(t.rp), t.cfg, bc, index, startTime, endTime, snapshotEnd, header, 1, networkState)
+	if err != nil {
+		return fmt.Errorf("error creating Merkle tree generator: %w", err)
+	}
+	checkpointInterval := t.cfg.Smartnode.RewardsTreeCheckpointInterval.Value.(uint64)
+	treegen.EnableCheckpoints(t.cfg.Smartnode.GetRewardsCheckpointPath(index, true), checkpointInterval)
+	err = treegen.UpdateCheckpoint()
+	if err != nil {
+		return fmt.Errorf("error updating rewards checkpoint for interval %d: %w", index, err)
+	}
+	t.log.Printlnf("Updated the rewards checkpoint for interval %d (%s).", index, time.Since(start).Round(time.Second))
+
+	// Remove the checkpoint of the previous interval if the tree generator didn't
+	previousPath := t.cfg.Smartnode.GetRewardsCheckpointPath(index-1, true)
+	err = os.Remove(previousPath)
+	if err != nil && !os.IsNotExist(err) {
+		t.log.Printlnf("WARNING: couldn't remove old rewards checkpoint [%s]: %s", previousPath, err.Error())
+	}
+
+	return nil
+
+}
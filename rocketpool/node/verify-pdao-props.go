@@ -1,6 +1,7 @@
 package node
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"time"
@@ -17,12 +18,21 @@ import (
 	rpgas "github.com/rocket-pool/smartnode/shared/services/gas"
 	"github.com/rocket-pool/smartnode/shared/services/proposals"
 	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/services/store"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
 	"github.com/rocket-pool/smartnode/shared/services/wallet"
 	"github.com/rocket-pool/smartnode/shared/utils/api"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
 	"github.com/urfave/cli"
 )
 
+// The names of the duties in the daemon state store
+const (
+	verifyPdaoPropsDuty    string = "verify-pdao-props"
+	challengePdaoPropsDuty string = "challenge-pdao-props"
+	defeatPdaoPropsDuty    string = "defeat-pdao-props"
+)
+
 type challenge struct {
 	proposalID      uint64
 	challengedIndex uint64
@@ -40,8 +50,10 @@ type verifyPdaoProps struct {
 	log                 *log.ColorLogger
 	cfg                 *config.RocketPoolConfig
 	w                   wallet.Wallet
+	txMgr               *txmanager.TransactionManager
 	rp                  *rocketpool.RocketPool
 	bc                  beacon.Client
+	store               *store.DutyStore
 	gasThreshold        float64
 	maxFee              *big.Int
 	maxPriorityFee      *big.Int
@@ -70,10 +82,18 @@ func newVerifyPdaoProps(c *cli.Context, logger log.ColorLogger) (*verifyPdaoProp
 	if err != nil {
 		return nil, err
 	}
+	txMgr, err := services.GetTransactionManager(c)
+	if err != nil {
+		return nil, err
+	}
 	bc, err := services.GetBeaconClient(c)
 	if err != nil {
 		return nil, err
 	}
+	dutyStore, err := services.GetDutyStore(c)
+	if err != nil {
+		return nil, err
+	}
 
 	gasThreshold := cfg.Smartnode.AutoTxGasThreshold.Value.(float64)
 
@@ -114,8 +134,10 @@ func newVerifyPdaoProps(c *cli.Context, logger log.ColorLogger) (*verifyPdaoProp
 		log:                 &logger,
 		cfg:                 cfg,
 		w:                   w,
+		txMgr:               txMgr,
 		rp:                  rp,
 		bc:                  bc,
+		store:               dutyStore,
 		gasThreshold:        gasThreshold,
 		maxFee:              maxFee,
 		maxPriorityFee:      priorityFee,
@@ -131,7 +153,7 @@ func newVerifyPdaoProps(c *cli.Context, logger log.ColorLogger) (*verifyPdaoProp
 }
 
 // Verify pDAO proposals
-func (t *verifyPdaoProps) run(state *state.NetworkState) error {
+func (t *verifyPdaoProps) run(ctx context.Context, state *state.NetworkState) error {
 	// Log
 	t.log.Println("Checking for Protocol DAO proposals to challenge...")
 
@@ -140,15 +162,22 @@ func (t *verifyPdaoProps) run(state *state.NetworkState) error {
 		BlockNumber: big.NewInt(0).SetUint64(state.ElBlockNumber),
 	}
 
+	// Keep every Beacon call in this run on the same endpoint
+	bc := services.PinBeaconClient(ctx, t.bc)
+
 	// Get any challenges that need to be submitted
-	challenges, defeats, err := t.getChallengesandDefeats(state, opts)
+	challenges, defeats, err := t.getChallengesandDefeats(bc, state, opts)
 	if err != nil {
 		return fmt.Errorf("error checking for challenges or defeats: %w", err)
 	}
 
 	// Submit challenges
 	for _, challenge := range challenges {
-		err := t.submitChallenge(challenge)
+		dutyKey := fmt.Sprintf("%d-%d", challenge.proposalID, challenge.challengedIndex)
+		if t.isDutyPending(challengePdaoPropsDuty, dutyKey) {
+			continue
+		}
+		err := t.submitChallenge(ctx, challenge, dutyKey)
 		if err != nil {
 			return fmt.Errorf("error submitting challenge against proposal %d, index %d: %w", challenge.proposalID, challenge.challengedIndex, err)
 		}
@@ -156,7 +185,11 @@ func (t *verifyPdaoProps) run(state *state.NetworkState) error {
 
 	// Submit defeats
 	for _, defeat := range defeats {
-		err := t.submitDefeat(defeat)
+		dutyKey := fmt.Sprintf("%d-%d", defeat.proposalID, defeat.challengedIndex)
+		if t.isDutyPending(defeatPdaoPropsDuty, dutyKey) {
+			continue
+		}
+		err := t.submitDefeat(ctx, defeat, dutyKey)
 		if err != nil {
 			return fmt.Errorf("error submitting defeat of proposal %d, index %d: %w", defeat.proposalID, defeat.challengedIndex, err)
 		}
@@ -166,7 +199,7 @@ func (t *verifyPdaoProps) run(state *state.NetworkState) error {
 	return nil
 }
 
-func (t *verifyPdaoProps) getChallengesandDefeats(state *state.NetworkState, opts *bind.CallOpts) ([]challenge, []defeat, error) {
+func (t *verifyPdaoProps) getChallengesandDefeats(bc beacon.Client, state *state.NetworkState, opts *bind.CallOpts) ([]challenge, []defeat, error) {
 	// Get proposals *not* made by this node that are still in the challenge phase (Pending)
 	eligibleProps := []protocol.ProtocolDaoProposalDetails{}
 	for _, prop := range state.ProtocolDaoProposalDetails {
@@ -190,6 +223,14 @@ func (t *verifyPdaoProps) getChallengesandDefeats(state *state.NetworkState, opt
 			// Ignore proposals that have already been cleared
 			continue
 		}
+		record, err := t.store.Get(verifyPdaoPropsDuty, fmt.Sprint(prop.ID))
+		if err != nil {
+			t.log.Printlnf("WARNING: couldn't check if proposal %d was verified previously: %s", prop.ID, err.Error())
+		} else if record != nil && record.Outcome == store.Outcome_Succeeded {
+			// Ignore proposals that were cleared before the daemon restarted
+			t.validPropCache[prop.ID] = true
+			continue
+		}
 
 		// Get the proposal's network tree root
 		propRoot, err := protocol.GetNode(t.rp, prop.ID, 1, opts)
@@ -208,6 +249,7 @@ func (t *verifyPdaoProps) getChallengesandDefeats(state *state.NetworkState, opt
 		if propRoot.Sum.Cmp(localRoot.Sum) == 0 && propRoot.Hash == localRoot.Hash {
 			t.log.Printlnf("Proposal %d matches the local tree artifacts, so it does not need to be challenged.", prop.ID)
 			t.validPropCache[prop.ID] = true
+			t.store.TryRecordOutcome(t.log, verifyPdaoPropsDuty, fmt.Sprint(prop.ID), nil)
 			continue
 		}
 
@@ -230,7 +272,7 @@ func (t *verifyPdaoProps) getChallengesandDefeats(state *state.NetworkState, opt
 		startSlot := uint64(startTime.Sub(genesisTime) / secondsPerSlot)
 
 		// Get the Beacon block for the slot
-		block, exists, err := t.bc.GetBeaconBlock(fmt.Sprint(startSlot))
+		block, exists, err := bc.GetBeaconBlock(fmt.Sprint(startSlot))
 		if err != nil {
 			return nil, nil, fmt.Errorf("error getting Beacon block at slot %d: %w", startSlot, err)
 		}
@@ -350,16 +392,17 @@ func (t *verifyPdaoProps) getChallengeOrDefeatForProposal(prop protocol.Protocol
 }
 
 // Submit a challenge against a proposal
-func (t *verifyPdaoProps) submitChallenge(challenge challenge) error {
+func (t *verifyPdaoProps) submitChallenge(ctx context.Context, challenge challenge, dutyKey string) error {
 	propID := challenge.proposalID
 	challengedIndex := challenge.challengedIndex
 	t.log.Printlnf("Submitting challenge against proposal %d, index %d...", propID, challengedIndex)
 
 	// Get transactor
-	opts, err := t.w.GetNodeAccountTransactor()
+	opts, err := t.txMgr.GetTransactor()
 	if err != nil {
 		return err
 	}
+	defer t.txMgr.ReleaseTransactor(opts)
 
 	// Get the gas limit
 	gasInfo, err := protocol.EstimateCreateChallengeGas(t.rp, propID, challengedIndex, challenge.challengedNode, challenge.witness, opts)
@@ -371,7 +414,7 @@ func (t *verifyPdaoProps) submitChallenge(challenge challenge) error {
 	// Get the max fee
 	maxFee := t.maxFee
 	if maxFee == nil || maxFee.Uint64() == 0 {
-		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg)
+		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg, t.rp.Client)
 		if err != nil {
 			return err
 		}
@@ -391,9 +434,11 @@ func (t *verifyPdaoProps) submitChallenge(challenge challenge) error {
 	if err != nil {
 		return err
 	}
+	t.store.TryRecordSubmission(t.log, challengePdaoPropsDuty, dutyKey, hash)
 
 	// Print TX info and wait for it to be included in a block
-	err = api.PrintAndWaitForTransaction(t.cfg, hash, t.rp.Client, t.log)
+	err = t.txMgr.TrackAndWaitContext(ctx, t.cfg, "verify-pdao-props", opts, hash, t.log)
+	t.store.TryRecordOutcome(t.log, challengePdaoPropsDuty, dutyKey, err)
 	if err != nil {
 		return err
 	}
@@ -406,16 +451,17 @@ func (t *verifyPdaoProps) submitChallenge(challenge challenge) error {
 }
 
 // Defeat a proposal
-func (t *verifyPdaoProps) submitDefeat(defeat defeat) error {
+func (t *verifyPdaoProps) submitDefeat(ctx context.Context, defeat defeat, dutyKey string) error {
 	propID := defeat.proposalID
 	challengedIndex := defeat.challengedIndex
 	t.log.Printlnf("Proposal %d has been defeated with node index %d, submitting defeat...", propID, challengedIndex)
 
 	// Get transactor
-	opts, err := t.w.GetNodeAccountTransactor()
+	opts, err := t.txMgr.GetTransactor()
 	if err != nil {
 		return err
 	}
+	defer t.txMgr.ReleaseTransactor(opts)
 
 	// Get the gas limit
 	gasInfo, err := protocol.EstimateDefeatProposalGas(t.rp, propID, challengedIndex, opts)
@@ -427,7 +473,7 @@ func (t *verifyPdaoProps) submitDefeat(defeat defeat) error {
 	// Get the max fee
 	maxFee := t.maxFee
 	if maxFee == nil || maxFee.Uint64() == 0 {
-		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg)
+		maxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg, t.rp.Client)
 		if err != nil {
 			return err
 		}
@@ -447,9 +493,11 @@ func (t *verifyPdaoProps) submitDefeat(defeat defeat) error {
 	if err != nil {
 		return err
 	}
+	t.store.TryRecordSubmission(t.log, defeatPdaoPropsDuty, dutyKey, hash)
 
 	// Print TX info and wait for it to be included in a block
-	err = api.PrintAndWaitForTransaction(t.cfg, hash, t.rp.Client, t.log)
+	err = t.txMgr.TrackAndWaitContext(ctx, t.cfg, "verify-pdao-props", opts, hash, t.log)
+	t.store.TryRecordOutcome(t.log, defeatPdaoPropsDuty, dutyKey, err)
 	if err != nil {
 		return err
 	}
@@ -460,3 +508,16 @@ func (t *verifyPdaoProps) submitDefeat(defeat defeat) error {
 	// Return
 	return nil
 }
+
+// Check if a transaction for a duty from a previous run is still in flight
+func (t *verifyPdaoProps) isDutyPending(duty string, key string) bool {
+	pending, err := t.store.IsPending(t.rp.Client, duty, key)
+	if err != nil {
+		t.log.Printlnf("WARNING: couldn't check previous attempts of %s [%s]: %s", duty, key, err.Error())
+		return false
+	}
+	if pending {
+		t.log.Printlnf("%s [%s] already has a pending transaction, skipping it.", duty, key)
+	}
+	return pending
+}
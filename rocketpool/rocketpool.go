@@ -7,6 +7,7 @@ import (
 	"github.com/urfave/cli"
 
 	"github.com/rocket-pool/smartnode/rocketpool/api"
+	"github.com/rocket-pool/smartnode/rocketpool/apiserver"
 	"github.com/rocket-pool/smartnode/rocketpool/node"
 	"github.com/rocket-pool/smartnode/rocketpool/watchtower"
 	"github.com/rocket-pool/smartnode/shared"
@@ -76,10 +77,16 @@ func main() {
 			Name:  "use-protected-api",
 			Usage: "Set this to true to use the Flashbots Protect RPC instead of your local Execution Client. Useful to ensure your transactions aren't front-run.",
 		},
+		cli.BoolFlag{
+			Name:   "unsigned",
+			Usage:  "Set this to true to return the node account's transactions unsigned for offline signing, instead of signing and submitting them",
+			Hidden: true,
+		},
 	}
 
 	// Register commands
 	api.RegisterCommands(app, "api", []string{"a"})
+	apiserver.RegisterCommands(app, "api-server", []string{})
 	node.RegisterCommands(app, "node", []string{"n"})
 	watchtower.RegisterCommands(app, "watchtower", []string{"w"})
 
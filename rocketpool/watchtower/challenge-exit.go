@@ -2,6 +2,7 @@ package watchtower
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/rocket-pool/smartnode/bindings/megapool"
@@ -9,6 +10,7 @@ import (
 	"github.com/rocket-pool/smartnode/bindings/settings/protocol"
 	"github.com/rocket-pool/smartnode/bindings/types"
 	"github.com/rocket-pool/smartnode/bindings/utils/eth"
+	"github.com/rocket-pool/smartnode/rocketpool/watchtower/shadow"
 	"github.com/rocket-pool/smartnode/rocketpool/watchtower/utils"
 	"github.com/rocket-pool/smartnode/shared/services"
 	"github.com/rocket-pool/smartnode/shared/services/config"
@@ -20,16 +22,25 @@ import (
 )
 
 type challengeValidatorsExiting struct {
-	c   *cli.Context
-	log log.ColorLogger
-	cfg *config.RocketPoolConfig
-	w   wallet.Wallet
-	ec  rocketpool.ExecutionClient
-	rp  *rocketpool.RocketPool
-	bc  *services.BeaconClientManager
+	c      *cli.Context
+	log    log.ColorLogger
+	cfg    *config.RocketPoolConfig
+	w      wallet.Wallet
+	ec     rocketpool.ExecutionClient
+	rp     *rocketpool.RocketPool
+	bc     *services.BeaconClientManager
+	shadow *shadow.Store
 }
 
-func newChallengeValidatorsExiting(c *cli.Context, logger log.ColorLogger) (*challengeValidatorsExiting, error) {
+// The exit challenge that would have been submitted for a validator in shadow mode
+type shadowExitChallenge struct {
+	Megapool          common.Address `json:"megapool"`
+	ValidatorId       uint32         `json:"validatorId"`
+	ValidatorIndex    uint64         `json:"validatorIndex"`
+	WithdrawableEpoch uint64         `json:"withdrawableEpoch"`
+}
+
+func newChallengeValidatorsExiting(c *cli.Context, logger log.ColorLogger, shadowStore *shadow.Store) (*challengeValidatorsExiting, error) {
 	// Get services
 	cfg, err := services.GetConfig(c)
 	if err != nil {
@@ -55,13 +66,14 @@ func newChallengeValidatorsExiting(c *cli.Context, logger log.ColorLogger) (*cha
 
 	// Return task
 	return &challengeValidatorsExiting{
-		c:   c,
-		log: logger,
-		cfg: cfg,
-		w:   w,
-		ec:  ec,
-		rp:  rp,
-		bc:  bc,
+		c:      c,
+		log:    logger,
+		cfg:    cfg,
+		w:      w,
+		ec:     ec,
+		rp:     rp,
+		bc:     bc,
+		shadow: shadowStore,
 	}, nil
 }
 
@@ -75,6 +87,17 @@ func (t *challengeValidatorsExiting) run(state *state.NetworkState) error {
 	if err := services.WaitEthClientSynced(t.c, true); err != nil {
 		return err
 	}
+	// Compare earlier shadow challenges with what happened to the validators since
+	if t.shadow != nil {
+		err := compareShadowReports(&t.log, t.shadow, shadow.Duty_ChallengeExits, func(report shadow.Report) (shadow.Comparison, error) {
+			return compareShadowExitChallenge(report, state)
+		})
+		if err != nil {
+			// Error is not fatal for this task so print and continue
+			t.log.Printlnf("%s %s", shadowLogPrefix, err.Error())
+		}
+	}
+
 	// Log
 	t.log.Println("Challenging validators exiting without a notification...")
 
@@ -104,6 +127,7 @@ func (t *challengeValidatorsExiting) challengeValidatorsExiting(state *state.Net
 	notifyThresholdInEpochs := notifyThresholdInSeconds / (state.BeaconConfig.SlotsPerEpoch * state.BeaconConfig.SecondsPerSlot)
 
 	challengeMegapoolAddressToIds := make(map[common.Address][]uint32)
+	shadowChallenges := []shadowExitChallenge{}
 	batched := 0
 	for _, validator := range state.MegapoolValidatorGlobalIndex {
 		if batched >= batchSize {
@@ -117,10 +141,26 @@ func (t *challengeValidatorsExiting) challengeValidatorsExiting(state *state.Net
 				t.log.Printlnf("Validator %d has an withdrawable epoch %d which is past the notify threshold... Challenging", validator.ValidatorInfo.ValidatorIndex, validatorFromState.WithdrawableEpoch)
 				batched++
 				challengeMegapoolAddressToIds[validator.MegapoolAddress] = append(challengeMegapoolAddressToIds[validator.MegapoolAddress], validator.ValidatorId)
+				shadowChallenges = append(shadowChallenges, shadowExitChallenge{
+					Megapool:          validator.MegapoolAddress,
+					ValidatorId:       validator.ValidatorId,
+					ValidatorIndex:    validator.ValidatorInfo.ValidatorIndex,
+					WithdrawableEpoch: validatorFromState.WithdrawableEpoch,
+				})
 			}
 
 		}
 	}
+	if batched > 0 && t.shadow != nil {
+		// In shadow mode, save the challenges instead of submitting them
+		for _, challenge := range shadowChallenges {
+			err := saveShadowReport(&t.log, t.shadow, shadow.Duty_ChallengeExits, getExitChallengeShadowTarget(challenge.Megapool, challenge.ValidatorId), challenge)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
 	if batched > 0 {
 		t.log.Printlnf("Challenging %d validators exiting without a notification...", batched)
 
@@ -179,3 +219,38 @@ func (t *challengeValidatorsExiting) challengeValidatorsExiting(state *state.Net
 
 	return nil
 }
+
+// Get the shadow report target for a megapool validator
+func getExitChallengeShadowTarget(megapoolAddress common.Address, validatorId uint32) string {
+	return fmt.Sprintf("%s/%d", megapoolAddress.Hex(), validatorId)
+}
+
+// Compare a shadow exit challenge with what happened to the validator.
+// Challenges aren't tracked per member, so this checks whether the validator was locked instead.
+func compareShadowExitChallenge(report shadow.Report, state *state.NetworkState) (shadow.Comparison, error) {
+	var payload shadowExitChallenge
+	if err := report.GetPayload(&payload); err != nil {
+		return shadow.Comparison{}, err
+	}
+
+	comparison := shadow.Comparison{
+		Time:    time.Now(),
+		Outcome: "the validator has not been challenged yet",
+	}
+	for _, validator := range state.MegapoolValidatorGlobalIndex {
+		if validator.MegapoolAddress != payload.Megapool || validator.ValidatorId != payload.ValidatorId {
+			continue
+		}
+		switch {
+		case validator.ValidatorInfo.Locked:
+			comparison.Final = true
+			comparison.Agreed = true
+			comparison.Outcome = "the validator was challenged and locked"
+		case validator.ValidatorInfo.Exiting || validator.ValidatorInfo.Exited:
+			comparison.Final = true
+			comparison.Outcome = "the validator was not challenged and its exit was notified"
+		}
+		break
+	}
+	return comparison, nil
+}
@@ -0,0 +1,99 @@
+package collectors
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// The latest state of a single L2 price messenger
+type PriceMessengerStatus struct {
+	// True if the messenger reported a stale rate the last time it was checked
+	RateStale bool
+
+	// The rETH rate this node last submitted to the messenger, and the block it was included in
+	LastSubmittedRate  float64
+	LastSubmittedBlock uint64
+}
+
+// Represents the collector for the L2 price messenger metrics
+type PriceMessengerCollector struct {
+
+	// Whether or not each messenger's rate is stale
+	rateStaleDesc *prometheus.Desc
+
+	// The rETH rate last submitted to each messenger
+	lastSubmittedRateDesc *prometheus.Desc
+
+	// The block of the last submission to each messenger
+	lastSubmittedBlockDesc *prometheus.Desc
+
+	// The status of each messenger, keyed by chain name
+	Messengers map[string]*PriceMessengerStatus
+
+	// Mutex
+	UpdateLock *sync.Mutex
+}
+
+// Create a new PriceMessengerCollector instance
+func NewPriceMessengerCollector() *PriceMessengerCollector {
+	subsystem := "price_messenger"
+	return &PriceMessengerCollector{
+		rateStaleDesc: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "rate_stale"),
+			"Whether or not the messenger's L2 rate is stale (1 if stale, 0 if not)",
+			[]string{"chain"}, nil,
+		),
+		lastSubmittedRateDesc: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "last_submitted_rate"),
+			"The rETH rate this node last submitted to the messenger",
+			[]string{"chain"}, nil,
+		),
+		lastSubmittedBlockDesc: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "last_submitted_block"),
+			"The block that this node's last submission to the messenger was included in",
+			[]string{"chain"}, nil,
+		),
+		Messengers: map[string]*PriceMessengerStatus{},
+		UpdateLock: &sync.Mutex{},
+	}
+}
+
+// Get the status of a messenger, creating it if it doesn't exist yet. The caller must hold UpdateLock.
+func (collector *PriceMessengerCollector) GetStatus(chain string) *PriceMessengerStatus {
+	status, exists := collector.Messengers[chain]
+	if !exists {
+		status = &PriceMessengerStatus{}
+		collector.Messengers[chain] = status
+	}
+	return status
+}
+
+// Write metric descriptions to the Prometheus channel
+func (collector *PriceMessengerCollector) Describe(channel chan<- *prometheus.Desc) {
+	channel <- collector.rateStaleDesc
+	channel <- collector.lastSubmittedRateDesc
+	channel <- collector.lastSubmittedBlockDesc
+}
+
+// Collect the latest metric values and pass them to Prometheus
+func (collector *PriceMessengerCollector) Collect(channel chan<- prometheus.Metric) {
+
+	// Sync
+	collector.UpdateLock.Lock()
+	defer collector.UpdateLock.Unlock()
+
+	// Update all of the metrics
+	for chain, status := range collector.Messengers {
+		rateStale := float64(0)
+		if status.RateStale {
+			rateStale = 1
+		}
+		channel <- prometheus.MustNewConstMetric(
+			collector.rateStaleDesc, prometheus.GaugeValue, rateStale, chain)
+		if status.LastSubmittedBlock == 0 {
+			continue
+		}
+		channel <- prometheus.MustNewConstMetric(
+			collector.lastSubmittedRateDesc, prometheus.GaugeValue, status.LastSubmittedRate, chain)
+		channel <- prometheus.MustNewConstMetric(
+			collector.lastSubmittedBlockDesc, prometheus.GaugeValue, float64(status.LastSubmittedBlock), chain)
+	}
+}
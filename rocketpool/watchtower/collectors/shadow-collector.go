@@ -0,0 +1,109 @@
+package collectors
+
+import (
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/rocket-pool/smartnode/rocketpool/watchtower/shadow"
+)
+
+// Represents the collector for the watchtower's shadow mode metrics
+type ShadowCollector struct {
+
+	// The number of shadow payloads that agreed with the Oracle DAO
+	agreedDesc *prometheus.Desc
+
+	// The number of shadow payloads that disagreed with the Oracle DAO
+	disagreedDesc *prometheus.Desc
+
+	// The number of shadow payloads that are still waiting for the Oracle DAO
+	pendingDesc *prometheus.Desc
+
+	// The number of Oracle DAO members that agreed with the latest shadow payload
+	latestAgreeingDesc *prometheus.Desc
+
+	// The number of Oracle DAO members that disagreed with the latest shadow payload
+	latestDisagreeingDesc *prometheus.Desc
+
+	// Whether or not the latest shadow payload agreed with the Oracle DAO
+	latestAgreedDesc *prometheus.Desc
+
+	// The store the shadow payloads are saved in
+	store *shadow.Store
+}
+
+// Create a new ShadowCollector instance
+func NewShadowCollector(store *shadow.Store) *ShadowCollector {
+	subsystem := "shadow"
+	return &ShadowCollector{
+		agreedDesc: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "agreed"),
+			"The number of recent shadow payloads that agreed with the Oracle DAO",
+			[]string{"duty"}, nil,
+		),
+		disagreedDesc: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "disagreed"),
+			"The number of recent shadow payloads that disagreed with the Oracle DAO",
+			[]string{"duty"}, nil,
+		),
+		pendingDesc: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "pending"),
+			"The number of recent shadow payloads that are still waiting for the Oracle DAO",
+			[]string{"duty"}, nil,
+		),
+		latestAgreeingDesc: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "latest_agreeing_members"),
+			"The number of Oracle DAO members that submitted the same payload as the latest shadow payload",
+			[]string{"duty"}, nil,
+		),
+		latestDisagreeingDesc: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "latest_disagreeing_members"),
+			"The number of Oracle DAO members that submitted a different payload than the latest shadow payload",
+			[]string{"duty"}, nil,
+		),
+		latestAgreedDesc: prometheus.NewDesc(prometheus.BuildFQName(namespace, subsystem, "latest_agreed"),
+			"Whether or not the latest shadow payload agrees with the Oracle DAO so far (1 if it agrees, 0 if not)",
+			[]string{"duty"}, nil,
+		),
+		store: store,
+	}
+}
+
+// Write metric descriptions to the Prometheus channel
+func (collector *ShadowCollector) Describe(channel chan<- *prometheus.Desc) {
+	channel <- collector.agreedDesc
+	channel <- collector.disagreedDesc
+	channel <- collector.pendingDesc
+	channel <- collector.latestAgreeingDesc
+	channel <- collector.latestDisagreeingDesc
+	channel <- collector.latestAgreedDesc
+}
+
+// Collect the latest metric values and pass them to Prometheus
+func (collector *ShadowCollector) Collect(channel chan<- prometheus.Metric) {
+	summaries, err := collector.store.GetSummaries()
+	if err != nil {
+		fmt.Printf("Shadow Collector error getting shadow reports: %s\n", err.Error())
+		return
+	}
+
+	for _, summary := range summaries {
+		channel <- prometheus.MustNewConstMetric(
+			collector.agreedDesc, prometheus.GaugeValue, float64(summary.Agreed), summary.Duty)
+		channel <- prometheus.MustNewConstMetric(
+			collector.disagreedDesc, prometheus.GaugeValue, float64(summary.Disagreed), summary.Duty)
+		channel <- prometheus.MustNewConstMetric(
+			collector.pendingDesc, prometheus.GaugeValue, float64(summary.Pending), summary.Duty)
+
+		if summary.Latest == nil || summary.Latest.Comparison == nil {
+			continue
+		}
+		comparison := summary.Latest.Comparison
+		latestAgreed := float64(0)
+		if comparison.Agreed {
+			latestAgreed = 1
+		}
+		channel <- prometheus.MustNewConstMetric(
+			collector.latestAgreeingDesc, prometheus.GaugeValue, float64(len(comparison.Agreeing)), summary.Duty)
+		channel <- prometheus.MustNewConstMetric(
+			collector.latestDisagreeingDesc, prometheus.GaugeValue, float64(len(comparison.Disagreeing)), summary.Duty)
+		channel <- prometheus.MustNewConstMetric(
+			collector.latestAgreedDesc, prometheus.GaugeValue, latestAgreed, summary.Duty)
+	}
+}
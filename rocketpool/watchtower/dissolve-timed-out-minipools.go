@@ -16,6 +16,7 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services"
 	"github.com/rocket-pool/smartnode/shared/services/config"
 	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/services/store"
 	"github.com/rocket-pool/smartnode/shared/services/wallet"
 	"github.com/rocket-pool/smartnode/shared/utils/api"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
@@ -24,14 +25,18 @@ import (
 // Settings
 const MinipoolStatusBatchSize = 20
 
+// The name of the duty in the daemon state store
+const dissolveTimedOutMinipoolsDuty string = "dissolve-timed-out-minipools"
+
 // Dissolve timed out minipools task
 type dissolveTimedOutMinipools struct {
-	c   *cli.Context
-	log log.ColorLogger
-	cfg *config.RocketPoolConfig
-	w   wallet.Wallet
-	ec  rocketpool.ExecutionClient
-	rp  *rocketpool.RocketPool
+	c     *cli.Context
+	log   log.ColorLogger
+	cfg   *config.RocketPoolConfig
+	w     wallet.Wallet
+	ec    rocketpool.ExecutionClient
+	rp    *rocketpool.RocketPool
+	store *store.DutyStore
 }
 
 // Create dissolve timed out minipools task
@@ -54,15 +59,20 @@ func newDissolveTimedOutMinipools(c *cli.Context, logger log.ColorLogger) (*diss
 	if err != nil {
 		return nil, err
 	}
+	dutyStore, err := services.GetDutyStore(c)
+	if err != nil {
+		return nil, err
+	}
 
 	// Return task
 	return &dissolveTimedOutMinipools{
-		c:   c,
-		log: logger,
-		cfg: cfg,
-		w:   w,
-		ec:  ec,
-		rp:  rp,
+		c:     c,
+		log:   logger,
+		cfg:   cfg,
+		w:     w,
+		ec:    ec,
+		rp:    rp,
+		store: dutyStore,
 	}, nil
 
 }
@@ -91,6 +101,15 @@ func (t *dissolveTimedOutMinipools) run(state *state.NetworkState) error {
 
 	// Dissolve minipools
 	for _, mp := range minipools {
+		// Skip minipools that still have a dissolve in flight from a previous run
+		pending, err := t.store.IsPending(t.rp.Client, dissolveTimedOutMinipoolsDuty, mp.GetAddress().Hex())
+		if err != nil {
+			t.log.Printlnf("WARNING: couldn't check previous dissolves of minipool %s: %s", mp.GetAddress().Hex(), err.Error())
+		} else if pending {
+			t.log.Printlnf("Minipool %s already has a pending dissolve, skipping it.", mp.GetAddress().Hex())
+			continue
+		}
+
 		if err := t.dissolveMinipool(mp); err != nil {
 			t.log.Println(fmt.Errorf("Could not dissolve minipool %s: %w", mp.GetAddress().Hex(), err))
 		}
@@ -166,9 +185,11 @@ func (t *dissolveTimedOutMinipools) dissolveMinipool(mp minipool.Minipool) error
 	if err != nil {
 		return err
 	}
+	t.store.TryRecordSubmission(&t.log, dissolveTimedOutMinipoolsDuty, mp.GetAddress().Hex(), hash)
 
 	// Print TX info and wait for it to be included in a block
 	err = api.PrintAndWaitForTransaction(t.cfg, hash, t.rp.Client, &t.log)
+	t.store.TryRecordOutcome(&t.log, dissolveTimedOutMinipoolsDuty, mp.GetAddress().Hex(), err)
 	if err != nil {
 		return err
 	}
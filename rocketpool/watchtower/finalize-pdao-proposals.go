@@ -13,19 +13,24 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services"
 	"github.com/rocket-pool/smartnode/shared/services/config"
 	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/services/store"
 	"github.com/rocket-pool/smartnode/shared/services/wallet"
 	"github.com/rocket-pool/smartnode/shared/utils/api"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
 )
 
+// The name of the duty in the daemon state store
+const finalizePdaoProposalsDuty string = "finalize-pdao-proposals"
+
 // Finalize PDAO proposals task
 type finalizePdaoProposals struct {
-	c   *cli.Context
-	log log.ColorLogger
-	cfg *config.RocketPoolConfig
-	w   wallet.Wallet
-	ec  rocketpool.ExecutionClient
-	rp  *rocketpool.RocketPool
+	c     *cli.Context
+	log   log.ColorLogger
+	cfg   *config.RocketPoolConfig
+	w     wallet.Wallet
+	ec    rocketpool.ExecutionClient
+	rp    *rocketpool.RocketPool
+	store *store.DutyStore
 }
 
 // Create finalize PDAO proposals task
@@ -48,15 +53,20 @@ func newFinalizePdaoProposals(c *cli.Context, logger log.ColorLogger) (*finalize
 	if err != nil {
 		return nil, err
 	}
+	dutyStore, err := services.GetDutyStore(c)
+	if err != nil {
+		return nil, err
+	}
 
 	// Return task
 	return &finalizePdaoProposals{
-		c:   c,
-		log: logger,
-		cfg: cfg,
-		w:   w,
-		ec:  ec,
-		rp:  rp,
+		c:     c,
+		log:   logger,
+		cfg:   cfg,
+		w:     w,
+		ec:    ec,
+		rp:    rp,
+		store: dutyStore,
 	}, nil
 
 }
@@ -82,6 +92,15 @@ func (t *finalizePdaoProposals) run(state *state.NetworkState) error {
 
 	// Finalize proposals
 	for _, propID := range propIDs {
+		// Skip proposals that still have a finalization in flight from a previous run
+		pending, err := t.store.IsPending(t.rp.Client, finalizePdaoProposalsDuty, fmt.Sprint(propID))
+		if err != nil {
+			t.log.Printlnf("WARNING: couldn't check previous finalizations of proposal %d: %s", propID, err.Error())
+		} else if pending {
+			t.log.Printlnf("Proposal %d already has a pending finalization, skipping it.", propID)
+			continue
+		}
+
 		if err := t.finalizeProposal(propID); err != nil {
 			t.log.Println(fmt.Errorf("Could not finalize proposal %d: %w", propID, err))
 		}
@@ -136,9 +155,11 @@ func (t *finalizePdaoProposals) finalizeProposal(propID uint64) error {
 	if err != nil {
 		return err
 	}
+	t.store.TryRecordSubmission(&t.log, finalizePdaoProposalsDuty, fmt.Sprint(propID), hash)
 
 	// Print TX info and wait for it to be included in a block
 	err = api.PrintAndWaitForTransaction(t.cfg, hash, t.rp.Client, &t.log)
+	t.store.TryRecordOutcome(&t.log, finalizePdaoProposalsDuty, fmt.Sprint(propID), err)
 	if err != nil {
 		return err
 	}
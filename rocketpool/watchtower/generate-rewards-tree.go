@@ -217,13 +217,32 @@ func (t *generateRewardsTree) generateRewardsTree(index uint64) {
 		return
 	}
 
+	// Use a saved snapshot of the target slot's state if there is one
+	snapshots, err := services.GetSnapshotStore(t.c)
+	if err != nil {
+		t.handleError(fmt.Errorf("%s error getting state snapshot store: %w", generationPrefix, err))
+		return
+	}
+	stateManager.SetSnapshotStore(snapshots)
+
 	// Get the state for the target slot
-	state, err := stateManager.GetStateForSlot(rewardsEvent.ConsensusBlock.Uint64())
+	state, fromSnapshot, err := stateManager.GetStateForSlotWithSource(rewardsEvent.ConsensusBlock.Uint64())
 	if err != nil {
 		t.handleError(fmt.Errorf("%s error getting state for beacon slot %d: %w", generationPrefix, rewardsEvent.ConsensusBlock.Uint64(), err))
 		return
 	}
 
+	// A snapshot must have been built from the exact EL block of the rewards event, otherwise rebuild the state
+	if fromSnapshot && (state.ElBlockNumber != elBlockHeader.Number.Uint64() || state.ElBlockHash != elBlockHeader.Hash()) {
+		t.log.Printlnf("%s WARNING: the state snapshot for slot %d doesn't match EL block %d (%s), rebuilding it.", generationPrefix, state.BeaconSlotNumber, elBlockHeader.Number.Uint64(), elBlockHeader.Hash().Hex())
+		stateManager.SetSnapshotStore(nil)
+		state, err = stateManager.GetStateForSlot(rewardsEvent.ConsensusBlock.Uint64())
+		if err != nil {
+			t.handleError(fmt.Errorf("%s error getting state for beacon slot %d: %w", generationPrefix, rewardsEvent.ConsensusBlock.Uint64(), err))
+			return
+		}
+	}
+
 	// Generate the tree
 	t.generateRewardsTreeImpl(client, index, generationPrefix, rewardsEvent, elBlockHeader, state)
 }
@@ -245,6 +264,10 @@ func (t *generateRewardsTree) generateRewardsTreeImpl(rp *rocketpool.RocketPool,
 		t.handleError(fmt.Errorf("%s Error creating Merkle tree generator: %w", generationPrefix, err))
 		return
 	}
+	checkpointInterval := t.cfg.Smartnode.RewardsTreeCheckpointInterval.Value.(uint64)
+	if checkpointInterval > 0 {
+		treegen.EnableCheckpoints(t.cfg.Smartnode.GetRewardsCheckpointPath(index, true), checkpointInterval)
+	}
 	treeResult, err := treegen.GenerateTree()
 	if err != nil {
 		t.handleError(fmt.Errorf("%s Error generating Merkle tree: %w", generationPrefix, err))
@@ -0,0 +1,239 @@
+package messengers
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/rocket-pool/smartnode/bindings/utils/eth"
+
+	"github.com/rocket-pool/smartnode/shared/services/config"
+)
+
+const (
+	ScrollFeeEstimatorAbi string = `[
+		{
+			"inputs": [
+				{
+				"internalType": "uint256",
+				"name": "_l2GasLimit",
+				"type": "uint256"
+				}
+			],
+			"name": "estimateCrossDomainMessageFee",
+			"outputs": [
+				{
+				"internalType":"uint256","name":"","type":"uint256"
+				}
+			]
+			,"stateMutability":"view",
+			"type": "function"
+		}
+	]`
+
+	// The fixed and per-byte L1 gas used by an Arbitrum retryable ticket's submission
+	arbitrumSubmissionBaseGas    int64 = 1400
+	arbitrumSubmissionGasPerByte int64 = 6
+)
+
+// The fees that a price messenger's submission is based on
+type Fees struct {
+	// The max fee of the L1 transaction
+	MaxFee *big.Int
+
+	// The network's recommended max fee; only required by messengers where NeedsNetworkMaxFee() is true
+	NetworkMaxFee *big.Int
+}
+
+// The arguments and value of a call to a price messenger's submit method
+type Submission struct {
+	Args  []interface{}
+	Value *big.Int
+}
+
+// A price messenger bound to its contract
+type Messenger struct {
+	config.PriceMessenger
+	Address  common.Address
+	ABI      *abi.ABI
+	contract *bind.BoundContract
+	backend  bind.ContractBackend
+}
+
+// Bind a price messenger to its contract
+func NewMessenger(messenger config.PriceMessenger, backend bind.ContractBackend) (*Messenger, error) {
+	if !common.IsHexAddress(messenger.Address) {
+		return nil, fmt.Errorf("invalid %s price messenger address [%s]", messenger.Chain, messenger.Address)
+	}
+	if messenger.Type == config.PriceMessengerType_Scroll && !common.IsHexAddress(messenger.FeeEstimatorAddress) {
+		return nil, fmt.Errorf("invalid %s fee estimator address [%s]", messenger.Chain, messenger.FeeEstimatorAddress)
+	}
+	parsed, err := buildAbi(messenger.PriceMessengerDefinition)
+	if err != nil {
+		return nil, fmt.Errorf("error building %s price messenger ABI: %w", messenger.Chain, err)
+	}
+
+	address := common.HexToAddress(messenger.Address)
+	return &Messenger{
+		PriceMessenger: messenger,
+		Address:        address,
+		ABI:            parsed,
+		contract:       bind.NewBoundContract(address, *parsed, backend, backend, backend),
+		backend:        backend,
+	}, nil
+}
+
+// Check if the L2's rate is out of date
+func (m *Messenger) IsRateStale(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	if err := m.contract.Call(opts, &out, m.StaleMethod); err != nil {
+		return false, fmt.Errorf("error checking if the %s rate is stale: %w", m.Chain, err)
+	}
+	return *abi.ConvertType(out[0], new(bool)).(*bool), nil
+}
+
+// True if the messenger's submission is priced from the network's recommended max fee
+func (m *Messenger) NeedsNetworkMaxFee() bool {
+	return m.Type == config.PriceMessengerType_Arbitrum
+}
+
+// Get the arguments and value of a submission
+func (m *Messenger) GetSubmission(opts *bind.CallOpts, fees Fees) (Submission, error) {
+	l2GasLimit := new(big.Int).SetUint64(m.L2GasLimit)
+
+	switch m.Type {
+	case config.PriceMessengerType_Simple:
+		return Submission{
+			Args:  []interface{}{},
+			Value: big.NewInt(0),
+		}, nil
+
+	case config.PriceMessengerType_Arbitrum:
+		if fees.NetworkMaxFee == nil {
+			return Submission{}, fmt.Errorf("the %s submission requires the network's recommended max fee", m.Chain)
+		}
+		l2MaxFeePerGas := eth.GweiToWei(m.L2MaxFeePerGas)
+
+		// (base gas + gas per byte * data length) * network max fee * buffer
+		maxSubmissionCost := big.NewInt(arbitrumSubmissionGasPerByte)
+		maxSubmissionCost.Mul(maxSubmissionCost, new(big.Int).SetUint64(m.SubmissionDataLength))
+		maxSubmissionCost.Add(maxSubmissionCost, big.NewInt(arbitrumSubmissionBaseGas))
+		maxSubmissionCost.Mul(maxSubmissionCost, fees.NetworkMaxFee)
+		maxSubmissionCost.Mul(maxSubmissionCost, new(big.Int).SetUint64(m.SubmissionCostBuffer))
+
+		// Provide enough ETH for the L2 and roundtrip TX's
+		value := new(big.Int).Mul(l2GasLimit, l2MaxFeePerGas)
+		value.Add(value, maxSubmissionCost)
+		return Submission{
+			Args:  []interface{}{maxSubmissionCost, l2GasLimit, l2MaxFeePerGas},
+			Value: value,
+		}, nil
+
+	case config.PriceMessengerType_ZkSyncEra:
+		if fees.MaxFee == nil {
+			return Submission{}, fmt.Errorf("the %s submission requires a max fee", m.Chain)
+		}
+		gasPerPubdataByte := new(big.Int).SetUint64(m.L2GasPerPubdataByte)
+
+		// The L2 gas price is the larger of the fair price and the price implied by the L1 pubdata cost, rounded up
+		pubdataPrice := new(big.Int).Mul(new(big.Int).SetUint64(m.L1GasPerPubdataByte), fees.MaxFee)
+		minL2GasPrice := new(big.Int).Add(pubdataPrice, gasPerPubdataByte)
+		minL2GasPrice.Sub(minL2GasPrice, big.NewInt(1))
+		minL2GasPrice.Div(minL2GasPrice, gasPerPubdataByte)
+		gasPrice := eth.GweiToWei(m.FairL2GasPrice)
+		if minL2GasPrice.Cmp(gasPrice) > 0 {
+			gasPrice = minL2GasPrice
+		}
+		return Submission{
+			Args:  []interface{}{l2GasLimit, gasPerPubdataByte},
+			Value: new(big.Int).Mul(l2GasLimit, gasPrice),
+		}, nil
+
+	case config.PriceMessengerType_Scroll:
+		parsed, err := abi.JSON(strings.NewReader(ScrollFeeEstimatorAbi))
+		if err != nil {
+			return Submission{}, fmt.Errorf("error decoding Scroll fee estimator ABI: %w", err)
+		}
+		estimatorAddress := common.HexToAddress(m.FeeEstimatorAddress)
+		estimator := bind.NewBoundContract(estimatorAddress, parsed, m.backend, m.backend, m.backend)
+		var out []interface{}
+		if err := estimator.Call(opts, &out, "estimateCrossDomainMessageFee", l2GasLimit); err != nil {
+			return Submission{}, fmt.Errorf("error getting cross domain message fee for %s: %w", m.Chain, err)
+		}
+		return Submission{
+			Args:  []interface{}{l2GasLimit},
+			Value: *abi.ConvertType(out[0], new(*big.Int)).(**big.Int),
+		}, nil
+	}
+
+	return Submission{}, fmt.Errorf("unknown price messenger type [%s] for %s", m.Type, m.Chain)
+}
+
+// Encode the calldata of a submission
+func (m *Messenger) PackSubmission(submission Submission) ([]byte, error) {
+	input, err := m.ABI.Pack(m.SubmitMethod, submission.Args...)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode input data for %s price submission: %w", m.Chain, err)
+	}
+	return input, nil
+}
+
+// Send a submission to the messenger
+func (m *Messenger) Submit(opts *bind.TransactOpts, submission Submission) (*types.Transaction, error) {
+	opts.Value = submission.Value
+	tx, err := m.contract.Transact(opts, m.SubmitMethod, submission.Args...)
+	if err != nil {
+		return nil, fmt.Errorf("error submitting %s rate: %w", m.Chain, err)
+	}
+	return tx, nil
+}
+
+// Build the messenger's ABI from its type and method names
+func buildAbi(definition config.PriceMessengerDefinition) (*abi.ABI, error) {
+	uint256Type, err := abi.NewType("uint256", "", nil)
+	if err != nil {
+		return nil, err
+	}
+	boolType, err := abi.NewType("bool", "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the submit method's inputs
+	var inputNames []string
+	switch definition.Type {
+	case config.PriceMessengerType_Simple:
+		inputNames = []string{}
+	case config.PriceMessengerType_Arbitrum:
+		inputNames = []string{"_maxSubmissionCost", "_gasLimit", "_gasPriceBid"}
+	case config.PriceMessengerType_ZkSyncEra:
+		inputNames = []string{"_l2GasLimit", "_l2GasPerPubdataByteLimit"}
+	case config.PriceMessengerType_Scroll:
+		inputNames = []string{"_l2GasLimit"}
+	default:
+		return nil, fmt.Errorf("unknown price messenger type [%s]", definition.Type)
+	}
+	inputs := abi.Arguments{}
+	for _, name := range inputNames {
+		inputs = append(inputs, abi.Argument{Name: name, Type: uint256Type})
+	}
+
+	// Only the messengers that pay for their L2 message take a value
+	payable := definition.Type != config.PriceMessengerType_Simple
+	mutability := "nonpayable"
+	if payable {
+		mutability = "payable"
+	}
+
+	parsed := abi.ABI{
+		Methods: map[string]abi.Method{
+			definition.StaleMethod:  abi.NewMethod(definition.StaleMethod, definition.StaleMethod, abi.Function, "view", true, false, abi.Arguments{}, abi.Arguments{{Type: boolType}}),
+			definition.SubmitMethod: abi.NewMethod(definition.SubmitMethod, definition.SubmitMethod, abi.Function, mutability, false, payable, inputs, abi.Arguments{}),
+		},
+	}
+	return &parsed, nil
+}
@@ -0,0 +1,231 @@
+package messengers
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+var (
+	messengerAddress = common.HexToAddress("0x1000000000000000000000000000000000000001")
+	estimatorAddress = common.HexToAddress("0x1000000000000000000000000000000000000002")
+	valueSlot        = common.BigToHash(big.NewInt(1))
+)
+
+func gwei(amount float64) *big.Int {
+	value, _ := new(big.Float).Mul(big.NewFloat(amount), big.NewFloat(params.GWei)).Int(nil)
+	return value
+}
+
+// Runtime code for a messenger that reports a stale rate (storage slot 0) until its submit method is called,
+// and records the value of the submission in storage slot 1
+func mockMessengerCode(t *testing.T, definition config.PriceMessengerDefinition) []byte {
+	parsed, err := buildAbi(definition)
+	if err != nil {
+		t.Fatal(err)
+	}
+	staleSelector := parsed.Methods[definition.StaleMethod].ID
+	submitSelector := parsed.Methods[definition.SubmitMethod].ID
+
+	code := []byte{0x60, 0x00, 0x35, 0x60, 0xe0, 0x1c} // selector := calldata[0:4]
+	code = append(code, 0x80, 0x63)                    // if selector == stale, jump to 0x1d
+	code = append(code, staleSelector...)
+	code = append(code, 0x14, 0x60, 0x1d, 0x57)
+	code = append(code, 0x63) // if selector == submit, jump to 0x29
+	code = append(code, submitSelector...)
+	code = append(code, 0x14, 0x60, 0x29, 0x57)
+	code = append(code, 0x60, 0x00, 0x80, 0xfd)                                                 // revert
+	code = append(code, 0x5b, 0x60, 0x00, 0x54, 0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3) // 0x1d: return slot 0
+	code = append(code, 0x5b, 0x34, 0x60, 0x01, 0x55, 0x60, 0x00, 0x60, 0x00, 0x55, 0x00)       // 0x29: slot 1 = value, slot 0 = false
+	return code
+}
+
+// Runtime code for a fee estimator that quotes 1 gwei per unit of L2 gas
+var mockEstimatorCode = []byte{0x60, 0x04, 0x35, 0x63, 0x3b, 0x9a, 0xca, 0x00, 0x02, 0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3}
+
+func TestSubmit(t *testing.T) {
+	definitions := map[config.PriceMessengerType]config.PriceMessengerDefinition{}
+	for _, definition := range config.PriceMessengerDefinitions {
+		definitions[definition.Type] = definition
+	}
+
+	tests := []struct {
+		name          string
+		definition    config.PriceMessengerDefinition
+		fees          Fees
+		expectedValue *big.Int
+		expectedArgs  []*big.Int
+	}{
+		{
+			name:          "simple",
+			definition:    definitions[config.PriceMessengerType_Simple],
+			fees:          Fees{MaxFee: gwei(100)},
+			expectedValue: big.NewInt(0),
+			expectedArgs:  []*big.Int{},
+		},
+		{
+			// (1400 + 6 * 36) * 20 gwei * 4, plus 40000 L2 gas at 0.1 gwei
+			name:          "arbitrum",
+			definition:    definitions[config.PriceMessengerType_Arbitrum],
+			fees:          Fees{MaxFee: gwei(100), NetworkMaxFee: gwei(20)},
+			expectedValue: gwei(133280),
+			expectedArgs:  []*big.Int{gwei(129280), big.NewInt(40000), gwei(0.1)},
+		},
+		{
+			// 17 * 100 gwei / 800 = 2.125 gwei per L2 gas, above the fair price
+			name:          "zksync era pubdata price",
+			definition:    definitions[config.PriceMessengerType_ZkSyncEra],
+			fees:          Fees{MaxFee: gwei(100)},
+			expectedValue: gwei(750000 * 2.125),
+			expectedArgs:  []*big.Int{big.NewInt(750000), big.NewInt(800)},
+		},
+		{
+			// 17 * 10 gwei / 800 = 0.2125 gwei per L2 gas, below the fair price of 0.5 gwei
+			name:          "zksync era fair price",
+			definition:    definitions[config.PriceMessengerType_ZkSyncEra],
+			fees:          Fees{MaxFee: gwei(10)},
+			expectedValue: gwei(750000 * 0.5),
+			expectedArgs:  []*big.Int{big.NewInt(750000), big.NewInt(800)},
+		},
+		{
+			name:          "scroll",
+			definition:    definitions[config.PriceMessengerType_Scroll],
+			fees:          Fees{MaxFee: gwei(100)},
+			expectedValue: gwei(90000),
+			expectedArgs:  []*big.Int{big.NewInt(90000)},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			key, err := crypto.GenerateKey()
+			if err != nil {
+				t.Fatal(err)
+			}
+			from := crypto.PubkeyToAddress(key.PublicKey)
+			sim := backends.NewSimulatedBackend(core.GenesisAlloc{
+				from: {Balance: new(big.Int).Mul(big.NewInt(100), big.NewInt(params.Ether))},
+				messengerAddress: {
+					Code:    mockMessengerCode(t, test.definition),
+					Storage: map[common.Hash]common.Hash{{}: common.BigToHash(big.NewInt(1))},
+				},
+				estimatorAddress: {Code: mockEstimatorCode},
+			}, 10_000_000)
+			defer sim.Close()
+
+			messenger, err := NewMessenger(config.PriceMessenger{
+				PriceMessengerDefinition: test.definition,
+				Address:                  messengerAddress.Hex(),
+				FeeEstimatorAddress:      estimatorAddress.Hex(),
+			}, sim)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// The rate starts out stale
+			stale, err := messenger.IsRateStale(nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !stale {
+				t.Fatal("expected the rate to be stale before the submission")
+			}
+
+			// Build the submission
+			submission, err := messenger.GetSubmission(nil, test.fees)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if submission.Value.Cmp(test.expectedValue) != 0 {
+				t.Fatalf("expected a value of %s but got %s", test.expectedValue, submission.Value)
+			}
+			input, err := messenger.PackSubmission(submission)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := sim.EstimateGas(context.Background(), ethereum.CallMsg{From: from, To: &messenger.Address, Value: submission.Value, Data: input}); err != nil {
+				t.Fatalf("error estimating gas: %s", err.Error())
+			}
+
+			// Submit it
+			opts, err := bind.NewKeyedTransactorWithChainID(key, big.NewInt(1337))
+			if err != nil {
+				t.Fatal(err)
+			}
+			tx, err := messenger.Submit(opts, submission)
+			if err != nil {
+				t.Fatal(err)
+			}
+			sim.Commit()
+			receipt, err := sim.TransactionReceipt(context.Background(), tx.Hash())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if receipt.Status != types.ReceiptStatusSuccessful {
+				t.Fatal("the submission reverted")
+			}
+
+			// Check the calldata and value that reached the messenger
+			args, err := messenger.ABI.Methods[test.definition.SubmitMethod].Inputs.Unpack(tx.Data()[4:])
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(args) != len(test.expectedArgs) {
+				t.Fatalf("expected %d arguments but got %d", len(test.expectedArgs), len(args))
+			}
+			for i, arg := range args {
+				if arg.(*big.Int).Cmp(test.expectedArgs[i]) != 0 {
+					t.Errorf("expected argument %d to be %s but got %s", i, test.expectedArgs[i], arg)
+				}
+			}
+			paid, err := sim.StorageAt(context.Background(), messenger.Address, valueSlot, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if new(big.Int).SetBytes(paid).Cmp(test.expectedValue) != 0 {
+				t.Errorf("expected the messenger to receive %s but got %s", test.expectedValue, new(big.Int).SetBytes(paid))
+			}
+
+			// The rate is no longer stale
+			stale, err = messenger.IsRateStale(nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if stale {
+				t.Error("expected the rate to be current after the submission")
+			}
+		})
+	}
+}
+
+func TestDefinitions(t *testing.T) {
+	messengers := config.GetPriceMessengers(cfgtypes.Network_Mainnet)
+	if len(messengers) != len(config.PriceMessengerDefinitions) {
+		t.Fatalf("expected all %d messengers on mainnet but got %d", len(config.PriceMessengerDefinitions), len(messengers))
+	}
+	chains := map[string]bool{}
+	for _, messenger := range messengers {
+		if chains[messenger.Chain] {
+			t.Errorf("duplicate chain name %s", messenger.Chain)
+		}
+		chains[messenger.Chain] = true
+		if _, err := NewMessenger(messenger, nil); err != nil {
+			t.Errorf("error binding %s: %s", messenger.Chain, err.Error())
+		}
+	}
+	if len(config.GetPriceMessengers(cfgtypes.Network_Testnet)) != 0 {
+		t.Error("expected no messengers on testnet")
+	}
+}
@@ -0,0 +1,79 @@
+package watchtower
+
+import (
+	"fmt"
+
+	"github.com/rocket-pool/smartnode/rocketpool/watchtower/shadow"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+const shadowLogPrefix string = "[Shadow Mode]"
+
+// Save a payload that a duty computed in shadow mode instead of submitting it
+func saveShadowReport(logger *log.ColorLogger, store *shadow.Store, duty string, target string, payload interface{}) error {
+	report, err := shadow.NewReport(duty, target, payload)
+	if err != nil {
+		return err
+	}
+	added, err := store.Add(report)
+	if err != nil {
+		return fmt.Errorf("error saving %s shadow payload for %s: %w", duty, target, err)
+	}
+	if added {
+		logger.Printlnf("%s Saved the %s payload for %s instead of submitting it: %s", shadowLogPrefix, duty, target, string(report.Payload))
+	}
+	return nil
+}
+
+// Compare each of a duty's pending shadow payloads against the chain, saving and logging the results
+func compareShadowReports(logger *log.ColorLogger, store *shadow.Store, duty string, compare func(shadow.Report) (shadow.Comparison, error)) error {
+	reports, err := store.GetPending(duty)
+	if err != nil {
+		return fmt.Errorf("error getting pending %s shadow payloads: %w", duty, err)
+	}
+
+	for _, report := range reports {
+		comparison, err := compare(report)
+		if err != nil {
+			return fmt.Errorf("error comparing %s shadow payload for %s: %w", duty, report.Target, err)
+		}
+		err = store.SetComparison(duty, report.Target, comparison)
+		if err != nil {
+			return fmt.Errorf("error saving comparison of %s shadow payload for %s: %w", duty, report.Target, err)
+		}
+
+		// Only log comparisons that changed
+		previous := report.Comparison
+		if previous != nil &&
+			previous.Final == comparison.Final &&
+			previous.Agreed == comparison.Agreed &&
+			len(previous.Agreeing) == len(comparison.Agreeing) &&
+			len(previous.Disagreeing) == len(comparison.Disagreeing) &&
+			previous.Outcome == comparison.Outcome {
+			continue
+		}
+		logShadowComparison(logger, report, comparison)
+	}
+	return nil
+}
+
+// Log how a shadow payload compares to what the Oracle DAO did
+func logShadowComparison(logger *log.ColorLogger, report shadow.Report, comparison shadow.Comparison) {
+	result := "DISAGREES with"
+	if comparison.Agreed {
+		result = "agrees with"
+	}
+	status := "final"
+	if !comparison.Final {
+		status = "so far"
+	}
+
+	details := comparison.Outcome
+	if details == "" {
+		details = fmt.Sprintf("%d members agree, %d disagree", len(comparison.Agreeing), len(comparison.Disagreeing))
+		for _, member := range comparison.Disagreeing {
+			details += fmt.Sprintf("\n\tDisagreeing member: %s", member.Hex())
+		}
+	}
+	logger.Printlnf("%s The %s payload for %s %s the Oracle DAO (%s): %s", shadowLogPrefix, report.Duty, report.Target, result, status, details)
+}
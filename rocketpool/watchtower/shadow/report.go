@@ -0,0 +1,124 @@
+package shadow
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// The watchtower duties that can run in shadow mode
+const (
+	Duty_NetworkBalances string = "network-balances"
+	Duty_RplPrice        string = "rpl-price"
+	Duty_ScrubMinipools  string = "scrub-minipools"
+	Duty_RewardsTree     string = "rewards-tree"
+	Duty_ChallengeExits  string = "challenge-exits"
+)
+
+// All of the duties that can run in shadow mode
+var Duties = []string{
+	Duty_NetworkBalances,
+	Duty_RplPrice,
+	Duty_ScrubMinipools,
+	Duty_RewardsTree,
+	Duty_ChallengeExits,
+}
+
+// How a shadow payload compares to what the Oracle DAO actually did on-chain
+type Comparison struct {
+	// When the comparison was made
+	Time time.Time `json:"time"`
+
+	// True once the outcome on-chain is settled, so the comparison won't change anymore
+	Final bool `json:"final"`
+
+	// True if the payload matches the outcome the Oracle DAO reached (or is heading towards, if it isn't final yet)
+	Agreed bool `json:"agreed"`
+
+	// The Oracle DAO members that submitted the same payload, and the ones that submitted something else.
+	// These are only known for duties that record each member's submission on-chain.
+	Agreeing    []common.Address `json:"agreeing,omitempty"`
+	Disagreeing []common.Address `json:"disagreeing,omitempty"`
+
+	// A description of the outcome for duties that are compared by their effect instead of by each member's submission
+	Outcome string `json:"outcome,omitempty"`
+}
+
+// A payload a duty computed in shadow mode instead of submitting it
+type Report struct {
+	// The duty that computed the payload
+	Duty string `json:"duty"`
+
+	// What the payload is for (a block, a rewards interval, a minipool...); unique per duty
+	Target string `json:"target"`
+
+	// When the payload was first computed
+	Created time.Time `json:"created"`
+
+	// The payload that would have been submitted
+	Payload json.RawMessage `json:"payload"`
+
+	// The latest comparison against the chain, if one has been made
+	Comparison *Comparison `json:"comparison,omitempty"`
+}
+
+// Create a new report for a payload
+func NewReport(duty string, target string, payload interface{}) (Report, error) {
+	bytes, err := json.Marshal(payload)
+	if err != nil {
+		return Report{}, fmt.Errorf("error serializing %s shadow payload for %s: %w", duty, target, err)
+	}
+	return Report{
+		Duty:    duty,
+		Target:  target,
+		Created: time.Now(),
+		Payload: bytes,
+	}, nil
+}
+
+// Decode the report's payload
+func (r Report) GetPayload(payload interface{}) error {
+	err := json.Unmarshal(r.Payload, payload)
+	if err != nil {
+		return fmt.Errorf("error deserializing %s shadow payload for %s: %w", r.Duty, r.Target, err)
+	}
+	return nil
+}
+
+// True if the report still needs to be compared against the chain
+func (r Report) IsPending() bool {
+	return r.Comparison == nil || !r.Comparison.Final
+}
+
+// Compare a payload against each Oracle DAO member's submission for the same target.
+// hasSubmitted reports whether a member submitted anything for the target, and hasSubmittedPayload reports whether it submitted this payload.
+// The payload agrees if more of the members that submitted chose it than anything else.
+func CompareMembers(members []common.Address, hasSubmitted func(common.Address) (bool, error), hasSubmittedPayload func(common.Address) (bool, error)) (Comparison, error) {
+	comparison := Comparison{
+		Time:        time.Now(),
+		Agreeing:    []common.Address{},
+		Disagreeing: []common.Address{},
+	}
+	for _, member := range members {
+		submitted, err := hasSubmitted(member)
+		if err != nil {
+			return Comparison{}, fmt.Errorf("error checking if member %s submitted: %w", member.Hex(), err)
+		}
+		if !submitted {
+			continue
+		}
+		matches, err := hasSubmittedPayload(member)
+		if err != nil {
+			return Comparison{}, fmt.Errorf("error checking the submission of member %s: %w", member.Hex(), err)
+		}
+		if matches {
+			comparison.Agreeing = append(comparison.Agreeing, member)
+		} else {
+			comparison.Disagreeing = append(comparison.Disagreeing, member)
+		}
+	}
+	comparison.Agreed = len(comparison.Agreeing) > len(comparison.Disagreeing)
+	return comparison, nil
+}
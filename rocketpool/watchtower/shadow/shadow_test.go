@@ -0,0 +1,210 @@
+package shadow
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type testPayload struct {
+	Block uint64 `json:"block"`
+	Value string `json:"value"`
+}
+
+func TestCompareMembers(t *testing.T) {
+	members := []common.Address{
+		common.HexToAddress("0x01"),
+		common.HexToAddress("0x02"),
+		common.HexToAddress("0x03"),
+		common.HexToAddress("0x04"),
+	}
+
+	tests := []struct {
+		name        string
+		submissions map[common.Address]bool // Members that submitted, and whether their payload matched
+		agreed      bool
+		agreeing    int
+		disagreeing int
+	}{
+		{
+			name:        "no submissions",
+			submissions: map[common.Address]bool{},
+			agreed:      false,
+		},
+		{
+			name:        "majority agrees",
+			submissions: map[common.Address]bool{members[0]: true, members[1]: true, members[2]: false},
+			agreed:      true,
+			agreeing:    2,
+			disagreeing: 1,
+		},
+		{
+			name:        "tie",
+			submissions: map[common.Address]bool{members[0]: true, members[3]: false},
+			agreed:      false,
+			agreeing:    1,
+			disagreeing: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			comparison, err := CompareMembers(members,
+				func(member common.Address) (bool, error) {
+					_, submitted := test.submissions[member]
+					return submitted, nil
+				},
+				func(member common.Address) (bool, error) {
+					return test.submissions[member], nil
+				},
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if comparison.Agreed != test.agreed {
+				t.Errorf("expected agreed to be %t but it was %t", test.agreed, comparison.Agreed)
+			}
+			if len(comparison.Agreeing) != test.agreeing {
+				t.Errorf("expected %d agreeing members but got %d", test.agreeing, len(comparison.Agreeing))
+			}
+			if len(comparison.Disagreeing) != test.disagreeing {
+				t.Errorf("expected %d disagreeing members but got %d", test.disagreeing, len(comparison.Disagreeing))
+			}
+		})
+	}
+
+	// Errors are passed through
+	_, err := CompareMembers(members,
+		func(member common.Address) (bool, error) {
+			return false, fmt.Errorf("test error")
+		},
+		nil,
+	)
+	if err == nil {
+		t.Error("expected an error")
+	}
+}
+
+func TestStore(t *testing.T) {
+	store := NewStore(filepath.Join(t.TempDir(), "shadow", "reports.json"))
+
+	// An empty store has a summary for every duty
+	summaries, err := store.GetSummaries()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(summaries) != len(Duties) {
+		t.Fatalf("expected %d summaries but got %d", len(Duties), len(summaries))
+	}
+
+	// Add two reports
+	for _, block := range []uint64{100, 200} {
+		report, err := NewReport(Duty_RplPrice, fmt.Sprintf("block %d", block), testPayload{Block: block, Value: "1"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		added, err := store.Add(report)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !added {
+			t.Fatalf("expected the report for block %d to be added", block)
+		}
+	}
+
+	// Reports are only added once per target
+	duplicate, err := NewReport(Duty_RplPrice, "block 100", testPayload{Block: 100, Value: "2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	added, err := store.Add(duplicate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if added {
+		t.Fatal("expected the duplicate report to be ignored")
+	}
+	report, exists, err := store.Get(Duty_RplPrice, "block 100")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !exists {
+		t.Fatal("expected the report for block 100 to exist")
+	}
+	var payload testPayload
+	if err := report.GetPayload(&payload); err != nil {
+		t.Fatal(err)
+	}
+	if payload.Value != "1" {
+		t.Errorf("expected the original payload to be kept but got %s", payload.Value)
+	}
+
+	// Settle the first report and leave a non-final comparison on the second
+	err = store.SetComparison(Duty_RplPrice, "block 100", Comparison{Final: true, Agreed: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = store.SetComparison(Duty_RplPrice, "block 200", Comparison{Final: false, Agreed: false})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := store.SetComparison(Duty_RplPrice, "block 300", Comparison{}); err == nil {
+		t.Error("expected an error setting the comparison of a missing report")
+	}
+
+	pending, err := store.GetPending(Duty_RplPrice)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != 1 || pending[0].Target != "block 200" {
+		t.Fatalf("expected block 200 to be the only pending report but got %v", pending)
+	}
+
+	// Check the summary from a new store backed by the same file
+	summaries, err = NewStore(store.path).GetSummaries()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, summary := range summaries {
+		if summary.Duty != Duty_RplPrice {
+			if summary.Latest != nil {
+				t.Errorf("expected no reports for %s", summary.Duty)
+			}
+			continue
+		}
+		if summary.Agreed != 1 || summary.Disagreed != 0 || summary.Pending != 1 {
+			t.Errorf("expected 1 agreed and 1 pending report but got %d agreed, %d disagreed and %d pending", summary.Agreed, summary.Disagreed, summary.Pending)
+		}
+		if summary.Latest == nil || summary.Latest.Target != "block 200" {
+			t.Errorf("expected the latest report to be for block 200")
+		}
+	}
+}
+
+func TestStoreLimit(t *testing.T) {
+	store := NewStore(filepath.Join(t.TempDir(), "reports.json"))
+	store.reportsToKeep = 3
+
+	for i := 0; i < 5; i++ {
+		report, err := NewReport(Duty_ScrubMinipools, fmt.Sprintf("minipool %d", i), testPayload{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := store.Add(report); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	pending, err := store.GetPending(Duty_ScrubMinipools)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != 3 {
+		t.Fatalf("expected 3 reports to be kept but got %d", len(pending))
+	}
+	if pending[0].Target != "minipool 2" {
+		t.Errorf("expected the oldest reports to be dropped but the first one is %s", pending[0].Target)
+	}
+}
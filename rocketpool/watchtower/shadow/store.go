@@ -0,0 +1,197 @@
+package shadow
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+const (
+	// How many reports to keep for each duty
+	DefaultReportsToKeep int = 100
+)
+
+// The number of reports a duty has in each state
+type Summary struct {
+	Duty      string
+	Agreed    int
+	Disagreed int
+	Pending   int
+
+	// The most recently added report, if there is one
+	Latest *Report
+}
+
+// The reports as saved to disk, indexed by duty
+type storeFile struct {
+	UpdatedAt time.Time           `json:"updatedAt"`
+	Reports   map[string][]Report `json:"reports"`
+}
+
+// Persists the payloads computed in shadow mode along with how they compare to the chain.
+// Each duty's reports are kept in the order they were added.
+type Store struct {
+	path          string
+	reportsToKeep int
+	lock          sync.Mutex
+}
+
+// Create a new store backed by the file at the provided path; the file is created on first use
+func NewStore(path string) *Store {
+	return &Store{
+		path:          path,
+		reportsToKeep: DefaultReportsToKeep,
+	}
+}
+
+// Get a duty's report for a target
+func (s *Store) Get(duty string, target string) (Report, bool, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	file, err := s.load()
+	if err != nil {
+		return Report{}, false, err
+	}
+	for _, report := range file.Reports[duty] {
+		if report.Target == target {
+			return report, true, nil
+		}
+	}
+	return Report{}, false, nil
+}
+
+// Add a report, unless the duty already has one for the same target. Returns true if it was added.
+func (s *Store) Add(report Report) (bool, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	file, err := s.load()
+	if err != nil {
+		return false, err
+	}
+	for _, existing := range file.Reports[report.Duty] {
+		if existing.Target == report.Target {
+			return false, nil
+		}
+	}
+
+	reports := append(file.Reports[report.Duty], report)
+	if len(reports) > s.reportsToKeep {
+		reports = reports[len(reports)-s.reportsToKeep:]
+	}
+	file.Reports[report.Duty] = reports
+	return true, s.save(file)
+}
+
+// Set the latest comparison of a duty's report for a target
+func (s *Store) SetComparison(duty string, target string, comparison Comparison) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	file, err := s.load()
+	if err != nil {
+		return err
+	}
+	for i, report := range file.Reports[duty] {
+		if report.Target == target {
+			file.Reports[duty][i].Comparison = &comparison
+			return s.save(file)
+		}
+	}
+	return fmt.Errorf("there is no %s shadow report for %s", duty, target)
+}
+
+// Get a duty's reports that still need to be compared against the chain, oldest first
+func (s *Store) GetPending(duty string) ([]Report, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	file, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	pending := []Report{}
+	for _, report := range file.Reports[duty] {
+		if report.IsPending() {
+			pending = append(pending, report)
+		}
+	}
+	return pending, nil
+}
+
+// Summarize the reports of every shadow duty, in the order of Duties
+func (s *Store) GetSummaries() ([]Summary, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	file, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	summaries := make([]Summary, 0, len(Duties))
+	for _, duty := range Duties {
+		summary := Summary{
+			Duty: duty,
+		}
+		reports := file.Reports[duty]
+		for i, report := range reports {
+			switch {
+			case report.IsPending():
+				summary.Pending++
+			case report.Comparison.Agreed:
+				summary.Agreed++
+			default:
+				summary.Disagreed++
+			}
+			summary.Latest = &reports[i]
+		}
+		summaries = append(summaries, summary)
+	}
+	return summaries, nil
+}
+
+// Load the store from disk; a missing file is treated as an empty store
+func (s *Store) load() (*storeFile, error) {
+	file := &storeFile{}
+	bytes, err := os.ReadFile(s.path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("error reading shadow reports [%s]: %w", s.path, err)
+	}
+	if err == nil {
+		err = json.Unmarshal(bytes, file)
+		if err != nil {
+			return nil, fmt.Errorf("error deserializing shadow reports [%s]: %w", s.path, err)
+		}
+	}
+	if file.Reports == nil {
+		file.Reports = map[string][]Report{}
+	}
+	return file, nil
+}
+
+// Save the store to disk, replacing the old file atomically
+func (s *Store) save(file *storeFile) error {
+	file.UpdatedAt = time.Now()
+	bytes, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error serializing shadow reports: %w", err)
+	}
+	err = os.MkdirAll(filepath.Dir(s.path), 0755)
+	if err != nil {
+		return fmt.Errorf("error creating folder for shadow reports [%s]: %w", s.path, err)
+	}
+	tempPath := s.path + ".tmp"
+	err = os.WriteFile(tempPath, bytes, 0644)
+	if err != nil {
+		return fmt.Errorf("error writing shadow reports [%s]: %w", tempPath, err)
+	}
+	err = os.Rename(tempPath, s.path)
+	if err != nil {
+		return fmt.Errorf("error replacing shadow reports [%s]: %w", s.path, err)
+	}
+	return nil
+}
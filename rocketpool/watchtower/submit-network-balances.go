@@ -10,6 +10,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/rocket-pool/smartnode/bindings/dao/trustednode"
 	"github.com/rocket-pool/smartnode/bindings/megapool"
 	"github.com/rocket-pool/smartnode/bindings/network"
 	"github.com/rocket-pool/smartnode/bindings/rocketpool"
@@ -19,6 +20,7 @@ import (
 	"github.com/urfave/cli"
 	"golang.org/x/sync/errgroup"
 
+	"github.com/rocket-pool/smartnode/rocketpool/watchtower/shadow"
 	"github.com/rocket-pool/smartnode/rocketpool/watchtower/utils"
 	"github.com/rocket-pool/smartnode/shared/services"
 	"github.com/rocket-pool/smartnode/shared/services/beacon"
@@ -46,6 +48,7 @@ type submitNetworkBalances struct {
 	ec        rocketpool.ExecutionClient
 	rp        *rocketpool.RocketPool
 	bc        beacon.Client
+	shadow    *shadow.Store
 	lock      *sync.Mutex
 	isRunning bool
 }
@@ -65,6 +68,16 @@ type networkBalances struct {
 	RETHSupply              *big.Int
 	NodeCreditBalance       *big.Int
 }
+
+// The network balances that would have been submitted in shadow mode
+type shadowNetworkBalances struct {
+	Block         uint64   `json:"block"`
+	SlotTimestamp uint64   `json:"slotTimestamp"`
+	TotalEth      *big.Int `json:"totalEth"`
+	TotalStaking  *big.Int `json:"totalStaking"`
+	RETHSupply    *big.Int `json:"rethSupply"`
+}
+
 type validatorBalanceDetails struct {
 	IsStaking   bool
 	UserBalance *big.Int
@@ -79,7 +92,7 @@ type megapoolBalanceDetail struct {
 }
 
 // Create submit network balances task
-func newSubmitNetworkBalances(c *cli.Context, logger log.ColorLogger, errorLogger log.ColorLogger) (*submitNetworkBalances, error) {
+func newSubmitNetworkBalances(c *cli.Context, logger log.ColorLogger, errorLogger log.ColorLogger, shadowStore *shadow.Store) (*submitNetworkBalances, error) {
 
 	// Get services
 	cfg, err := services.GetConfig(c)
@@ -114,6 +127,7 @@ func newSubmitNetworkBalances(c *cli.Context, logger log.ColorLogger, errorLogge
 		ec:        ec,
 		rp:        rp,
 		bc:        bc,
+		shadow:    shadowStore,
 		lock:      lock,
 		isRunning: false,
 	}, nil
@@ -137,6 +151,17 @@ func (t *submitNetworkBalances) run(state *state.NetworkState) error {
 		return err
 	}
 
+	// Compare earlier shadow payloads with what the Oracle DAO has submitted since
+	if t.shadow != nil {
+		err = compareShadowReports(t.log, t.shadow, shadow.Duty_NetworkBalances, func(report shadow.Report) (shadow.Comparison, error) {
+			return t.compareShadowReport(report, state)
+		})
+		if err != nil {
+			// Error is not fatal for this task so print and continue
+			t.log.Printlnf("%s %s", shadowLogPrefix, err.Error())
+		}
+	}
+
 	// Check if balance submission is enabled
 	if !state.NetworkDetails.SubmitBalancesEnabled {
 		return nil
@@ -163,6 +188,17 @@ func (t *submitNetworkBalances) run(state *state.NetworkState) error {
 		return nil
 	}
 
+	// In shadow mode, each target only needs to be calculated once
+	if t.shadow != nil {
+		_, exists, err := t.shadow.Get(shadow.Duty_NetworkBalances, getBalancesShadowTarget(targetBlockNumber))
+		if err != nil {
+			return err
+		}
+		if exists {
+			return nil
+		}
+	}
+
 	// Check if the process is already running
 	t.lock.Lock()
 	if t.isRunning {
@@ -201,8 +237,21 @@ func (t *submitNetworkBalances) run(state *state.NetworkState) error {
 		t.log.Printlnf("rETH contract balance: %s wei", balances.RETHContract.String())
 		t.log.Printlnf("rETH token supply: %s wei", balances.RETHSupply.String())
 
-		// Check if we have reported these specific values before
+		// In shadow mode, save the balances instead of submitting them
 		balances.SlotTimestamp = uint64(nextSubmissionTime.Unix())
+		if t.shadow != nil {
+			if err := t.saveShadowReport(balances); err != nil {
+				t.handleError(fmt.Errorf("%s %w", logPrefix, err))
+				return
+			}
+			t.log.Printlnf("%s Balance report complete.", logPrefix)
+			t.lock.Lock()
+			t.isRunning = false
+			t.lock.Unlock()
+			return
+		}
+
+		// Check if we have reported these specific values before
 		hasSubmittedSpecific, err := t.hasSubmittedSpecificBlockBalances(nodeAccount.Address, targetBlockNumber, balances)
 		if err != nil {
 			t.handleError(fmt.Errorf("%s %w", logPrefix, err))
@@ -267,36 +316,76 @@ func (t *submitNetworkBalances) hasSubmittedBlockBalances(nodeAddress common.Add
 
 // Check whether specific balances for a block has already been submitted by the node
 func (t *submitNetworkBalances) hasSubmittedSpecificBlockBalances(nodeAddress common.Address, blockNumber uint64, balances networkBalances) (bool, error) {
+	return t.hasSubmittedBalanceValues(nodeAddress, blockNumber, balances.SlotTimestamp, getTotalEth(balances), balances.MinipoolsStaking, balances.RETHSupply)
+}
 
-	// Calculate total ETH balance
-	totalEth := big.NewInt(0)
-	totalEth.Sub(totalEth, balances.NodeCreditBalance)
-	totalEth.Add(totalEth, balances.DepositPool)
-	totalEth.Add(totalEth, balances.MinipoolsTotal)
-	totalEth.Add(totalEth, balances.MegapoolsUserShareTotal)
-	totalEth.Add(totalEth, balances.RETHContract)
-	totalEth.Add(totalEth, balances.DistributorShareTotal)
-	totalEth.Add(totalEth, balances.SmoothingPoolShare)
+// Check whether specific balance values for a block have already been submitted by the node
+func (t *submitNetworkBalances) hasSubmittedBalanceValues(nodeAddress common.Address, blockNumber uint64, slotTimestamp uint64, totalEth *big.Int, totalStaking *big.Int, rethSupply *big.Int) (bool, error) {
 
 	blockNumberBuf := make([]byte, 32)
 	big.NewInt(int64(blockNumber)).FillBytes(blockNumberBuf)
 
 	slotTimestampBuf := make([]byte, 32)
-	big.NewInt(int64(balances.SlotTimestamp)).FillBytes(slotTimestampBuf)
+	big.NewInt(int64(slotTimestamp)).FillBytes(slotTimestampBuf)
 
 	totalEthBuf := make([]byte, 32)
 	totalEth.FillBytes(totalEthBuf)
 
 	stakingBuf := make([]byte, 32)
-	balances.MinipoolsStaking.FillBytes(stakingBuf)
+	totalStaking.FillBytes(stakingBuf)
 
 	rethSupplyBuf := make([]byte, 32)
-	balances.RETHSupply.FillBytes(rethSupplyBuf)
+	rethSupply.FillBytes(rethSupplyBuf)
 
 	return t.rp.RocketStorage.GetBool(nil, crypto.Keccak256Hash([]byte(networkBalanceSubmissionKey), nodeAddress.Bytes(), blockNumberBuf, slotTimestampBuf, totalEthBuf, stakingBuf, rethSupplyBuf))
 
 }
 
+// Get the shadow report target for a block
+func getBalancesShadowTarget(blockNumber uint64) string {
+	return fmt.Sprintf("block %d", blockNumber)
+}
+
+// Save the balances that would have been submitted in shadow mode
+func (t *submitNetworkBalances) saveShadowReport(balances networkBalances) error {
+	payload := shadowNetworkBalances{
+		Block:         balances.Block,
+		SlotTimestamp: balances.SlotTimestamp,
+		TotalEth:      getTotalEth(balances),
+		TotalStaking:  new(big.Int).Add(balances.MinipoolsStaking, balances.MegapoolStaking),
+		RETHSupply:    balances.RETHSupply,
+	}
+	return saveShadowReport(t.log, t.shadow, shadow.Duty_NetworkBalances, getBalancesShadowTarget(balances.Block), payload)
+}
+
+// Compare shadow balances with what each Oracle DAO member submitted for the same block
+func (t *submitNetworkBalances) compareShadowReport(report shadow.Report, state *state.NetworkState) (shadow.Comparison, error) {
+	var payload shadowNetworkBalances
+	if err := report.GetPayload(&payload); err != nil {
+		return shadow.Comparison{}, err
+	}
+	members, err := trustednode.GetMemberAddresses(t.rp, nil)
+	if err != nil {
+		return shadow.Comparison{}, fmt.Errorf("error getting Oracle DAO members: %w", err)
+	}
+
+	comparison, err := shadow.CompareMembers(members,
+		func(member common.Address) (bool, error) {
+			return t.hasSubmittedBlockBalances(member, payload.Block)
+		},
+		func(member common.Address) (bool, error) {
+			return t.hasSubmittedBalanceValues(member, payload.Block, payload.SlotTimestamp, payload.TotalEth, payload.TotalStaking, payload.RETHSupply)
+		},
+	)
+	if err != nil {
+		return shadow.Comparison{}, err
+	}
+
+	// Members stop submitting once consensus is reached
+	comparison.Final = state.NetworkDetails.BalancesBlock >= payload.Block
+	return comparison, nil
+}
+
 // Prints a message to the log
 func (t *submitNetworkBalances) printMessage(message string) {
 	t.log.Println(message)
@@ -564,10 +653,8 @@ func (t *submitNetworkBalances) getMinipoolBalanceDetails(mpd *rpstate.NativeMin
 
 }
 
-// Submit network balances
-func (t *submitNetworkBalances) submitBalances(balances networkBalances) error {
-
-	// Calculate total ETH balance
+// Calculate the total ETH balance
+func getTotalEth(balances networkBalances) *big.Int {
 	totalEth := big.NewInt(0)
 	totalEth.Sub(totalEth, balances.NodeCreditBalance)
 	totalEth.Add(totalEth, balances.DepositPool)
@@ -576,6 +663,14 @@ func (t *submitNetworkBalances) submitBalances(balances networkBalances) error {
 	totalEth.Add(totalEth, balances.RETHContract)
 	totalEth.Add(totalEth, balances.DistributorShareTotal)
 	totalEth.Add(totalEth, balances.SmoothingPoolShare)
+	return totalEth
+}
+
+// Submit network balances
+func (t *submitNetworkBalances) submitBalances(balances networkBalances) error {
+
+	// Calculate total ETH balance
+	totalEth := getTotalEth(balances)
 
 	ratio := eth.WeiToEth(totalEth) / eth.WeiToEth(balances.RETHSupply)
 	t.log.Printlnf("Total ETH = %s\n", totalEth)
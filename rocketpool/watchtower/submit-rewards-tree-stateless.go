@@ -16,10 +16,12 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/rocket-pool/smartnode/bindings/dao/trustednode"
 	"github.com/rocket-pool/smartnode/bindings/rewards"
 	"github.com/rocket-pool/smartnode/bindings/rocketpool"
 	"github.com/rocket-pool/smartnode/bindings/tokens"
 	"github.com/rocket-pool/smartnode/bindings/utils/eth"
+	"github.com/rocket-pool/smartnode/rocketpool/watchtower/shadow"
 	"github.com/rocket-pool/smartnode/rocketpool/watchtower/utils"
 	"github.com/rocket-pool/smartnode/shared/services"
 	"github.com/rocket-pool/smartnode/shared/services/beacon"
@@ -49,10 +51,11 @@ type submitRewardsTree_Stateless struct {
 	isRunning        bool
 	generationPrefix string
 	m                *state.NetworkStateManager
+	shadow           *shadow.Store
 }
 
 // Create submit rewards Merkle Tree task
-func newSubmitRewardsTree_Stateless(c *cli.Context, logger log.ColorLogger, errorLogger log.ColorLogger, m *state.NetworkStateManager) (*submitRewardsTree_Stateless, error) {
+func newSubmitRewardsTree_Stateless(c *cli.Context, logger log.ColorLogger, errorLogger log.ColorLogger, m *state.NetworkStateManager, shadowStore *shadow.Store) (*submitRewardsTree_Stateless, error) {
 
 	// Get services
 	cfg, err := services.GetConfig(c)
@@ -90,6 +93,7 @@ func newSubmitRewardsTree_Stateless(c *cli.Context, logger log.ColorLogger, erro
 		isRunning:        false,
 		generationPrefix: "[Merkle Tree]",
 		m:                m,
+		shadow:           shadowStore,
 	}
 
 	return generator, nil
@@ -112,11 +116,14 @@ func (t *submitRewardsTree_Stateless) Run(nodeTrusted bool, state *state.Network
 		return err
 	}
 
+	// Shadow mode never submits the tree, even for Oracle DAO members
+	nodeTrusted = nodeTrusted && t.shadow == nil
+
 	// Check node trusted status
 	if !nodeTrusted {
-		if t.cfg.Smartnode.RewardsTreeMode.Value.(cfgtypes.RewardsMode) != cfgtypes.RewardsMode_Generate {
+		if t.cfg.Smartnode.RewardsTreeMode.Value.(cfgtypes.RewardsMode) != cfgtypes.RewardsMode_Generate && t.shadow == nil {
 			return nil
-		} else {
+		} else if state == nil {
 			// Create the state, since it's not done except for manual generators
 			state, err = t.m.GetStateForSlot(beaconSlot)
 			if err != nil {
@@ -125,6 +132,17 @@ func (t *submitRewardsTree_Stateless) Run(nodeTrusted bool, state *state.Network
 		}
 	}
 
+	// Compare earlier shadow trees with what the Oracle DAO has submitted since
+	if t.shadow != nil {
+		err = compareShadowReports(t.log, t.shadow, shadow.Duty_RewardsTree, func(report shadow.Report) (shadow.Comparison, error) {
+			return t.compareShadowReport(report, state)
+		})
+		if err != nil {
+			// Error is not fatal for this task so print and continue
+			t.log.Printlnf("%s %s", shadowLogPrefix, err.Error())
+		}
+	}
+
 	// Log
 	t.log.Println("Checking for rewards checkpoint...")
 
@@ -191,7 +209,23 @@ func (t *submitRewardsTree_Stateless) Run(nodeTrusted bool, state *state.Network
 	if t.isExistingRewardsFileValid(rewardsTreePathJSON, uint64(intervalsPassed)) {
 		if !nodeTrusted {
 			t.log.Printlnf("Merkle rewards tree for interval %d already exists at %s.", currentIndex, rewardsTreePathJSON)
-			return nil
+			if t.shadow == nil {
+				return nil
+			}
+
+			// Make sure the tree has been saved in shadow mode
+			_, exists, err := t.shadow.Get(shadow.Duty_RewardsTree, getRewardsShadowTarget(currentIndex))
+			if err != nil {
+				return err
+			}
+			if exists {
+				return nil
+			}
+			localRewardsFile, err := rprewards.ReadLocalRewardsFile(rewardsTreePathJSON)
+			if err != nil {
+				return fmt.Errorf("Error reading rewards tree file: %w", err)
+			}
+			return t.saveShadowReport(currentIndexBig, snapshotBeaconBlock, elBlockIndex, localRewardsFile.Impl(), big.NewInt(int64(intervalsPassed)))
 		}
 
 		// Return if this node has already submitted the tree for the current interval and there's a file present
@@ -333,6 +367,10 @@ func (t *submitRewardsTree_Stateless) generateTreeImpl(rp *rocketpool.RocketPool
 	if err != nil {
 		return fmt.Errorf("Error creating Merkle tree generator: %w", err)
 	}
+	checkpointInterval := t.cfg.Smartnode.RewardsTreeCheckpointInterval.Value.(uint64)
+	if checkpointInterval > 0 {
+		treegen.EnableCheckpoints(t.cfg.Smartnode.GetRewardsCheckpointPath(currentIndex, true), checkpointInterval)
+	}
 	treeResult, err := treegen.GenerateTree()
 	if err != nil {
 		return fmt.Errorf("Error generating Merkle tree: %w", err)
@@ -359,18 +397,24 @@ func (t *submitRewardsTree_Stateless) generateTreeImpl(rp *rocketpool.RocketPool
 		t.printMessage(fmt.Sprintf("Successfully submitted rewards snapshot for interval %d.", currentIndex))
 	} else {
 		t.printMessage(fmt.Sprintf("Successfully generated rewards snapshot for interval %d.", currentIndex))
+		if t.shadow != nil {
+			err = t.saveShadowReport(big.NewInt(int64(currentIndex)), snapshotBeaconBlock, elBlockIndex, rewardsFile, big.NewInt(int64(intervalsPassed)))
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
 
 }
 
-// Submit rewards info to the contracts
-func (t *submitRewardsTree_Stateless) submitRewardsSnapshot(index *big.Int, consensusBlock uint64, executionBlock uint64, rewardsFile rprewards.IRewardsFile, cid string, intervalsPassed *big.Int) error {
+// Create the submission for a rewards file
+func createRewardSubmission(index *big.Int, consensusBlock uint64, executionBlock uint64, rewardsFile rprewards.IRewardsFile, cid string, intervalsPassed *big.Int) (rewards.RewardSubmission, error) {
 
 	treeRootBytes, err := hex.DecodeString(hexutil.RemovePrefix(rewardsFile.GetMerkleRoot()))
 	if err != nil {
-		return fmt.Errorf("Error decoding merkle root: %w", err)
+		return rewards.RewardSubmission{}, fmt.Errorf("Error decoding merkle root: %w", err)
 	}
 	treeRoot := common.BytesToHash(treeRootBytes)
 
@@ -387,14 +431,7 @@ func (t *submitRewardsTree_Stateless) submitRewardsSnapshot(index *big.Int, cons
 		smoothingPoolEthRewards = append(smoothingPoolEthRewards, rewardsFile.GetNetworkSmoothingPoolEth(network))
 	}
 
-	// Get transactor
-	opts, err := t.w.GetNodeAccountTransactor()
-	if err != nil {
-		return err
-	}
-
-	// Create the submission
-	submission := rewards.RewardSubmission{
+	return rewards.RewardSubmission{
 		RewardIndex:     index,
 		ExecutionBlock:  big.NewInt(0).SetUint64(executionBlock),
 		ConsensusBlock:  big.NewInt(0).SetUint64(consensusBlock),
@@ -406,6 +443,22 @@ func (t *submitRewardsTree_Stateless) submitRewardsSnapshot(index *big.Int, cons
 		TrustedNodeRPL:  oDaoRplRewards,
 		NodeETH:         smoothingPoolEthRewards,
 		UserETH:         rewardsFile.GetTotalPoolStakerSmoothingPoolEth(),
+	}, nil
+}
+
+// Submit rewards info to the contracts
+func (t *submitRewardsTree_Stateless) submitRewardsSnapshot(index *big.Int, consensusBlock uint64, executionBlock uint64, rewardsFile rprewards.IRewardsFile, cid string, intervalsPassed *big.Int) error {
+
+	// Create the submission
+	submission, err := createRewardSubmission(index, consensusBlock, executionBlock, rewardsFile, cid, intervalsPassed)
+	if err != nil {
+		return err
+	}
+
+	// Get transactor
+	opts, err := t.w.GetNodeAccountTransactor()
+	if err != nil {
+		return err
 	}
 
 	// Get the gas limit
@@ -449,6 +502,49 @@ func (t *submitRewardsTree_Stateless) submitRewardsSnapshot(index *big.Int, cons
 	return nil
 }
 
+// Save the submission for a rewards file that would have been submitted in shadow mode
+func (t *submitRewardsTree_Stateless) saveShadowReport(index *big.Int, consensusBlock uint64, executionBlock uint64, rewardsFile rprewards.IRewardsFile, intervalsPassed *big.Int) error {
+	submission, err := createRewardSubmission(index, consensusBlock, executionBlock, rewardsFile, "", intervalsPassed)
+	if err != nil {
+		return err
+	}
+	return saveShadowReport(t.log, t.shadow, shadow.Duty_RewardsTree, getRewardsShadowTarget(index.Uint64()), submission)
+}
+
+// Get the shadow report target for a rewards interval
+func getRewardsShadowTarget(index uint64) string {
+	return fmt.Sprintf("interval %d", index)
+}
+
+// Compare a shadow tree with what each Oracle DAO member submitted for the same interval
+func (t *submitRewardsTree_Stateless) compareShadowReport(report shadow.Report, state *state.NetworkState) (shadow.Comparison, error) {
+	var submission rewards.RewardSubmission
+	if err := report.GetPayload(&submission); err != nil {
+		return shadow.Comparison{}, err
+	}
+	index := submission.RewardIndex.Uint64()
+	members, err := trustednode.GetMemberAddresses(t.rp, nil)
+	if err != nil {
+		return shadow.Comparison{}, fmt.Errorf("error getting Oracle DAO members: %w", err)
+	}
+
+	comparison, err := shadow.CompareMembers(members,
+		func(member common.Address) (bool, error) {
+			return rewards.GetTrustedNodeSubmitted(t.rp, member, index, nil)
+		},
+		func(member common.Address) (bool, error) {
+			return rewards.GetTrustedNodeSubmittedSpecificRewards(t.rp, member, submission, nil)
+		},
+	)
+	if err != nil {
+		return shadow.Comparison{}, err
+	}
+
+	// The interval is over once consensus is reached
+	comparison.Final = state.NetworkDetails.RewardIndex > index
+	return comparison, nil
+}
+
 // Get the first finalized, successful consensus block that occurred after the given target time
 func (t *submitRewardsTree_Stateless) getSnapshotEnd(endTime time.Time, state *state.NetworkState) (*rprewards.SnapshotEnd, error) {
 
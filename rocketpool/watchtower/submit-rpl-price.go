@@ -19,9 +19,13 @@ import (
 	"github.com/rocket-pool/smartnode/bindings/dao/trustednode"
 	"github.com/rocket-pool/smartnode/bindings/network"
 	"github.com/rocket-pool/smartnode/bindings/rocketpool"
+	"github.com/rocket-pool/smartnode/bindings/tokens"
 	"github.com/rocket-pool/smartnode/bindings/utils/eth"
 	"github.com/urfave/cli"
 
+	"github.com/rocket-pool/smartnode/rocketpool/watchtower/collectors"
+	"github.com/rocket-pool/smartnode/rocketpool/watchtower/messengers"
+	"github.com/rocket-pool/smartnode/rocketpool/watchtower/shadow"
 	"github.com/rocket-pool/smartnode/rocketpool/watchtower/utils"
 	"github.com/rocket-pool/smartnode/shared/services"
 	"github.com/rocket-pool/smartnode/shared/services/beacon"
@@ -36,174 +40,6 @@ import (
 )
 
 const (
-	OptimismMessengerAbi string = `[
-		{
-		"inputs": [],
-		"name": "rateStale",
-		"outputs": [
-			{
-			"internalType": "bool",
-			"name": "",
-			"type": "bool"
-			}
-		],
-		"stateMutability": "view",
-		"type": "function"
-		},
-		{
-		"inputs": [],
-		"name": "submitRate",
-		"outputs": [],
-		"stateMutability": "nonpayable",
-		"type": "function"
-		}
-	]`
-
-	PolygonMessengerAbi string = `[
-		{
-		"inputs": [],
-		"name": "rateStale",
-		"outputs": [
-			{
-			"internalType": "bool",
-			"name": "",
-			"type": "bool"
-			}
-		],
-		"stateMutability": "view",
-		"type": "function"
-		},
-		{
-		"inputs": [],
-		"name": "submitRate",
-		"outputs": [],
-		"stateMutability": "nonpayable",
-		"type": "function"
-		}
-	]`
-
-	ArbitrumMessengerAbi string = `[
-		{
-		"inputs": [],
-		"name": "rateStale",
-		"outputs": [
-			{
-			"internalType": "bool",
-			"name": "",
-			"type": "bool"
-			}
-		],
-		"stateMutability": "view",
-		"type": "function"
-		},
-		{
-		"inputs": [
-			{
-			"internalType": "uint256",
-			"name": "_maxSubmissionCost",
-			"type": "uint256"
-			},
-			{
-			"internalType": "uint256",
-			"name": "_gasLimit",
-			"type": "uint256"
-			},
-			{
-			"internalType": "uint256",
-			"name": "_gasPriceBid",
-			"type": "uint256"
-			}
-		],
-		"name": "submitRate",
-		"outputs": [],
-		"stateMutability": "payable",
-		"type": "function"
-		}
-	]`
-
-	zkSyncEraMessengerAbi string = `[
-		{
-			"inputs": [],
-			"name": "rateStale",
-			"outputs": [
-			{
-				"internalType": "bool",
-				"name": "",
-				"type": "bool"
-			}
-			],
-			"stateMutability": "view",
-			"type": "function"
-		},
-		{
-			"inputs": [
-			{
-				"internalType": "uint256",
-				"name": "_l2GasLimit",
-				"type": "uint256"
-			},
-			{
-				"internalType": "uint256",
-				"name": "_l2GasPerPubdataByteLimit",
-				"type": "uint256"
-			}
-			],
-			"name": "submitRate",
-			"outputs": [],
-			"stateMutability": "payable",
-			"type": "function"
-		}
-	]`
-
-	ScrollMessengerAbi string = `[
-		{
-		"inputs": [],
-		"name": "rateStale",
-		"outputs": [
-			{
-			"internalType": "bool",
-			"name": "",
-			"type": "bool"
-			}
-		],
-		"stateMutability": "view",
-		"type": "function"
-		},
-		{
-		"inputs": [
-			{
-			"internalType": "uint256",
-			"name": "_l2GasLimit",
-			"type": "uint256"
-			}
-		],
-		"name": "submitRate",
-		"outputs": [],
-		"stateMutability": "payable",
-		"type": "function"
-		}
-	]`
-
-	ScrollFeeEstimatorAbi string = `[
-		{
-			"inputs": [
-				{
-				"internalType": "uint256",
-				"name": "_l2GasLimit",
-				"type": "uint256"
-				}
-			],
-			"name": "estimateCrossDomainMessageFee",
-			"outputs": [ 
-				{
-				"internalType":"uint256","name":"","type":"uint256"
-				}
-			]
-			,"stateMutability":"view",
-			"type": "function"
-		}
-	]`
-
 	RplTwapPoolAbi string = `[
 		{
 		"inputs": [{
@@ -240,6 +76,13 @@ type poolObserveResponse struct {
 	SecondsPerLiquidityCumulativeX128s []*big.Int `abi:"secondsPerLiquidityCumulativeX128s"`
 }
 
+// The RPL price that would have been submitted in shadow mode
+type shadowRplPrice struct {
+	Block         uint64   `json:"block"`
+	SlotTimestamp uint64   `json:"slotTimestamp"`
+	RplPrice      *big.Int `json:"rplPrice"`
+}
+
 // Submit RPL price task
 type submitRplPrice struct {
 	c         *cli.Context
@@ -250,12 +93,14 @@ type submitRplPrice struct {
 	ec        rocketpool.ExecutionClient
 	rp        *rocketpool.RocketPool
 	bc        beacon.Client
+	coll      *collectors.PriceMessengerCollector
+	shadow    *shadow.Store
 	lock      *sync.Mutex
 	isRunning bool
 }
 
 // Create submit RPL price task
-func newSubmitRplPrice(c *cli.Context, logger log.ColorLogger, errorLogger log.ColorLogger) (*submitRplPrice, error) {
+func newSubmitRplPrice(c *cli.Context, logger log.ColorLogger, errorLogger log.ColorLogger, coll *collectors.PriceMessengerCollector, shadowStore *shadow.Store) (*submitRplPrice, error) {
 
 	// Get services
 	cfg, err := services.GetConfig(c)
@@ -290,6 +135,8 @@ func newSubmitRplPrice(c *cli.Context, logger log.ColorLogger, errorLogger log.C
 		w:      w,
 		rp:     rp,
 		bc:     bc,
+		coll:   coll,
+		shadow: shadowStore,
 		lock:   lock,
 	}, nil
 
@@ -309,58 +156,25 @@ func (t *submitRplPrice) run(state *state.NetworkState) error {
 		return err
 	}
 
+	// Compare earlier shadow payloads with what the Oracle DAO has submitted since
+	if t.shadow != nil {
+		err = compareShadowReports(t.log, t.shadow, shadow.Duty_RplPrice, func(report shadow.Report) (shadow.Comparison, error) {
+			return t.compareShadowReport(report, state)
+		})
+		if err != nil {
+			// Error is not fatal for this task so print and continue
+			t.log.Printlnf("%s %s", shadowLogPrefix, err.Error())
+		}
+	}
+
 	// Check if submission is enabled
 	if !state.NetworkDetails.SubmitPricesEnabled {
 		return nil
 	}
 
-	// Check if Optimism rate is stale and submit
-	err = t.submitOptimismPrice()
-	if err != nil {
-		// Error is not fatal for this task so print and continue
-		t.log.Printlnf("Error submitting Optimism price: %s", err.Error())
-	}
-
-	// Check if Polygon rate is stale and submit
-	err = t.submitPolygonPrice()
-	if err != nil {
-		// Error is not fatal for this task so print and continue
-		t.log.Printlnf("Error submitting Polygon price: %s", err.Error())
-	}
-
-	// Check if Arbitrum rate is stale and submit. This messenger will be deprecated soon.
-	err = t.submitArbitrumPrice(t.cfg.Smartnode.GetArbitrumMessengerAddress())
-	if err != nil {
-		// Error is not fatal for this task so print and continue
-		t.log.Printlnf("Error submitting Arbitrum V1 price: %s", err.Error())
-	}
-
-	// Temporarily submit to both Arbitrum messengers until the first one sunsets
-	err = t.submitArbitrumPrice(t.cfg.Smartnode.GetArbitrumMessengerAddressV2())
-	if err != nil {
-		// Error is not fatal for this task so print and continue
-		t.log.Printlnf("Error submitting Arbitrum price to messenger v2: %s", err.Error())
-	}
-
-	// Check if zkSync rate is stale and submit
-	err = t.submitZkSyncEraPrice()
-	if err != nil {
-		// Error is not fatal for this task so print and continue
-		t.log.Printlnf("Error submitting zkSync Era price: %s", err.Error())
-	}
-
-	// Check if Base rate is stale and submit
-	err = t.submitBasePrice()
-	if err != nil {
-		// Error is not fatal for this task so print and continue
-		t.log.Printlnf("Error submitting Base price: %s", err.Error())
-	}
-
-	// Check if Scroll rate is stale and submit
-	err = t.submitScrollPrice()
-	if err != nil {
-		// Error is not fatal for this task so print and continue
-		t.log.Printlnf("Error submitting Scroll price: %s", err.Error())
+	// Check if any L2 rates are stale and submit (not needed in shadow mode)
+	if t.shadow == nil {
+		t.submitMessengerPrices()
 	}
 
 	// Log
@@ -399,8 +213,8 @@ func (t *submitRplPrice) run(state *state.NetworkState) error {
 			}
 		}
 	}
-	if hasSubmittedPastBlock || lastSubmissionBlock == 0 || !eventFound {
-		// If the node participated in consensus, find the next submission target
+	if t.shadow != nil || hasSubmittedPastBlock || lastSubmissionBlock == 0 || !eventFound {
+		// If the node participated in consensus (or is in shadow mode), find the next submission target
 		var targetBlockHeader *types.Header
 		_, nextSubmissionTime, targetBlockHeader, err = utils.FindNextSubmissionTarget(t.rp, eth2Config, t.bc, t.ec, lastSubmissionBlock, referenceTimestamp, submissionIntervalInSeconds)
 		if err != nil {
@@ -417,6 +231,17 @@ func (t *submitRplPrice) run(state *state.NetworkState) error {
 
 	}
 
+	// In shadow mode, each target only needs to be calculated once
+	if t.shadow != nil {
+		_, exists, err := t.shadow.Get(shadow.Duty_RplPrice, getPriceShadowTarget(targetBlockNumber))
+		if err != nil {
+			return err
+		}
+		if exists {
+			return nil
+		}
+	}
+
 	// Check if the process is already running
 	t.lock.Lock()
 	if t.isRunning {
@@ -448,6 +273,24 @@ func (t *submitRplPrice) run(state *state.NetworkState) error {
 
 		submissionTimestamp := uint64(nextSubmissionTime.Unix())
 
+		// In shadow mode, save the price instead of submitting it
+		if t.shadow != nil {
+			payload := shadowRplPrice{
+				Block:         targetBlockNumber,
+				SlotTimestamp: submissionTimestamp,
+				RplPrice:      rplPrice,
+			}
+			if err := saveShadowReport(t.log, t.shadow, shadow.Duty_RplPrice, getPriceShadowTarget(targetBlockNumber), payload); err != nil {
+				t.handleError(fmt.Errorf("%s %w", logPrefix, err))
+				return
+			}
+			t.log.Printlnf("%s Price report complete.", logPrefix)
+			t.lock.Lock()
+			t.isRunning = false
+			t.lock.Unlock()
+			return
+		}
+
 		// Check if we have reported these specific values before
 		hasSubmittedSpecific, err := t.hasSubmittedSpecificBlockPrices(nodeAccount.Address, targetBlockNumber, submissionTimestamp, rplPrice)
 		if err != nil {
@@ -527,6 +370,39 @@ func (t *submitRplPrice) hasSubmittedSpecificBlockPrices(nodeAddress common.Addr
 
 }
 
+// Get the shadow report target for a block
+func getPriceShadowTarget(blockNumber uint64) string {
+	return fmt.Sprintf("block %d", blockNumber)
+}
+
+// Compare a shadow price with what each Oracle DAO member submitted for the same block
+func (t *submitRplPrice) compareShadowReport(report shadow.Report, state *state.NetworkState) (shadow.Comparison, error) {
+	var payload shadowRplPrice
+	if err := report.GetPayload(&payload); err != nil {
+		return shadow.Comparison{}, err
+	}
+	members, err := trustednode.GetMemberAddresses(t.rp, nil)
+	if err != nil {
+		return shadow.Comparison{}, fmt.Errorf("error getting Oracle DAO members: %w", err)
+	}
+
+	comparison, err := shadow.CompareMembers(members,
+		func(member common.Address) (bool, error) {
+			return t.hasSubmittedBlockPrices(member, payload.Block, payload.SlotTimestamp)
+		},
+		func(member common.Address) (bool, error) {
+			return t.hasSubmittedSpecificBlockPrices(member, payload.Block, payload.SlotTimestamp, payload.RplPrice)
+		},
+	)
+	if err != nil {
+		return shadow.Comparison{}, err
+	}
+
+	// Members stop submitting once consensus is reached
+	comparison.Final = state.NetworkDetails.PricesBlock >= payload.Block
+	return comparison, nil
+}
+
 // Get RPL price via TWAP at block
 func (t *submitRplPrice) getRplTwap(blockNumber uint64) (*big.Int, error) {
 
@@ -649,55 +525,33 @@ func (t *submitRplPrice) submitRplPrice(blockNumber uint64, slotTimestamp uint64
 
 }
 
-// Checks if Optimism rate is stale and if it's our turn to submit, calls submitRate on the messenger
-func (t *submitRplPrice) submitOptimismPrice() error {
-	priceMessengerAddress := t.cfg.Smartnode.GetOptimismMessengerAddress()
-
-	if priceMessengerAddress == "" {
-		// No price messenger deployed on the current network
-		return nil
-	}
-
-	// Get transactor
-	opts, err := t.w.GetNodeAccountTransactor()
-	if err != nil {
-		return fmt.Errorf("Failed getting transactor: %q", err)
-	}
-
-	// Construct the price messenger contract instance
-	parsed, err := abi.JSON(strings.NewReader(OptimismMessengerAbi))
-	if err != nil {
-		return fmt.Errorf("Failed decoding ABI: %q", err)
-	}
-
-	addr := common.HexToAddress(priceMessengerAddress)
-	priceMessengerContract := bind.NewBoundContract(addr, parsed, t.ec, t.ec, t.ec)
-	priceMessenger := rocketpool.Contract{
-		Contract: priceMessengerContract,
-		Address:  &addr,
-		ABI:      &parsed,
-		Client:   t.ec,
-	}
-
-	// Check if the rate is stale
-	var out []interface{}
-	err = priceMessengerContract.Call(nil, &out, "rateStale")
-
-	if err != nil {
-		return fmt.Errorf("Failed to query rate staleness for Optimism: %q", err)
+// Checks each L2 price messenger's rate and, if it's stale and our turn to submit, calls its submit method
+func (t *submitRplPrice) submitMessengerPrices() {
+	var turn *messengerTurn
+	for _, definition := range t.cfg.Smartnode.GetPriceMessengers() {
+		messenger, err := messengers.NewMessenger(definition, t.ec)
+		if err == nil {
+			turn, err = t.submitMessengerPrice(messenger, turn)
+		}
+		if err != nil {
+			// Error is not fatal for this task so print and continue
+			t.log.Printlnf("Error submitting %s price: %s", definition.Chain, err.Error())
+		}
 	}
+}
 
-	rateStale := *abi.ConvertType(out[0], new(bool)).(*bool)
-
-	if !rateStale {
-		// Nothing to do
-		return nil
-	}
+// Whose turn it is to submit to the price messengers
+type messengerTurn struct {
+	blockNumber uint64
+	isOurTurn   bool
+}
 
+// Get whether or not it's this node's turn to submit to the price messengers
+func (t *submitRplPrice) getMessengerTurn(nodeAddress common.Address) (*messengerTurn, error) {
 	// Get total number of ODAO members
 	count, err := trustednode.GetMemberCount(t.rp, nil)
 	if err != nil {
-		return fmt.Errorf("Failed to get member count: %q", err)
+		return nil, fmt.Errorf("Failed to get member count: %w", err)
 	}
 
 	// Find out which index we are
@@ -705,10 +559,10 @@ func (t *submitRplPrice) submitOptimismPrice() error {
 	for i := uint64(0); i < count; i++ {
 		addr, err := trustednode.GetMemberAt(t.rp, i, nil)
 		if err != nil {
-			return fmt.Errorf("Failed to get member at %d: %q", i, err)
+			return nil, fmt.Errorf("Failed to get member at %d: %w", i, err)
 		}
 
-		if bytes.Compare(addr.Bytes(), opts.From.Bytes()) == 0 {
+		if bytes.Equal(addr.Bytes(), nodeAddress.Bytes()) {
 			index = i
 			break
 		}
@@ -717,728 +571,122 @@ func (t *submitRplPrice) submitOptimismPrice() error {
 	// Get current block number
 	blockNumber, err := t.ec.BlockNumber(context.Background())
 	if err != nil {
-		return fmt.Errorf("Failed to get block number: %q", err)
+		return nil, fmt.Errorf("Failed to get block number: %w", err)
 	}
 
 	// Calculate whose turn it is to submit
 	indexToSubmit := (blockNumber / BlocksPerTurn) % count
-
-	if index == indexToSubmit {
-
-		// Temporary gas calculations until this gets put into a binding
-		input, err := priceMessenger.ABI.Pack("submitRate")
-		if err != nil {
-			return fmt.Errorf("Could not encode input data: %w", err)
-		}
-
-		// Estimate gas limit
-		gasInfo, err := t.estimateGasLimit(opts, priceMessenger.Address, input)
-		if err != nil {
-			return fmt.Errorf("Error estimating gas limit of submitOptimismPrice: %w", err)
-		}
-
-		// Print the gas info
-		maxFee := eth.GweiToWei(utils.GetWatchtowerMaxFee(t.cfg))
-		if !api.PrintAndCheckGasInfo(gasInfo, false, 0, t.log, maxFee, 0) {
-			return nil
-		}
-
-		// Set the gas settings
-		opts.GasFeeCap = maxFee
-		opts.GasTipCap = eth.GweiToWei(utils.GetWatchtowerPrioFee(t.cfg))
-		opts.GasLimit = gasInfo.SafeGasLimit
-
-		t.log.Println("Submitting rate to Optimism...")
-
-		// Submit rates
-		tx, err := priceMessenger.Transact(opts, "submitRate")
-		if err != nil {
-			return fmt.Errorf("Failed to submit rate: %q", err)
-		}
-
-		// Print TX info and wait for it to be included in a block
-		err = api.PrintAndWaitForTransaction(t.cfg, tx.Hash(), t.rp.Client, t.log)
-		if err != nil {
-			return err
-		}
-
-		// Log
-		t.log.Printlnf("Successfully submitted Optimism price for block %d.", blockNumber)
-
-	}
-
-	return nil
+	return &messengerTurn{
+		blockNumber: blockNumber,
+		isOurTurn:   index == indexToSubmit,
+	}, nil
 }
 
-// Checks if Polygon rate is stale and if it's our turn to submit, calls submitRate on the messenger
-func (t *submitRplPrice) submitPolygonPrice() error {
-	priceMessengerAddress := t.cfg.Smartnode.GetPolygonMessengerAddress()
-
-	if priceMessengerAddress == "" {
-		// No price messenger deployed on the current network
-		return nil
-	}
-
-	// Get transactor
-	opts, err := t.w.GetNodeAccountTransactor()
-	if err != nil {
-		return fmt.Errorf("Failed getting transactor: %q", err)
-	}
-
-	// Construct the price messenger contract instance
-	parsed, err := abi.JSON(strings.NewReader(PolygonMessengerAbi))
-	if err != nil {
-		return fmt.Errorf("Failed decoding ABI: %q", err)
-	}
-
-	addr := common.HexToAddress(priceMessengerAddress)
-	priceMessengerContract := bind.NewBoundContract(addr, parsed, t.ec, t.ec, t.ec)
-	priceMessenger := rocketpool.Contract{
-		Contract: priceMessengerContract,
-		Address:  &addr,
-		ABI:      &parsed,
-		Client:   t.ec,
-	}
-
+// Checks if a price messenger's rate is stale and if it's our turn to submit, submits the current rate to it.
+// The turn is looked up once and shared between messengers; the (possibly new) turn is returned.
+func (t *submitRplPrice) submitMessengerPrice(messenger *messengers.Messenger, turn *messengerTurn) (*messengerTurn, error) {
 	// Check if the rate is stale
-	var out []interface{}
-	err = priceMessengerContract.Call(nil, &out, "rateStale")
-
+	rateStale, err := messenger.IsRateStale(nil)
 	if err != nil {
-		return fmt.Errorf("Failed to query rate staleness for Polygon: %q", err)
+		return turn, err
 	}
-
-	rateStale := *abi.ConvertType(out[0], new(bool)).(*bool)
-
+	t.coll.UpdateLock.Lock()
+	t.coll.GetStatus(messenger.Chain).RateStale = rateStale
+	t.coll.UpdateLock.Unlock()
 	if !rateStale {
 		// Nothing to do
-		return nil
+		return turn, nil
 	}
 
-	// Get total number of ODAO members
-	count, err := trustednode.GetMemberCount(t.rp, nil)
+	// Get transactor
+	opts, err := t.w.GetNodeAccountTransactor()
 	if err != nil {
-		return fmt.Errorf("Failed to get member count: %q", err)
+		return turn, fmt.Errorf("Failed getting transactor: %w", err)
 	}
 
-	// Find out which index we are
-	var index = uint64(0)
-	for i := uint64(0); i < count; i++ {
-		addr, err := trustednode.GetMemberAt(t.rp, i, nil)
+	// Check if it's our turn
+	if turn == nil {
+		turn, err = t.getMessengerTurn(opts.From)
 		if err != nil {
-			return fmt.Errorf("Failed to get member at %d: %q", i, err)
-		}
-
-		if bytes.Compare(addr.Bytes(), opts.From.Bytes()) == 0 {
-			index = i
-			break
+			return nil, err
 		}
 	}
-
-	// Get current block number
-	blockNumber, err := t.ec.BlockNumber(context.Background())
-	if err != nil {
-		return fmt.Errorf("Failed to get block number: %q", err)
+	if !turn.isOurTurn {
+		return turn, nil
 	}
 
-	// Calculate whose turn it is to submit
-	indexToSubmit := (blockNumber / BlocksPerTurn) % count
-
-	if index == indexToSubmit {
-
-		// Temporary gas calculations until this gets put into a binding
-		input, err := priceMessenger.ABI.Pack("submitRate")
-		if err != nil {
-			return fmt.Errorf("Could not encode input data for Polygon price submission: %w", err)
-		}
-
-		// Estimate gas limit
-		gasInfo, err := t.estimateGasLimit(opts, priceMessenger.Address, input)
-		if err != nil {
-			return fmt.Errorf("Error estimating gas limit of submitPolygonPrice: %w", err)
-		}
-
-		// Print the gas info
-		maxFee := eth.GweiToWei(utils.GetWatchtowerMaxFee(t.cfg))
-		if !api.PrintAndCheckGasInfo(gasInfo, false, 0, t.log, maxFee, 0) {
-			return nil
-		}
-
-		// Set the gas settings
-		opts.GasFeeCap = maxFee
-		opts.GasTipCap = eth.GweiToWei(utils.GetWatchtowerPrioFee(t.cfg))
-		opts.GasLimit = gasInfo.SafeGasLimit
-
-		t.log.Println("Submitting rate to Polygon...")
-
-		// Submit rates
-		tx, err := priceMessenger.Transact(opts, "submitRate")
-		if err != nil {
-			return fmt.Errorf("Failed to submit rate to Polygon: %q", err)
-		}
-
-		// Print TX info and wait for it to be included in a block
-		err = api.PrintAndWaitForTransaction(t.cfg, tx.Hash(), t.rp.Client, t.log)
+	// Get the submission
+	maxFee := eth.GweiToWei(utils.GetWatchtowerMaxFee(t.cfg))
+	fees := messengers.Fees{
+		MaxFee: maxFee,
+	}
+	if messenger.NeedsNetworkMaxFee() {
+		fees.NetworkMaxFee, err = rpgas.GetHeadlessMaxFeeWei(t.cfg, t.ec)
 		if err != nil {
-			return err
+			return turn, fmt.Errorf("error getting recommended base fee from the network for %s price submission: %w", messenger.Chain, err)
 		}
-
-		// Log
-		t.log.Printlnf("Successfully submitted Polygon price for block %d.", blockNumber)
-
 	}
-
-	return nil
-}
-
-// Checks if Arbitrum rate is stale and if it's our turn to submit, calls submitRate on the messenger
-func (t *submitRplPrice) submitArbitrumPrice(priceMessengerAddress string) error {
-	if priceMessengerAddress == "" {
-		// No price messenger deployed on the current network
-		return nil
+	submission, err := messenger.GetSubmission(nil, fees)
+	if err != nil {
+		return turn, err
 	}
-
-	// Get transactor
-	opts, err := t.w.GetNodeAccountTransactor()
+	input, err := messenger.PackSubmission(submission)
 	if err != nil {
-		return fmt.Errorf("Failed getting transactor: %q", err)
+		return turn, err
 	}
 
-	// Construct the price messenger contract instance
-	parsed, err := abi.JSON(strings.NewReader(ArbitrumMessengerAbi))
+	// Estimate gas limit
+	opts.Value = submission.Value
+	gasInfo, err := t.estimateGasLimit(opts, &messenger.Address, input)
 	if err != nil {
-		return fmt.Errorf("Failed decoding ABI: %q", err)
+		return turn, fmt.Errorf("Error estimating gas limit of %s price submission: %w", messenger.Chain, err)
 	}
 
-	addr := common.HexToAddress(priceMessengerAddress)
-	priceMessengerContract := bind.NewBoundContract(addr, parsed, t.ec, t.ec, t.ec)
-	priceMessenger := rocketpool.Contract{
-		Contract: priceMessengerContract,
-		Address:  &addr,
-		ABI:      &parsed,
-		Client:   t.ec,
+	// Print the gas info
+	if !api.PrintAndCheckGasInfo(gasInfo, false, 0, t.log, maxFee, 0) {
+		return turn, nil
 	}
 
-	// Check if the rate is stale
-	var out []interface{}
-	err = priceMessengerContract.Call(nil, &out, "rateStale")
+	// Set the gas settings
+	opts.GasFeeCap = maxFee
+	opts.GasTipCap = eth.GweiToWei(utils.GetWatchtowerPrioFee(t.cfg))
+	opts.GasLimit = gasInfo.SafeGasLimit
+
+	t.log.Printlnf("Submitting rate to %s (messenger %s)...", messenger.Chain, messenger.Address.Hex())
 
+	// Get the rate being submitted
+	rate, err := tokens.GetRETHExchangeRate(t.rp, nil)
 	if err != nil {
-		return fmt.Errorf("Failed to query rate staleness for Arbitrum: %q", err)
+		return turn, fmt.Errorf("error getting rETH exchange rate: %w", err)
 	}
 
-	rateStale := *abi.ConvertType(out[0], new(bool)).(*bool)
-
-	if !rateStale {
-		// Nothing to do
-		return nil
+	// Submit rates
+	tx, err := messenger.Submit(opts, submission)
+	if err != nil {
+		return turn, err
 	}
 
-	// Get total number of ODAO members
-	count, err := trustednode.GetMemberCount(t.rp, nil)
+	// Print TX info and wait for it to be included in a block
+	err = api.PrintAndWaitForTransaction(t.cfg, tx.Hash(), t.rp.Client, t.log)
+	if err != nil {
+		return turn, err
+	}
+	receipt, err := t.ec.TransactionReceipt(context.Background(), tx.Hash())
 	if err != nil {
-		return fmt.Errorf("Failed to get member count: %q", err)
+		return turn, fmt.Errorf("error getting receipt for %s price submission: %w", messenger.Chain, err)
 	}
 
-	// Find out which index we are
-	var index = uint64(0)
-	for i := uint64(0); i < count; i++ {
-		addr, err := trustednode.GetMemberAt(t.rp, i, nil)
-		if err != nil {
-			return fmt.Errorf("Failed to get member at %d: %q", i, err)
-		}
-
-		if bytes.Compare(addr.Bytes(), opts.From.Bytes()) == 0 {
-			index = i
-			break
-		}
-	}
-
-	// Get current block number
-	blockNumber, err := t.ec.BlockNumber(context.Background())
-	if err != nil {
-		return fmt.Errorf("Failed to get block number: %q", err)
-	}
-
-	// Calculate whose turn it is to submit
-	indexToSubmit := (blockNumber / BlocksPerTurn) % count
-
-	if index == indexToSubmit {
-
-		// Get the current network recommended max fee
-		suggestedMaxFee, err := rpgas.GetHeadlessMaxFeeWei(t.cfg)
-		if err != nil {
-			return fmt.Errorf("error getting recommended base fee from the network for Arbitrum price submission: %w", err)
-		}
-
-		// Constants for Arbitrum
-		bufferMultiplier := big.NewInt(4)
-		dataLength := big.NewInt(36)
-		arbitrumGasLimit := big.NewInt(40000)
-		arbitrumMaxFeePerGas := eth.GweiToWei(0.1)
-
-		// Gas limit calculation on Arbitrum
-		maxSubmissionCost := big.NewInt(6)
-		maxSubmissionCost.Mul(maxSubmissionCost, dataLength)
-		maxSubmissionCost.Add(maxSubmissionCost, big.NewInt(1400))
-		maxSubmissionCost.Mul(maxSubmissionCost, suggestedMaxFee)  // (1400 + 6 * dataLength) * baseFee
-		maxSubmissionCost.Mul(maxSubmissionCost, bufferMultiplier) // Multiply by the buffer constant for safety
-
-		// Provide enough ETH for the L2 and roundtrip TX's
-		value := big.NewInt(0)
-		value.Mul(arbitrumGasLimit, arbitrumMaxFeePerGas)
-		value.Add(value, maxSubmissionCost)
-		opts.Value = value
-
-		// Temporary gas calculations until this gets put into a binding
-		input, err := priceMessenger.ABI.Pack("submitRate", maxSubmissionCost, arbitrumGasLimit, arbitrumMaxFeePerGas)
-		if err != nil {
-			return fmt.Errorf("Could not encode input data for Arbitrum price submission: %w", err)
-		}
-
-		// Estimate gas limit
-		gasInfo, err := t.estimateGasLimit(opts, priceMessenger.Address, input)
-		if err != nil {
-			return fmt.Errorf("Error estimating gas limit of submitArbitrumPrice: %w", err)
-		}
-
-		// Print the gas info
-		maxFee := eth.GweiToWei(utils.GetWatchtowerMaxFee(t.cfg))
-		if !api.PrintAndCheckGasInfo(gasInfo, false, 0, t.log, maxFee, 0) {
-			return nil
-		}
-
-		// Set the gas settings
-		opts.GasFeeCap = maxFee
-		opts.GasTipCap = eth.GweiToWei(utils.GetWatchtowerPrioFee(t.cfg))
-		opts.GasLimit = gasInfo.SafeGasLimit
-
-		t.log.Println("Submitting rate to Arbitrum %s...", priceMessengerAddress)
-
-		// Submit rates
-		tx, err := priceMessenger.Transact(opts, "submitRate", maxSubmissionCost, arbitrumGasLimit, arbitrumMaxFeePerGas)
-		if err != nil {
-			return fmt.Errorf("Failed to submit Arbitrum rate: %q", err)
-		}
-
-		// Print TX info and wait for it to be included in a block
-		err = api.PrintAndWaitForTransaction(t.cfg, tx.Hash(), t.rp.Client, t.log)
-		if err != nil {
-			return err
-		}
-
-		// Log
-		t.log.Printlnf("Successfully submitted Arbitrum price for block %d - messenger %s.", blockNumber, priceMessengerAddress)
-
-	}
-
-	return nil
-}
-
-// Checks if zkSync Era rate is stale and if it's our turn to submit, calls submitRate on the messenger
-func (t *submitRplPrice) submitZkSyncEraPrice() error {
-	priceMessengerAddress := t.cfg.Smartnode.GetZkSyncEraMessengerAddress()
-
-	if priceMessengerAddress == "" {
-		// No price messenger deployed on the current network
-		return nil
-	}
-
-	// Get transactor
-	opts, err := t.w.GetNodeAccountTransactor()
-	if err != nil {
-		return fmt.Errorf("Failed getting transactor: %q", err)
-	}
-
-	// Construct the price messenger contract instance
-	parsed, err := abi.JSON(strings.NewReader(zkSyncEraMessengerAbi))
-	if err != nil {
-		return fmt.Errorf("Failed decoding ABI: %q", err)
-	}
-
-	addr := common.HexToAddress(priceMessengerAddress)
-	priceMessengerContract := bind.NewBoundContract(addr, parsed, t.ec, t.ec, t.ec)
-	priceMessenger := rocketpool.Contract{
-		Contract: priceMessengerContract,
-		Address:  &addr,
-		ABI:      &parsed,
-		Client:   t.ec,
-	}
-
-	// Check if the rate is stale
-	var out []interface{}
-	err = priceMessengerContract.Call(nil, &out, "rateStale")
-
-	if err != nil {
-		return fmt.Errorf("Failed to query rate staleness for zkSync Era: %q", err)
-	}
-
-	rateStale := *abi.ConvertType(out[0], new(bool)).(*bool)
-
-	if !rateStale {
-		// Nothing to do
-		return nil
-	}
-
-	// Get total number of ODAO members
-	count, err := trustednode.GetMemberCount(t.rp, nil)
-	if err != nil {
-		return fmt.Errorf("Failed to get member count: %q", err)
-	}
-
-	// Find out which index we are
-	var index = uint64(0)
-	for i := uint64(0); i < count; i++ {
-		addr, err := trustednode.GetMemberAt(t.rp, i, nil)
-		if err != nil {
-			return fmt.Errorf("Failed to get member at %d: %q", i, err)
-		}
-
-		if bytes.Compare(addr.Bytes(), opts.From.Bytes()) == 0 {
-			index = i
-			break
-		}
-	}
-
-	// Get current block number
-	blockNumber, err := t.ec.BlockNumber(context.Background())
-	if err != nil {
-		return fmt.Errorf("Failed to get block number: %q", err)
-	}
-
-	// Calculate whose turn it is to submit
-	indexToSubmit := (blockNumber / BlocksPerTurn) % count
-
-	if index == indexToSubmit {
-
-		// Constants for zkSync Era
-		l1GasPerPubdataByte := big.NewInt(17)
-		fairL2GasPrice := eth.GweiToWei(0.5)
-		l2GasLimit := big.NewInt(750000)
-		gasPerPubdataByte := big.NewInt(800)
-		maxFee := eth.GweiToWei(utils.GetWatchtowerMaxFee(t.cfg))
-
-		// Value calculation on zkSync Era
-		pubdataPrice := big.NewInt(0).Mul(l1GasPerPubdataByte, maxFee)
-		minL2GasPrice := big.NewInt(0).Add(pubdataPrice, gasPerPubdataByte)
-		minL2GasPrice.Sub(minL2GasPrice, big.NewInt(1))
-		minL2GasPrice.Div(minL2GasPrice, gasPerPubdataByte)
-		gasPrice := big.NewInt(0).Set(fairL2GasPrice)
-		if minL2GasPrice.Cmp(gasPrice) > 0 {
-			gasPrice.Set(minL2GasPrice)
-		}
-		txValue := big.NewInt(0).Mul(l2GasLimit, gasPrice)
-		opts.Value = txValue
-
-		// Temporary gas calculations until this gets put into a binding
-		input, err := priceMessenger.ABI.Pack("submitRate", l2GasLimit, gasPerPubdataByte)
-		if err != nil {
-			return fmt.Errorf("Could not encode input data for zkSync Era price submission: %w", err)
-		}
-
-		// Estimate gas limit
-		gasInfo, err := t.estimateGasLimit(opts, priceMessenger.Address, input)
-		if err != nil {
-			return fmt.Errorf("Error estimating gas limit of submitZkSyncEraPrice: %w", err)
-		}
-
-		// Print the gas info
-		if !api.PrintAndCheckGasInfo(gasInfo, false, 0, t.log, maxFee, 0) {
-			return nil
-		}
-
-		// Set the gas settings
-		opts.GasFeeCap = maxFee
-		opts.GasTipCap = eth.GweiToWei(utils.GetWatchtowerPrioFee(t.cfg))
-		opts.GasLimit = gasInfo.SafeGasLimit
-
-		t.log.Println("Submitting rate to zkSync Era...")
-
-		// Submit rates
-		tx, err := priceMessenger.Transact(opts, "submitRate", l2GasLimit, gasPerPubdataByte)
-		if err != nil {
-			return fmt.Errorf("Failed to submit zkSync Era rate: %q", err)
-		}
-
-		// Print TX info and wait for it to be included in a block
-		err = api.PrintAndWaitForTransaction(t.cfg, tx.Hash(), t.rp.Client, t.log)
-		if err != nil {
-			return err
-		}
-
-		// Log
-		t.log.Printlnf("Successfully submitted zkSync Era price for block %d.", blockNumber)
-
-	}
-
-	return nil
-}
-
-// Checks if Base rate is stale and if it's our turn to submit, calls submitRate on the messenger
-func (t *submitRplPrice) submitBasePrice() error {
-	priceMessengerAddress := t.cfg.Smartnode.GetBaseMessengerAddress()
-
-	if priceMessengerAddress == "" {
-		// No price messenger deployed on the current network
-		return nil
-	}
-
-	// Get transactor
-	opts, err := t.w.GetNodeAccountTransactor()
-	if err != nil {
-		return fmt.Errorf("Failed getting transactor: %q", err)
-	}
-
-	// Construct the price messenger contract instance - this is the same as the Optimism messenger
-	parsed, err := abi.JSON(strings.NewReader(OptimismMessengerAbi))
-	if err != nil {
-		return fmt.Errorf("Failed decoding ABI: %q", err)
-	}
-
-	addr := common.HexToAddress(priceMessengerAddress)
-	priceMessengerContract := bind.NewBoundContract(addr, parsed, t.ec, t.ec, t.ec)
-	priceMessenger := rocketpool.Contract{
-		Contract: priceMessengerContract,
-		Address:  &addr,
-		ABI:      &parsed,
-		Client:   t.ec,
-	}
-
-	// Check if the rate is stale
-	var out []interface{}
-	err = priceMessengerContract.Call(nil, &out, "rateStale")
-
-	if err != nil {
-		return fmt.Errorf("Failed to query rate staleness for Base: %q", err)
-	}
-
-	rateStale := *abi.ConvertType(out[0], new(bool)).(*bool)
-
-	if !rateStale {
-		// Nothing to do
-		return nil
-	}
-
-	// Get total number of ODAO members
-	count, err := trustednode.GetMemberCount(t.rp, nil)
-	if err != nil {
-		return fmt.Errorf("Failed to get member count: %q", err)
-	}
-
-	// Find out which index we are
-	var index = uint64(0)
-	for i := uint64(0); i < count; i++ {
-		addr, err := trustednode.GetMemberAt(t.rp, i, nil)
-		if err != nil {
-			return fmt.Errorf("Failed to get member at %d: %q", i, err)
-		}
-
-		if bytes.Compare(addr.Bytes(), opts.From.Bytes()) == 0 {
-			index = i
-			break
-		}
-	}
-
-	// Get current block number
-	blockNumber, err := t.ec.BlockNumber(context.Background())
-	if err != nil {
-		return fmt.Errorf("Failed to get block number: %q", err)
-	}
-
-	// Calculate whose turn it is to submit
-	indexToSubmit := (blockNumber / BlocksPerTurn) % count
-
-	if index == indexToSubmit {
-
-		// Temporary gas calculations until this gets put into a binding
-		input, err := priceMessenger.ABI.Pack("submitRate")
-		if err != nil {
-			return fmt.Errorf("Could not encode input data: %w", err)
-		}
-
-		// Estimate gas limit
-		gasInfo, err := t.estimateGasLimit(opts, priceMessenger.Address, input)
-		if err != nil {
-			return fmt.Errorf("Error estimating gas limit of submitBasePrice: %w", err)
-		}
-
-		// Print the gas info
-		maxFee := eth.GweiToWei(utils.GetWatchtowerMaxFee(t.cfg))
-		if !api.PrintAndCheckGasInfo(gasInfo, false, 0, t.log, maxFee, 0) {
-			return nil
-		}
-
-		// Set the gas settings
-		opts.GasFeeCap = maxFee
-		opts.GasTipCap = eth.GweiToWei(utils.GetWatchtowerPrioFee(t.cfg))
-		opts.GasLimit = gasInfo.SafeGasLimit
-
-		t.log.Println("Submitting rate to Base...")
-
-		// Submit rates
-		tx, err := priceMessenger.Transact(opts, "submitRate")
-		if err != nil {
-			return fmt.Errorf("Failed to submit rate: %q", err)
-		}
-
-		// Print TX info and wait for it to be included in a block
-		err = api.PrintAndWaitForTransaction(t.cfg, tx.Hash(), t.rp.Client, t.log)
-		if err != nil {
-			return err
-		}
-
-		// Log
-		t.log.Printlnf("Successfully submitted Base price for block %d.", blockNumber)
-
-	}
-
-	return nil
-}
-
-// Checks if Scroll rate is stale and if it's our turn to submit, calls submitRate on the messenger
-func (t *submitRplPrice) submitScrollPrice() error {
-	priceMessengerAddress := t.cfg.Smartnode.GetScrollMessengerAddress()
-
-	if priceMessengerAddress == "" {
-		// No price messenger deployed on the current network
-		return nil
-	}
-
-	// Get transactor
-	opts, err := t.w.GetNodeAccountTransactor()
-	if err != nil {
-		return fmt.Errorf("Failed getting transactor: %q", err)
-	}
-
-	// Construct the price messenger contract instance
-	parsed, err := abi.JSON(strings.NewReader(ScrollMessengerAbi))
-	if err != nil {
-		return fmt.Errorf("Failed decoding Scroll messenger ABI: %q", err)
-	}
+	// Update the metrics
+	t.coll.UpdateLock.Lock()
+	status := t.coll.GetStatus(messenger.Chain)
+	status.RateStale = false
+	status.LastSubmittedRate = rate
+	status.LastSubmittedBlock = receipt.BlockNumber.Uint64()
+	t.coll.UpdateLock.Unlock()
 
-	addr := common.HexToAddress(priceMessengerAddress)
-	priceMessengerContract := bind.NewBoundContract(addr, parsed, t.ec, t.ec, t.ec)
-	priceMessenger := rocketpool.Contract{
-		Contract: priceMessengerContract,
-		Address:  &addr,
-		ABI:      &parsed,
-		Client:   t.ec,
-	}
-
-	// Check if the rate is stale
-	var out []interface{}
-	err = priceMessengerContract.Call(nil, &out, "rateStale")
-
-	if err != nil {
-		return fmt.Errorf("Failed to query rate staleness for Scroll: %q", err)
-	}
-
-	rateStale := *abi.ConvertType(out[0], new(bool)).(*bool)
-
-	if !rateStale {
-		// Nothing to do
-		return nil
-	}
-
-	// Get total number of ODAO members
-	count, err := trustednode.GetMemberCount(t.rp, nil)
-	if err != nil {
-		return fmt.Errorf("Failed to get member count: %q", err)
-	}
-
-	// Find out which index we are
-	var index = uint64(0)
-	for i := uint64(0); i < count; i++ {
-		addr, err := trustednode.GetMemberAt(t.rp, i, nil)
-		if err != nil {
-			return fmt.Errorf("Failed to get member at %d: %q", i, err)
-		}
-
-		if bytes.Compare(addr.Bytes(), opts.From.Bytes()) == 0 {
-			index = i
-			break
-		}
-	}
-
-	// Get current block number
-	blockNumber, err := t.ec.BlockNumber(context.Background())
-	if err != nil {
-		return fmt.Errorf("Failed to get block number: %q", err)
-	}
-
-	// Calculate whose turn it is to submit
-	indexToSubmit := (blockNumber / BlocksPerTurn) % count
-
-	if index == indexToSubmit {
-		l2GasEstimatorAddress := t.cfg.Smartnode.GetScrollFeeEstimatorAddress()
-		l2GasEstimatorAddr := common.HexToAddress(l2GasEstimatorAddress)
-		// Construct the gas estimator contract instance
-		feeEstimatorParsed, err := abi.JSON(strings.NewReader(ScrollFeeEstimatorAbi))
-		if err != nil {
-			return fmt.Errorf("Failed decoding Scroll fee estimator ABI: %q", err)
-		}
-		l2FeeEstimatorContract := bind.NewBoundContract(l2GasEstimatorAddr, feeEstimatorParsed, t.ec, t.ec, t.ec)
-		l2FeeEstimator := rocketpool.Contract{
-			Contract: l2FeeEstimatorContract,
-			Address:  &addr,
-			ABI:      &feeEstimatorParsed,
-			Client:   t.ec,
-		}
-		// Set a fixed gas limit a bit above the estimated 85,283
-		l2GasLimit := big.NewInt(90000)
-
-		// Query the L2 message fee
-		var messageFee *big.Int
-		err = l2FeeEstimator.Call(nil, &messageFee, "estimateCrossDomainMessageFee", l2GasLimit)
-		if err != nil {
-			return fmt.Errorf("Error getting cross domain message fee for Scroll: %w", err)
-		}
-
-		// Temporary gas calculations until this gets put into a binding
-		input, err := priceMessenger.ABI.Pack("submitRate", l2GasLimit)
-		if err != nil {
-			return fmt.Errorf("Could not encode input data: %w", err)
-		}
-		// Set the tx value using the fetched message fee
-		opts.Value = messageFee
-
-		// Estimate gas limit
-		gasInfo, err := t.estimateGasLimit(opts, priceMessenger.Address, input)
-		if err != nil {
-			return fmt.Errorf("Error estimating gas limit of submitScrollPrice: %w", err)
-		}
-
-		// Print the gas info
-		maxFee := eth.GweiToWei(utils.GetWatchtowerMaxFee(t.cfg))
-		if !api.PrintAndCheckGasInfo(gasInfo, false, 0, t.log, maxFee, 0) {
-			return nil
-		}
-
-		// Set the gas settings
-		opts.GasFeeCap = maxFee
-		opts.GasTipCap = eth.GweiToWei(utils.GetWatchtowerPrioFee(t.cfg))
-		opts.GasLimit = gasInfo.SafeGasLimit
-
-		t.log.Println("Submitting rate to Scroll...")
-
-		// Submit rates
-		tx, err := priceMessenger.Transact(opts, "submitRate", l2GasLimit)
-		if err != nil {
-			return fmt.Errorf("Failed to submit Scroll rate: %w", err)
-		}
-
-		// Print TX info and wait for it to be included in a block
-		err = api.PrintAndWaitForTransaction(t.cfg, tx.Hash(), t.rp.Client, t.log)
-		if err != nil {
-			return err
-		}
-
-		// Log
-		t.log.Printlnf("Successfully submitted Scroll price for block %d.", blockNumber)
-
-	}
-
-	return nil
+	// Log
+	t.log.Printlnf("Successfully submitted %s price for block %d.", messenger.Chain, turn.blockNumber)
+	return turn, nil
 }
 
 // estimateGasLimit estimates gas limit for a transaction
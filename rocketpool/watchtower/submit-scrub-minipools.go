@@ -21,6 +21,7 @@ import (
 
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/rocket-pool/smartnode/rocketpool/watchtower/collectors"
+	"github.com/rocket-pool/smartnode/rocketpool/watchtower/shadow"
 	"github.com/rocket-pool/smartnode/rocketpool/watchtower/utils"
 	"github.com/rocket-pool/smartnode/shared/services"
 	"github.com/rocket-pool/smartnode/shared/services/beacon"
@@ -50,6 +51,7 @@ type submitScrubMinipools struct {
 	bc        beacon.Client
 	it        *iterationData
 	coll      *collectors.ScrubCollector
+	shadow    *shadow.Store
 	lock      *sync.Mutex
 	isRunning bool
 }
@@ -77,13 +79,18 @@ type iterationData struct {
 	stateBlockTime   time.Time
 }
 
+// The scrub vote that would have been submitted in shadow mode
+type shadowScrubVote struct {
+	Minipool common.Address `json:"minipool"`
+}
+
 type minipoolDetails struct {
 	pubkey                        types.ValidatorPubkey
 	expectedWithdrawalCredentials common.Hash
 }
 
 // Create submit scrub minipools task
-func newSubmitScrubMinipools(c *cli.Context, logger log.ColorLogger, errorLogger log.ColorLogger, coll *collectors.ScrubCollector) (*submitScrubMinipools, error) {
+func newSubmitScrubMinipools(c *cli.Context, logger log.ColorLogger, errorLogger log.ColorLogger, coll *collectors.ScrubCollector, shadowStore *shadow.Store) (*submitScrubMinipools, error) {
 
 	// Get services
 	cfg, err := services.GetConfig(c)
@@ -119,6 +126,7 @@ func newSubmitScrubMinipools(c *cli.Context, logger log.ColorLogger, errorLogger
 		ec:        ec,
 		bc:        bc,
 		coll:      coll,
+		shadow:    shadowStore,
 		lock:      lock,
 		isRunning: false,
 	}, nil
@@ -136,6 +144,17 @@ func (t *submitScrubMinipools) run(state *state.NetworkState) error {
 		return err
 	}
 
+	// Compare earlier shadow votes with what happened to the minipools since
+	if t.shadow != nil {
+		err := compareShadowReports(&t.log, t.shadow, shadow.Duty_ScrubMinipools, func(report shadow.Report) (shadow.Comparison, error) {
+			return compareShadowScrubVote(report, state)
+		})
+		if err != nil {
+			// Error is not fatal for this task so print and continue
+			t.log.Printlnf("%s %s", shadowLogPrefix, err.Error())
+		}
+	}
+
 	// Log
 	t.log.Println("Checking for minipools to scrub...")
 
@@ -561,6 +580,14 @@ func (t *submitScrubMinipools) checkSafetyScrub(state *state.NetworkState) error
 // Submit minipool scrub status
 func (t *submitScrubMinipools) submitVoteScrubMinipool(mp minipool.Minipool) error {
 
+	// In shadow mode, save the vote instead of submitting it
+	if t.shadow != nil {
+		payload := shadowScrubVote{
+			Minipool: mp.GetAddress(),
+		}
+		return saveShadowReport(&t.log, t.shadow, shadow.Duty_ScrubMinipools, mp.GetAddress().Hex(), payload)
+	}
+
 	// Log
 	t.log.Printlnf("Voting to scrub minipool %s...", mp.GetAddress().Hex())
 
@@ -607,6 +634,36 @@ func (t *submitScrubMinipools) submitVoteScrubMinipool(mp minipool.Minipool) err
 
 }
 
+// Compare a shadow scrub vote with what happened to the minipool.
+// Scrub votes aren't tracked per member, so this checks whether the minipool was dissolved instead.
+func compareShadowScrubVote(report shadow.Report, state *state.NetworkState) (shadow.Comparison, error) {
+	var payload shadowScrubVote
+	if err := report.GetPayload(&payload); err != nil {
+		return shadow.Comparison{}, err
+	}
+
+	comparison := shadow.Comparison{
+		Time: time.Now(),
+	}
+	mpd, exists := state.MinipoolDetailsByAddress[payload.Minipool]
+	switch {
+	case !exists:
+		comparison.Final = true
+		comparison.Agreed = true
+		comparison.Outcome = "the minipool was dissolved and closed"
+	case mpd.Status == types.Dissolved:
+		comparison.Final = true
+		comparison.Agreed = true
+		comparison.Outcome = "the minipool was dissolved"
+	case mpd.Status == types.Prelaunch:
+		comparison.Outcome = "the minipool is still in prelaunch"
+	default:
+		comparison.Final = true
+		comparison.Outcome = fmt.Sprintf("the minipool was not scrubbed and is now in %s status", mpd.Status.String())
+	}
+	return comparison, nil
+}
+
 // Prints the final tally of minipool counts
 func (t *submitScrubMinipools) printFinalTally(prefix string) {
 
@@ -16,6 +16,7 @@ import (
 	"github.com/rocket-pool/smartnode/bindings/dao/trustednode"
 	"github.com/rocket-pool/smartnode/bindings/rocketpool"
 	"github.com/rocket-pool/smartnode/rocketpool/watchtower/collectors"
+	"github.com/rocket-pool/smartnode/rocketpool/watchtower/shadow"
 	"github.com/rocket-pool/smartnode/shared/services"
 	"github.com/rocket-pool/smartnode/shared/services/beacon"
 	"github.com/rocket-pool/smartnode/shared/services/state"
@@ -102,6 +103,16 @@ func run(c *cli.Context) error {
 	scrubCollector := collectors.NewScrubCollector()
 	bondReductionCollector := collectors.NewBondReductionCollector()
 	soloMigrationCollector := collectors.NewSoloMigrationCollector()
+	priceMessengerCollector := collectors.NewPriceMessengerCollector()
+
+	// Set up shadow mode, where the Oracle DAO duties are computed and compared without submitting anything
+	var shadowStore *shadow.Store
+	var shadowCollector *collectors.ShadowCollector
+	if cfg.Smartnode.WatchtowerShadowMode.Value == true {
+		shadowStore = shadow.NewStore(cfg.Smartnode.GetShadowReportsPath())
+		shadowCollector = collectors.NewShadowCollector(shadowStore)
+		fmt.Printf("Shadow mode is enabled; Oracle DAO duties will be saved to %s instead of being submitted.\n", cfg.Smartnode.GetShadowReportsPath())
+	}
 
 	// Initialize error logger
 	errorLog := log.NewColorLogger(ErrorColor)
@@ -121,11 +132,11 @@ func run(c *cli.Context) error {
 	if err != nil {
 		return fmt.Errorf("error during respond-to-challenges check: %w", err)
 	}
-	submitRplPrice, err := newSubmitRplPrice(c, log.NewColorLogger(SubmitRplPriceColor), errorLog)
+	submitRplPrice, err := newSubmitRplPrice(c, log.NewColorLogger(SubmitRplPriceColor), errorLog, priceMessengerCollector, shadowStore)
 	if err != nil {
 		return fmt.Errorf("error during rpl price check: %w", err)
 	}
-	submitNetworkBalances, err := newSubmitNetworkBalances(c, log.NewColorLogger(SubmitNetworkBalancesColor), errorLog)
+	submitNetworkBalances, err := newSubmitNetworkBalances(c, log.NewColorLogger(SubmitNetworkBalancesColor), errorLog, shadowStore)
 	if err != nil {
 		return fmt.Errorf("error during network balances check: %w", err)
 	}
@@ -137,7 +148,7 @@ func run(c *cli.Context) error {
 	if err != nil {
 		return fmt.Errorf("error during timed-out minipools check: %w", err)
 	}
-	challengeValidatorsExiting, err := newChallengeValidatorsExiting(c, log.NewColorLogger(ChallengeValidatorsExitingColor))
+	challengeValidatorsExiting, err := newChallengeValidatorsExiting(c, log.NewColorLogger(ChallengeValidatorsExitingColor), shadowStore)
 	if err != nil {
 		return fmt.Errorf("error during flag validators exiting: %w", err)
 	}
@@ -145,12 +156,12 @@ func run(c *cli.Context) error {
 	if err != nil {
 		return fmt.Errorf("error during invalid credentials check: %w", err)
 	}
-	submitScrubMinipools, err := newSubmitScrubMinipools(c, log.NewColorLogger(SubmitScrubMinipoolsColor), errorLog, scrubCollector)
+	submitScrubMinipools, err := newSubmitScrubMinipools(c, log.NewColorLogger(SubmitScrubMinipoolsColor), errorLog, scrubCollector, shadowStore)
 	if err != nil {
 		return fmt.Errorf("error during scrub check: %w", err)
 	}
 	var submitRewardsTree_Stateless *submitRewardsTree_Stateless
-	submitRewardsTree_Stateless, err = newSubmitRewardsTree_Stateless(c, log.NewColorLogger(SubmitRewardsTreeColor), errorLog, m)
+	submitRewardsTree_Stateless, err = newSubmitRewardsTree_Stateless(c, log.NewColorLogger(SubmitRewardsTreeColor), errorLog, m, shadowStore)
 	if err != nil {
 		return fmt.Errorf("error during stateless rewards tree check: %w", err)
 	}
@@ -222,13 +233,18 @@ func run(c *cli.Context) error {
 				continue
 			}
 
+			// Shadow mode never submits anything, so members don't perform their duties while it's enabled
+			if isOnOdao && shadowStore != nil {
+				errorLog.Println("WARNING: This node is an Oracle DAO member but watchtower shadow mode is enabled, so it is NOT performing any of its Oracle DAO duties! Disable shadow mode with `rocketpool service config` as soon as possible.")
+			}
+
 			// Run the manual rewards tree generation
 			if err := generateRewardsTree.run(); err != nil {
 				errorLog.Println(err)
 			}
 			time.Sleep(taskCooldown)
 
-			if isOnOdao {
+			if isOnOdao && shadowStore == nil {
 				// Run the challenge check
 				if err := respondChallenges.run(); err != nil {
 					errorLog.Println(err)
@@ -314,6 +330,39 @@ func run(c *cli.Context) error {
 					errorLog.Println(err)
 				}*/
 				// DISABLED until MEV-Boost can support it
+			} else if shadowStore != nil {
+				// Update the network state
+				state, err := updateNetworkState(m, &updateLog, latestBlock)
+				if err != nil {
+					errorLog.Println(err)
+					time.Sleep(taskCooldown)
+					continue
+				}
+
+				// Run the Oracle DAO duties that support shadow mode
+				if err := challengeValidatorsExiting.run(state); err != nil {
+					errorLog.Println(err)
+				}
+				time.Sleep(taskCooldown)
+
+				if err := submitNetworkBalances.run(state); err != nil {
+					errorLog.Println(err)
+				}
+				time.Sleep(taskCooldown)
+
+				if err := submitRewardsTree_Stateless.Run(isOnOdao, nil, latestBlock.Slot); err != nil {
+					errorLog.Println(err)
+				}
+				time.Sleep(taskCooldown)
+
+				if err := submitRplPrice.run(state); err != nil {
+					errorLog.Println(err)
+				}
+				time.Sleep(taskCooldown)
+
+				if err := submitScrubMinipools.run(state); err != nil {
+					errorLog.Println(err)
+				}
 			} else {
 				// Run the rewards tree submission check
 				if err := submitRewardsTree_Stateless.Run(isOnOdao, nil, latestBlock.Slot); err != nil {
@@ -328,7 +377,7 @@ func run(c *cli.Context) error {
 
 	// Run metrics loop
 	go func() {
-		err := runMetricsServer(c, log.NewColorLogger(MetricsColor), scrubCollector, bondReductionCollector, soloMigrationCollector)
+		err := runMetricsServer(c, log.NewColorLogger(MetricsColor), scrubCollector, bondReductionCollector, soloMigrationCollector, priceMessengerCollector, shadowCollector)
 		if err != nil {
 			errorLog.Println(err)
 		}
@@ -1,16 +1,21 @@
 package alerting
 
 import (
+	"bytes"
 	"fmt"
 	"log"
+	"strings"
+	"text/template"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/go-openapi/strfmt"
+	"github.com/rocket-pool/smartnode/bindings/types"
 	apiclient "github.com/rocket-pool/smartnode/shared/services/alerting/alertmanager/client"
-	apialert "github.com/rocket-pool/smartnode/shared/services/alerting/alertmanager/client/alert"
 	"github.com/rocket-pool/smartnode/shared/services/alerting/alertmanager/models"
 	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/governance"
+	"github.com/rocket-pool/smartnode/shared/services/state"
 )
 
 const (
@@ -22,7 +27,7 @@ const (
 // If alerting/metrics are disabled, this function returns an empty array.
 func FetchAlerts(cfg *config.RocketPoolConfig) ([]*models.GettableAlert, error) {
 	// NOTE: don't log to stdout here since this method is on the "api" path and all stdout is parsed as a json "api" response.
-	if !isAlertingEnabled(cfg) {
+	if !isAlertmanagerEnabled(cfg) {
 		// metrics are disabled, so no alerts will be fetched.
 		return nil, nil
 	}
@@ -40,237 +45,264 @@ func FetchAlerts(cfg *config.RocketPoolConfig) ([]*models.GettableAlert, error)
 // Sends an alert when the node automatically changed a node's fee recipient or attempted to (success or failure).
 // If alerting/metrics are disabled, this function does nothing.
 func AlertFeeRecipientChanged(cfg *config.RocketPoolConfig, newFeeRecipient common.Address, succeeded bool) error {
-	if !isAlertingEnabled(cfg) {
-		logMessage("alerting is disabled, not sending AlertFeeRecipientChanged.")
-		return nil
-	}
-
-	if cfg.Alertmanager.AlertEnabled_FeeRecipientChanged.Value != true {
-		logMessage("alert for FeeRecipientChanged is disabled, not sending.")
-		return nil
-	}
-
-	// prepare the alert information:
-	endsAt, severity, succeededOrFailedText := getAlertSettingsForEvent(succeeded)
-	alert := createAlert(
-		fmt.Sprintf("FeeRecipientChanged-%s-%s", succeededOrFailedText, newFeeRecipient.Hex()),
-		fmt.Sprintf("Fee Recipient Change %s", succeededOrFailedText),
-		fmt.Sprintf("The fee recipient was changed to %s with status %s.", newFeeRecipient.Hex(), succeededOrFailedText),
-		severity,
-		endsAt,
-		map[string]string{},
-	)
-	return sendAlert(alert, cfg)
+	return SendAlert(cfg, config.AlertID_FeeRecipientChanged, map[string]string{
+		"feeRecipient": newFeeRecipient.Hex(),
+		"status":       getStatusText(succeeded),
+	})
 }
 
 // Sends an alert when the node automatically reduced a minipool's bond or attempted to (success or failure).
 // If alerting/metrics are disabled, this function does nothing.
 func AlertMinipoolBondReduced(cfg *config.RocketPoolConfig, minipoolAddress common.Address, succeeded bool) error {
-	if !isAlertingEnabled(cfg) {
-		logMessage("alerting is disabled, not sending AlertMinipoolBondReduced.")
-		return nil
-	}
-
-	if cfg.Alertmanager.AlertEnabled_MinipoolBondReduced.Value != true {
-		logMessage("alert for MinipoolBondReduced is disabled, not sending.")
-		return nil
-	}
-
-	// prepare the alert information:
-	endsAt, severity, succeededOrFailedText := getAlertSettingsForEvent(succeeded)
-	alert := createAlert(
-		fmt.Sprintf("MinipoolBondReduced-%s-%s", succeededOrFailedText, minipoolAddress.Hex()),
-		fmt.Sprintf("Minipool %s reduce bond %s", minipoolAddress.Hex(), succeededOrFailedText),
-		fmt.Sprintf("The minipool with address %s reduced bond with status %s.", minipoolAddress.Hex(), succeededOrFailedText),
-		severity,
-		endsAt,
-		map[string]string{
-			"minipool": minipoolAddress.Hex(),
-		},
-	)
-	return sendAlert(alert, cfg)
-
+	return SendAlert(cfg, config.AlertID_MinipoolBondReduced, map[string]string{
+		"minipool": minipoolAddress.Hex(),
+		"status":   getStatusText(succeeded),
+	})
 }
 
 // Sends an alert when the node automatically distributes a minipool's balance (success or failure).
 // If alerting/metrics are disabled, this function does nothing.
 func AlertMinipoolBalanceDistributed(cfg *config.RocketPoolConfig, minipoolAddress common.Address, succeeded bool) error {
-	if !isAlertingEnabled(cfg) {
-		logMessage("alerting is disabled, not sending AlertMinipoolBalanceDistributed.")
-		return nil
-	}
-
-	if cfg.Alertmanager.AlertEnabled_MinipoolBalanceDistributed.Value != true {
-		logMessage("alert for MinipoolBalanceDistributed is disabled, not sending.")
-		return nil
-	}
-
-	// prepare the alert information:
-	endsAt, severity, succeededOrFailedText := getAlertSettingsForEvent(succeeded)
-	alert := createAlert(
-		fmt.Sprintf("MinipoolBalanceDistributed-%s-%s", succeededOrFailedText, minipoolAddress.Hex()),
-		fmt.Sprintf("Minipool %s balance distributed %s", minipoolAddress.Hex(), succeededOrFailedText),
-		fmt.Sprintf("The minipool with address %s had its balance distributed with status %s.", minipoolAddress.Hex(), succeededOrFailedText),
-		severity,
-		endsAt,
-		map[string]string{
-			"minipool": minipoolAddress.Hex(),
-		},
-	)
-	return sendAlert(alert, cfg)
+	return SendAlert(cfg, config.AlertID_MinipoolBalanceDistributed, map[string]string{
+		"minipool": minipoolAddress.Hex(),
+		"status":   getStatusText(succeeded),
+	})
 }
 
 // Sends an alert when the node automatically prompted a minipool or attempted to (success or failure).
 // If alerting/metrics are disabled, this function does nothing.
 func AlertMinipoolPromoted(cfg *config.RocketPoolConfig, minipoolAddress common.Address, succeeded bool) error {
-	if !isAlertingEnabled(cfg) {
-		logMessage("alerting is disabled, not sending AlertMinipoolPromoted.")
-		return nil
-	}
-
-	if cfg.Alertmanager.AlertEnabled_MinipoolPromoted.Value != true {
-		logMessage("alert for MinipoolPromoted is disabled, not sending.")
-		return nil
-	}
-
-	// prepare the alert information:
-	endsAt, severity, succeededOrFailedText := getAlertSettingsForEvent(succeeded)
-	alert := createAlert(
-		fmt.Sprintf("MinipoolPromoted-%s-%s", succeededOrFailedText, minipoolAddress.Hex()),
-		fmt.Sprintf("Minipool %s promote %s", minipoolAddress.Hex(), succeededOrFailedText),
-		fmt.Sprintf("The vacant minipool with address %s promoted with status %s.", minipoolAddress.Hex(), succeededOrFailedText),
-		severity,
-		endsAt,
-		map[string]string{
-			"minipool": minipoolAddress.Hex(),
-		},
-	)
-	return sendAlert(alert, cfg)
+	return SendAlert(cfg, config.AlertID_MinipoolPromoted, map[string]string{
+		"minipool": minipoolAddress.Hex(),
+		"status":   getStatusText(succeeded),
+	})
 }
 
 // Sends an alert when the node automatically staked a minipool or attempted to (success or failure).
 // If alerting/metrics are disabled, this function does nothing.
 func AlertMinipoolStaked(cfg *config.RocketPoolConfig, minipoolAddress common.Address, succeeded bool) error {
-	if !isAlertingEnabled(cfg) {
-		logMessage("alerting is disabled, not sending AlertMinipoolStaked.")
-		return nil
-	}
+	return SendAlert(cfg, config.AlertID_MinipoolStaked, map[string]string{
+		"minipool": minipoolAddress.Hex(),
+		"status":   getStatusText(succeeded),
+	})
+}
 
-	if cfg.Alertmanager.AlertEnabled_MinipoolStaked.Value != true {
-		logMessage("alert for MinipoolStaked is disabled, not sending.")
-		return nil
-	}
+func AlertExecutionClientSyncComplete(cfg *config.RocketPoolConfig) error {
+	return SendAlert(cfg, config.AlertID_ExecutionClientSyncComplete, nil)
+}
 
-	// prepare the alert information:
-	endsAt, severity, succeededOrFailedText := getAlertSettingsForEvent(succeeded)
-
-	alert := createAlert(
-		fmt.Sprintf("MinipoolStaked-%s-%s", succeededOrFailedText, minipoolAddress.Hex()),
-		fmt.Sprintf("Minipool %s stake %s", minipoolAddress.Hex(), succeededOrFailedText),
-		fmt.Sprintf("The minipool with address %s staked with status %s.", minipoolAddress.Hex(), succeededOrFailedText),
-		severity,
-		endsAt,
-		map[string]string{
-			"minipool": minipoolAddress.Hex(),
-		},
-	)
-	return sendAlert(alert, cfg)
+func AlertBeaconClientSyncComplete(cfg *config.RocketPoolConfig) error {
+	return SendAlert(cfg, config.AlertID_BeaconClientSyncComplete, nil)
 }
 
-// Gets various settings for an alert based on whether a process succeeded or failed.
-func getAlertSettingsForEvent(succeeded bool) (strfmt.DateTime, Severity, string) {
-	endsAt := strfmt.DateTime(time.Now().Add(DefaultEndsAtDurationForSeverityInfo))
-	severity := SeverityInfo
-	if !succeeded {
-		severity = SeverityCritical
-		endsAt = strfmt.DateTime(time.Now().Add(DefaultEndsAtDurationForSeverityCritical))
-	}
-	succeededOrFailedText := "failed"
-	if succeeded {
-		succeededOrFailedText = "succeeded"
-	}
-	return endsAt, severity, succeededOrFailedText
+// Sends an alert when one of the node's megapool validators has been dissolved.
+func AlertMegapoolValidatorDissolved(cfg *config.RocketPoolConfig, megapoolAddress common.Address, validatorId uint32, pubkey types.ValidatorPubkey) error {
+	return SendAlert(cfg, config.AlertID_MegapoolValidatorDissolved, map[string]string{
+		"megapool":    megapoolAddress.Hex(),
+		"validatorId": fmt.Sprint(validatorId),
+		"pubkey":      pubkey.Hex(),
+	})
 }
 
-func AlertExecutionClientSyncComplete(cfg *config.RocketPoolConfig) error {
-	if cfg.Alertmanager.AlertEnabled_ExecutionClientSyncComplete.Value != true {
-		logMessage("alert for ExecutionClientSyncComplete is disabled, not sending.")
-		return nil
-	}
-	return alertClientSyncComplete(cfg, ClientKindExecution)
+// Sends an alert when one of the node's megapool validators has been locked by an exit challenge.
+func AlertExitChallengeReceived(cfg *config.RocketPoolConfig, megapoolAddress common.Address, validatorId uint32, pubkey types.ValidatorPubkey) error {
+	return SendAlert(cfg, config.AlertID_ExitChallengeReceived, map[string]string{
+		"megapool":    megapoolAddress.Hex(),
+		"validatorId": fmt.Sprint(validatorId),
+		"pubkey":      pubkey.Hex(),
+	})
 }
 
-func AlertBeaconClientSyncComplete(cfg *config.RocketPoolConfig) error {
-	if cfg.Alertmanager.AlertEnabled_BeaconClientSyncComplete.Value != true {
-		logMessage("alert for BeaconClientSyncComplete is disabled, not sending.")
-		return nil
-	}
-	return alertClientSyncComplete(cfg, ClientKindBeacon)
+// Sends an alert when one of the node's Protocol DAO proposals has been challenged.
+func AlertPDAOProposalChallenged(cfg *config.RocketPoolConfig, proposalId uint64, index uint64) error {
+	return SendAlert(cfg, config.AlertID_PDAOProposalChallenged, map[string]string{
+		"proposalId": fmt.Sprint(proposalId),
+		"index":      fmt.Sprint(index),
+	})
 }
 
-type ClientKind string
+// Sends an alert when the rewards tree for an interval couldn't be downloaded.
+func AlertRewardsTreeDownloadFailed(cfg *config.RocketPoolConfig, interval uint64, downloadErr error) error {
+	return SendAlert(cfg, config.AlertID_RewardsTreeDownloadFailed, map[string]string{
+		"interval": fmt.Sprint(interval),
+		"error":    downloadErr.Error(),
+	})
+}
 
-const (
-	ClientKindExecution ClientKind = "Execution"
-	ClientKindBeacon    ClientKind = "Beacon"
-)
+// Sends an alert when one of the node's megapool validators has a duty to perform before an upcoming deadline.
+func AlertMegapoolDeadlineApproaching(cfg *config.RocketPoolConfig, deadline state.MegapoolValidatorDeadline) error {
+	return SendAlert(cfg, config.AlertID_MegapoolDeadlineApproaching, map[string]string{
+		"megapool":    deadline.MegapoolAddress.Hex(),
+		"validatorId": fmt.Sprint(deadline.ValidatorId),
+		"pubkey":      deadline.Pubkey.Hex(),
+		"type":        string(deadline.Type),
+		"duty":        deadline.Type.Description(),
+		"deadline":    deadline.Deadline.UTC().Format(time.RFC1123),
+		"remaining":   time.Until(deadline.Deadline).Round(time.Minute).String(),
+	})
+}
+
+// Sends an alert when a governance proposal enters a voting phase the node hasn't voted in.
+func AlertGovernanceVoteNeeded(cfg *config.RocketPoolConfig, proposal governance.Proposal) error {
+	return SendAlert(cfg, config.AlertID_GovernanceVoteNeeded, getGovernanceAlertFields(proposal))
+}
+
+// Sends an alert when a voting phase the node hasn't voted in is about to close.
+func AlertGovernanceVoteClosing(cfg *config.RocketPoolConfig, proposal governance.Proposal) error {
+	return SendAlert(cfg, config.AlertID_GovernanceVoteClosing, getGovernanceAlertFields(proposal))
+}
+
+// Sends an alert when the voting policy votes on a Protocol DAO proposal.
+func AlertPDAOAutomaticVote(cfg *config.RocketPoolConfig, vote governance.AutomaticVote, txHash common.Hash) error {
+	return SendAlert(cfg, config.AlertID_PDAOAutomaticVote, map[string]string{
+		"proposalId": fmt.Sprint(vote.ProposalID),
+		"message":    vote.Message,
+		"phase":      string(vote.Phase),
+		"direction":  types.VoteDirections[vote.Decision.Direction],
+		"reason":     vote.Decision.Reason,
+		"txHash":     txHash.Hex(),
+	})
+}
+
+// Get the alert fields for a governance proposal
+func getGovernanceAlertFields(proposal governance.Proposal) map[string]string {
+	return map[string]string{
+		"dao":        string(proposal.DAO),
+		"daoName":    proposal.DAO.GetName(),
+		"command":    proposal.DAO.GetCommand(),
+		"proposalId": fmt.Sprint(proposal.ID),
+		"message":    proposal.Message,
+		"phase":      string(proposal.Phase),
+		"deadline":   proposal.PhaseEnd.UTC().Format(time.RFC1123),
+		"remaining":  time.Until(proposal.PhaseEnd).Round(time.Minute).String(),
+	}
+}
+
+// Sends an alert from the registry. The fields are used to render the alert's templates, name and labels.
+// If alerting is disabled or the alert is turned off, this function does nothing.
+func SendAlert(cfg *config.RocketPoolConfig, id string, fields map[string]string) error {
+	definition, exists := config.GetAlertDefinition(id)
+	if !exists {
+		return fmt.Errorf("unknown alert [%s]", id)
+	}
+	if definition.Source != config.AlertSource_Daemon {
+		return fmt.Errorf("alert [%s] is raised by Prometheus and can't be sent directly", id)
+	}
 
-func alertClientSyncComplete(cfg *config.RocketPoolConfig, client ClientKind) error {
-	alertName := fmt.Sprintf("%sClientSyncComplete", client)
 	if !isAlertingEnabled(cfg) {
-		logMessage("alerting is disabled, not sending %s.", alertName)
+		logMessage("alerting is disabled, not sending %s.", id)
+		return nil
+	}
+	if !cfg.Alertmanager.IsAlertEnabled(id) {
+		logMessage("alert for %s is disabled, not sending.", id)
 		return nil
 	}
 
-	alert := createAlert(
-		alertName,
-		fmt.Sprintf("%s Client Sync Complete", client),
-		fmt.Sprintf("The %s client has completed syncing.", client),
-		SeverityInfo,
-		strfmt.DateTime(time.Now().Add(time.Minute*1)),
-		nil,
-	)
+	alert, err := createAlertFromDefinition(definition, fields)
+	if err != nil {
+		return err
+	}
 	return sendAlert(alert, cfg)
 }
 
-func sendAlert(alert *models.PostableAlert, cfg *config.RocketPoolConfig) error {
-	logMessage("sending alert for %s: %s", alert.Labels["alertname"], alert.Annotations["summary"])
+// Build a notification from a registered alert definition
+func createAlertFromDefinition(definition config.AlertDefinition, fields map[string]string) (*Notification, error) {
+	if fields == nil {
+		fields = map[string]string{}
+	}
 
-	params := apialert.NewPostAlertsParams().WithDefaults().WithAlerts(models.PostableAlerts{alert})
-	client := createClient(cfg)
-	_, err := client.Alert.PostAlerts(params)
+	summary, err := renderAlertTemplate(definition.ID, definition.Summary, fields)
+	if err != nil {
+		return nil, err
+	}
+	description, err := renderAlertTemplate(definition.ID, definition.Description, fields)
 	if err != nil {
-		return fmt.Errorf("error posting alert: %s", err.Error())
+		return nil, err
 	}
-	return nil
+
+	// Apply the severity policy
+	severity := Severity(definition.Severity)
+	if definition.FailureSeverity != "" && fields["status"] == config.AlertStatus_Failed {
+		severity = Severity(definition.FailureSeverity)
+	}
+	endsAt := time.Now().Add(definition.Duration)
+	if definition.Duration == 0 {
+		endsAt = time.Now().Add(DefaultEndsAtDurationForSeverityInfo)
+		if severity == SeverityCritical {
+			endsAt = time.Now().Add(DefaultEndsAtDurationForSeverityCritical)
+		}
+	}
+
+	// Build the unique name and labels
+	nameParts := []string{definition.ID}
+	for _, field := range definition.KeyFields {
+		nameParts = append(nameParts, fields[field])
+	}
+	labels := map[string]string{}
+	for _, field := range definition.Labels {
+		labels[field] = fields[field]
+	}
+
+	return createAlert(strings.Join(nameParts, "-"), summary, description, severity, endsAt, labels), nil
+}
+
+// Render one of an alert's templates
+func renderAlertTemplate(id string, text string, fields map[string]string) (string, error) {
+	tmpl, err := template.New(id).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("error parsing template for alert [%s]: %w", id, err)
+	}
+	buffer := &bytes.Buffer{}
+	err = tmpl.Execute(buffer, fields)
+	if err != nil {
+		return "", fmt.Errorf("error rendering template for alert [%s]: %w", id, err)
+	}
+	return buffer.String(), nil
+}
+
+// Get the status text for an alert that reports the outcome of an action
+func getStatusText(succeeded bool) string {
+	if succeeded {
+		return config.AlertStatus_Succeeded
+	}
+	return config.AlertStatus_Failed
+}
+
+func sendAlert(alert *Notification, cfg *config.RocketPoolConfig) error {
+	logMessage("sending alert for %s: %s", alert.Labels["alertname"], alert.Summary)
+	return notifyAll(GetNotifiers(cfg), alert)
 }
 
 type Severity string
 
 const (
-	SeverityInfo     Severity = "info"
-	SeverityWarning  Severity = "warning"
-	SeverityCritical Severity = "critical"
+	SeverityInfo     Severity = config.AlertSeverity_Info
+	SeverityWarning  Severity = config.AlertSeverity_Warning
+	SeverityCritical Severity = config.AlertSeverity_Critical
 )
 
+// Returns true if at least one notification sink (Alertmanager or otherwise) is enabled
 func isAlertingEnabled(cfg *config.RocketPoolConfig) bool {
+	return len(GetNotifiers(cfg)) > 0
+}
+
+func isAlertmanagerEnabled(cfg *config.RocketPoolConfig) bool {
 	return cfg.Alertmanager.EnableAlerting.Value == true
 }
 
 // Creates a uniform alert with the basic labels and annotations we expect.
-func createAlert(uniqueName string, summary string, description string, severity Severity, endsAt strfmt.DateTime, extraLabels map[string]string) *models.PostableAlert {
-	alert := &models.PostableAlert{
-		Annotations: map[string]string{
-			"description": description,
-			"summary":     summary,
-		},
-		Alert: models.Alert{
-			Labels: map[string]string{
-				"alertname": uniqueName,
-				"severity":  string(severity),
-			},
+func createAlert(uniqueName string, summary string, description string, severity Severity, endsAt time.Time, extraLabels map[string]string) *Notification {
+	alert := &Notification{
+		Name:        uniqueName,
+		Summary:     summary,
+		Description: description,
+		Severity:    severity,
+		Labels: map[string]string{
+			"alertname": uniqueName,
+			"severity":  string(severity),
 		},
-		EndsAt: endsAt,
+		StartsAt: time.Now(),
+		EndsAt:   endsAt,
 	}
 
 	for k, v := range extraLabels {
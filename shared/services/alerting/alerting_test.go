@@ -0,0 +1,76 @@
+package alerting
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rocket-pool/smartnode/shared/services/config"
+)
+
+func TestCreateAlertFromDefinition(t *testing.T) {
+	definition, exists := config.GetAlertDefinition(config.AlertID_MinipoolStaked)
+	if !exists {
+		t.Fatalf("alert %s is not registered", config.AlertID_MinipoolStaked)
+	}
+
+	alert, err := createAlertFromDefinition(definition, map[string]string{
+		"minipool": "0x01",
+		"status":   getStatusText(false),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if alert.Name != "MinipoolStaked-failed-0x01" {
+		t.Fatalf("unexpected alert name %s", alert.Name)
+	}
+	if alert.Severity != SeverityCritical {
+		t.Fatalf("expected the failure severity, got %s", alert.Severity)
+	}
+	if alert.Labels["minipool"] != "0x01" || !strings.Contains(alert.Summary, "0x01") {
+		t.Fatalf("unexpected alert: %+v", alert)
+	}
+
+	// Missing fields are reported instead of rendering "<no value>"
+	_, err = createAlertFromDefinition(definition, map[string]string{})
+	if err == nil {
+		t.Fatal("expected an error for a missing field")
+	}
+}
+
+func TestDaemonAlertsRender(t *testing.T) {
+	fields := map[string]string{
+		"status":       config.AlertStatus_Succeeded,
+		"feeRecipient": "0x01",
+		"minipool":     "0x02",
+		"megapool":     "0x03",
+		"validatorId":  "4",
+		"pubkey":       "0x05",
+		"proposalId":   "6",
+		"index":        "7",
+		"balance":      "0.01",
+		"threshold":    "0.1",
+		"interval":     "8",
+		"error":        "timeout",
+		"type":         "dissolve",
+		"duty":         "must be staked before it can be dissolved",
+		"deadline":     "Mon, 02 Jan 2006 15:04:05 UTC",
+		"remaining":    "5h0m0s",
+		"dao":          "pdao",
+		"daoName":      "Protocol DAO",
+		"command":      "pdao",
+		"message":      "test proposal",
+		"phase":        "phase1",
+		"direction":    "In Favor",
+		"reason":       "rule `network.*` votes for",
+		"txHash":       "0xabc",
+	}
+	for _, definition := range config.AlertDefinitions {
+		if definition.Source != config.AlertSource_Daemon {
+			continue
+		}
+		_, err := createAlertFromDefinition(definition, fields)
+		if err != nil {
+			t.Errorf("error rendering alert %s: %s", definition.ID, err.Error())
+		}
+	}
+}
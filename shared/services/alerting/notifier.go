@@ -0,0 +1,96 @@
+package alerting
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+// Settings
+const (
+	notifierTimeout time.Duration = 10 * time.Second
+)
+
+// A single alert, independent of where it's delivered
+type Notification struct {
+	Name        string            `json:"name"`
+	Summary     string            `json:"summary"`
+	Description string            `json:"description"`
+	Severity    Severity          `json:"severity"`
+	Labels      map[string]string `json:"labels"`
+	StartsAt    time.Time         `json:"startsAt"`
+	EndsAt      time.Time         `json:"endsAt"`
+}
+
+// A destination that alerts can be sent to
+type Notifier interface {
+	// The name of the notifier, used for logging
+	GetName() string
+
+	// Deliver a notification
+	Notify(notification *Notification) error
+}
+
+// Get all of the notifiers that are enabled in the config
+func GetNotifiers(cfg *config.RocketPoolConfig) []Notifier {
+	notifiers := []Notifier{}
+	if cfg.Alertmanager.EnableAlerting.Value == true {
+		notifiers = append(notifiers, NewAlertmanagerNotifier(cfg))
+	}
+
+	httpClient := &http.Client{
+		Timeout: notifierTimeout,
+	}
+	notificationsCfg := cfg.Notifications
+	if notificationsCfg == nil {
+		return notifiers
+	}
+
+	webhookUrl := notificationsCfg.WebhookUrl.Value.(string)
+	if webhookUrl != "" {
+		notifiers = append(notifiers, NewWebhookNotifier(httpClient, webhookUrl, notificationsCfg.WebhookSecret.Value.(string)))
+	}
+
+	smtpHost := notificationsCfg.SmtpHost.Value.(string)
+	if smtpHost != "" {
+		recipients := []string{}
+		for _, recipient := range strings.Split(notificationsCfg.SmtpTo.Value.(string), ",") {
+			recipient = strings.TrimSpace(recipient)
+			if recipient != "" {
+				recipients = append(recipients, recipient)
+			}
+		}
+		notifiers = append(notifiers, NewSmtpNotifier(
+			smtpHost,
+			notificationsCfg.SmtpPort.Value.(uint16),
+			notificationsCfg.SmtpUsername.Value.(string),
+			notificationsCfg.SmtpPassword.Value.(string),
+			notificationsCfg.SmtpFrom.Value.(string),
+			recipients,
+		))
+	}
+
+	pushUrl := notificationsCfg.PushUrl.Value.(string)
+	if pushUrl != "" {
+		notifiers = append(notifiers, NewPushNotifier(httpClient, notificationsCfg.PushService.Value.(cfgtypes.PushNotificationService), pushUrl, notificationsCfg.PushToken.Value.(string)))
+	}
+
+	return notifiers
+}
+
+// Send a notification to every provided notifier, collecting any errors along the way
+func notifyAll(notifiers []Notifier, notification *Notification) error {
+	errs := []error{}
+	for _, notifier := range notifiers {
+		err := notifier.Notify(notification)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error sending alert to %s: %w", notifier.GetName(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
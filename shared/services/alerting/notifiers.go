@@ -0,0 +1,271 @@
+package alerting
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	apiclient "github.com/rocket-pool/smartnode/shared/services/alerting/alertmanager/client"
+	apialert "github.com/rocket-pool/smartnode/shared/services/alerting/alertmanager/client/alert"
+	"github.com/rocket-pool/smartnode/shared/services/alerting/alertmanager/models"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+const (
+	WebhookSignatureHeader string = "X-Smartnode-Signature"
+)
+
+// Sends alerts to the bundled (or native mode) Alertmanager instance
+type AlertmanagerNotifier struct {
+	client *apiclient.Alertmanager
+}
+
+func NewAlertmanagerNotifier(cfg *config.RocketPoolConfig) *AlertmanagerNotifier {
+	return &AlertmanagerNotifier{
+		client: createClient(cfg),
+	}
+}
+
+func (n *AlertmanagerNotifier) GetName() string {
+	return "Alertmanager"
+}
+
+func (n *AlertmanagerNotifier) Notify(notification *Notification) error {
+	alert := &models.PostableAlert{
+		Annotations: map[string]string{
+			"description": notification.Description,
+			"summary":     notification.Summary,
+		},
+		Alert: models.Alert{
+			Labels: map[string]string{},
+		},
+		EndsAt: strfmt.DateTime(notification.EndsAt),
+	}
+	for k, v := range notification.Labels {
+		alert.Labels[k] = v
+	}
+
+	params := apialert.NewPostAlertsParams().WithDefaults().WithAlerts(models.PostableAlerts{alert})
+	_, err := n.client.Alert.PostAlerts(params)
+	if err != nil {
+		return fmt.Errorf("error posting alert: %s", err.Error())
+	}
+	return nil
+}
+
+// Sends alerts as JSON POST requests to an arbitrary URL, optionally signed with an HMAC-SHA256 of the body
+type WebhookNotifier struct {
+	client *http.Client
+	url    string
+	secret string
+}
+
+func NewWebhookNotifier(client *http.Client, url string, secret string) *WebhookNotifier {
+	return &WebhookNotifier{
+		client: client,
+		url:    url,
+		secret: secret,
+	}
+}
+
+func (n *WebhookNotifier) GetName() string {
+	return "webhook"
+}
+
+func (n *WebhookNotifier) Notify(notification *Notification) error {
+	body, err := json.Marshal(notification)
+	if err != nil {
+		return fmt.Errorf("error serializing notification: %w", err)
+	}
+
+	request, err := http.NewRequest(http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+	if n.secret != "" {
+		request.Header.Set(WebhookSignatureHeader, GetWebhookSignature(n.secret, body))
+	}
+
+	return doNotificationRequest(n.client, request)
+}
+
+// Get the hex-encoded HMAC-SHA256 signature of a webhook body
+func GetWebhookSignature(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Sends alerts as plain text emails
+type SmtpNotifier struct {
+	host     string
+	port     uint16
+	username string
+	password string
+	from     string
+	to       []string
+
+	// Swappable for testing
+	sendMail func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+}
+
+func NewSmtpNotifier(host string, port uint16, username string, password string, from string, to []string) *SmtpNotifier {
+	return &SmtpNotifier{
+		host:     host,
+		port:     port,
+		username: username,
+		password: password,
+		from:     from,
+		to:       to,
+		sendMail: smtp.SendMail,
+	}
+}
+
+func (n *SmtpNotifier) GetName() string {
+	return "email"
+}
+
+func (n *SmtpNotifier) Notify(notification *Notification) error {
+	if len(n.to) == 0 {
+		return fmt.Errorf("no email recipients are configured")
+	}
+
+	var auth smtp.Auth
+	if n.username != "" {
+		auth = smtp.PlainAuth("", n.username, n.password, n.host)
+	}
+
+	// Alert text can come from on-chain data, so line breaks are removed to keep it from adding headers, and non-ASCII text is encoded
+	subject := fmt.Sprintf("[Rocket Pool] [%s] %s", strings.ToUpper(string(notification.Severity)), notification.Summary)
+	subject = strings.NewReplacer("\r\n", " ", "\r", " ", "\n", " ").Replace(subject)
+	subject = mime.QEncoding.Encode("UTF-8", subject)
+	message := fmt.Sprintf("From: %s\r\nTo: %s\r\nSubject: %s\r\nDate: %s\r\nContent-Type: text/plain; charset=UTF-8\r\n\r\n%s\r\n",
+		n.from,
+		strings.Join(n.to, ", "),
+		subject,
+		notification.StartsAt.Format(time.RFC1123Z),
+		notification.Description,
+	)
+
+	address := net.JoinHostPort(n.host, strconv.FormatUint(uint64(n.port), 10))
+	err := n.sendMail(address, auth, n.from, n.to, []byte(message))
+	if err != nil {
+		return fmt.Errorf("error sending email via %s: %w", address, err)
+	}
+	return nil
+}
+
+// Sends alerts to an ntfy topic or a Gotify server
+type PushNotifier struct {
+	client  *http.Client
+	service cfgtypes.PushNotificationService
+	url     string
+	token   string
+}
+
+func NewPushNotifier(client *http.Client, service cfgtypes.PushNotificationService, url string, token string) *PushNotifier {
+	return &PushNotifier{
+		client:  client,
+		service: service,
+		url:     strings.TrimSuffix(url, "/"),
+		token:   token,
+	}
+}
+
+func (n *PushNotifier) GetName() string {
+	return string(n.service)
+}
+
+func (n *PushNotifier) Notify(notification *Notification) error {
+	var request *http.Request
+	var err error
+
+	switch n.service {
+	case cfgtypes.PushNotificationService_Ntfy:
+		// ntfy takes the message as the body and the rest as headers
+		request, err = http.NewRequest(http.MethodPost, n.url, strings.NewReader(notification.Description))
+		if err != nil {
+			return fmt.Errorf("error creating request: %w", err)
+		}
+		request.Header.Set("Title", notification.Summary)
+		request.Header.Set("Priority", getNtfyPriority(notification.Severity))
+		request.Header.Set("Tags", string(notification.Severity))
+		if n.token != "" {
+			request.Header.Set("Authorization", "Bearer "+n.token)
+		}
+
+	case cfgtypes.PushNotificationService_Gotify:
+		body, err := json.Marshal(map[string]interface{}{
+			"title":    notification.Summary,
+			"message":  notification.Description,
+			"priority": getGotifyPriority(notification.Severity),
+		})
+		if err != nil {
+			return fmt.Errorf("error serializing notification: %w", err)
+		}
+		request, err = http.NewRequest(http.MethodPost, n.url+"/message", bytes.NewReader(body))
+		if err != nil {
+			return fmt.Errorf("error creating request: %w", err)
+		}
+		request.Header.Set("Content-Type", "application/json")
+		request.Header.Set("X-Gotify-Key", n.token)
+
+	default:
+		return fmt.Errorf("unknown push notification service [%s]", n.service)
+	}
+
+	return doNotificationRequest(n.client, request)
+}
+
+// Map a severity to an ntfy priority (1-5)
+func getNtfyPriority(severity Severity) string {
+	switch severity {
+	case SeverityCritical:
+		return "5"
+	case SeverityWarning:
+		return "4"
+	default:
+		return "3"
+	}
+}
+
+// Map a severity to a Gotify priority (0-10)
+func getGotifyPriority(severity Severity) int {
+	switch severity {
+	case SeverityCritical:
+		return 8
+	case SeverityWarning:
+		return 5
+	default:
+		return 2
+	}
+}
+
+// Run an HTTP request for a notifier and make sure it succeeded
+func doNotificationRequest(client *http.Client, request *http.Request) error {
+	response, err := client.Do(request)
+	if err != nil {
+		return fmt.Errorf("error sending request to %s: %w", request.URL.Host, err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(response.Body, 512))
+		return fmt.Errorf("request to %s failed with status %d: %s", request.URL.Host, response.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
@@ -0,0 +1,190 @@
+package alerting
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/smtp"
+	"strings"
+	"testing"
+	"time"
+
+	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+func getTestNotification() *Notification {
+	return createAlert("MinipoolStaked-failed-0x01", "Minipool 0x01 stake failed", "The minipool with address 0x01 staked with status failed.", SeverityCritical, time.Now().Add(time.Hour), map[string]string{"minipool": "0x01"})
+}
+
+func TestWebhookNotifier(t *testing.T) {
+	secret := "hunter2"
+	var receivedBody []byte
+	var receivedSignature string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedBody, _ = io.ReadAll(r.Body)
+		receivedSignature = r.Header.Get(WebhookSignatureHeader)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	notifier := NewWebhookNotifier(server.Client(), server.URL, secret)
+	err := notifier.Notify(getTestNotification())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	if receivedSignature != GetWebhookSignature(secret, receivedBody) {
+		t.Fatalf("signature %s doesn't match the body", receivedSignature)
+	}
+	var received Notification
+	err = json.Unmarshal(receivedBody, &received)
+	if err != nil {
+		t.Fatalf("error deserializing webhook body: %s", err.Error())
+	}
+	if received.Severity != SeverityCritical || received.Labels["minipool"] != "0x01" || received.Labels["alertname"] != "MinipoolStaked-failed-0x01" {
+		t.Fatalf("unexpected webhook body: %s", string(receivedBody))
+	}
+}
+
+func TestWebhookNotifierFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "nope", http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	notifier := NewWebhookNotifier(server.Client(), server.URL, "")
+	err := notifier.Notify(getTestNotification())
+	if err == nil || !strings.Contains(err.Error(), "401") {
+		t.Fatalf("expected a 401 error, got %v", err)
+	}
+}
+
+func TestNtfyNotifier(t *testing.T) {
+	var title, priority, auth, body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/node-alerts" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		title = r.Header.Get("Title")
+		priority = r.Header.Get("Priority")
+		auth = r.Header.Get("Authorization")
+		bytes, _ := io.ReadAll(r.Body)
+		body = string(bytes)
+	}))
+	defer server.Close()
+
+	notifier := NewPushNotifier(server.Client(), cfgtypes.PushNotificationService_Ntfy, server.URL+"/node-alerts", "tk_abc")
+	err := notifier.Notify(getTestNotification())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if title != "Minipool 0x01 stake failed" || priority != "5" || auth != "Bearer tk_abc" || !strings.Contains(body, "status failed") {
+		t.Fatalf("unexpected ntfy request: title=%s priority=%s auth=%s body=%s", title, priority, auth, body)
+	}
+}
+
+func TestGotifyNotifier(t *testing.T) {
+	var key string
+	var message map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/message" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		key = r.Header.Get("X-Gotify-Key")
+		json.NewDecoder(r.Body).Decode(&message)
+	}))
+	defer server.Close()
+
+	notifier := NewPushNotifier(server.Client(), cfgtypes.PushNotificationService_Gotify, server.URL+"/", "app-token")
+	err := notifier.Notify(getTestNotification())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if key != "app-token" || message["title"] != "Minipool 0x01 stake failed" || message["priority"] != float64(8) {
+		t.Fatalf("unexpected gotify request: key=%s message=%v", key, message)
+	}
+}
+
+func TestSmtpNotifier(t *testing.T) {
+	var sentAddress string
+	var sentTo []string
+	var sentMessage string
+	notifier := NewSmtpNotifier("mail.example.com", 587, "", "", "node@example.com", []string{"ops@example.com", "me@example.com"})
+	notifier.sendMail = func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+		sentAddress = addr
+		sentTo = to
+		sentMessage = string(msg)
+		return nil
+	}
+
+	err := notifier.Notify(getTestNotification())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if sentAddress != "mail.example.com:587" || len(sentTo) != 2 {
+		t.Fatalf("unexpected recipient info: %s %v", sentAddress, sentTo)
+	}
+	if !strings.Contains(sentMessage, "Subject: [Rocket Pool] [CRITICAL] Minipool 0x01 stake failed") {
+		t.Fatalf("unexpected email:\n%s", sentMessage)
+	}
+}
+
+func TestSmtpNotifierSubject(t *testing.T) {
+	var sentMessage string
+	notifier := NewSmtpNotifier("mail.example.com", 587, "", "", "node@example.com", []string{"ops@example.com"})
+	notifier.sendMail = func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+		sentMessage = string(msg)
+		return nil
+	}
+
+	// Line breaks in the summary can't add headers or end the header block
+	notification := getTestNotification()
+	notification.Summary = "Proposal\r\nBcc: attacker@example.com\n\nInjected body"
+	err := notifier.Notify(notification)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	headers, _, _ := strings.Cut(sentMessage, "\r\n\r\n")
+	if strings.Contains(headers, "\r\nBcc:") || strings.Contains(headers, "\n\n") || strings.Count(headers, "\n") != 4 {
+		t.Fatalf("the summary changed the headers:\n%s", headers)
+	}
+	if !strings.Contains(headers, "Subject: [Rocket Pool] [CRITICAL] Proposal Bcc: attacker@example.com  Injected body\r\n") {
+		t.Fatalf("unexpected subject:\n%s", headers)
+	}
+
+	// Non-ASCII text is encoded
+	notification.Summary = "Proposal été"
+	err = notifier.Notify(notification)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if !strings.Contains(sentMessage, "Subject: =?UTF-8?q?") {
+		t.Fatalf("expected an encoded subject:\n%s", sentMessage)
+	}
+}
+
+func TestNotifyAllCollectsErrors(t *testing.T) {
+	calls := 0
+	good := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+	}))
+	defer good.Close()
+	bad := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer bad.Close()
+
+	notifiers := []Notifier{
+		NewWebhookNotifier(bad.Client(), bad.URL, ""),
+		NewWebhookNotifier(good.Client(), good.URL, ""),
+	}
+	err := notifyAll(notifiers, getTestNotification())
+	if err == nil {
+		t.Fatal("expected an error from the failing notifier")
+	}
+	if calls != 2 {
+		t.Fatalf("expected both notifiers to be called, got %d calls", calls)
+	}
+}
@@ -0,0 +1,115 @@
+package services
+
+import (
+	"math"
+	"time"
+)
+
+// Settings
+const (
+	// The score at or below which an endpoint is demoted and only used if nothing better is available
+	bcDemotionScore float64 = 50
+
+	// The score an endpoint needs to reach before a demoted endpoint is promoted again
+	bcPromotionScore float64 = 70
+
+	// How much better another endpoint's score has to be before the manager switches away from the selected one
+	bcSwitchMargin float64 = 15
+
+	// The weight of the latest call in the error rate and latency moving averages
+	bcHealthSmoothing float64 = 0.2
+
+	// How long to wait between health checks when the daemon isn't checking the clients itself
+	bcHealthCheckInterval time.Duration = time.Minute
+)
+
+// The health of a single Beacon endpoint, as tracked by the manager
+type bcEndpointHealth struct {
+	// Whether or not the last sync check succeeded
+	IsWorking bool
+
+	// Whether or not the endpoint reported itself as synced during the last check
+	IsSynced bool
+
+	// The sync progress reported during the last check
+	SyncProgress float64
+
+	// The number of slots the endpoint still has to sync
+	SyncDistance uint64
+
+	// The endpoint's head slot during the last check
+	HeadSlot uint64
+
+	// The number of slots the endpoint's head is behind the best head in the pool
+	HeadLag uint64
+
+	// The moving average of calls that failed because the endpoint couldn't be reached (0 to 1)
+	ErrorRate float64
+
+	// The moving average of the endpoint's response time
+	Latency time.Duration
+
+	// The error from the last failed check or call
+	LastError string
+
+	// The endpoint's overall score, from 0 (unusable) to 100 (perfect)
+	Score float64
+
+	// True if the endpoint's score dropped too low and it hasn't recovered yet
+	Demoted bool
+}
+
+// Record the result of a call made against the endpoint
+func (h *bcEndpointHealth) recordCall(latency time.Duration, failed bool) {
+	failure := 0.0
+	if failed {
+		failure = 1
+	}
+	h.ErrorRate = h.ErrorRate*(1-bcHealthSmoothing) + failure*bcHealthSmoothing
+	if !failed {
+		if h.Latency == 0 {
+			h.Latency = latency
+		} else {
+			h.Latency = time.Duration(float64(h.Latency)*(1-bcHealthSmoothing) + float64(latency)*bcHealthSmoothing)
+		}
+	}
+}
+
+// Recalculate the endpoint's score and update its demotion status
+func (h *bcEndpointHealth) updateScore() {
+	h.Score = scoreBeaconEndpoint(h)
+	if h.Demoted {
+		h.Demoted = h.Score < bcPromotionScore
+	} else {
+		h.Demoted = h.Score <= bcDemotionScore
+	}
+}
+
+// Score an endpoint based on its sync status, how far behind the other endpoints it is, how often it fails, and how slow it is
+func scoreBeaconEndpoint(h *bcEndpointHealth) float64 {
+	if !h.IsWorking {
+		return 0
+	}
+
+	score := 100.0
+
+	// Lose a point per slot left to sync, and at least half of the score if the node reports itself as syncing
+	score -= math.Min(float64(h.SyncDistance), 50)
+	if !h.IsSynced {
+		score = math.Min(score, bcDemotionScore)
+	}
+
+	// Lose 5 points per slot behind the best head in the pool
+	score -= math.Min(5*float64(h.HeadLag), 30)
+
+	// Lose up to 50 points for failed calls
+	score -= 50 * h.ErrorRate
+
+	// Lose a point per 50ms of latency above 200ms
+	latencyMs := float64(h.Latency.Milliseconds())
+	if latencyMs > 200 {
+		score -= math.Min((latencyMs-200)/50, 20)
+	}
+
+	return math.Max(score, 0)
+}
@@ -1,9 +1,13 @@
 package services
 
 import (
+	"context"
 	"fmt"
 	"math/big"
+	"sort"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/fatih/color"
@@ -18,14 +22,43 @@ import (
 
 const bnContainerName string = "eth2"
 
+// A single Beacon endpoint in the manager's pool
+type bcEndpoint struct {
+	name   string
+	client beacon.Client
+	ready  bool
+	health bcEndpointHealth
+}
+
 // This is a proxy for multiple Beacon clients, providing natural fallback support if one of them fails.
+// The first endpoint is the primary client, the second is the fallback client if one is enabled, and the rest are additional endpoints.
+// Endpoints are scored on their health; the manager sticks with the selected endpoint until it fails or another one is clearly healthier.
+// Pinned and fallback-only views of the manager share its pool, so they see the same endpoint health.
 type BeaconClientManager struct {
-	primaryBc       beacon.Client
-	fallbackBc      beacon.Client
+	*bcPool
+
+	// The endpoint this view is pinned to, if it's a pinned view
+	pin *bcPin
+
+	// True if this view should never use the primary client
+	skipPrimary bool
+}
+
+// The endpoints and the selection shared by a manager and all of its views
+type bcPool struct {
+	endpoints       []*bcEndpoint
+	fallbackEnabled bool
+	selected        *bcEndpoint
+	lastCheck       time.Time
 	logger          log.ColorLogger
-	primaryReady    bool
-	fallbackReady   bool
 	ignoreSyncCheck bool
+	lock            *sync.Mutex
+}
+
+// A pinned view's endpoint, which it keeps using until its context is done
+type bcPin struct {
+	ctx      context.Context
+	endpoint *bcEndpoint
 }
 
 // This is a signature for a wrapped Beacon client function that only returns an error
@@ -75,21 +108,53 @@ func NewBeaconClientManager(cfg *config.RocketPoolConfig) (*BeaconClientManager,
 		}
 	}
 
-	var primaryBc beacon.Client
 	var fallbackBc beacon.Client
-	primaryBc = client.NewStandardHttpClient(primaryProvider)
 	if fallbackProvider != "" {
 		fallbackBc = client.NewStandardHttpClient(fallbackProvider)
 	}
 
-	return &BeaconClientManager{
-		primaryBc:     primaryBc,
-		fallbackBc:    fallbackBc,
-		logger:        log.NewColorLogger(color.FgHiBlue),
-		primaryReady:  true,
-		fallbackReady: fallbackBc != nil,
-	}, nil
+	// Additional CCs
+	additionalBcs := []beacon.Client{}
+	for _, url := range cfg.Smartnode.GetAdditionalBeaconNodeUrls() {
+		additionalBcs = append(additionalBcs, client.NewStandardHttpClient(url))
+	}
+
+	return newBeaconClientManager(client.NewStandardHttpClient(primaryProvider), fallbackBc, additionalBcs...), nil
+
+}
 
+// Creates a new BeaconClientManager from a primary client, an optional fallback client, and any number of additional clients
+func newBeaconClientManager(primaryBc beacon.Client, fallbackBc beacon.Client, additionalBcs ...beacon.Client) *BeaconClientManager {
+	m := &BeaconClientManager{
+		bcPool: &bcPool{
+			fallbackEnabled: fallbackBc != nil,
+			logger:          log.NewColorLogger(color.FgHiBlue),
+			lock:            &sync.Mutex{},
+		},
+	}
+	m.addEndpoint("primary Beacon client", primaryBc)
+	if fallbackBc != nil {
+		m.addEndpoint("fallback Beacon client", fallbackBc)
+	}
+	for i, bc := range additionalBcs {
+		m.addEndpoint(fmt.Sprintf("additional Beacon client %d", i+1), bc)
+	}
+	m.selected = m.endpoints[0]
+	return m
+}
+
+// Add an endpoint to the pool; endpoints start out ready with a perfect score until they're checked
+func (m *BeaconClientManager) addEndpoint(name string, bc beacon.Client) {
+	m.endpoints = append(m.endpoints, &bcEndpoint{
+		name:   name,
+		client: bc,
+		ready:  true,
+		health: bcEndpointHealth{
+			IsWorking: true,
+			IsSynced:  true,
+			Score:     100,
+		},
+	})
 }
 
 /// ======================
@@ -367,41 +432,154 @@ func (m *BeaconClientManager) GetValidatorBalances(indices []string, opts *beaco
 /// Internal Functions
 /// ==================
 
+// Check the health of every endpoint, rescore them, and update the selected endpoint
 func (m *BeaconClientManager) CheckStatus() *api.ClientManagerStatus {
 
-	status := &api.ClientManagerStatus{
-		FallbackEnabled: m.fallbackBc != nil,
-	}
-
 	// Ignore the sync check and just use the predefined settings if requested
 	if m.ignoreSyncCheck {
-		status.PrimaryClientStatus.IsWorking = m.primaryReady
-		status.PrimaryClientStatus.IsSynced = m.primaryReady
-		if status.FallbackEnabled {
-			status.FallbackClientStatus.IsWorking = m.fallbackReady
-			status.FallbackClientStatus.IsSynced = m.fallbackReady
+		return m.getStatus()
+	}
+
+	// Check the endpoints without holding the lock, since the checks make network calls
+	m.lock.Lock()
+	endpoints := make([]*bcEndpoint, len(m.endpoints))
+	copy(endpoints, m.endpoints)
+	m.lock.Unlock()
+
+	statuses := make([]api.ClientStatus, len(endpoints))
+	syncStatuses := make([]beacon.SyncStatus, len(endpoints))
+	latencies := make([]time.Duration, len(endpoints))
+	var wg sync.WaitGroup
+	for i, endpoint := range endpoints {
+		wg.Add(1)
+		go func(i int, endpoint *bcEndpoint) {
+			defer wg.Done()
+			start := time.Now()
+			statuses[i], syncStatuses[i] = checkBcStatus(endpoint.client)
+			latencies[i] = time.Since(start)
+		}(i, endpoint)
+	}
+	wg.Wait()
+
+	// Find the best head in the pool
+	var bestHead uint64
+	for i, status := range statuses {
+		if status.IsWorking && syncStatuses[i].HeadSlot > bestHead {
+			bestHead = syncStatuses[i].HeadSlot
 		}
-		return status
 	}
 
-	// Get the primary BC status
-	status.PrimaryClientStatus = checkBcStatus(m.primaryBc)
+	// Update each endpoint's health
+	m.lock.Lock()
+	for i, endpoint := range endpoints {
+		status := statuses[i]
+		health := &endpoint.health
+		health.IsWorking = status.IsWorking
+		health.IsSynced = status.IsSynced
+		health.SyncProgress = status.SyncProgress
+		health.SyncDistance = syncStatuses[i].SyncDistance
+		health.HeadSlot = syncStatuses[i].HeadSlot
+		health.HeadLag = 0
+		if status.IsWorking {
+			health.HeadLag = bestHead - syncStatuses[i].HeadSlot
+		}
+		health.LastError = status.Error
+		health.recordCall(latencies[i], !status.IsWorking)
+		health.updateScore()
 
-	// Get the fallback BC status if applicable
-	if status.FallbackEnabled {
-		status.FallbackClientStatus = checkBcStatus(m.fallbackBc)
+		// Flag the ready clients
+		endpoint.ready = status.IsWorking && status.IsSynced
 	}
+	m.lastCheck = time.Now()
+	m.updateSelection()
+	m.lock.Unlock()
 
-	// Flag the ready clients
-	m.primaryReady = (status.PrimaryClientStatus.IsWorking && status.PrimaryClientStatus.IsSynced)
-	m.fallbackReady = (status.FallbackEnabled && status.FallbackClientStatus.IsWorking && status.FallbackClientStatus.IsSynced)
+	return m.getStatus()
 
-	return status
+}
 
+// Get a view of the manager that keeps using the currently selected endpoint until ctx is done, only moving to another one if it fails.
+// Use this to make sure every Beacon call made during a task is served by the same endpoint; the pin doesn't hold back the
+// manager's own selection, so everything else still fails back to the healthiest endpoint.
+func (m *BeaconClientManager) Pin(ctx context.Context) *BeaconClientManager {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return &BeaconClientManager{
+		bcPool:      m.bcPool,
+		pin:         &bcPin{ctx: ctx, endpoint: m.getPreferred()},
+		skipPrimary: m.skipPrimary,
+	}
+}
+
+// Get a view of the manager that never uses the primary client; used to force the fallbacks for a single request
+func (m *BeaconClientManager) withoutPrimary() *BeaconClientManager {
+	return &BeaconClientManager{
+		bcPool:      m.bcPool,
+		pin:         m.pin,
+		skipPrimary: true,
+	}
+}
+
+// Get a client that keeps serving calls from the same Beacon endpoint until ctx is done.
+// Clients that aren't a BeaconClientManager never switch endpoints, so they're returned as they are.
+func PinBeaconClient(ctx context.Context, bc beacon.Client) beacon.Client {
+	if m, ok := bc.(*BeaconClientManager); ok {
+		return m.Pin(ctx)
+	}
+	return bc
+}
+
+// Get the manager's status report from the current health of its endpoints
+func (m *BeaconClientManager) getStatus() *api.ClientManagerStatus {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	status := &api.ClientManagerStatus{
+		FallbackEnabled: m.fallbackEnabled,
+		Endpoints:       make([]api.ClientEndpointStatus, len(m.endpoints)),
+	}
+	for i, endpoint := range m.endpoints {
+		clientStatus := api.ClientStatus{
+			IsWorking:    endpoint.health.IsWorking,
+			IsSynced:     endpoint.health.IsSynced,
+			SyncProgress: endpoint.health.SyncProgress,
+			Error:        endpoint.health.LastError,
+		}
+
+		// Use the predefined settings if the sync check is being ignored
+		if m.ignoreSyncCheck {
+			clientStatus = api.ClientStatus{
+				IsWorking: endpoint.ready,
+				IsSynced:  endpoint.ready,
+			}
+		}
+		if i == 0 && m.skipPrimary {
+			clientStatus = api.ClientStatus{
+				Error: "the fallback clients are being forced",
+			}
+		}
+		status.Endpoints[i] = api.ClientEndpointStatus{
+			ClientStatus: clientStatus,
+			Name:         endpoint.name,
+			Selected:     endpoint == m.getPreferred() && !(i == 0 && m.skipPrimary),
+			Demoted:      endpoint.health.Demoted,
+			Score:        endpoint.health.Score,
+			SyncDistance: endpoint.health.SyncDistance,
+			HeadLag:      endpoint.health.HeadLag,
+			ErrorRate:    endpoint.health.ErrorRate,
+			Latency:      endpoint.health.Latency,
+		}
+	}
+
+	status.PrimaryClientStatus = status.Endpoints[0].ClientStatus
+	if m.fallbackEnabled {
+		status.FallbackClientStatus = status.Endpoints[1].ClientStatus
+	}
+	return status
 }
 
 // Check the client status
-func checkBcStatus(client beacon.Client) api.ClientStatus {
+func checkBcStatus(client beacon.Client) (api.ClientStatus, beacon.SyncStatus) {
 
 	status := api.ClientStatus{}
 
@@ -411,7 +589,7 @@ func checkBcStatus(client beacon.Client) api.ClientStatus {
 		status.Error = fmt.Sprintf("Sync progress check failed with [%s]", err.Error())
 		status.IsSynced = false
 		status.IsWorking = false
-		return status
+		return status, syncStatus
 	}
 
 	// Return the sync status
@@ -424,134 +602,223 @@ func checkBcStatus(client beacon.Client) api.ClientStatus {
 		status.IsSynced = false
 		status.SyncProgress = syncStatus.Progress
 	}
-	return status
+	return status, syncStatus
 
 }
 
-// Attempts to run a function progressively through each client until one succeeds or they all fail.
-func (m *BeaconClientManager) runFunction0(function bcFunction0) error {
+// Switch to the best endpoint if the selected one isn't usable anymore, or if another one is clearly healthier.
+// The caller must hold the lock.
+func (m *BeaconClientManager) updateSelection() {
+	candidates := m.getRankedEndpoints(false)
+	if len(candidates) == 0 {
+		return
+	}
+	best := candidates[0]
+	current := m.selected
+	if best == current {
+		return
+	}
 
-	// Check if we can use the primary
-	if m.primaryReady {
-		// Try to run the function on the primary
-		err := function(m.primaryBc)
-		if err != nil {
-			if m.isDisconnected(err) {
-				// If it's disconnected, log it and try the fallback
-				m.logger.Printlnf("WARNING: Primary Beacon client disconnected (%s), using fallback...", err.Error())
-				m.primaryReady = false
-				return m.runFunction0(function)
-			}
-			// If it's a different error, just return it
-			return err
+	// Stay on a usable endpoint unless the best one is clearly healthier, or just as healthy and earlier in the configured order (e.g. the primary recovering)
+	currentUsable := current.ready && !current.health.Demoted
+	if currentUsable {
+		preferred := m.getIndex(best) < m.getIndex(current) && best.health.Score >= current.health.Score
+		if !preferred && best.health.Score < current.health.Score+bcSwitchMargin {
+			return
 		}
-		// If there's no error, return the result
-		return nil
 	}
 
-	if m.fallbackReady {
-		// Try to run the function on the fallback
-		err := function(m.fallbackBc)
-		if err != nil {
-			if m.isDisconnected(err) {
-				// If it's disconnected, log it and try the fallback
-				m.logger.Printlnf("WARNING: Fallback Beacon client disconnected (%s)", err.Error())
-				m.fallbackReady = false
-				return fmt.Errorf("all Beacon clients failed")
-			}
+	m.logger.Printlnf("Switching from the %s (score %.0f) to the %s (score %.0f).", current.name, current.health.Score, best.name, best.health.Score)
+	m.selected = best
+}
 
-			// If it's a different error, just return it
-			return err
+// Get the position of an endpoint in the configured order
+func (m *BeaconClientManager) getIndex(endpoint *bcEndpoint) int {
+	for i, candidate := range m.endpoints {
+		if candidate == endpoint {
+			return i
+		}
+	}
+	return len(m.endpoints)
+}
+
+// Get the ready endpoints, healthy ones by score and then demoted ones, optionally leaving out the primary.
+// The caller must hold the lock.
+func (m *BeaconClientManager) getRankedEndpoints(skipPrimary bool) []*bcEndpoint {
+	ranked := []*bcEndpoint{}
+	for i, endpoint := range m.endpoints {
+		if endpoint.ready && !(i == 0 && skipPrimary) {
+			ranked = append(ranked, endpoint)
 		}
-		// If there's no error, return the result
-		return nil
 	}
 
-	return fmt.Errorf("no Beacon clients were ready")
+	// Stable sorting keeps the configured order for endpoints with the same score, so the primary wins ties
+	sort.SliceStable(ranked, func(i, j int) bool {
+		a, b := ranked[i], ranked[j]
+		if a.health.Demoted != b.health.Demoted {
+			return !a.health.Demoted
+		}
+		return a.health.Score > b.health.Score
+	})
+	return ranked
 }
 
-// Attempts to run a function progressively through each client until one succeeds or they all fail.
-func (m *BeaconClientManager) runFunction1(function bcFunction1) (interface{}, error) {
+// Get the endpoint this view should use: the pinned one while the pin's context is alive, otherwise the manager's selection.
+// The caller must hold the lock.
+func (m *BeaconClientManager) getPreferred() *bcEndpoint {
+	if m.pin != nil && m.pin.ctx.Err() == nil {
+		return m.pin.endpoint
+	}
+	return m.selected
+}
 
-	// Check if we can use the primary
-	if m.primaryReady {
-		// Try to run the function on the primary
-		result, err := function(m.primaryBc)
-		if err != nil {
-			if m.isDisconnected(err) {
-				// If it's disconnected, log it and try the fallback
-				m.logger.Printlnf("WARNING: Primary Beacon client disconnected (%s), using fallback...", err.Error())
-				m.primaryReady = false
-				return m.runFunction1(function)
-			}
-			// If it's a different error, just return it
-			return nil, err
+// Get the ready endpoints in the order they should be tried: the preferred one first as long as it's healthy, then the rest by rank.
+// The caller must hold the lock.
+func (m *BeaconClientManager) getCandidates() []*bcEndpoint {
+	candidates := m.getRankedEndpoints(m.skipPrimary)
+	preferred := m.getPreferred()
+	for i, endpoint := range candidates {
+		if endpoint == preferred && !endpoint.health.Demoted {
+			copy(candidates[1:i+1], candidates[:i])
+			candidates[0] = endpoint
+			break
 		}
-		// If there's no error, return the result
-		return result, nil
 	}
+	return candidates
+}
 
-	if m.fallbackReady {
-		// Try to run the function on the fallback
-		result, err := function(m.fallbackBc)
-		if err != nil {
-			if m.isDisconnected(err) {
-				// If it's disconnected, log it and try the fallback
-				m.logger.Printlnf("WARNING: Fallback Beacon client disconnected (%s)", err.Error())
-				m.fallbackReady = false
-				return nil, fmt.Errorf("all Beacon clients failed")
-			}
-			// If it's a different error, just return it
-			return nil, err
+// Refresh the endpoint health if it's gone stale, so long-running processes that don't check the clients themselves still fail back
+func (m *BeaconClientManager) refreshIfStale() {
+	if m.ignoreSyncCheck || len(m.endpoints) < 2 {
+		return
+	}
+	m.lock.Lock()
+	stale := time.Since(m.lastCheck) > bcHealthCheckInterval
+	if stale {
+		// Claim the refresh so concurrent calls don't all run one
+		m.lastCheck = time.Now()
+	}
+	m.lock.Unlock()
+	if stale {
+		m.CheckStatus()
+	}
+}
+
+// Attempts to run a function progressively through each client until one succeeds or they all fail.
+func (m *BeaconClientManager) runFunction0(function bcFunction0) error {
+	m.refreshIfStale()
+
+	m.lock.Lock()
+	candidates := m.getCandidates()
+	m.lock.Unlock()
+	if len(candidates) == 0 {
+		return fmt.Errorf("no Beacon clients were ready")
+	}
+
+	for i, endpoint := range candidates {
+		start := time.Now()
+		err := function(endpoint.client)
+		disconnected := err != nil && m.isDisconnected(err)
+		m.recordCall(endpoint, time.Since(start), disconnected)
+		if !disconnected {
+			// A pinned view sticks with whichever endpoint took over for the rest of its task
+			m.updatePin(endpoint)
+
+			// If there's no error, or if it's a different error, just return it
+			return err
 		}
-		// If there's no error, return the result
-		return result, nil
+
+		// If it's disconnected, log it and try the next endpoint
+		m.markDisconnected(endpoint, err, i < len(candidates)-1)
 	}
 
-	return nil, fmt.Errorf("no Beacon clients were ready")
+	return fmt.Errorf("all Beacon clients failed")
+}
 
+// Attempts to run a function progressively through each client until one succeeds or they all fail.
+func (m *BeaconClientManager) runFunction1(function bcFunction1) (interface{}, error) {
+	var result interface{}
+	err := m.runFunction0(func(client beacon.Client) error {
+		var err error
+		result, err = function(client)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
 }
 
 // Attempts to run a function progressively through each client until one succeeds or they all fail.
 func (m *BeaconClientManager) runFunction2(function bcFunction2) (interface{}, interface{}, error) {
+	var result1 interface{}
+	var result2 interface{}
+	err := m.runFunction0(func(client beacon.Client) error {
+		var err error
+		result1, result2, err = function(client)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return result1, result2, nil
+}
 
-	// Check if we can use the primary
-	if m.primaryReady {
-		// Try to run the function on the primary
-		result1, result2, err := function(m.primaryBc)
-		if err != nil {
-			if m.isDisconnected(err) {
-				// If it's disconnected, log it and try the fallback
-				m.logger.Printlnf("WARNING: Primary Beacon client disconnected (%s), using fallback...", err.Error())
-				m.primaryReady = false
-				return m.runFunction2(function)
-			}
-			// If it's a different error, just return it
-			return nil, nil, err
-		}
-		// If there's no error, return the result
-		return result1, result2, nil
+// Move a pinned view to the endpoint that served its last call
+func (m *BeaconClientManager) updatePin(endpoint *bcEndpoint) {
+	if m.pin == nil {
+		return
 	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.pin.ctx.Err() == nil {
+		m.pin.endpoint = endpoint
+	}
+}
 
-	if m.fallbackReady {
-		// Try to run the function on the fallback
-		result1, result2, err := function(m.fallbackBc)
-		if err != nil {
-			if m.isDisconnected(err) {
-				// If it's disconnected, log it and try the fallback
-				m.logger.Printlnf("WARNING: Fallback Beacon client disconnected (%s)", err.Error())
-				m.fallbackReady = false
-				return nil, nil, fmt.Errorf("all Beacon clients failed")
-			}
-			// If it's a different error, just return it
-			return nil, nil, err
-		}
-		// If there's no error, return the result
-		return result1, result2, nil
+// Record the outcome of a call against an endpoint
+func (m *BeaconClientManager) recordCall(endpoint *bcEndpoint, latency time.Duration, failed bool) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	endpoint.health.recordCall(latency, failed)
+	endpoint.health.updateScore()
+}
+
+// Take an endpoint that couldn't be reached out of rotation until the next status check
+func (m *BeaconClientManager) markDisconnected(endpoint *bcEndpoint, err error, hasNext bool) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	endpoint.ready = false
+	endpoint.health.IsWorking = false
+	endpoint.health.LastError = err.Error()
+	endpoint.health.updateScore()
+	if hasNext {
+		m.logger.Printlnf("WARNING: %s disconnected (%s), trying the next one...", endpoint.name, err.Error())
+	} else {
+		m.logger.Printlnf("WARNING: %s disconnected (%s)", endpoint.name, err.Error())
 	}
+	if endpoint == m.selected {
+		m.updateSelection()
+	}
+}
 
-	return nil, nil, fmt.Errorf("no Beacon clients were ready")
+// Check if the primary client is ready
+func (m *BeaconClientManager) isPrimaryReady() bool {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.endpoints[0].ready && !m.skipPrimary
+}
 
+// Check if any client other than the primary is ready
+func (m *BeaconClientManager) isFallbackReady() bool {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	for _, endpoint := range m.endpoints[1:] {
+		if endpoint.ready {
+			return true
+		}
+	}
+	return false
 }
 
 // Returns true if the error was a connection failure and a backup client is available
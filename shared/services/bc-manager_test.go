@@ -0,0 +1,221 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/beacon/client"
+)
+
+// A fake Beacon node that only serves the sync status endpoint
+type fakeBeaconNode struct {
+	server       *httptest.Server
+	lock         sync.Mutex
+	headSlot     uint64
+	syncDistance uint64
+	requests     int
+}
+
+func newFakeBeaconNode(t *testing.T, headSlot uint64) *fakeBeaconNode {
+	node := &fakeBeaconNode{headSlot: headSlot}
+	node.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/eth/v1/node/syncing" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		node.lock.Lock()
+		defer node.lock.Unlock()
+		node.requests++
+		fmt.Fprintf(w, `{"data":{"head_slot":"%d","sync_distance":"%d","is_syncing":%t,"is_optimistic":false,"el_offline":false}}`, node.headSlot, node.syncDistance, node.syncDistance > 0)
+	}))
+	t.Cleanup(node.server.Close)
+	return node
+}
+
+func (n *fakeBeaconNode) setSync(headSlot uint64, syncDistance uint64) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.headSlot = headSlot
+	n.syncDistance = syncDistance
+}
+
+func (n *fakeBeaconNode) getRequests() int {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return n.requests
+}
+
+func newTestBeaconClientManager(nodes ...*fakeBeaconNode) *BeaconClientManager {
+	clients := []beacon.Client{}
+	for _, node := range nodes {
+		clients = append(clients, client.NewStandardHttpClient(node.server.URL))
+	}
+	return newBeaconClientManager(clients[0], clients[1], clients[2:]...)
+}
+
+func TestScoreBeaconEndpoint(t *testing.T) {
+	tests := []struct {
+		name     string
+		health   bcEndpointHealth
+		expected float64
+	}{
+		{"healthy", bcEndpointHealth{IsWorking: true, IsSynced: true, Latency: 50 * time.Millisecond}, 100},
+		{"down", bcEndpointHealth{IsWorking: false, IsSynced: true}, 0},
+		{"syncing", bcEndpointHealth{IsWorking: true, IsSynced: false, SyncDistance: 10}, 50},
+		{"lagging", bcEndpointHealth{IsWorking: true, IsSynced: true, HeadLag: 2}, 90},
+		{"flaky", bcEndpointHealth{IsWorking: true, IsSynced: true, ErrorRate: 0.5}, 75},
+		{"slow", bcEndpointHealth{IsWorking: true, IsSynced: true, Latency: 700 * time.Millisecond}, 90},
+	}
+
+	for _, test := range tests {
+		score := scoreBeaconEndpoint(&test.health)
+		if score != test.expected {
+			t.Errorf("%s: expected a score of %.0f, got %.0f", test.name, test.expected, score)
+		}
+	}
+}
+
+func TestBeaconClientManagerFailover(t *testing.T) {
+	primary := newFakeBeaconNode(t, 1000)
+	fallback := newFakeBeaconNode(t, 1000)
+	m := newTestBeaconClientManager(primary, fallback)
+	m.lastCheck = time.Now()
+
+	// Calls go to the primary while it's up
+	if _, err := m.GetSyncStatus(); err != nil {
+		t.Fatal(err)
+	}
+	if primary.getRequests() != 1 || fallback.getRequests() != 0 {
+		t.Fatalf("expected the primary to serve the call, got %d primary and %d fallback requests", primary.getRequests(), fallback.getRequests())
+	}
+
+	// Once the primary goes down, calls fail over to the fallback
+	primary.server.Close()
+	if _, err := m.GetSyncStatus(); err != nil {
+		t.Fatal(err)
+	}
+	if fallback.getRequests() != 1 {
+		t.Fatalf("expected the fallback to serve the call, got %d requests", fallback.getRequests())
+	}
+	if m.isPrimaryReady() || !m.isFallbackReady() {
+		t.Fatal("expected the primary to be out of rotation")
+	}
+	status := m.CheckStatus()
+	if status.PrimaryClientStatus.IsWorking || !status.Endpoints[1].Selected {
+		t.Fatalf("expected the fallback to be selected, got %+v", status.Endpoints)
+	}
+
+	// Once every endpoint is down, calls fail
+	fallback.server.Close()
+	if _, err := m.GetSyncStatus(); err == nil {
+		t.Fatal("expected an error with every endpoint down")
+	}
+}
+
+func TestBeaconClientManagerScoring(t *testing.T) {
+	primary := newFakeBeaconNode(t, 1000)
+	fallback := newFakeBeaconNode(t, 1000)
+	additional := newFakeBeaconNode(t, 1000)
+	m := newTestBeaconClientManager(primary, fallback, additional)
+
+	// A syncing primary gets demoted and the next healthiest endpoint takes over
+	primary.setSync(900, 100)
+	status := m.CheckStatus()
+	if !status.Endpoints[0].Demoted || status.Endpoints[0].Selected {
+		t.Fatalf("expected the syncing primary to be demoted, got %+v", status.Endpoints[0])
+	}
+	if !status.Endpoints[1].Selected {
+		t.Fatalf("expected the fallback to be selected, got %+v", status.Endpoints)
+	}
+
+	// A lagging fallback loses to the additional endpoint once it's clearly worse
+	fallback.setSync(990, 0)
+	status = m.CheckStatus()
+	if !status.Endpoints[2].Selected {
+		t.Fatalf("expected the additional endpoint to be selected, got %+v", status.Endpoints)
+	}
+
+	// Once it recovers, the primary is promoted and preferred again, even while a task is pinned to another endpoint
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	pinned := m.Pin(ctx)
+	primary.setSync(1000, 0)
+	fallback.setSync(1000, 0)
+	status = m.CheckStatus()
+	if status.Endpoints[0].Demoted || !status.Endpoints[0].Selected {
+		t.Fatalf("expected the recovered primary to be selected, got %+v", status.Endpoints)
+	}
+
+	// The pinned task keeps using its endpoint
+	requests := additional.getRequests()
+	if _, err := pinned.GetSyncStatus(); err != nil {
+		t.Fatal(err)
+	}
+	if additional.getRequests() != requests+1 {
+		t.Fatal("expected the pinned endpoint to serve the task's call")
+	}
+
+	// Once the task is done, its view follows the manager's selection again
+	cancel()
+	requests = primary.getRequests()
+	if _, err := pinned.GetSyncStatus(); err != nil {
+		t.Fatal(err)
+	}
+	if primary.getRequests() != requests+1 {
+		t.Fatal("expected the primary to serve the call once the pin was released")
+	}
+}
+
+func TestBeaconClientManagerPinFailover(t *testing.T) {
+	primary := newFakeBeaconNode(t, 1000)
+	fallback := newFakeBeaconNode(t, 1000)
+	m := newTestBeaconClientManager(primary, fallback)
+	m.lastCheck = time.Now()
+
+	// A pinned task moves on when its endpoint goes down, and sticks with the new one
+	pinned := m.Pin(context.Background())
+	primary.server.Close()
+	if _, err := pinned.GetSyncStatus(); err != nil {
+		t.Fatal(err)
+	}
+	if fallback.getRequests() != 1 {
+		t.Fatalf("expected the fallback to serve the call, got %d requests", fallback.getRequests())
+	}
+	m.lock.Lock()
+	pinnedEndpoint := pinned.pin.endpoint
+	m.lock.Unlock()
+	if pinnedEndpoint != m.endpoints[1] {
+		t.Fatalf("expected the task to be pinned to the fallback, got the %s", pinnedEndpoint.name)
+	}
+}
+
+func TestBeaconClientManagerWithoutPrimary(t *testing.T) {
+	primary := newFakeBeaconNode(t, 1000)
+	fallback := newFakeBeaconNode(t, 1000)
+	m := newTestBeaconClientManager(primary, fallback)
+	m.lastCheck = time.Now()
+
+	// Forcing the fallbacks only affects the view, not the shared manager
+	forced := m.withoutPrimary()
+	if _, err := forced.GetSyncStatus(); err != nil {
+		t.Fatal(err)
+	}
+	if primary.getRequests() != 0 || fallback.getRequests() != 1 {
+		t.Fatalf("expected the fallback to serve the call, got %d primary and %d fallback requests", primary.getRequests(), fallback.getRequests())
+	}
+	if forced.isPrimaryReady() || !m.isPrimaryReady() {
+		t.Fatal("expected only the view to skip the primary")
+	}
+	if _, err := m.GetSyncStatus(); err != nil {
+		t.Fatal(err)
+	}
+	if primary.getRequests() != 1 {
+		t.Fatalf("expected the primary to serve the manager's call, got %d requests", primary.getRequests())
+	}
+}
@@ -17,8 +17,10 @@ type ValidatorStatusOptions struct {
 
 // API response types
 type SyncStatus struct {
-	Syncing  bool
-	Progress float64
+	Syncing      bool
+	Progress     float64
+	HeadSlot     uint64
+	SyncDistance uint64
 }
 type Eth2DepositContract struct {
 	ChainID uint64
@@ -61,6 +63,7 @@ type BeaconBlock struct {
 	Attestations         []AttestationInfo
 	FeeRecipient         common.Address
 	ExecutionBlockNumber uint64
+	ExecutionBlockHash   common.Hash
 	Withdrawals          []WithdrawalInfo
 }
 type BeaconBlockHeader struct {
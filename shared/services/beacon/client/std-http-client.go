@@ -92,8 +92,10 @@ func (c *StandardHttpClient) GetSyncStatus() (beacon.SyncStatus, error) {
 
 	// Return response
 	return beacon.SyncStatus{
-		Syncing:  syncStatus.Data.IsSyncing,
-		Progress: progress,
+		Syncing:      syncStatus.Data.IsSyncing,
+		Progress:     progress,
+		HeadSlot:     uint64(syncStatus.Data.HeadSlot),
+		SyncDistance: uint64(syncStatus.Data.SyncDistance),
 	}, nil
 
 }
@@ -144,6 +146,8 @@ func (c *StandardHttpClient) GetEth2Config() (beacon.Eth2Config, error) {
 		SlotsPerEpoch:                uint64(eth2Config.Data.SlotsPerEpoch),
 		SecondsPerEpoch:              uint64(eth2Config.Data.SecondsPerSlot * eth2Config.Data.SlotsPerEpoch),
 		EpochsPerSyncCommitteePeriod: uint64(eth2Config.Data.EpochsPerSyncCommitteePeriod),
+		CapellaForkVersion:           eth2Config.Data.CapellaForkVersion,
+		CapellaForkEpoch:             uint64(eth2Config.Data.CapellaForkEpoch),
 	}
 	eth2ConfigCache.Store(&out)
 
@@ -659,6 +663,7 @@ func (c *StandardHttpClient) GetBeaconBlock(blockId string) (beacon.BeaconBlock,
 		beaconBlock.HasExecutionPayload = true
 		beaconBlock.FeeRecipient = common.BytesToAddress(block.Data.Message.Body.ExecutionPayload.FeeRecipient)
 		beaconBlock.ExecutionBlockNumber = uint64(block.Data.Message.Body.ExecutionPayload.BlockNumber)
+		beaconBlock.ExecutionBlockHash = common.BytesToHash(block.Data.Message.Body.ExecutionPayload.BlockHash)
 	}
 
 	// Add attestation info
@@ -44,6 +44,7 @@ type Eth2ConfigResponse struct {
 		SecondsPerSlot               uinteger  `json:"SECONDS_PER_SLOT"`
 		SlotsPerEpoch                uinteger  `json:"SLOTS_PER_EPOCH"`
 		CapellaForkVersion           byteArray `json:"CAPELLA_FORK_VERSION"`
+		CapellaForkEpoch             uinteger  `json:"CAPELLA_FORK_EPOCH"`
 		EpochsPerSyncCommitteePeriod uinteger  `json:"EPOCHS_PER_SYNC_COMMITTEE_PERIOD"`
 	} `json:"data"`
 }
@@ -98,6 +99,7 @@ type BeaconBlockResponse struct {
 				ExecutionPayload *struct {
 					FeeRecipient byteArray    `json:"fee_recipient"`
 					BlockNumber  uinteger     `json:"block_number"`
+					BlockHash    byteArray    `json:"block_hash"`
 					Withdrawals  []Withdrawal `json:"withdrawals"`
 				} `json:"execution_payload"`
 			} `json:"body"`
@@ -17,21 +17,28 @@ type Eth2Config struct {
 	SlotsPerEpoch                uint64 `json:"slots_per_epoch"`
 	SecondsPerEpoch              uint64 `json:"seconds_per_epoch"`
 	EpochsPerSyncCommitteePeriod uint64 `json:"epochs_per_sync_committee_period"`
+	CapellaForkVersion           []byte `json:"capella_fork_version,omitempty"`
+	CapellaForkEpoch             uint64 `json:"capella_fork_epoch,omitempty"`
 }
 
 func (c *Eth2Config) MarshalJSON() ([]byte, error) {
-	// GenesisForkVersion and GenesisValidatorsRoot are returned as hex strings with 0x prefixes.
+	// GenesisForkVersion, GenesisValidatorsRoot and CapellaForkVersion are returned as hex strings with 0x prefixes.
 	// The other fields are returned as uint64s.
 	type Alias Eth2Config
-	return json.Marshal(&struct {
+	out := &struct {
 		GenesisForkVersion    string `json:"genesis_fork_version"`
 		GenesisValidatorsRoot string `json:"genesis_validators_root"`
+		CapellaForkVersion    string `json:"capella_fork_version,omitempty"`
 		*Alias
 	}{
 		GenesisForkVersion:    hexutil.Encode(c.GenesisForkVersion),
 		GenesisValidatorsRoot: hexutil.Encode(c.GenesisValidatorsRoot),
 		Alias:                 (*Alias)(c),
-	})
+	}
+	if len(c.CapellaForkVersion) > 0 {
+		out.CapellaForkVersion = hexutil.Encode(c.CapellaForkVersion)
+	}
+	return json.Marshal(out)
 }
 
 func (c *Eth2Config) UnmarshalJSON(data []byte) error {
@@ -39,6 +46,7 @@ func (c *Eth2Config) UnmarshalJSON(data []byte) error {
 	aux := &struct {
 		GenesisForkVersion    string `json:"genesis_fork_version"`
 		GenesisValidatorsRoot string `json:"genesis_validators_root"`
+		CapellaForkVersion    string `json:"capella_fork_version,omitempty"`
 		*Alias
 	}{
 		Alias: (*Alias)(c),
@@ -57,6 +65,15 @@ func (c *Eth2Config) UnmarshalJSON(data []byte) error {
 	if err != nil {
 		return err
 	}
+
+	// Older serialized configs don't have the Capella fork version
+	c.CapellaForkVersion = nil
+	if aux.CapellaForkVersion != "" {
+		c.CapellaForkVersion, err = hexutil.Decode(aux.CapellaForkVersion)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -67,6 +67,8 @@ func TestMarshalJSON(t *testing.T) {
 		SlotsPerEpoch:                32,
 		SecondsPerEpoch:              32 * 4,
 		EpochsPerSyncCommitteePeriod: 256,
+		CapellaForkVersion:           []byte{0x03, 0x00, 0x00, 0x08},
+		CapellaForkEpoch:             194048,
 	}
 
 	json, err := config.MarshalJSON()
@@ -107,4 +109,12 @@ func TestMarshalJSON(t *testing.T) {
 	if unmarshalled.EpochsPerSyncCommitteePeriod != config.EpochsPerSyncCommitteePeriod {
 		t.Fatalf("epochs per sync committee period should be %v, instead got %v", config.EpochsPerSyncCommitteePeriod, unmarshalled.EpochsPerSyncCommitteePeriod)
 	}
+
+	if !slices.Equal(unmarshalled.CapellaForkVersion, config.CapellaForkVersion) {
+		t.Fatalf("capella fork version should be %v, instead got %v", config.CapellaForkVersion, unmarshalled.CapellaForkVersion)
+	}
+
+	if unmarshalled.CapellaForkEpoch != config.CapellaForkEpoch {
+		t.Fatalf("capella fork epoch should be %v, instead got %v", config.CapellaForkEpoch, unmarshalled.CapellaForkEpoch)
+	}
 }
@@ -0,0 +1,440 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Where an alert is raised from
+type AlertSource int
+
+const (
+	// Sent directly by the node daemon or watchtower when an event occurs
+	AlertSource_Daemon AlertSource = iota
+
+	// Evaluated by Prometheus from a rule in the generated alerting rules file
+	AlertSource_Prometheus
+)
+
+// Alert IDs
+const (
+	AlertID_ClientSyncStatusBeacon      string = "ClientSyncStatusBeacon"
+	AlertID_ClientSyncStatusExecution   string = "ClientSyncStatusExecution"
+	AlertID_UpcomingSyncCommittee       string = "UpcomingSyncCommittee"
+	AlertID_ActiveSyncCommittee         string = "ActiveSyncCommittee"
+	AlertID_UpcomingProposal            string = "UpcomingProposal"
+	AlertID_RecentProposal              string = "RecentProposal"
+	AlertID_LowDiskSpaceWarning         string = "LowDiskSpaceWarning"
+	AlertID_LowDiskSpaceCritical        string = "LowDiskSpaceCritical"
+	AlertID_OSUpdatesAvailable          string = "OSUpdatesAvailable"
+	AlertID_RPUpdatesAvailable          string = "RPUpdatesAvailable"
+	AlertID_LowETHBalance               string = "LowETHBalance"
+	AlertID_FeeRecipientChanged         string = "FeeRecipientChanged"
+	AlertID_MinipoolBondReduced         string = "MinipoolBondReduced"
+	AlertID_MinipoolBalanceDistributed  string = "MinipoolBalanceDistributed"
+	AlertID_MinipoolPromoted            string = "MinipoolPromoted"
+	AlertID_MinipoolStaked              string = "MinipoolStaked"
+	AlertID_ExecutionClientSyncComplete string = "ExecutionClientSyncComplete"
+	AlertID_BeaconClientSyncComplete    string = "BeaconClientSyncComplete"
+	AlertID_MegapoolValidatorDissolved  string = "MegapoolValidatorDissolved"
+	AlertID_ExitChallengeReceived       string = "ExitChallengeReceived"
+	AlertID_PDAOProposalChallenged      string = "PDAOProposalChallenged"
+	AlertID_RewardsTreeDownloadFailed   string = "RewardsTreeDownloadFailed"
+	AlertID_MegapoolDeadlineApproaching string = "MegapoolDeadlineApproaching"
+	AlertID_GovernanceVoteNeeded        string = "GovernanceVoteNeeded"
+	AlertID_GovernanceVoteClosing       string = "GovernanceVoteClosing"
+	AlertID_PDAOAutomaticVote           string = "PDAOAutomaticVote"
+)
+
+// Severities
+const (
+	AlertSeverity_Info     = "info"
+	AlertSeverity_Warning  = "warning"
+	AlertSeverity_Critical = "critical"
+)
+
+// The value of the "status" field for alerts that report the outcome of an action
+const (
+	AlertStatus_Succeeded string = "succeeded"
+	AlertStatus_Failed    string = "failed"
+)
+
+// Declarative description of a single alert
+type AlertDefinition struct {
+	// The unique ID of the alert, used as the alert name and for its config toggle (alertEnabled_<ID>)
+	ID string
+
+	// A short lowercase phrase describing when the alert fires, used for the config toggle
+	Label string
+
+	// Where the alert is raised from
+	Source AlertSource
+
+	// True if the alert can be used in Native mode
+	NativeMode bool
+
+	// The severity of the alert
+	Severity string
+
+	// The severity of the alert when its "status" field is "failed"; if blank, Severity is used
+	FailureSeverity string
+
+	// How long the alert stays active after it's sent; if zero, this is derived from the severity. Only used by daemon alerts.
+	Duration time.Duration
+
+	// The summary and description of the alert. For daemon alerts these are Go templates over the alert's fields;
+	// for Prometheus alerts they are passed to Alertmanager verbatim.
+	Summary     string
+	Description string
+
+	// The fields that make an alert instance unique; their values are appended to the alert name. Only used by daemon alerts.
+	KeyFields []string
+
+	// The fields that are attached to the alert as labels. Only used by daemon alerts.
+	Labels []string
+
+	// The PromQL expression, "for" duration and job label of the rule. Only used by Prometheus alerts.
+	Expr string
+	For  string
+	Job  string
+
+	// An optional comment written above the rule's expression. Only used by Prometheus alerts.
+	ExprComment string
+}
+
+// All of the alerts the Smartnode can raise. New alerts only need to be added here to get a config toggle, a TUI entry and (for Prometheus alerts) a rule.
+var AlertDefinitions = []AlertDefinition{
+	{
+		ID:       AlertID_ClientSyncStatusBeacon,
+		Label:    "beacon client is not synced",
+		Source:   AlertSource_Prometheus,
+		Severity: AlertSeverity_Critical,
+		Expr:     `rocketpool_node_sync_progress{client="beacon"} < 1.0`,
+		For:      "5m",
+		Summary:  "The beacon client is not synced",
+	},
+	{
+		ID:       AlertID_ClientSyncStatusExecution,
+		Label:    "execution client is not synced",
+		Source:   AlertSource_Prometheus,
+		Severity: AlertSeverity_Critical,
+		Expr:     `rocketpool_node_sync_progress{client="execution"} < 1.0`,
+		For:      "5m",
+		Summary:  "The execution client is not synced",
+	},
+	{
+		ID:       AlertID_UpcomingSyncCommittee,
+		Label:    "about to become part of a sync committee",
+		Source:   AlertSource_Prometheus,
+		Severity: AlertSeverity_Warning,
+		Expr:     `rocketpool_beacon_upcoming_sync_committee > 0`,
+		Job:      "validator",
+		Summary:  "Your Rocket Pool node is about to become part of a sync committee",
+		Description: "If you were planning on doing maintenance to your node, **you should wait until the sync committee is over**. Not only are they worth an **extremely** large amount of ETH, but if you miss attestations during a sync committee, you **lose an extremely large amount of ETH** instead!\n" +
+			"You should be online as long as possible while you are in a sync committee.",
+	},
+	{
+		ID:       AlertID_ActiveSyncCommittee,
+		Label:    "part of a sync committee",
+		Source:   AlertSource_Prometheus,
+		Severity: AlertSeverity_Warning,
+		Expr:     `rocketpool_beacon_active_sync_committee > 0`,
+		Job:      "validator",
+		Summary:  "Your Rocket Pool node is part of a sync committee",
+		Description: "If you were planning on doing maintenance to your node, **you should wait until the sync committee is over**. Not only are they worth an **extremely** large amount of ETH, but if you miss attestations during a sync committee, you **lose an extremely large amount of ETH** instead!\n" +
+			"You should be online as long as possible while you are in a sync committee.",
+	},
+	{
+		ID:          AlertID_UpcomingProposal,
+		Label:       "about to propose a block",
+		Source:      AlertSource_Prometheus,
+		Severity:    AlertSeverity_Warning,
+		Expr:        `rocketpool_beacon_upcoming_proposals > 0`,
+		Job:         "validator",
+		Summary:     "Your Rocket Pool node is about to propose a block",
+		Description: "You have {{ $value }} block proposals coming up in the next few minutes. If you were planning on taking your node down for maintenance, you should wait until after the proposals because they're worth a lot of ETH!",
+	},
+	{
+		ID:          AlertID_RecentProposal,
+		Label:       "recently proposed a block",
+		Source:      AlertSource_Prometheus,
+		Severity:    AlertSeverity_Info,
+		Expr:        `rocketpool_beacon_recent_proposals > 0`,
+		ExprComment: "note: 384s = 12s slot time * 32 slots per epoch: This should prevent the alert from refiring during a single epoch",
+		For:         "384s",
+		Job:         "validator",
+		Summary:     "Your Rocket Pool node proposed a block",
+		Description: "Your node proposed {{ $value }} blocks a recent epoch.",
+	},
+	{
+		ID:          AlertID_LowDiskSpaceWarning,
+		Label:       "low disk space",
+		Source:      AlertSource_Prometheus,
+		Severity:    AlertSeverity_Warning,
+		Expr:        `node_filesystem_avail_bytes{job="node", mountpoint="/"} / 1024^3 < 200`,
+		Job:         "node",
+		Summary:     "Device {{ $labels.device }} on instance {{ $labels.instance }} is getting low on disk space",
+		Description: "{{ $labels.instance }} has low disk space. Currently has {{ humanize $value }} GB free.",
+	},
+	{
+		ID:          AlertID_LowDiskSpaceCritical,
+		Label:       "critically low disk space",
+		Source:      AlertSource_Prometheus,
+		Severity:    AlertSeverity_Critical,
+		ExprComment: "NOTE: 50GB taken from PruneFreeSpaceRequired in rocketpool-cli's nethermind pruning (it won't prune below 50GB)",
+		Expr:        `node_filesystem_avail_bytes{job="node", mountpoint="/"} / 1024^3 < 50`,
+		Job:         "node",
+		Summary:     "Device {{ $labels.device }} on instance {{ $labels.instance }} has critically low disk space",
+		Description: "{{ $labels.instance }} has critically low disk space. Currently has {{ humanize $value }} GB free.",
+	},
+	{
+		ID:       AlertID_OSUpdatesAvailable,
+		Label:    "OS updates available",
+		Source:   AlertSource_Prometheus,
+		Severity: AlertSeverity_Warning,
+		Expr:     `max(os_upgrades_pending{job="node"}) > 0`,
+		Job:      "node",
+		Summary:  "Rocket Pool OS Updates Available",
+		Description: "There are updates available for your OS that haven't been applied yet. You should update your OS.\n" +
+			"For more information on updating see the documentation at https://docs.rocketpool.net/guides/node/updates#updating-your-operating-system",
+	},
+	{
+		ID:       AlertID_RPUpdatesAvailable,
+		Label:    "Smartnode Update Available",
+		Source:   AlertSource_Prometheus,
+		Severity: AlertSeverity_Warning,
+		Expr:     `max(rocketpool_version_update{job="node"}) > 0`,
+		Job:      "node",
+		Summary:  "Rocket Pool Smartnode Update Available",
+		Description: "There are updates available for the Rocket Pool Smartnode that haven't been applied yet. You should update the smartnode stack.\n" +
+			"For more information on updating see the documentation at https://docs.rocketpool.net/guides/node/updates#updating-the-smartnode-stack",
+	},
+	{
+		ID:          AlertID_LowETHBalance,
+		Label:       "Low ETH Balance",
+		Source:      AlertSource_Prometheus,
+		NativeMode:  true,
+		Severity:    AlertSeverity_Critical,
+		Expr:        `rocketpool_node_balance{job="rocketpool",Token="ETH"} < scalar(rocketpool_node_low_eth_balance_threshold)`,
+		For:         "60m",
+		Job:         "node",
+		Summary:     "Low ETH Balance",
+		Description: "The node ETH balance is low.",
+	},
+	{
+		ID:              AlertID_FeeRecipientChanged,
+		Label:           "Fee Recipient Changed",
+		Source:          AlertSource_Daemon,
+		NativeMode:      true,
+		Severity:        AlertSeverity_Info,
+		FailureSeverity: AlertSeverity_Critical,
+		Summary:         "Fee Recipient Change {{.status}}",
+		Description:     "The fee recipient was changed to {{.feeRecipient}} with status {{.status}}.",
+		KeyFields:       []string{"status", "feeRecipient"},
+	},
+	{
+		ID:              AlertID_MinipoolBondReduced,
+		Label:           "Minipool Bond Reduced",
+		Source:          AlertSource_Daemon,
+		NativeMode:      true,
+		Severity:        AlertSeverity_Info,
+		FailureSeverity: AlertSeverity_Critical,
+		Summary:         "Minipool {{.minipool}} reduce bond {{.status}}",
+		Description:     "The minipool with address {{.minipool}} reduced bond with status {{.status}}.",
+		KeyFields:       []string{"status", "minipool"},
+		Labels:          []string{"minipool"},
+	},
+	{
+		ID:              AlertID_MinipoolBalanceDistributed,
+		Label:           "Minipool Balance Distributed",
+		Source:          AlertSource_Daemon,
+		NativeMode:      true,
+		Severity:        AlertSeverity_Info,
+		FailureSeverity: AlertSeverity_Critical,
+		Summary:         "Minipool {{.minipool}} balance distributed {{.status}}",
+		Description:     "The minipool with address {{.minipool}} had its balance distributed with status {{.status}}.",
+		KeyFields:       []string{"status", "minipool"},
+		Labels:          []string{"minipool"},
+	},
+	{
+		ID:              AlertID_MinipoolPromoted,
+		Label:           "Minipool Promoted",
+		Source:          AlertSource_Daemon,
+		NativeMode:      true,
+		Severity:        AlertSeverity_Info,
+		FailureSeverity: AlertSeverity_Critical,
+		Summary:         "Minipool {{.minipool}} promote {{.status}}",
+		Description:     "The vacant minipool with address {{.minipool}} promoted with status {{.status}}.",
+		KeyFields:       []string{"status", "minipool"},
+		Labels:          []string{"minipool"},
+	},
+	{
+		ID:              AlertID_MinipoolStaked,
+		Label:           "Minipool Staked",
+		Source:          AlertSource_Daemon,
+		NativeMode:      true,
+		Severity:        AlertSeverity_Info,
+		FailureSeverity: AlertSeverity_Critical,
+		Summary:         "Minipool {{.minipool}} stake {{.status}}",
+		Description:     "The minipool with address {{.minipool}} staked with status {{.status}}.",
+		KeyFields:       []string{"status", "minipool"},
+		Labels:          []string{"minipool"},
+	},
+	{
+		ID:          AlertID_ExecutionClientSyncComplete,
+		Label:       "execution client is synced",
+		Source:      AlertSource_Daemon,
+		NativeMode:  true,
+		Severity:    AlertSeverity_Info,
+		Duration:    time.Minute,
+		Summary:     "Execution Client Sync Complete",
+		Description: "The Execution client has completed syncing.",
+	},
+	{
+		ID:          AlertID_BeaconClientSyncComplete,
+		Label:       "beacon client is synced",
+		Source:      AlertSource_Daemon,
+		NativeMode:  true,
+		Severity:    AlertSeverity_Info,
+		Duration:    time.Minute,
+		Summary:     "Beacon Client Sync Complete",
+		Description: "The Beacon client has completed syncing.",
+	},
+	{
+		ID:          AlertID_MegapoolValidatorDissolved,
+		Label:       "a megapool validator was dissolved",
+		Source:      AlertSource_Daemon,
+		NativeMode:  true,
+		Severity:    AlertSeverity_Critical,
+		Summary:     "Megapool validator {{.validatorId}} was dissolved",
+		Description: "Validator {{.validatorId}} ({{.pubkey}}) in megapool {{.megapool}} has been dissolved. Check `rocketpool megapool status` for details.",
+		KeyFields:   []string{"megapool", "validatorId"},
+		Labels:      []string{"megapool", "validatorId", "pubkey"},
+	},
+	{
+		ID:          AlertID_ExitChallengeReceived,
+		Label:       "a megapool validator received an exit challenge",
+		Source:      AlertSource_Daemon,
+		NativeMode:  true,
+		Severity:    AlertSeverity_Warning,
+		Summary:     "Megapool validator {{.validatorId}} was challenged for exiting",
+		Description: "Validator {{.validatorId}} ({{.pubkey}}) in megapool {{.megapool}} was locked by an exit challenge. The node will try to respond with a beacon state proof automatically; make sure it has enough ETH for gas.",
+		KeyFields:   []string{"megapool", "validatorId"},
+		Labels:      []string{"megapool", "validatorId", "pubkey"},
+	},
+	{
+		ID:          AlertID_PDAOProposalChallenged,
+		Label:       "one of your Protocol DAO proposals was challenged",
+		Source:      AlertSource_Daemon,
+		NativeMode:  true,
+		Severity:    AlertSeverity_Critical,
+		Summary:     "Protocol DAO proposal {{.proposalId}} was challenged",
+		Description: "Your Protocol DAO proposal {{.proposalId}} was challenged at tree index {{.index}}. The node will try to respond automatically; if it can't before the challenge period ends, the proposal will be defeated and your bond lost.",
+		KeyFields:   []string{"proposalId", "index"},
+		Labels:      []string{"proposalId", "index"},
+	},
+	{
+		ID:          AlertID_RewardsTreeDownloadFailed,
+		Label:       "a rewards tree couldn't be downloaded",
+		Source:      AlertSource_Daemon,
+		NativeMode:  true,
+		Severity:    AlertSeverity_Warning,
+		Duration:    time.Hour,
+		Summary:     "Rewards tree for interval {{.interval}} couldn't be downloaded",
+		Description: "The node couldn't download the rewards tree for interval {{.interval}}: {{.error}}. You won't be able to claim rewards for this interval until it's downloaded or generated.",
+		KeyFields:   []string{"interval"},
+		Labels:      []string{"interval"},
+	},
+	{
+		ID:          AlertID_MegapoolDeadlineApproaching,
+		Label:       "a megapool validator deadline is approaching",
+		Source:      AlertSource_Daemon,
+		NativeMode:  true,
+		Severity:    AlertSeverity_Warning,
+		Duration:    time.Hour,
+		Summary:     "Megapool validator {{.validatorId}} {{.duty}} by {{.deadline}}",
+		Description: "Validator {{.validatorId}} ({{.pubkey}}) in megapool {{.megapool}} {{.duty}} by {{.deadline}}, which is {{.remaining}} away. The node will try to do this automatically; make sure it's synced and has enough ETH for gas, or do it manually with the `rocketpool megapool` commands.",
+		KeyFields:   []string{"megapool", "validatorId", "type"},
+		Labels:      []string{"megapool", "validatorId", "pubkey", "type"},
+	},
+	{
+		ID:          AlertID_GovernanceVoteNeeded,
+		Label:       "a governance proposal you haven't voted on is open for voting",
+		Source:      AlertSource_Daemon,
+		NativeMode:  true,
+		Severity:    AlertSeverity_Info,
+		Duration:    time.Hour,
+		Summary:     "{{.daoName}} proposal {{.proposalId}} is open for voting",
+		Description: "{{.daoName}} proposal {{.proposalId}} ({{.message}}) entered its {{.phase}} voting phase, which closes at {{.deadline}}, and your node hasn't voted on it yet. You can vote with `rocketpool {{.command}} proposals vote`.",
+		KeyFields:   []string{"dao", "proposalId", "phase"},
+		Labels:      []string{"dao", "proposalId", "phase"},
+	},
+	{
+		ID:          AlertID_GovernanceVoteClosing,
+		Label:       "voting on a governance proposal you haven't voted on is about to close",
+		Source:      AlertSource_Daemon,
+		NativeMode:  true,
+		Severity:    AlertSeverity_Warning,
+		Duration:    time.Hour,
+		Summary:     "Voting on {{.daoName}} proposal {{.proposalId}} closes in {{.remaining}}",
+		Description: "The {{.phase}} voting phase of {{.daoName}} proposal {{.proposalId}} ({{.message}}) closes at {{.deadline}}, which is {{.remaining}} away, and your node hasn't voted on it yet. You can vote with `rocketpool {{.command}} proposals vote`.",
+		KeyFields:   []string{"dao", "proposalId", "phase"},
+		Labels:      []string{"dao", "proposalId", "phase"},
+	},
+	{
+		ID:          AlertID_PDAOAutomaticVote,
+		Label:       "your voting policy voted on a Protocol DAO proposal",
+		Source:      AlertSource_Daemon,
+		NativeMode:  true,
+		Severity:    AlertSeverity_Info,
+		Duration:    time.Hour,
+		Summary:     "Your voting policy voted {{.direction}} on Protocol DAO proposal {{.proposalId}}",
+		Description: "The node voted {{.direction}} on Protocol DAO proposal {{.proposalId}} ({{.message}}) in {{.phase}} because {{.reason}}. The transaction is {{.txHash}}.",
+		KeyFields:   []string{"proposalId", "phase"},
+		Labels:      []string{"proposalId", "phase"},
+	},
+}
+
+// Get an alert definition by its ID
+func GetAlertDefinition(id string) (AlertDefinition, bool) {
+	for _, definition := range AlertDefinitions {
+		if definition.ID == id {
+			return definition, true
+		}
+	}
+	return AlertDefinition{}, false
+}
+
+// Get the ID of the config toggle for an alert
+func GetAlertToggleID(id string) string {
+	return fmt.Sprintf("alertEnabled_%s", id)
+}
+
+// Render the Prometheus alerting rule for an alert definition, indented for the rules list of a group
+func (definition AlertDefinition) GetPrometheusRule() string {
+	indent := "      "
+	builder := &strings.Builder{}
+	fmt.Fprintf(builder, "%s- alert: %s\n", indent, definition.ID)
+	if definition.ExprComment != "" {
+		fmt.Fprintf(builder, "%s  # %s\n", indent, definition.ExprComment)
+	}
+	fmt.Fprintf(builder, "%s  expr: %s\n", indent, definition.Expr)
+	if definition.For != "" {
+		fmt.Fprintf(builder, "%s  for: %s\n", indent, definition.For)
+	}
+	fmt.Fprintf(builder, "%s  labels:\n", indent)
+	fmt.Fprintf(builder, "%s    severity: %s\n", indent, definition.Severity)
+	if definition.Job != "" {
+		fmt.Fprintf(builder, "%s    job: %s\n", indent, definition.Job)
+	}
+	fmt.Fprintf(builder, "%s  annotations:\n", indent)
+	fmt.Fprintf(builder, "%s    summary: %q\n", indent, definition.Summary)
+	if definition.Description != "" {
+		fmt.Fprintf(builder, "%s    description: |\n", indent)
+		for _, line := range strings.Split(definition.Description, "\n") {
+			fmt.Fprintf(builder, "%s      %s\n", indent, line)
+		}
+	}
+	return builder.String()
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/mitchellh/go-homedir"
 	"golang.org/x/text/cases"
@@ -25,6 +26,8 @@ const defaultAlertmanagerPort uint16 = 9093
 const defaultAlertmanagerHost string = "localhost"
 const defaultAlertmanagerOpenPort config.RPCMode = config.RPC_Closed
 const defaultLowETHBalanceThreshold float64 = 0.05
+const defaultMegapoolDeadlineWarningHours uint64 = 24
+const defaultGovernanceVoteReminderHours uint64 = 24
 
 // Configuration for Alertmanager
 type AlertmanagerConfig struct {
@@ -59,31 +62,30 @@ type AlertmanagerConfig struct {
 	// The Pushover User Key for alert notifications
 	PushoverUserKey config.Parameter `yaml:"pushoverUserKey,omitempty"`
 
-	// Alerts configured in prometheus rule configuration file:
-	AlertEnabled_ClientSyncStatusBeacon    config.Parameter `yaml:"alertEnabled_ClientSyncStatusBeacon,omitempty"`
-	AlertEnabled_ClientSyncStatusExecution config.Parameter `yaml:"alertEnabled_ClientSyncStatusExecution,omitempty"`
-	AlertEnabled_UpcomingSyncCommittee     config.Parameter `yaml:"alertEnabled_UpcomingSyncCommittee,omitempty"`
-	AlertEnabled_ActiveSyncCommittee       config.Parameter `yaml:"alertEnabled_ActiveSyncCommittee,omitempty"`
-	AlertEnabled_UpcomingProposal          config.Parameter `yaml:"alertEnabled_UpcomingProposal,omitempty"`
-	AlertEnabled_RecentProposal            config.Parameter `yaml:"alertEnabled_RecentProposal,omitempty"`
-	AlertEnabled_LowDiskSpaceWarning       config.Parameter `yaml:"alertEnabled_LowDiskSpaceWarning,omitempty"`
-	AlertEnabled_LowDiskSpaceCritical      config.Parameter `yaml:"alertEnabled_LowDiskSpaceCritical,omitempty"`
-	AlertEnabled_OSUpdatesAvailable        config.Parameter `yaml:"alertEnabled_OSUpdatesAvailable,omitempty"`
-	AlertEnabled_RPUpdatesAvailable        config.Parameter `yaml:"alertEnabled_RPUpdatesAvailable,omitempty"`
-	AlertEnabled_LowETHBalance             config.Parameter `yaml:"alertEnabled_LowETHBalance,omitempty"`
-	LowETHBalanceThreshold                 config.Parameter `yaml:"lowETHBalanceThreshold,omitempty"`
-	// Alerts manually sent in alerting.go:
-	AlertEnabled_FeeRecipientChanged         config.Parameter `yaml:"alertEnabled_FeeRecipientChanged,omitempty"`
-	AlertEnabled_MinipoolBondReduced         config.Parameter `yaml:"alertEnabled_MinipoolBondReduced,omitempty"`
-	AlertEnabled_MinipoolBalanceDistributed  config.Parameter `yaml:"alertEnabled_MinipoolBalanceDistributed,omitempty"`
-	AlertEnabled_MinipoolPromoted            config.Parameter `yaml:"alertEnabled_MinipoolPromoted,omitempty"`
-	AlertEnabled_MinipoolStaked              config.Parameter `yaml:"alertEnabled_MinipoolStaked,omitempty"`
-	AlertEnabled_ExecutionClientSyncComplete config.Parameter `yaml:"alertEnabled_ExecutionClientSyncComplete,omitempty"`
-	AlertEnabled_BeaconClientSyncComplete    config.Parameter `yaml:"alertEnabled_BeaconClientSyncComplete,omitempty"`
+	// The threshold for the low ETH balance alerts
+	LowETHBalanceThreshold config.Parameter `yaml:"lowETHBalanceThreshold,omitempty"`
+
+	// How long before a megapool validator deadline to send an alert
+	MegapoolDeadlineWarningHours config.Parameter `yaml:"megapoolDeadlineWarningHours,omitempty"`
+
+	// How long before a voting phase the node hasn't voted in closes to send a reminder
+	GovernanceVoteReminderHours config.Parameter `yaml:"governanceVoteReminderHours,omitempty"`
+
+	// Toggles for each alert in the registry, in registry order
+	AlertToggles []*config.Parameter `yaml:"-"`
 }
 
 func NewAlertmanagerConfig(cfg *RocketPoolConfig) *AlertmanagerConfig {
 
+	toggles := make([]*config.Parameter, len(AlertDefinitions))
+	for i, definition := range AlertDefinitions {
+		toggle := createParameterForAlertEnablement(definition.ID, definition.Label)
+		if definition.Source == AlertSource_Daemon {
+			toggle.AffectsContainers = []config.ContainerID{config.ContainerID_Node, config.ContainerID_Watchtower}
+		}
+		toggles[i] = &toggle
+	}
+
 	return &AlertmanagerConfig{
 		Parent: cfg,
 
@@ -161,6 +163,7 @@ func NewAlertmanagerConfig(cfg *RocketPoolConfig) *AlertmanagerConfig {
 			Name:               "Alertmanager Discord Webhook URL",
 			Description:        "Discord notifications are sent via the Discord webhook API. See Discord's 'Intro to Webhooks' article to learn how to configure a webhook integration for a channel at https://support.discord.com/hc/en-us/articles/228383668-Intro-to-Webhooks",
 			Type:               config.ParameterType_String,
+			Secret:             true,
 			Default:            map[config.Network]interface{}{config.Network_All: ""},
 			AffectsContainers:  []config.ContainerID{config.ContainerID_Alertmanager},
 			CanBeBlank:         true,
@@ -172,6 +175,7 @@ func NewAlertmanagerConfig(cfg *RocketPoolConfig) *AlertmanagerConfig {
 			Name:               "Alertmanager Pushover Token",
 			Description:        "Pushover notifications are sent via the Pushover API. See docs for detailed technical explanation or a tl;dr on how to configure at https://pushover.net/api",
 			Type:               config.ParameterType_String,
+			Secret:             true,
 			Default:            map[config.Network]interface{}{config.Network_All: ""},
 			AffectsContainers:  []config.ContainerID{config.ContainerID_Alertmanager},
 			CanBeBlank:         true,
@@ -183,100 +187,54 @@ func NewAlertmanagerConfig(cfg *RocketPoolConfig) *AlertmanagerConfig {
 			Name:               "Alertmanager Pushover User Key",
 			Description:        "Pushover notifications are sent via the Pushover API. See docs for detailed technical explanation or a tl;dr on how to configure at https://pushover.net/api",
 			Type:               config.ParameterType_String,
+			Secret:             true,
 			Default:            map[config.Network]interface{}{config.Network_All: ""},
 			AffectsContainers:  []config.ContainerID{config.ContainerID_Alertmanager},
 			CanBeBlank:         true,
 			OverwriteOnUpgrade: false,
 		},
 
-		AlertEnabled_ClientSyncStatusBeacon: createParameterForAlertEnablement(
-			"ClientSyncStatusBeacon",
-			"beacon client is not synced"),
-
-		AlertEnabled_ClientSyncStatusExecution: createParameterForAlertEnablement(
-			"ClientSyncStatusExecution",
-			"execution client is not synced"),
-
-		AlertEnabled_UpcomingSyncCommittee: createParameterForAlertEnablement(
-			"UpcomingSyncCommittee",
-			"about to become part of a sync committee"),
-
-		AlertEnabled_ActiveSyncCommittee: createParameterForAlertEnablement(
-			"ActiveSyncCommittee",
-			"part of a sync committee"),
-
-		AlertEnabled_UpcomingProposal: createParameterForAlertEnablement(
-			"UpcomingProposal",
-			"about to propose a block"),
-
-		AlertEnabled_RecentProposal: createParameterForAlertEnablement(
-			"RecentProposal",
-			"recently proposed a block"),
-
-		AlertEnabled_LowDiskSpaceWarning: createParameterForAlertEnablement(
-			"LowDiskSpaceWarning",
-			"low disk space"),
-
-		AlertEnabled_LowDiskSpaceCritical: createParameterForAlertEnablement(
-			"LowDiskSpaceCritical",
-			"critically low disk space"),
-
-		AlertEnabled_OSUpdatesAvailable: createParameterForAlertEnablement(
-			"OSUpdatesAvailable",
-			"OS updates available"),
-
-		AlertEnabled_RPUpdatesAvailable: createParameterForAlertEnablement(
-			"RPUpdatesAvailable",
-			"Smartnode Update Available"),
-
-		AlertEnabled_FeeRecipientChanged: createParameterForAlertEnablement(
-			"FeeRecipientChanged",
-			"Fee Recipient Changed"),
-
-		AlertEnabled_MinipoolBondReduced: createParameterForAlertEnablement(
-			"MinipoolBondReduced",
-			"Minipool Bond Reduced"),
-
-		AlertEnabled_MinipoolBalanceDistributed: createParameterForAlertEnablement(
-			"MinipoolBalanceDistributed",
-			"Minipool Balance Distributed"),
-
-		AlertEnabled_MinipoolPromoted: createParameterForAlertEnablement(
-			"MinipoolPromoted",
-			"Minipool Promoted"),
-
-		AlertEnabled_MinipoolStaked: createParameterForAlertEnablement(
-			"MinipoolStaked",
-			"Minipool Staked"),
-
-		AlertEnabled_ExecutionClientSyncComplete: createParameterForAlertEnablement(
-			"ExecutionClientSyncComplete",
-			"execution client is synced"),
-
-		AlertEnabled_BeaconClientSyncComplete: createParameterForAlertEnablement(
-			"BeaconClientSyncComplete",
-			"beacon client is synced"),
-		AlertEnabled_LowETHBalance: createParameterForAlertEnablement(
-			"LowETHBalance",
-			"Low ETH Balance"),
-
 		LowETHBalanceThreshold: config.Parameter{
 			ID:                 "lowETHBalanceThreshold",
 			Name:               "Low ETH Balance Threshold",
 			Description:        "The threshold for the low ETH balance alert.",
 			Type:               config.ParameterType_Float,
 			Default:            map[config.Network]interface{}{config.Network_All: defaultLowETHBalanceThreshold},
-			AffectsContainers:  []config.ContainerID{config.ContainerID_Prometheus},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node, config.ContainerID_Prometheus},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
+		MegapoolDeadlineWarningHours: config.Parameter{
+			ID:                 "megapoolDeadlineWarningHours",
+			Name:               "Megapool Deadline Warning (Hours)",
+			Description:        "How many hours before one of your megapool validators is dissolved, can be challenged for not notifying its exit, or loses the chance to notify its final balance to send an alert.",
+			Type:               config.ParameterType_Uint,
+			Default:            map[config.Network]interface{}{config.Network_All: defaultMegapoolDeadlineWarningHours},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node},
 			CanBeBlank:         false,
 			OverwriteOnUpgrade: false,
 		},
+
+		GovernanceVoteReminderHours: config.Parameter{
+			ID:                 "governanceVoteReminderHours",
+			Name:               "Governance Vote Reminder (Hours)",
+			Description:        "How many hours before the voting phase of a Protocol DAO, Oracle DAO or security council proposal closes to send a reminder, if your node hasn't voted on it.",
+			Type:               config.ParameterType_Uint,
+			Default:            map[config.Network]interface{}{config.Network_All: defaultGovernanceVoteReminderHours},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
+		AlertToggles: toggles,
 	}
 }
 
 func createParameterForAlertEnablement(uniqueName string, label string) config.Parameter {
 	titleCaser := cases.Title(language.Und, cases.NoLower)
 	return config.Parameter{
-		ID:                 fmt.Sprintf("alertEnabled_%s", uniqueName),
+		ID:                 GetAlertToggleID(uniqueName),
 		Name:               fmt.Sprintf("Alert for %s", titleCaser.String(label)),
 		Description:        fmt.Sprintf("Enable an alert when %s", label),
 		Type:               config.ParameterType_Bool,
@@ -288,7 +246,7 @@ func createParameterForAlertEnablement(uniqueName string, label string) config.P
 }
 
 func (cfg *AlertmanagerConfig) GetParameters() []*config.Parameter {
-	return []*config.Parameter{
+	params := []*config.Parameter{
 		&cfg.EnableAlerting,
 		&cfg.Port,
 		&cfg.OpenPort,
@@ -298,26 +256,37 @@ func (cfg *AlertmanagerConfig) GetParameters() []*config.Parameter {
 		&cfg.PushoverToken,
 		&cfg.PushoverUserKey,
 		&cfg.ContainerTag,
-		&cfg.AlertEnabled_ClientSyncStatusBeacon,
-		&cfg.AlertEnabled_ClientSyncStatusExecution,
-		&cfg.AlertEnabled_UpcomingSyncCommittee,
-		&cfg.AlertEnabled_ActiveSyncCommittee,
-		&cfg.AlertEnabled_UpcomingProposal,
-		&cfg.AlertEnabled_RecentProposal,
-		&cfg.AlertEnabled_LowDiskSpaceWarning,
-		&cfg.AlertEnabled_LowDiskSpaceCritical,
-		&cfg.AlertEnabled_OSUpdatesAvailable,
-		&cfg.AlertEnabled_RPUpdatesAvailable,
-		&cfg.AlertEnabled_FeeRecipientChanged,
-		&cfg.AlertEnabled_MinipoolBondReduced,
-		&cfg.AlertEnabled_MinipoolBalanceDistributed,
-		&cfg.AlertEnabled_MinipoolPromoted,
-		&cfg.AlertEnabled_MinipoolStaked,
-		&cfg.AlertEnabled_ExecutionClientSyncComplete,
-		&cfg.AlertEnabled_BeaconClientSyncComplete,
-		&cfg.AlertEnabled_LowETHBalance,
 		&cfg.LowETHBalanceThreshold,
+		&cfg.MegapoolDeadlineWarningHours,
+		&cfg.GovernanceVoteReminderHours,
+	}
+	return append(params, cfg.AlertToggles...)
+}
+
+// Returns true if the alert with the given ID is enabled in the config
+func (cfg *AlertmanagerConfig) IsAlertEnabled(id string) bool {
+	toggleID := GetAlertToggleID(id)
+	for _, toggle := range cfg.AlertToggles {
+		if toggle.ID == toggleID {
+			return toggle.Value == true
+		}
+	}
+	return false
+}
+
+// Used by text/template to generate the Prometheus rules for every enabled alert in the registry
+func (cfg *AlertmanagerConfig) GetAlertingRules() string {
+	rules := []string{}
+	for _, definition := range AlertDefinitions {
+		if definition.Source != AlertSource_Prometheus || !cfg.IsAlertEnabled(definition.ID) {
+			continue
+		}
+		rules = append(rules, definition.GetPrometheusRule())
+	}
+	if len(rules) == 0 {
+		return " []"
 	}
+	return "\n" + strings.Join(rules, "\n")
 }
 
 func (cfg *AlertmanagerConfig) GetConfigTitle() string {
@@ -0,0 +1,251 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/rocket-pool/smartnode/shared"
+	"github.com/rocket-pool/smartnode/shared/services/config/migration"
+	"github.com/rocket-pool/smartnode/shared/types/config"
+	"gopkg.in/yaml.v2"
+)
+
+// Root settings that describe the machine rather than the node's configuration; they're left out of config documents and ignored when applying them
+var machineSpecificRootSettings = []string{"rpDir", "isNative"}
+
+// Written in place of secret values, such as passwords and API keys, when a config document is exported; applying it keeps the current value
+const secretPlaceholder = "<secret: keep current>"
+
+// Export the configuration as a YAML document with the name, description and options of every parameter in comments.
+// The document uses the same layout as the settings file, so it can be applied to other nodes or kept in version control.
+func ExportConfigDocument(cfg *RocketPoolConfig) []byte {
+	var buffer bytes.Buffer
+	fmt.Fprintf(&buffer, "# Rocket Pool Smart Node configuration, exported from v%s.\n", shared.RocketPoolVersion())
+	buffer.WriteString("# Review changes with `rocketpool service config diff <file>` and apply them with `rocketpool service config apply <file>`.\n")
+	buffer.WriteString("# Settings that are removed from this file keep their current values when it's applied.\n")
+	fmt.Fprintf(&buffer, "# Secrets such as passwords, API keys and auth tokens are left out and replaced with %s, which keeps the current value when it's applied.\n\n", quoteDocumentValue(secretPlaceholder))
+
+	// The root section carries the version, which is used to migrate older documents
+	fmt.Fprintf(&buffer, "# %s\n", cfg.Title)
+	fmt.Fprintf(&buffer, "%s:\n", rootConfigName)
+	buffer.WriteString("  # The Smart Node version this document was exported from\n")
+	fmt.Fprintf(&buffer, "  version: %s\n", quoteDocumentValue(fmt.Sprintf("v%s", shared.RocketPoolVersion())))
+	writeDocumentParameters(&buffer, cfg.GetParameters())
+
+	// Write the subconfigs in a stable order
+	subconfigs := cfg.GetSubconfigs()
+	names := make([]string, 0, len(subconfigs))
+	for name := range subconfigs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		subconfig := subconfigs[name]
+		fmt.Fprintf(&buffer, "\n# %s\n", subconfig.GetConfigTitle())
+		fmt.Fprintf(&buffer, "%s:\n", name)
+		writeDocumentParameters(&buffer, subconfig.GetParameters())
+	}
+
+	return buffer.Bytes()
+}
+
+// Parse a config document and layer it over the current configuration, migrating it first if it came from an older version.
+// Migrations expect the settings they upgrade to be there, so documents from older versions have to be complete exports.
+// Returns the new configuration, or a list of the problems with the document if it isn't valid.
+func ParseConfigDocument(document []byte, current *RocketPoolConfig) (*RocketPoolConfig, []string, error) {
+	var settings map[string]map[string]string
+	err := yaml.Unmarshal(document, &settings)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not parse config document: %w", err)
+	}
+	rootSettings, exists := settings[rootConfigName]
+	if !exists || rootSettings["version"] == "" {
+		return nil, nil, fmt.Errorf("the config document doesn't have a `%s.version` setting, which is needed to migrate it", rootConfigName)
+	}
+
+	// Upgrade the document on its own, so older documents are migrated before they're layered over the current settings
+	err = migration.UpdateConfig(settings)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error upgrading config document to v%s: %w", shared.RocketPoolVersion(), err)
+	}
+
+	// Check every setting in the document
+	sections := map[string]map[string]*config.Parameter{
+		rootConfigName: getParameterMap(current.GetParameters()),
+	}
+	for name, subconfig := range current.GetSubconfigs() {
+		sections[name] = getParameterMap(subconfig.GetParameters())
+	}
+	problems := []string{}
+	for sectionName, sectionSettings := range settings {
+		params, exists := sections[sectionName]
+		if !exists {
+			problems = append(problems, fmt.Sprintf("unknown section [%s]", sectionName))
+			continue
+		}
+		for id, value := range sectionSettings {
+			if sectionName == rootConfigName && isIgnoredRootSetting(id) {
+				continue
+			}
+			param, exists := params[id]
+			if !exists {
+				problems = append(problems, fmt.Sprintf("unknown setting [%s.%s]", sectionName, id))
+				continue
+			}
+			if isKeptSecret(param, value) {
+				continue
+			}
+			err = validateDocumentValue(param, value)
+			if err != nil {
+				problems = append(problems, fmt.Sprintf("invalid value for [%s.%s]: %s", sectionName, id, err.Error()))
+			}
+		}
+	}
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return nil, problems, nil
+	}
+
+	// Layer the document over the current settings
+	merged := current.Serialize()
+	for sectionName, sectionSettings := range settings {
+		for id, value := range sectionSettings {
+			if sectionName == rootConfigName && isIgnoredRootSetting(id) {
+				continue
+			}
+			if isKeptSecret(sections[sectionName][id], value) {
+				continue
+			}
+			merged[sectionName][id] = value
+		}
+	}
+	cfg := NewRocketPoolConfig(current.RocketPoolDirectory, current.IsNativeMode)
+	err = cfg.Deserialize(merged)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Check the settings against each other
+	problems = cfg.Validate()
+	if len(problems) > 0 {
+		return nil, problems, nil
+	}
+	return cfg, nil, nil
+}
+
+// Write the parameters of a section with their descriptions as comments
+func writeDocumentParameters(buffer *bytes.Buffer, params []*config.Parameter) {
+	for _, param := range params {
+		fmt.Fprintf(buffer, "\n  # %s\n", param.Name)
+		for _, line := range strings.Split(strings.TrimSpace(param.Description), "\n") {
+			if line == "" {
+				buffer.WriteString("  #\n")
+				continue
+			}
+			fmt.Fprintf(buffer, "  # %s\n", line)
+		}
+		if param.Type == config.ParameterType_Choice {
+			options := make([]string, len(param.Options))
+			for i, option := range param.Options {
+				options[i] = fmt.Sprint(option.Value)
+			}
+			fmt.Fprintf(buffer, "  # Options: %s\n", strings.Join(options, ", "))
+		}
+		if len(param.AffectsContainers) > 0 {
+			containers := make([]string, len(param.AffectsContainers))
+			for i, container := range param.AffectsContainers {
+				containers[i] = string(container)
+			}
+			fmt.Fprintf(buffer, "  # Restarts: %s\n", strings.Join(containers, ", "))
+		}
+		value := ""
+		if param.Secret {
+			value = secretPlaceholder
+		} else if param.Value != nil {
+			value = fmt.Sprint(param.Value)
+		}
+		fmt.Fprintf(buffer, "  %s: %s\n", param.ID, quoteDocumentValue(value))
+	}
+}
+
+// Quote a value so it's always read back as the same string
+func quoteDocumentValue(value string) string {
+	// JSON strings are valid double-quoted YAML scalars, and this never fails for a string
+	quoted, _ := json.Marshal(value)
+	return string(quoted)
+}
+
+// Check that a value can be used for a parameter
+func validateDocumentValue(param *config.Parameter, value string) error {
+	var err error
+	switch param.Type {
+	case config.ParameterType_Int:
+		_, err = strconv.ParseInt(value, 0, 0)
+	case config.ParameterType_Uint:
+		_, err = strconv.ParseUint(value, 0, 0)
+	case config.ParameterType_Uint16:
+		_, err = strconv.ParseUint(value, 0, 16)
+	case config.ParameterType_Bool:
+		_, err = strconv.ParseBool(value)
+	case config.ParameterType_Float:
+		_, err = strconv.ParseFloat(value, 64)
+	case config.ParameterType_String:
+		if param.MaxLength > 0 && len(value) > param.MaxLength {
+			return fmt.Errorf("[%s] is longer than the max length of %d", value, param.MaxLength)
+		}
+		if param.Regex != "" && value != "" {
+			regex, err := regexp.Compile(param.Regex)
+			if err != nil {
+				return fmt.Errorf("the parameter has an invalid format: %w", err)
+			}
+			if !regex.MatchString(value) {
+				return fmt.Errorf("[%s] doesn't match the expected format", value)
+			}
+		}
+	case config.ParameterType_Choice:
+		options := make([]string, len(param.Options))
+		for i, option := range param.Options {
+			options[i] = fmt.Sprint(option.Value)
+			if options[i] == value {
+				return nil
+			}
+		}
+		return fmt.Errorf("[%s] isn't one of the options (%s)", value, strings.Join(options, ", "))
+	}
+	if err != nil {
+		return fmt.Errorf("[%s] isn't a valid %s", value, param.Type)
+	}
+	return nil
+}
+
+// Index a list of parameters by ID
+func getParameterMap(params []*config.Parameter) map[string]*config.Parameter {
+	paramMap := make(map[string]*config.Parameter, len(params))
+	for _, param := range params {
+		paramMap[param.ID] = param
+	}
+	return paramMap
+}
+
+// Check if a document value is the placeholder for a secret parameter, which keeps its current value
+func isKeptSecret(param *config.Parameter, value string) bool {
+	return param.Secret && value == secretPlaceholder
+}
+
+// Check if a root setting isn't a parameter and should be skipped when applying a document
+func isIgnoredRootSetting(id string) bool {
+	if id == "version" {
+		return true
+	}
+	for _, setting := range machineSpecificRootSettings {
+		if id == setting {
+			return true
+		}
+	}
+	return false
+}
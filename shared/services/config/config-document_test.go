@@ -0,0 +1,132 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rocket-pool/smartnode/shared"
+	"github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+func TestConfigDocumentRoundTrip(t *testing.T) {
+	current := NewRocketPoolConfig(t.TempDir(), false)
+	current.EnableMevBoost.Value = false
+	document := ExportConfigDocument(current)
+
+	cfg, problems, err := ParseConfigDocument(document, current)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(problems) > 0 {
+		t.Fatalf("unexpected problems with the exported document: %v", problems)
+	}
+	_, containers, changeNetworks := cfg.GetChanges(current)
+	if len(containers) > 0 || changeNetworks {
+		t.Errorf("expected no changes after a round trip but %d containers are affected", len(containers))
+	}
+}
+
+func TestConfigDocumentChanges(t *testing.T) {
+	current := NewRocketPoolConfig(t.TempDir(), false)
+	current.EnableMevBoost.Value = false
+	document := strings.Join([]string{
+		"root:",
+		"  version: v" + shared.RocketPoolVersion(),
+		"  rpDir: /somewhere/else",
+		"  enableMetrics: false",
+		"alertmanager:",
+		"  governanceVoteReminderHours: 6",
+	}, "\n")
+
+	cfg, problems, err := ParseConfigDocument([]byte(document), current)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(problems) > 0 {
+		t.Fatalf("unexpected problems: %v", problems)
+	}
+	if cfg.EnableMetrics.Value != false {
+		t.Error("expected metrics to be disabled")
+	}
+	if cfg.Alertmanager.GovernanceVoteReminderHours.Value != uint64(6) {
+		t.Errorf("expected the reminder to be 6 hours but got %v", cfg.Alertmanager.GovernanceVoteReminderHours.Value)
+	}
+	if cfg.RocketPoolDirectory != current.RocketPoolDirectory {
+		t.Error("expected the machine-specific directory to be kept")
+	}
+
+	// Settings that aren't in the document keep their current values
+	if cfg.Smartnode.Network.Value != current.Smartnode.Network.Value {
+		t.Error("expected the network to be unchanged")
+	}
+	_, containers, _ := cfg.GetChanges(current)
+	if !containers[config.ContainerID_Grafana] {
+		t.Error("expected Grafana to be restarted")
+	}
+}
+
+func TestConfigDocumentProblems(t *testing.T) {
+	current := NewRocketPoolConfig(t.TempDir(), false)
+	document := strings.Join([]string{
+		"root:",
+		"  version: v" + shared.RocketPoolVersion(),
+		"  enableMetrics: maybe",
+		"  unknownSetting: 1",
+		"smartnode:",
+		"  network: notanetwork",
+		"notASection:",
+		"  foo: bar",
+	}, "\n")
+
+	_, problems, err := ParseConfigDocument([]byte(document), current)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(problems) != 4 {
+		t.Fatalf("expected 4 problems but got %d: %v", len(problems), problems)
+	}
+
+	// The version is required for migrations
+	_, _, err = ParseConfigDocument([]byte("smartnode:\n  network: mainnet\n"), current)
+	if err == nil {
+		t.Error("expected an error for a document without a version")
+	}
+}
+
+func TestConfigDocumentSecrets(t *testing.T) {
+	current := NewRocketPoolConfig(t.TempDir(), false)
+	current.EnableMevBoost.Value = false
+	current.Alertmanager.PushoverToken.Value = "averysecrettoken"
+	document := ExportConfigDocument(current)
+	if strings.Contains(string(document), "averysecrettoken") {
+		t.Fatal("expected the secret to be left out of the document")
+	}
+
+	// The placeholder keeps the current value, and a new value replaces it
+	cfg, problems, err := ParseConfigDocument(document, current)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(problems) > 0 {
+		t.Fatalf("unexpected problems: %v", problems)
+	}
+	if cfg.Alertmanager.PushoverToken.Value != "averysecrettoken" {
+		t.Errorf("expected the secret to be kept but got %v", cfg.Alertmanager.PushoverToken.Value)
+	}
+	document = []byte(strings.Join([]string{
+		"root:",
+		"  version: v" + shared.RocketPoolVersion(),
+		"alertmanager:",
+		"  pushoverToken: anothertoken",
+	}, "\n"))
+	cfg, problems, err = ParseConfigDocument(document, current)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(problems) > 0 {
+		t.Fatalf("unexpected problems: %v", problems)
+	}
+	if cfg.Alertmanager.PushoverToken.Value != "anothertoken" {
+		t.Errorf("expected the secret to be replaced but got %v", cfg.Alertmanager.PushoverToken.Value)
+	}
+}
@@ -24,6 +24,9 @@ type NativeConfig struct {
 
 	// The command for stopping the validator container in native mode
 	ValidatorStopCommand config.Parameter `yaml:"validatorStopCommand,omitempty"`
+
+	// The URL of the VC's Keymanager API
+	KeymanagerApiUrl config.Parameter `yaml:"keymanagerApiUrl,omitempty"`
 }
 
 // Generates a new Smartnode configuration
@@ -107,6 +110,17 @@ func NewNativeConfig(cfg *RocketPoolConfig) *NativeConfig {
 			CanBeBlank:         false,
 			OverwriteOnUpgrade: false,
 		},
+
+		KeymanagerApiUrl: config.Parameter{
+			ID:                 "keymanagerApiUrl",
+			Name:               "Keymanager API URL",
+			Description:        "The URL of your Validator Client's Keymanager API (e.g. http://localhost:5062), if you have it enabled. Its bearer token must be in the `keymanager-api-token` file in your `validators` directory.\n\nIf this is set, the Smartnode will use it to load new validator keys and change their fee recipients instead of running your VC restart script. **For Native mode only.**",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
 	}
 
 }
@@ -119,6 +133,7 @@ func (cfg *NativeConfig) GetParameters() []*config.Parameter {
 		&cfg.CcHttpUrl,
 		&cfg.ValidatorRestartCommand,
 		&cfg.ValidatorStopCommand,
+		&cfg.KeymanagerApiUrl,
 	}
 }
 
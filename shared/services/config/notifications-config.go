@@ -0,0 +1,192 @@
+package config
+
+import (
+	"github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+// Defaults
+const defaultSmtpPort uint16 = 587
+
+// Configuration for the notification sinks the Smartnode can send alerts to in addition to (or instead of) Alertmanager
+type NotificationsConfig struct {
+	Title string `yaml:"-"`
+
+	// Generic webhook sink
+	WebhookUrl    config.Parameter `yaml:"webhookUrl,omitempty"`
+	WebhookSecret config.Parameter `yaml:"webhookSecret,omitempty"`
+
+	// SMTP email sink
+	SmtpHost     config.Parameter `yaml:"smtpHost,omitempty"`
+	SmtpPort     config.Parameter `yaml:"smtpPort,omitempty"`
+	SmtpUsername config.Parameter `yaml:"smtpUsername,omitempty"`
+	SmtpPassword config.Parameter `yaml:"smtpPassword,omitempty"`
+	SmtpFrom     config.Parameter `yaml:"smtpFrom,omitempty"`
+	SmtpTo       config.Parameter `yaml:"smtpTo,omitempty"`
+
+	// ntfy / Gotify push sink
+	PushService config.Parameter `yaml:"pushService,omitempty"`
+	PushUrl     config.Parameter `yaml:"pushUrl,omitempty"`
+	PushToken   config.Parameter `yaml:"pushToken,omitempty"`
+}
+
+// Generates a new notifications config
+func NewNotificationsConfig(cfg *RocketPoolConfig) *NotificationsConfig {
+	return &NotificationsConfig{
+		Title: "Notification Settings",
+
+		WebhookUrl: config.Parameter{
+			ID:                 "webhookUrl",
+			Name:               "Webhook URL",
+			Description:        "The URL of an HTTP endpoint that should receive every Smartnode alert as a JSON POST request. Leave this blank to disable the webhook.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node, config.ContainerID_Watchtower},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		WebhookSecret: config.Parameter{
+			ID:                 "webhookSecret",
+			Name:               "Webhook Secret",
+			Description:        "An optional shared secret for the webhook. If set, every request will include an `X-Smartnode-Signature` header containing the hex-encoded HMAC-SHA256 of the request body using this secret, so the receiver can verify it came from your node.",
+			Type:               config.ParameterType_String,
+			Secret:             true,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node, config.ContainerID_Watchtower},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		SmtpHost: config.Parameter{
+			ID:                 "smtpHost",
+			Name:               "SMTP Host",
+			Description:        "The hostname of the SMTP server to send alert emails through. Leave this blank to disable email notifications.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node, config.ContainerID_Watchtower},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		SmtpPort: config.Parameter{
+			ID:                 "smtpPort",
+			Name:               "SMTP Port",
+			Description:        "The port of the SMTP server. The connection will be upgraded with STARTTLS if the server supports it.",
+			Type:               config.ParameterType_Uint16,
+			Default:            map[config.Network]interface{}{config.Network_All: defaultSmtpPort},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node, config.ContainerID_Watchtower},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
+		SmtpUsername: config.Parameter{
+			ID:                 "smtpUsername",
+			Name:               "SMTP Username",
+			Description:        "The username to log into the SMTP server with. Leave this blank if the server doesn't require authentication.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node, config.ContainerID_Watchtower},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		SmtpPassword: config.Parameter{
+			ID:                 "smtpPassword",
+			Name:               "SMTP Password",
+			Description:        "The password to log into the SMTP server with.",
+			Type:               config.ParameterType_String,
+			Secret:             true,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node, config.ContainerID_Watchtower},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		SmtpFrom: config.Parameter{
+			ID:                 "smtpFrom",
+			Name:               "Email Sender",
+			Description:        "The address alert emails should be sent from.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node, config.ContainerID_Watchtower},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		SmtpTo: config.Parameter{
+			ID:                 "smtpTo",
+			Name:               "Email Recipients",
+			Description:        "A comma-separated list of addresses that should receive alert emails.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node, config.ContainerID_Watchtower},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		PushService: config.Parameter{
+			ID:                 "pushService",
+			Name:               "Push Notification Service",
+			Description:        "The type of push notification server to send alerts to.",
+			Type:               config.ParameterType_Choice,
+			Default:            map[config.Network]interface{}{config.Network_All: config.PushNotificationService_Ntfy},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node, config.ContainerID_Watchtower},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+			Options: []config.ParameterOption{{
+				Name:        "ntfy",
+				Description: "Publish alerts to an ntfy topic. The URL should include the topic, e.g. `https://ntfy.sh/my-node-alerts`.",
+				Value:       config.PushNotificationService_Ntfy,
+			}, {
+				Name:        "Gotify",
+				Description: "Publish alerts to a Gotify server. The URL should be the root of the server, e.g. `https://gotify.example.com`.",
+				Value:       config.PushNotificationService_Gotify,
+			}},
+		},
+
+		PushUrl: config.Parameter{
+			ID:                 "pushUrl",
+			Name:               "Push Notification URL",
+			Description:        "The URL of the push notification server (see the service description for the expected format). Leave this blank to disable push notifications.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node, config.ContainerID_Watchtower},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		PushToken: config.Parameter{
+			ID:                 "pushToken",
+			Name:               "Push Notification Token",
+			Description:        "The access token for the push notification server. For ntfy this is optional; for Gotify this is the application token.",
+			Type:               config.ParameterType_String,
+			Secret:             true,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node, config.ContainerID_Watchtower},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+	}
+}
+
+// Get the parameters for this config
+func (cfg *NotificationsConfig) GetParameters() []*config.Parameter {
+	return []*config.Parameter{
+		&cfg.WebhookUrl,
+		&cfg.WebhookSecret,
+		&cfg.SmtpHost,
+		&cfg.SmtpPort,
+		&cfg.SmtpUsername,
+		&cfg.SmtpPassword,
+		&cfg.SmtpFrom,
+		&cfg.SmtpTo,
+		&cfg.PushService,
+		&cfg.PushUrl,
+		&cfg.PushToken,
+	}
+}
+
+// The title for the config
+func (cfg *NotificationsConfig) GetConfigTitle() string {
+	return cfg.Title
+}
@@ -0,0 +1,171 @@
+package config
+
+import (
+	"github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+// How a price messenger's submitRate call is parameterized and paid for
+type PriceMessengerType string
+
+const (
+	// submitRate() with no arguments or value; the L1 bridge pays for the L2 message
+	PriceMessengerType_Simple PriceMessengerType = "simple"
+
+	// submitRate(maxSubmissionCost, gasLimit, gasPriceBid), paying for the retryable ticket and its L2 execution
+	PriceMessengerType_Arbitrum PriceMessengerType = "arbitrum"
+
+	// submitRate(l2GasLimit, l2GasPerPubdataByteLimit), paying for the L2 gas at the greater of the fair and pubdata-derived prices
+	PriceMessengerType_ZkSyncEra PriceMessengerType = "zksync-era"
+
+	// submitRate(l2GasLimit), paying the fee quoted by the L2 message fee estimator
+	PriceMessengerType_Scroll PriceMessengerType = "scroll"
+)
+
+// Declarative description of an L1 messenger that relays the rETH rate to an L2
+type PriceMessengerDefinition struct {
+	// The name of the L2, used in logs and as the "chain" label of the messenger's metrics
+	Chain string
+
+	// The messenger's address on each network; networks without a messenger are skipped
+	Addresses map[config.Network]string
+
+	// How the submission is parameterized and paid for
+	Type PriceMessengerType
+
+	// The view method that returns true when the L2's rate is out of date
+	StaleMethod string
+
+	// The method that relays the current rate to the L2
+	SubmitMethod string
+
+	// The gas limit of the L2 message. Not used by Simple messengers.
+	L2GasLimit uint64
+
+	// The max fee per gas of the L2 message, in gwei. Only used by Arbitrum messengers.
+	L2MaxFeePerGas float64
+
+	// The calldata length of the L2 message and the multiplier applied to its max submission cost. Only used by Arbitrum messengers.
+	SubmissionDataLength uint64
+	SubmissionCostBuffer uint64
+
+	// The L1 gas per pubdata byte, the L2 gas per pubdata byte limit and the fair L2 gas price in gwei. Only used by zkSync Era messengers.
+	L1GasPerPubdataByte uint64
+	L2GasPerPubdataByte uint64
+	FairL2GasPrice      float64
+
+	// The L2 message fee estimator's address on each network. Only used by Scroll messengers.
+	FeeEstimatorAddresses map[config.Network]string
+}
+
+// A price messenger deployed on the current network
+type PriceMessenger struct {
+	PriceMessengerDefinition
+	Address             string
+	FeeEstimatorAddress string
+}
+
+// All of the price messengers the watchtower submits to, in order. New rollups only need to be added here.
+var PriceMessengerDefinitions = []PriceMessengerDefinition{
+	{
+		Chain: "Optimism",
+		Addresses: map[config.Network]string{
+			config.Network_Mainnet: "0x12759f8Df234f8f2cDdb3d2Ed5604adF9ACCfc9F",
+		},
+		Type:         PriceMessengerType_Simple,
+		StaleMethod:  "rateStale",
+		SubmitMethod: "submitRate",
+	},
+	{
+		Chain: "Polygon",
+		Addresses: map[config.Network]string{
+			config.Network_Mainnet: "0xb1029Ac2Be4e08516697093e2AFeC435057f3511",
+		},
+		Type:         PriceMessengerType_Simple,
+		StaleMethod:  "rateStale",
+		SubmitMethod: "submitRate",
+	},
+	{
+		// This messenger will be deprecated soon; submit to both until it sunsets
+		Chain: "Arbitrum V1",
+		Addresses: map[config.Network]string{
+			config.Network_Mainnet: "0x05330300f829AD3fC8f33838BC88CFC4093baD53",
+		},
+		Type:                 PriceMessengerType_Arbitrum,
+		StaleMethod:          "rateStale",
+		SubmitMethod:         "submitRate",
+		L2GasLimit:           40000,
+		L2MaxFeePerGas:       0.1,
+		SubmissionDataLength: 36,
+		SubmissionCostBuffer: 4,
+	},
+	{
+		Chain: "Arbitrum",
+		Addresses: map[config.Network]string{
+			config.Network_Mainnet: "0x312FcFB03eC9B1Ea38CB7BFCd26ee7bC3b505aB1",
+		},
+		Type:                 PriceMessengerType_Arbitrum,
+		StaleMethod:          "rateStale",
+		SubmitMethod:         "submitRate",
+		L2GasLimit:           40000,
+		L2MaxFeePerGas:       0.1,
+		SubmissionDataLength: 36,
+		SubmissionCostBuffer: 4,
+	},
+	{
+		Chain: "zkSync Era",
+		Addresses: map[config.Network]string{
+			config.Network_Mainnet: "0x6cf6CB29754aEBf88AF12089224429bD68b0b8c8",
+		},
+		Type:                PriceMessengerType_ZkSyncEra,
+		StaleMethod:         "rateStale",
+		SubmitMethod:        "submitRate",
+		L2GasLimit:          750000,
+		L1GasPerPubdataByte: 17,
+		L2GasPerPubdataByte: 800,
+		FairL2GasPrice:      0.5,
+	},
+	{
+		Chain: "Base",
+		Addresses: map[config.Network]string{
+			config.Network_Mainnet: "0x8aa4afc5a9793433eb37c9919ff49b54903c7cb1",
+		},
+		Type:         PriceMessengerType_Simple,
+		StaleMethod:  "rateStale",
+		SubmitMethod: "submitRate",
+	},
+	{
+		Chain: "Scroll",
+		Addresses: map[config.Network]string{
+			config.Network_Mainnet: "0x0f22dc9b9c03757d4676539203d7549c8f22c15c",
+		},
+		Type:         PriceMessengerType_Scroll,
+		StaleMethod:  "rateStale",
+		SubmitMethod: "submitRate",
+		L2GasLimit:   90000, // A bit above the estimated 85,283
+		FeeEstimatorAddresses: map[config.Network]string{
+			config.Network_Mainnet: "0x0d7E906BD9cAFa154b048cFa766Cc1E54E39AF9B",
+		},
+	},
+}
+
+// Get the price messengers deployed on the given network
+func GetPriceMessengers(network config.Network) []PriceMessenger {
+	messengers := []PriceMessenger{}
+	for _, definition := range PriceMessengerDefinitions {
+		address := definition.Addresses[network]
+		if address == "" {
+			continue
+		}
+		messengers = append(messengers, PriceMessenger{
+			PriceMessengerDefinition: definition,
+			Address:                  address,
+			FeeEstimatorAddress:      definition.FeeEstimatorAddresses[network],
+		})
+	}
+	return messengers
+}
+
+// Get the price messengers deployed on the current network
+func (cfg *SmartnodeConfig) GetPriceMessengers() []PriceMessenger {
+	return GetPriceMessengers(cfg.Network.Value.(config.Network))
+}
@@ -117,6 +117,7 @@ type RocketPoolConfig struct {
 	Grafana           *GrafanaConfig           `yaml:"grafana,omitempty"`
 	Prometheus        *PrometheusConfig        `yaml:"prometheus,omitempty"`
 	Alertmanager      *AlertmanagerConfig      `yaml:"alertmanager,omitempty"`
+	Notifications     *NotificationsConfig     `yaml:"notifications,omitempty"`
 	Exporter          *ExporterConfig          `yaml:"exporter,omitempty"`
 	BitflyNodeMetrics *BitflyNodeMetricsConfig `yaml:"bitflyNodeMetrics,omitempty"`
 
@@ -476,6 +477,7 @@ func NewRocketPoolConfig(rpDir string, isNativeMode bool) *RocketPoolConfig {
 	cfg.Grafana = NewGrafanaConfig(cfg)
 	cfg.Prometheus = NewPrometheusConfig(cfg)
 	cfg.Alertmanager = NewAlertmanagerConfig(cfg)
+	cfg.Notifications = NewNotificationsConfig(cfg)
 	cfg.Exporter = NewExporterConfig(cfg)
 	cfg.BitflyNodeMetrics = NewBitflyNodeMetricsConfig(cfg)
 	cfg.Native = NewNativeConfig(cfg)
@@ -582,6 +584,7 @@ func (cfg *RocketPoolConfig) GetSubconfigs() map[string]config.Config {
 		"grafana":            cfg.Grafana,
 		"prometheus":         cfg.Prometheus,
 		"alertmanager":       cfg.Alertmanager,
+		"notifications":      cfg.Notifications,
 		"exporter":           cfg.Exporter,
 		"bitflyNodeMetrics":  cfg.BitflyNodeMetrics,
 		"native":             cfg.Native,
@@ -1150,6 +1153,39 @@ func (cfg *RocketPoolConfig) FeeRecipientFile() string {
 	return FeeRecipientFilename
 }
 
+// Used by text/template to format validator.yml
+func (cfg *RocketPoolConfig) RemoteSignerUrl() string {
+	if !cfg.Smartnode.UseRemoteSigner.Value.(bool) {
+		return ""
+	}
+	return cfg.Smartnode.RemoteSignerUrl.Value.(string)
+}
+
+// Used by text/template to format validator.yml
+func (cfg *RocketPoolConfig) KeymanagerApiPort() string {
+	if cfg.GetKeymanagerApiUrl() == "" {
+		return ""
+	}
+	return fmt.Sprint(cfg.Smartnode.KeymanagerApiPort.Value)
+}
+
+// Get the URL of the Validator Client's Keymanager API, or an empty string if it isn't available
+func (cfg *RocketPoolConfig) GetKeymanagerApiUrl() string {
+	if cfg.IsNativeMode {
+		return cfg.Native.KeymanagerApiUrl.Value.(string)
+	}
+	if !cfg.Smartnode.EnableKeymanagerApi.Value.(bool) {
+		return ""
+	}
+
+	// Teku only serves its Keymanager API over TLS, so it falls back to restarts
+	cc, _ := cfg.GetSelectedConsensusClient()
+	if cc == config.ConsensusClient_Teku {
+		return ""
+	}
+	return fmt.Sprintf("http://%s:%d", ValidatorContainerName, cfg.Smartnode.KeymanagerApiPort.Value)
+}
+
 // Used by text/template to format validator.yml
 func (cfg *RocketPoolConfig) MevBoostUrl() string {
 	if !cfg.EnableMevBoost.Value.(bool) {
@@ -1516,105 +1552,7 @@ func (cfg *RocketPoolConfig) GetChanges(oldConfig *RocketPoolConfig) (map[string
 
 // Checks to see if the current configuration is valid; if not, returns a list of errors
 func (cfg *RocketPoolConfig) Validate() []string {
-	errors := []string{}
-
-	// Check for illegal blank strings
-	/* TODO - this needs to be smarter and ignore irrelevant settings
-	for _, param := range config.GetParameters() {
-		if param.Type == ParameterType_String && !param.CanBeBlank && param.Value == "" {
-			errors = append(errors, fmt.Sprintf("[%s] cannot be blank.", param.Name))
-		}
-	}
-
-	for name, subconfig := range config.GetSubconfigs() {
-		for _, param := range subconfig.GetParameters() {
-			if param.Type == ParameterType_String && !param.CanBeBlank && param.Value == "" {
-				errors = append(errors, fmt.Sprintf("[%s - %s] cannot be blank.", name, param.Name))
-			}
-		}
-	}
-	*/
-
-	// Force all Docker or all Hybrid
-	if cfg.ExecutionClientMode.Value.(config.Mode) == config.Mode_Local && cfg.ConsensusClientMode.Value.(config.Mode) == config.Mode_External {
-		errors = append(errors, "You are using a locally-managed Execution client and an externally-managed Consensus client.\nThis configuration is not compatible with The Merge; please select either locally-managed or externally-managed for both the EC and CC.")
-	} else if cfg.ExecutionClientMode.Value.(config.Mode) == config.Mode_External && cfg.ConsensusClientMode.Value.(config.Mode) == config.Mode_Local {
-		errors = append(errors, "You are using an externally-managed Execution client and a locally-managed Consensus client.\nThis configuration is not compatible with The Merge; please select either locally-managed or externally-managed for both the EC and CC.")
-	}
-
-	if !cfg.IsNativeMode && cfg.EnableMevBoost.Value == true {
-		switch cfg.MevBoost.Mode.Value.(config.Mode) {
-		case config.Mode_Local:
-			// In local MEV-boost mode, the user has to have at least one relay
-			relays := cfg.MevBoost.GetEnabledMevRelays()
-			if len(relays) == 0 {
-				errors = append(errors, "You have MEV-boost enabled in local mode but don't have any profiles or relays enabled. Please select at least one profile or relay to use MEV-boost.")
-			}
-		case config.Mode_External:
-			// In external MEV-boost mode, the user has to have an external URL if they're running Docker mode
-			if cfg.ExecutionClientMode.Value.(config.Mode) == config.Mode_Local && cfg.MevBoost.ExternalUrl.Value.(string) == "" {
-				errors = append(errors, "You have MEV-boost enabled in external mode but don't have a URL set. Please enter the external MEV-boost server URL to use it.")
-			}
-		default:
-			errors = append(errors, "You do not have a MEV-Boost mode configured. You must either select a mode in the `rocketpool service config` UI, or disable MEV-Boost.\nNote that MEV-Boost will be required in a future update, at which point you can no longer disable it.")
-		}
-	}
-
-	// Technically not required since native mode doesn't support addons, but defensively check to make sure a native mode
-	// user hasn't tried to configure the rescue node via the TUI
-	if cfg.RescueNode.GetEnabledParameter().Value.(bool) {
-		if cfg.IsNativeMode {
-			errors = append(errors, "Rescue Node add-on is incompatible with native mode.\nYou can still connect manually, visit the rescue node website for more information.")
-		}
-
-		params := cfg.RescueNode.GetConfig().GetParameters()
-		for _, param := range params {
-			if param.Type != config.ParameterType_String {
-				continue
-			}
-
-			if param.Value.(string) == "" {
-				errors = append(errors, "Rescue Node requires both a username and a password.")
-				break
-			}
-		}
-	}
-
-	// Ensure the selected port numbers are unique. Keeps track of all the errors
-	portMap := make(map[interface{}]bool)
-	portMap, errors = addAndCheckForDuplicate(portMap, cfg.ConsensusCommon.ApiPort, errors)
-	portMap, errors = addAndCheckForDuplicate(portMap, cfg.ConsensusCommon.P2pPort, errors)
-	portMap, errors = addAndCheckForDuplicate(portMap, cfg.ExecutionCommon.EnginePort, errors)
-	portMap, errors = addAndCheckForDuplicate(portMap, cfg.ExecutionCommon.WsPort, errors)
-	portMap, errors = addAndCheckForDuplicate(portMap, cfg.ExecutionCommon.P2pPort, errors)
-	portMap, errors = addAndCheckForDuplicate(portMap, cfg.ExecutionCommon.HttpPort, errors)
-	portMap, errors = addAndCheckForDuplicate(portMap, cfg.BnMetricsPort, errors)
-	portMap, errors = addAndCheckForDuplicate(portMap, cfg.EcMetricsPort, errors)
-	portMap, errors = addAndCheckForDuplicate(portMap, cfg.ExporterMetricsPort, errors)
-	portMap, errors = addAndCheckForDuplicate(portMap, cfg.NodeMetricsPort, errors)
-	portMap, errors = addAndCheckForDuplicate(portMap, cfg.VcMetricsPort, errors)
-	portMap, errors = addAndCheckForDuplicate(portMap, cfg.WatchtowerMetricsPort, errors)
-	portMap, errors = addAndCheckForDuplicate(portMap, cfg.Grafana.Port, errors)
-	portMap, errors = addAndCheckForDuplicate(portMap, cfg.MevBoost.Port, errors)
-	portMap, errors = addAndCheckForDuplicate(portMap, cfg.Prometheus.Port, errors)
-	portMap, errors = addAndCheckForDuplicate(portMap, cfg.Alertmanager.Port, errors)
-	_, errors = addAndCheckForDuplicate(portMap, cfg.Lighthouse.P2pQuicPort, errors)
-
-	return errors
-}
-
-func addAndCheckForDuplicate(portMap map[interface{}]bool, param config.Parameter, errors []string) (map[interface{}]bool, []string) {
-	port := fmt.Sprintf("%v", param.Value)
-	if port == "" {
-		return portMap, errors
-	}
-	if portMap[port] {
-		return portMap, append(errors, fmt.Sprintf("Port %s for %s is already in use", port, param.Name))
-	} else {
-		portMap[port] = true
-	}
-	return portMap, errors
-
+	return GetValidationMessages(cfg.ValidateRules(), ValidationSeverity_Error)
 }
 
 func (cfg *RocketPoolConfig) GetNetwork() config.Network {
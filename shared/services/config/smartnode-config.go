@@ -7,6 +7,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/rocket-pool/smartnode/shared"
+	"github.com/rocket-pool/smartnode/shared/services/passwords"
 	"github.com/rocket-pool/smartnode/shared/types/config"
 )
 
@@ -18,12 +19,15 @@ const (
 	SnapshotID                         string = "rocketpool-dao.eth"
 	rewardsTreeFilenameFormat          string = "rp-rewards-%s-%d%s"
 	minipoolPerformanceFilenameFormat  string = "rp-minipool-performance-%s-%d%s"
+	rewardsCheckpointFilenameFormat    string = "rp-rewards-checkpoint-%s-%d%s"
 	RewardsTreeIpfsExtension           string = ".zst"
 	RewardsTreesFolder                 string = "rewards-trees"
 	ChecksumTableFilename              string = "checksums.sha384"
 	DaemonDataPath                     string = "/.rocketpool/data"
+	DaemonRocketPoolPath               string = "/.rocketpool"
 	WatchtowerFolder                   string = "watchtower"
 	WatchtowerStateFile                string = "state.yml"
+	ShadowReportsFilename              string = "shadow-reports.json"
 	RegenerateRewardsTreeRequestSuffix string = ".request"
 	RegenerateRewardsTreeRequestFormat string = "%d" + RegenerateRewardsTreeRequestSuffix
 	PrimaryRewardsFileUrl              string = "https://%s.ipfs.dweb.link/%s"
@@ -31,13 +35,42 @@ const (
 	GithubRewardsFileUrl               string = "https://github.com/rocket-pool/rewards-trees/raw/main/%s/%s"
 	FeeRecipientFilename               string = "rp-fee-recipient.txt"
 	NativeFeeRecipientFilename         string = "rp-fee-recipient-env.txt"
+	DaemonStateFilename                string = "daemon-state.db"
+	TxQueueFilename                    string = "tx-queue.json"
+	GasLedgerFilename                  string = "gas-ledger.json"
+	GovernanceFeedFilename             string = "governance-feed.json"
+	VotingPolicyFilename               string = "voting-policy.yml"
+	ApiSocketFilename                  string = "api.sock"
+	ApiTokenFilename                   string = "api-token"
+	StateSnapshotsFolder               string = "state-snapshots"
+	KeymanagerApiTokenFilename         string = "keymanager-api-token"
+	PasswordKeyringFilename            string = "password-keyring"
+	PasswordAgentSocketFilename        string = "password-agent.sock"
 )
 
 // Defaults
 const (
-	defaultProjectName       string = "rocketpool"
-	WatchtowerMaxFeeDefault  uint64 = 50
-	WatchtowerPrioFeeDefault uint64 = 3
+	defaultProjectName               string = "rocketpool"
+	WatchtowerMaxFeeDefault          uint64 = 50
+	defaultApiServerPort             uint16 = 8280
+	defaultKeymanagerApiPort         uint16 = 5062
+	defaultStateSnapshotInterval     uint64 = 225
+	defaultStateSnapshotRetention    uint64 = 30
+	defaultRewardsCheckpointInterval uint64 = 225
+	WatchtowerPrioFeeDefault         uint64 = 3
+)
+
+// Node account signers
+const (
+	NodeSigner_Local   string = "local"
+	NodeSigner_Clef    string = "clef"
+	NodeSigner_Eip1193 string = "eip1193"
+)
+
+// Gas price oracles
+const (
+	GasOracle_FeeHistory string = "feeHistory"
+	GasOracle_External   string = "external"
 )
 
 type RewardsExtension string
@@ -82,9 +115,87 @@ type SmartnodeConfig struct {
 	// Manual priority fee override
 	PriorityFee config.Parameter `yaml:"priorityFee,omitempty"`
 
+	// Where gas price suggestions come from
+	GasOracle config.Parameter `yaml:"gasOracle,omitempty"`
+
 	// Threshold for automatic transactions
 	AutoTxGasThreshold config.Parameter `yaml:"minipoolStakeGasThreshold,omitempty"`
 
+	// The highest max fee an automatic transaction will pay once its deadline has passed
+	AutoTxDeadlineMaxFee config.Parameter `yaml:"autoTxDeadlineMaxFee,omitempty"`
+
+	// How much ETH the automatic transactions can spend on gas each month
+	AutoTxMonthlyGasBudget config.Parameter `yaml:"autoTxMonthlyGasBudget,omitempty"`
+
+	// Per-duty overrides for the automatic transaction thresholds
+	AutoTxGasPolicies config.Parameter `yaml:"autoTxGasPolicies,omitempty"`
+
+	// How long an automatic transaction can be pending before its fees are bumped
+	TxBumpInterval config.Parameter `yaml:"txBumpInterval,omitempty"`
+
+	// How much to raise the fees of a stuck automatic transaction by, as a percentage
+	TxBumpPercent config.Parameter `yaml:"txBumpPercent,omitempty"`
+
+	// The highest max fee a bumped automatic transaction can have
+	TxBumpMaxFee config.Parameter `yaml:"txBumpMaxFee,omitempty"`
+
+	// Toggle for serving the API commands from a long-running process instead of a new one per call
+	EnableApiServer config.Parameter `yaml:"enableApiServer,omitempty"`
+
+	// Exposure mode for the API server's authenticated TCP port
+	ApiServerOpenPort config.Parameter `yaml:"apiServerOpenPort,omitempty"`
+
+	// The API server's TCP port
+	ApiServerPort config.Parameter `yaml:"apiServerPort,omitempty"`
+
+	// Toggle for saving network state snapshots
+	EnableStateSnapshots config.Parameter `yaml:"enableStateSnapshots,omitempty"`
+
+	// How often to save a network state snapshot, in epochs
+	StateSnapshotInterval config.Parameter `yaml:"stateSnapshotInterval,omitempty"`
+
+	// How many network state snapshots to keep
+	StateSnapshotRetention config.Parameter `yaml:"stateSnapshotRetention,omitempty"`
+
+	// How often to save a checkpoint while generating a rewards tree, in epochs
+	RewardsTreeCheckpointInterval config.Parameter `yaml:"rewardsTreeCheckpointInterval,omitempty"`
+
+	// Toggle for keeping a running rewards tree checkpoint for the current interval
+	EnableRunningRewardsTree config.Parameter `yaml:"enableRunningRewardsTree,omitempty"`
+
+	// Toggle for keeping validator keys in a remote signer instead of on disk
+	UseRemoteSigner config.Parameter `yaml:"useRemoteSigner,omitempty"`
+
+	// The URL of the remote signer
+	RemoteSignerUrl config.Parameter `yaml:"remoteSignerUrl,omitempty"`
+
+	// The bearer token for the remote signer's keymanager API
+	RemoteSignerAuthToken config.Parameter `yaml:"remoteSignerAuthToken,omitempty"`
+
+	// Toggle for loading new validator keys through the Validator Client's Keymanager API instead of restarting it
+	EnableKeymanagerApi config.Parameter `yaml:"enableKeymanagerApi,omitempty"`
+
+	// The port the Validator Client's Keymanager API listens on
+	KeymanagerApiPort config.Parameter `yaml:"keymanagerApiPort,omitempty"`
+
+	// The signer that holds the node account's key
+	NodeSigner config.Parameter `yaml:"nodeSigner,omitempty"`
+
+	// The URL of the external node account signer
+	NodeSignerUrl config.Parameter `yaml:"nodeSignerUrl,omitempty"`
+
+	// The bearer token for the external node account signer
+	NodeSignerAuthToken config.Parameter `yaml:"nodeSignerAuthToken,omitempty"`
+
+	// The node account address to use from the external signer
+	NodeSignerAddress config.Parameter `yaml:"nodeSignerAddress,omitempty"`
+
+	// How the node wallet's password is stored
+	WalletPasswordStorage config.Parameter `yaml:"walletPasswordStorage,omitempty"`
+
+	// Extra Beacon Node URLs for the Smartnode to fail over to
+	AdditionalBeaconNodeUrls config.Parameter `yaml:"additionalBeaconNodeUrls,omitempty"`
+
 	// The amount of ETH in a minipool's balance before auto-distribute kicks in
 	DistributeThreshold config.Parameter `yaml:"distributeThreshold,omitempty"`
 
@@ -106,6 +217,9 @@ type SmartnodeConfig struct {
 	// Manual override for the watchtower's priority fee
 	WatchtowerPrioFeeOverride config.Parameter `yaml:"watchtowerPrioFeeOverride,omitempty"`
 
+	// Toggle for running the watchtower's Oracle DAO duties without submitting anything
+	WatchtowerShadowMode config.Parameter `yaml:"watchtowerShadowMode,omitempty"`
+
 	// The toggle for enabling pDAO proposal verification duties
 	VerifyProposals config.Parameter `yaml:"verifyProposals,omitempty"`
 
@@ -179,30 +293,6 @@ type SmartnodeConfig struct {
 	// Addresses for RocketDAOProtocolVerifier that have been upgraded during development
 	previousRocketDAOProtocolVerifier map[config.Network][]common.Address `yaml:"-"`
 
-	// The RocketOvmPriceMessenger Optimism address for each network
-	optimismPriceMessengerAddress map[config.Network]string `yaml:"-"`
-
-	// The RocketPolygonPriceMessenger Polygon address for each network
-	polygonPriceMessengerAddress map[config.Network]string `yaml:"-"`
-
-	// The RocketArbitumPriceMessenger Arbitrum address for each network
-	arbitrumPriceMessengerAddress map[config.Network]string `yaml:"-"`
-
-	// The RocketArbitumPriceMessengerV2 Arbitrum address for each network
-	arbitrumPriceMessengerAddressV2 map[config.Network]string `yaml:"-"`
-
-	// The RocketZkSyncPriceMessenger zkSyncEra address for each network
-	zkSyncEraPriceMessengerAddress map[config.Network]string `yaml:"-"`
-
-	// The RocketBasePriceMessenger Base address for each network
-	basePriceMessengerAddress map[config.Network]string `yaml:"-"`
-
-	// The RocketScrollPriceMessenger Scroll address for each network
-	scrollPriceMessengerAddress map[config.Network]string `yaml:"-"`
-
-	// The Scroll L2 message fee estimator address for each network
-	scrollFeeEstimatorAddress map[config.Network]string `yaml:"-"`
-
 	// The UniswapV3 pool address for each network (used for RPL price TWAP info)
 	rplTwapPoolAddress map[config.Network]string `yaml:"-"`
 
@@ -290,6 +380,26 @@ func NewSmartnodeConfig(cfg *RocketPoolConfig) *SmartnodeConfig {
 			OverwriteOnUpgrade: false,
 		},
 
+		GasOracle: config.Parameter{
+			ID:                 "gasOracle",
+			Name:               "Gas Price Oracle",
+			Description:        "Select where the Smartnode gets its max fee suggestions from, both when it prompts you for a max fee and for automatic transactions.\n\nWhen the Execution client is used, the prompt also suggests a priority fee for each speed based on what recent blocks paid, unless you provide one with `--maxPrioFee`.",
+			Type:               config.ParameterType_Choice,
+			Default:            map[config.Network]interface{}{config.Network_All: GasOracle_FeeHistory},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+			Options: []config.ParameterOption{{
+				Name:        "Execution Client",
+				Description: "Derive suggestions from the recent fee history of your own Execution client. This doesn't rely on any third-party services.",
+				Value:       GasOracle_FeeHistory,
+			}, {
+				Name:        "Etherchain / Etherscan",
+				Description: "Use the gas price APIs from beaconcha.in (Etherchain), falling back to Etherscan if it's unavailable. These are external services that may be rate-limited or go offline.",
+				Value:       GasOracle_External,
+			}},
+		},
+
 		AutoTxGasThreshold: config.Parameter{
 			ID:   "minipoolStakeGasThreshold",
 			Name: "Automatic TX Gas Threshold",
@@ -303,6 +413,310 @@ func NewSmartnodeConfig(cfg *RocketPoolConfig) *SmartnodeConfig {
 			OverwriteOnUpgrade: false,
 		},
 
+		AutoTxDeadlineMaxFee: config.Parameter{
+			ID:                 "autoTxDeadlineMaxFee",
+			Name:               "Automatic TX Deadline Max Fee",
+			Description:        "Some automatic transactions (such as staking a minipool or a megapool validator) have a deadline, after which they ignore the Automatic TX Gas Threshold and your monthly gas budget so your node doesn't lose funds. This is the highest max fee (in gwei) they will pay once that deadline has passed.\n\nSet this to 0 to pay whatever the suggested fee happens to be once the deadline has passed.",
+			Type:               config.ParameterType_Float,
+			Default:            map[config.Network]interface{}{config.Network_All: float64(0)},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
+		AutoTxMonthlyGasBudget: config.Parameter{
+			ID:                 "autoTxMonthlyGasBudget",
+			Name:               "Automatic TX Monthly Gas Budget",
+			Description:        "The most ETH your node's automatic transactions can spend on gas each calendar month (in UTC). Once a transaction would take the month's spending over this budget, it will wait until the next month or until its deadline, whichever comes first.\n\nSet this to 0 to disable the budget.",
+			Type:               config.ParameterType_Float,
+			Default:            map[config.Network]interface{}{config.Network_All: float64(0)},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
+		AutoTxGasPolicies: config.Parameter{
+			ID:                 "autoTxGasPolicies",
+			Name:               "Automatic TX Gas Policies",
+			Description:        "Overrides for the gas threshold and deadline max fee of specific automatic transactions, as a comma-separated list of `duty=threshold` or `duty=threshold:deadlineMaxFee` entries (in gwei). For example, `distribute-minipools=10,stake-prelaunch-minipools=40:300`.\n\nThe duties are stake-prelaunch-minipools, stake-megapool-validators, prestake-megapool-validator, promote-minipools, distribute-minipools, reduce-bonds and auto-vote-pdao-props.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		TxBumpInterval: config.Parameter{
+			ID:                 "txBumpInterval",
+			Name:               "Stuck TX Bump Interval",
+			Description:        "The number of minutes an automatic transaction (such as staking or promoting a minipool) can stay pending before the Smartnode replaces it with a copy that pays higher fees.\n\nSet this to 0 to never bump the fees of pending transactions.",
+			Type:               config.ParameterType_Uint,
+			Default:            map[config.Network]interface{}{config.Network_All: uint64(5)},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
+		TxBumpPercent: config.Parameter{
+			ID:                 "txBumpPercent",
+			Name:               "Stuck TX Bump Percent",
+			Description:        "The percentage to raise both the max fee and the priority fee of a stuck automatic transaction by each time it's bumped.\n\nExecution clients won't accept a replacement unless both fees are raised by at least 10%, so lower values will be treated as 10.",
+			Type:               config.ParameterType_Uint,
+			Default:            map[config.Network]interface{}{config.Network_All: uint64(15)},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
+		TxBumpMaxFee: config.Parameter{
+			ID:                 "txBumpMaxFee",
+			Name:               "Stuck TX Max Fee Cap",
+			Description:        "The highest max fee (in gwei) the Smartnode will raise a stuck automatic transaction to. Once a transaction reaches this limit it will be left pending until the network fees come back down.\n\nBumps are also limited by the Manual Max Fee if you set one, and by the max fee the transaction's gas policy allows: the Automatic TX Gas Threshold, or the Automatic TX Deadline Max Fee for transactions sent after their deadline.\n\nSet this to 0 to disable this cap; the other limits still apply.",
+			Type:               config.ParameterType_Float,
+			Default:            map[config.Network]interface{}{config.Network_All: float64(300)},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
+		EnableApiServer: config.Parameter{
+			ID:                 "enableApiServer",
+			Name:               "Enable API Server",
+			Description:        "Run the Smartnode's API as a long-lived server on a Unix socket in your data folder, so CLI commands don't need to start a new process and reconnect to your clients each time they're run. The CLI will fall back to the old behavior whenever the server isn't available.",
+			Type:               config.ParameterType_Bool,
+			Default:            map[config.Network]interface{}{config.Network_All: true},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Api},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
+		ApiServerOpenPort: config.Parameter{
+			ID:                 "apiServerOpenPort",
+			Name:               "Expose API Server Port",
+			Description:        "Expose the API server on a TCP port so other processes on your machine, such as dashboards or your own scripts, can use it.\n\nRequests on this port must include the token in the `api-token` file in your data folder as a bearer token.",
+			Type:               config.ParameterType_Choice,
+			Default:            map[config.Network]interface{}{config.Network_All: config.RPC_Closed},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Api},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+			Options:            config.PortModes("")[:2],
+		},
+
+		ApiServerPort: config.Parameter{
+			ID:                 "apiServerPort",
+			Name:               "API Server Port",
+			Description:        "The TCP port the API server will listen on if it's exposed.",
+			Type:               config.ParameterType_Uint16,
+			Default:            map[config.Network]interface{}{config.Network_All: defaultApiServerPort},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Api},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
+		EnableStateSnapshots: config.Parameter{
+			ID:                 "enableStateSnapshots",
+			Name:               "Enable Network State Snapshots",
+			Description:        "Periodically save a compressed snapshot of the complete Rocket Pool network state in your data folder. Debugging tools and rewards reproduction will use these snapshots instead of rebuilding the state from your clients, which is slow and needs an archive Execution client for old slots.\n\nEach snapshot can be tens of megabytes on Mainnet, and building one takes as long as building the state for a rewards interval.",
+			Type:               config.ParameterType_Bool,
+			Default:            map[config.Network]interface{}{config.Network_All: false},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
+		StateSnapshotInterval: config.Parameter{
+			ID:                 "stateSnapshotInterval",
+			Name:               "Network State Snapshot Interval",
+			Description:        "How often to save a network state snapshot, in epochs. There are 225 epochs in a day.",
+			Type:               config.ParameterType_Uint,
+			Default:            map[config.Network]interface{}{config.Network_All: defaultStateSnapshotInterval},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
+		StateSnapshotRetention: config.Parameter{
+			ID:                 "stateSnapshotRetention",
+			Name:               "Network State Snapshots to Keep",
+			Description:        "The number of network state snapshots to keep. Older snapshots are deleted when a new one is saved. Set this to 0 to keep all of them.",
+			Type:               config.ParameterType_Uint,
+			Default:            map[config.Network]interface{}{config.Network_All: defaultStateSnapshotRetention},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
+		RewardsTreeCheckpointInterval: config.Parameter{
+			ID:                 "rewardsTreeCheckpointInterval",
+			Name:               "Rewards Tree Checkpoint Interval",
+			Description:        "How often to save the progress of rewards tree generation, in epochs. If generation is interrupted, it resumes from the last checkpoint instead of starting over. There are 225 epochs in a day.\n\nSet this to 0 to disable checkpoints.",
+			Type:               config.ParameterType_Uint,
+			Default:            map[config.Network]interface{}{config.Network_All: defaultRewardsCheckpointInterval},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node, config.ContainerID_Watchtower},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
+		EnableRunningRewardsTree: config.Parameter{
+			ID:                 "enableRunningRewardsTree",
+			Name:               "Keep a Running Rewards Tree",
+			Description:        "Process the attestation performance of the current rewards interval in the background as it happens, updating the rewards tree checkpoint every checkpoint interval. Generating the tree at the end of the interval then only has to process the last few epochs instead of the whole interval.\n\nThis requires the checkpoint interval to be greater than 0.",
+			Type:               config.ParameterType_Bool,
+			Default:            map[config.Network]interface{}{config.Network_All: false},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
+		UseRemoteSigner: config.Parameter{
+			ID:                 "useRemoteSigner",
+			Name:               "Use Remote Signer",
+			Description:        "Keep your validator keys in a Web3Signer-compatible remote signer instead of on disk. New validator keys will be imported into the signer through its keymanager API, your Validator Client will ask the signer to sign its duties, and voluntary exits will be signed by the signer.\n\n[orange]NOTE: Keys for validators you already have are not moved; import them into your signer before enabling this.",
+			Type:               config.ParameterType_Bool,
+			Default:            map[config.Network]interface{}{config.Network_All: false},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Validator},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
+		RemoteSignerUrl: config.Parameter{
+			ID:                 "remoteSignerUrl",
+			Name:               "Remote Signer URL",
+			Description:        "The URL of your remote signer's HTTP API, for example `http://192.168.1.10:9000`. It must have its keymanager API enabled.\n\nNOTE: If you are running it on the same machine as the Smartnode, addresses like `localhost` and `127.0.0.1` will not work due to Docker limitations. Enter your machine's LAN IP address instead.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Validator},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		RemoteSignerAuthToken: config.Parameter{
+			ID:                 "remoteSignerAuthToken",
+			Name:               "Remote Signer Auth Token",
+			Description:        "The bearer token for your remote signer's keymanager API, if it requires one.",
+			Type:               config.ParameterType_String,
+			Secret:             true,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		EnableKeymanagerApi: config.Parameter{
+			ID:                 "enableKeymanagerApi",
+			Name:               "Hot-Load Validator Keys",
+			Description:        "Enable your Validator Client's Keymanager API so the Smartnode can load new validator keys and change their fee recipients without restarting it, which would otherwise make you miss attestations.\n\nIf your Validator Client doesn't support it (such as Teku) or the API can't be reached, the Smartnode will restart the Validator Client instead.",
+			Type:               config.ParameterType_Bool,
+			Default:            map[config.Network]interface{}{config.Network_All: false},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Validator},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
+		KeymanagerApiPort: config.Parameter{
+			ID:                 "keymanagerApiPort",
+			Name:               "Keymanager API Port",
+			Description:        "The port your Validator Client's Keymanager API will listen on. It is only reachable from inside the Smartnode's Docker network.",
+			Type:               config.ParameterType_Uint16,
+			Default:            map[config.Network]interface{}{config.Network_All: defaultKeymanagerApiPort},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Validator},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
+		NodeSigner: config.Parameter{
+			ID:                 "nodeSigner",
+			Name:               "Node Account Signer",
+			Description:        "Select where your node account's key is kept. Transactions and messages signed by your node account will be sent to this signer; your validator keys are still derived from your node wallet's mnemonic.\n\n[orange]NOTE: An external signer must hold the same account as your node wallet, or you will no longer be able to manage your node.",
+			Type:               config.ParameterType_Choice,
+			Default:            map[config.Network]interface{}{config.Network_All: NodeSigner_Local},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+			Options: []config.ParameterOption{{
+				Name:        "Local",
+				Description: "Use the key derived from your node wallet, which is stored on disk.",
+				Value:       NodeSigner_Local,
+			}, {
+				Name:        "Clef",
+				Description: "Use a Clef-compatible external signer, such as Clef fronting a hardware wallet. Requests are sent to its `account_*` API.",
+				Value:       NodeSigner_Clef,
+			}, {
+				Name:        "EIP-1193",
+				Description: "Use an external signer that supports the standard `eth_accounts`, `eth_signTransaction`, and `personal_sign` methods, such as Frame or a custodial signing service.",
+				Value:       NodeSigner_Eip1193,
+			}},
+		},
+
+		NodeSignerUrl: config.Parameter{
+			ID:                 "nodeSignerUrl",
+			Name:               "Node Signer URL",
+			Description:        "The URL of your external signer's JSON-RPC API, for example `http://192.168.1.10:8550`.\n\nNOTE: If you are running it on the same machine as the Smartnode, addresses like `localhost` and `127.0.0.1` will not work due to Docker limitations. Enter your machine's LAN IP address instead.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		NodeSignerAuthToken: config.Parameter{
+			ID:                 "nodeSignerAuthToken",
+			Name:               "Node Signer Auth Token",
+			Description:        "The bearer token for your external signer's API, if it requires one.",
+			Type:               config.ParameterType_String,
+			Secret:             true,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		NodeSignerAddress: config.Parameter{
+			ID:                 "nodeSignerAddress",
+			Name:               "Node Signer Address",
+			Description:        "The address of the account to use from your external signer. Leave this blank to use the first account it reports.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		WalletPasswordStorage: config.Parameter{
+			ID:                 "walletPasswordStorage",
+			Name:               "Wallet Password Storage",
+			Description:        "Select how the password for your node wallet is stored. Anyone who can read the password can decrypt your node wallet.",
+			Type:               config.ParameterType_Choice,
+			Default:            map[config.Network]interface{}{config.Network_All: passwords.StorageMode_Plaintext},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+			Options: []config.ParameterOption{{
+				Name:        "Plaintext",
+				Description: "Store the password in a file that only root can read. Your node can restart on its own without any help.",
+				Value:       passwords.StorageMode_Plaintext,
+			}, {
+				Name:        "Sealed",
+				Description: fmt.Sprintf("Store the password encrypted with a key encryption key. The key is taken from the `%s` environment variable when the Smartnode's containers are started, or from a keyring file in your Smartnode directory (outside of your data folder) if that isn't set. The keyring file is never stored in the same folder as the password. An existing plaintext password will be sealed the next time it is used.\n\n[orange]NOTE: If you use a keyring file, keep a backup of it somewhere other than this machine; the password can't be recovered without it.", passwords.KekEnvVar),
+				Value:       passwords.StorageMode_Sealed,
+			}, {
+				Name:        "Unlock on Start",
+				Description: "Never store the password. The node daemon will start locked and won't perform any duties until you run `rocketpool wallet unlock`, which you must do every time it restarts. Your Validator Client keeps attesting while it is locked.\n\n[orange]NOTE: Delete your existing password file after switching to this mode.",
+				Value:       passwords.StorageMode_Unlock,
+			}},
+		},
+
+		AdditionalBeaconNodeUrls: config.Parameter{
+			ID:                 "additionalBeaconNodeUrls",
+			Name:               "Additional Beacon Nodes",
+			Description:        "A comma-separated list of extra Beacon Node HTTP API URLs for the Smartnode to use alongside your primary (and fallback) Consensus clients. The Smartnode scores every Beacon Node on its sync status, how far behind the others it is, how often it fails and how quickly it responds, and uses the healthiest one.\n\nThese are only used by the Smartnode daemons, not by your Validator Client. Execution clients aren't pooled; they still use your primary and fallback clients only.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
 		DistributeThreshold: config.Parameter{
 			ID:                 "distributeThreshold",
 			Name:               "Auto-Distribute Threshold",
@@ -411,6 +825,17 @@ func NewSmartnodeConfig(cfg *RocketPoolConfig) *SmartnodeConfig {
 			OverwriteOnUpgrade: true,
 		},
 
+		WatchtowerShadowMode: config.Parameter{
+			ID:                 "watchtowerShadowMode",
+			Name:               "Watchtower Shadow Mode",
+			Description:        "[orange]**For new or prospective Oracle DAO members.**\n\n[white]Enable this to run the watchtower's network balance, RPL price, rewards tree, minipool scrub and exit challenge duties without submitting any transactions. Each duty saves what it would have submitted and compares it to what the Oracle DAO submitted on-chain, and the results are exported as metrics.\n\n[orange]NOTE: Shadow mode is meant for nodes that aren't Oracle DAO members yet. If your node is a member, it won't perform ANY of its Oracle DAO duties while shadow mode is enabled.",
+			Type:               config.ParameterType_Bool,
+			Default:            map[config.Network]interface{}{config.Network_All: false},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Watchtower},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
 		txWatchUrl: map[config.Network]string{
 			config.Network_Mainnet: "https://etherscan.io/tx",
 			config.Network_Devnet:  "https://hoodi.etherscan.io/tx",
@@ -540,54 +965,6 @@ func NewSmartnodeConfig(cfg *RocketPoolConfig) *SmartnodeConfig {
 			config.Network_Testnet: {},
 		},
 
-		optimismPriceMessengerAddress: map[config.Network]string{
-			config.Network_Mainnet: "0x12759f8Df234f8f2cDdb3d2Ed5604adF9ACCfc9F",
-			config.Network_Devnet:  "",
-			config.Network_Testnet: "",
-		},
-
-		polygonPriceMessengerAddress: map[config.Network]string{
-			config.Network_Mainnet: "0xb1029Ac2Be4e08516697093e2AFeC435057f3511",
-			config.Network_Devnet:  "",
-			config.Network_Testnet: "",
-		},
-
-		arbitrumPriceMessengerAddress: map[config.Network]string{
-			config.Network_Mainnet: "0x05330300f829AD3fC8f33838BC88CFC4093baD53",
-			config.Network_Devnet:  "",
-			config.Network_Testnet: "",
-		},
-
-		arbitrumPriceMessengerAddressV2: map[config.Network]string{
-			config.Network_Mainnet: "0x312FcFB03eC9B1Ea38CB7BFCd26ee7bC3b505aB1",
-			config.Network_Devnet:  "",
-			config.Network_Testnet: "",
-		},
-
-		zkSyncEraPriceMessengerAddress: map[config.Network]string{
-			config.Network_Mainnet: "0x6cf6CB29754aEBf88AF12089224429bD68b0b8c8",
-			config.Network_Devnet:  "",
-			config.Network_Testnet: "",
-		},
-
-		basePriceMessengerAddress: map[config.Network]string{
-			config.Network_Mainnet: "0x8aa4afc5a9793433eb37c9919ff49b54903c7cb1",
-			config.Network_Devnet:  "",
-			config.Network_Testnet: "",
-		},
-
-		scrollPriceMessengerAddress: map[config.Network]string{
-			config.Network_Mainnet: "0x0f22dc9b9c03757d4676539203d7549c8f22c15c",
-			config.Network_Devnet:  "",
-			config.Network_Testnet: "",
-		},
-
-		scrollFeeEstimatorAddress: map[config.Network]string{
-			config.Network_Mainnet: "0x0d7E906BD9cAFa154b048cFa766Cc1E54E39AF9B",
-			config.Network_Devnet:  "",
-			config.Network_Testnet: "",
-		},
-
 		rplTwapPoolAddress: map[config.Network]string{
 			config.Network_Mainnet: "0xe42318ea3b998e8355a3da364eb9d48ec725eb45",
 			config.Network_Devnet:  "0x0ca239d8AC5E49E3203d60eaf86Baa6712E5b454",
@@ -623,7 +1000,33 @@ func (cfg *SmartnodeConfig) GetParameters() []*config.Parameter {
 		&cfg.DataPath,
 		&cfg.ManualMaxFee,
 		&cfg.PriorityFee,
+		&cfg.GasOracle,
 		&cfg.AutoTxGasThreshold,
+		&cfg.AutoTxDeadlineMaxFee,
+		&cfg.AutoTxMonthlyGasBudget,
+		&cfg.AutoTxGasPolicies,
+		&cfg.TxBumpInterval,
+		&cfg.TxBumpPercent,
+		&cfg.TxBumpMaxFee,
+		&cfg.EnableApiServer,
+		&cfg.ApiServerOpenPort,
+		&cfg.ApiServerPort,
+		&cfg.EnableStateSnapshots,
+		&cfg.StateSnapshotInterval,
+		&cfg.StateSnapshotRetention,
+		&cfg.RewardsTreeCheckpointInterval,
+		&cfg.EnableRunningRewardsTree,
+		&cfg.UseRemoteSigner,
+		&cfg.RemoteSignerUrl,
+		&cfg.RemoteSignerAuthToken,
+		&cfg.EnableKeymanagerApi,
+		&cfg.KeymanagerApiPort,
+		&cfg.NodeSigner,
+		&cfg.NodeSignerUrl,
+		&cfg.NodeSignerAuthToken,
+		&cfg.NodeSignerAddress,
+		&cfg.WalletPasswordStorage,
+		&cfg.AdditionalBeaconNodeUrls,
 		&cfg.DistributeThreshold,
 		&cfg.VerifyProposals,
 		&cfg.AutoAssignmentDelay,
@@ -633,6 +1036,7 @@ func (cfg *SmartnodeConfig) GetParameters() []*config.Parameter {
 		&cfg.ArchiveECUrl,
 		&cfg.WatchtowerMaxFeeOverride,
 		&cfg.WatchtowerPrioFeeOverride,
+		&cfg.WatchtowerShadowMode,
 	}
 }
 
@@ -666,6 +1070,23 @@ func (cfg *SmartnodeConfig) GetPasswordPath() string {
 	return filepath.Join(DaemonDataPath, "password")
 }
 
+// The keyring is kept in the Smartnode directory instead of the data folder, so it isn't stored alongside the password it protects
+func (cfg *SmartnodeConfig) GetPasswordKeyringPath() string {
+	if cfg.parent.IsNativeMode {
+		return filepath.Join(cfg.parent.RocketPoolDirectory, PasswordKeyringFilename)
+	}
+
+	return filepath.Join(DaemonRocketPoolPath, PasswordKeyringFilename)
+}
+
+func (cfg *SmartnodeConfig) GetPasswordAgentSocketPath() string {
+	if cfg.parent.IsNativeMode {
+		return filepath.Join(cfg.DataPath.Value.(string), PasswordAgentSocketFilename)
+	}
+
+	return filepath.Join(DaemonDataPath, PasswordAgentSocketFilename)
+}
+
 func (cfg *SmartnodeConfig) GetNodeAddressPath() string {
 	if cfg.parent.IsNativeMode {
 		return filepath.Join(cfg.DataPath.Value.(string), "address")
@@ -698,6 +1119,90 @@ func (cfg *SmartnodeConfig) GetVotingPath() string {
 	return filepath.Join(DaemonDataPath, "voting", string(cfg.Network.Value.(config.Network)))
 }
 
+func (cfg *SmartnodeConfig) GetDaemonStatePath() string {
+	if cfg.parent.IsNativeMode {
+		return filepath.Join(cfg.DataPath.Value.(string), DaemonStateFilename)
+	}
+
+	return filepath.Join(DaemonDataPath, DaemonStateFilename)
+}
+
+func (cfg *SmartnodeConfig) GetTxQueuePath() string {
+	if cfg.parent.IsNativeMode {
+		return filepath.Join(cfg.DataPath.Value.(string), TxQueueFilename)
+	}
+
+	return filepath.Join(DaemonDataPath, TxQueueFilename)
+}
+
+func (cfg *SmartnodeConfig) GetGasLedgerPath() string {
+	if cfg.parent.IsNativeMode {
+		return filepath.Join(cfg.DataPath.Value.(string), GasLedgerFilename)
+	}
+
+	return filepath.Join(DaemonDataPath, GasLedgerFilename)
+}
+
+func (cfg *SmartnodeConfig) GetGovernanceFeedPath() string {
+	if cfg.parent.IsNativeMode {
+		return filepath.Join(cfg.DataPath.Value.(string), GovernanceFeedFilename)
+	}
+
+	return filepath.Join(DaemonDataPath, GovernanceFeedFilename)
+}
+
+func (cfg *SmartnodeConfig) GetVotingPolicyPath() string {
+	if cfg.parent.IsNativeMode {
+		return filepath.Join(cfg.DataPath.Value.(string), VotingPolicyFilename)
+	}
+
+	return filepath.Join(DaemonDataPath, VotingPolicyFilename)
+}
+
+func (cfg *SmartnodeConfig) GetApiSocketPath() string {
+	if cfg.parent.IsNativeMode {
+		return filepath.Join(cfg.DataPath.Value.(string), ApiSocketFilename)
+	}
+
+	return filepath.Join(DaemonDataPath, ApiSocketFilename)
+}
+
+func (cfg *SmartnodeConfig) GetApiTokenPath() string {
+	if cfg.parent.IsNativeMode {
+		return filepath.Join(cfg.DataPath.Value.(string), ApiTokenFilename)
+	}
+
+	return filepath.Join(DaemonDataPath, ApiTokenFilename)
+}
+
+func (cfg *SmartnodeConfig) GetStateSnapshotsPath() string {
+	if cfg.parent.IsNativeMode {
+		return filepath.Join(cfg.DataPath.Value.(string), StateSnapshotsFolder)
+	}
+
+	return filepath.Join(DaemonDataPath, StateSnapshotsFolder)
+}
+
+func (cfg *SmartnodeConfig) GetKeymanagerApiTokenPath() string {
+	return filepath.Join(cfg.GetValidatorKeychainPath(), KeymanagerApiTokenFilename)
+}
+
+// Get the additional Beacon Node URLs, with blank entries removed
+func (cfg *SmartnodeConfig) GetAdditionalBeaconNodeUrls() []string {
+	urls := []string{}
+	for _, url := range strings.Split(cfg.AdditionalBeaconNodeUrls.Value.(string), ",") {
+		url = strings.TrimSpace(url)
+		if url != "" {
+			urls = append(urls, url)
+		}
+	}
+	return urls
+}
+
+func (cfg *SmartnodeConfig) GetApiSocketPathInCLI() string {
+	return filepath.Join(cfg.DataPath.Value.(string), ApiSocketFilename)
+}
+
 func (cfg *SmartnodeConfig) GetWalletPathInCLI() string {
 	return filepath.Join(cfg.DataPath.Value.(string), "wallet")
 }
@@ -817,6 +1322,13 @@ func (cfg *SmartnodeConfig) GetMinipoolPerformancePath(interval uint64, daemon b
 	)
 }
 
+func (cfg *SmartnodeConfig) GetRewardsCheckpointPath(interval uint64, daemon bool) string {
+	return filepath.Join(
+		cfg.GetRewardsTreeDirectory(daemon),
+		cfg.formatRewardsFilename(rewardsCheckpointFilenameFormat, interval, RewardsExtensionJSON)+".gz",
+	)
+}
+
 func (cfg *SmartnodeConfig) GetRegenerateRewardsTreeRequestPath(interval uint64, daemon bool) string {
 	if daemon && !cfg.parent.IsNativeMode {
 		return filepath.Join(DaemonDataPath, WatchtowerFolder, fmt.Sprintf(RegenerateRewardsTreeRequestFormat, interval))
@@ -833,6 +1345,10 @@ func (cfg *SmartnodeConfig) GetWatchtowerFolder(daemon bool) string {
 	return filepath.Join(cfg.DataPath.Value.(string), WatchtowerFolder)
 }
 
+func (cfg *SmartnodeConfig) GetShadowReportsPath() string {
+	return filepath.Join(cfg.GetWatchtowerFolder(true), ShadowReportsFilename)
+}
+
 func (cfg *SmartnodeConfig) GetFeeRecipientFilePath() string {
 	if !cfg.parent.IsNativeMode {
 		return filepath.Join(DaemonDataPath, "validators", FeeRecipientFilename)
@@ -893,38 +1409,6 @@ func (cfg *SmartnodeConfig) GetPreviousRocketDAOProtocolVerifierAddresses() []co
 	return cfg.previousRocketDAOProtocolVerifier[cfg.Network.Value.(config.Network)]
 }
 
-func (cfg *SmartnodeConfig) GetOptimismMessengerAddress() string {
-	return cfg.optimismPriceMessengerAddress[cfg.Network.Value.(config.Network)]
-}
-
-func (cfg *SmartnodeConfig) GetPolygonMessengerAddress() string {
-	return cfg.polygonPriceMessengerAddress[cfg.Network.Value.(config.Network)]
-}
-
-func (cfg *SmartnodeConfig) GetArbitrumMessengerAddress() string {
-	return cfg.arbitrumPriceMessengerAddress[cfg.Network.Value.(config.Network)]
-}
-
-func (cfg *SmartnodeConfig) GetArbitrumMessengerAddressV2() string {
-	return cfg.arbitrumPriceMessengerAddressV2[cfg.Network.Value.(config.Network)]
-}
-
-func (cfg *SmartnodeConfig) GetZkSyncEraMessengerAddress() string {
-	return cfg.zkSyncEraPriceMessengerAddress[cfg.Network.Value.(config.Network)]
-}
-
-func (cfg *SmartnodeConfig) GetBaseMessengerAddress() string {
-	return cfg.basePriceMessengerAddress[cfg.Network.Value.(config.Network)]
-}
-
-func (cfg *SmartnodeConfig) GetScrollMessengerAddress() string {
-	return cfg.scrollPriceMessengerAddress[cfg.Network.Value.(config.Network)]
-}
-
-func (cfg *SmartnodeConfig) GetScrollFeeEstimatorAddress() string {
-	return cfg.scrollFeeEstimatorAddress[cfg.Network.Value.(config.Network)]
-}
-
 func (cfg *SmartnodeConfig) GetRplTwapPoolAddress() string {
 	return cfg.rplTwapPoolAddress[cfg.Network.Value.(config.Network)]
 }
@@ -949,6 +1433,15 @@ func (cfg *SmartnodeConfig) GetFlashbotsProtectUrl() string {
 	return cfg.flashbotsProtectUrl[cfg.Network.Value.(config.Network)]
 }
 
+// Used by text/template to format api.yml
+func (cfg *SmartnodeConfig) GetApiServerOpenPorts() string {
+	portMode := cfg.ApiServerOpenPort.Value.(config.RPCMode)
+	if !cfg.EnableApiServer.Value.(bool) || !portMode.Open() {
+		return ""
+	}
+	return fmt.Sprintf("\"%s\"", portMode.DockerPortMapping(cfg.ApiServerPort.Value.(uint16)))
+}
+
 func getNetworkOptions() []config.ParameterOption {
 	options := []config.ParameterOption{
 		{
@@ -0,0 +1,341 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+// How serious a validation issue is
+type ValidationSeverity string
+
+const (
+	// The configuration can't be saved or started until the issue is fixed
+	ValidationSeverity_Error ValidationSeverity = "error"
+
+	// The configuration works, but probably not the way the user intended
+	ValidationSeverity_Warning ValidationSeverity = "warning"
+)
+
+// A problem found by a validation rule
+type ValidationIssue struct {
+	Severity ValidationSeverity `json:"severity"`
+	Rule     string             `json:"rule"`
+	Message  string             `json:"message"`
+
+	// The parameters involved in the issue, as `section.id` (root parameters use the `root` section)
+	ParameterIDs []string `json:"parameterIds"`
+}
+
+// A check that looks at how parameters work together, since each parameter only validates its own value
+type ValidationRule struct {
+	Name  string
+	Check func(cfg *RocketPoolConfig, rule string) []ValidationIssue
+}
+
+// The rules every configuration is checked against, in the order their issues are reported
+var validationRules = []ValidationRule{
+	{Name: "client-modes", Check: checkClientModes},
+	{Name: "external-clients", Check: checkExternalClients},
+	{Name: "fallback-clients", Check: checkFallbackClients},
+	{Name: "mev-boost", Check: checkMevBoost},
+	{Name: "signers", Check: checkSigners},
+	{Name: "rescue-node", Check: checkRescueNode},
+	{Name: "ports", Check: checkPorts},
+	{Name: "checkpoint-sync", Check: checkCheckpointSync},
+}
+
+// Known public Ethereum networks by chain ID, used to spot URLs meant for a different network
+var publicChainNames = map[uint]string{
+	1:        "mainnet",
+	5:        "goerli",
+	17000:    "holesky",
+	560048:   "hoodi",
+	11155111: "sepolia",
+}
+
+// Run every validation rule against the configuration and return all of the errors and warnings they found
+func (cfg *RocketPoolConfig) ValidateRules() []ValidationIssue {
+	issues := []ValidationIssue{}
+	for _, rule := range validationRules {
+		issues = append(issues, rule.Check(cfg, rule.Name)...)
+	}
+	return issues
+}
+
+// Get the messages of the issues with the given severity
+func GetValidationMessages(issues []ValidationIssue, severity ValidationSeverity) []string {
+	messages := []string{}
+	for _, issue := range issues {
+		if issue.Severity == severity {
+			messages = append(messages, issue.Message)
+		}
+	}
+	return messages
+}
+
+// Format an issue for display, with the parameters it involves
+func (issue ValidationIssue) String() string {
+	if len(issue.ParameterIDs) == 0 {
+		return issue.Message
+	}
+	return fmt.Sprintf("%s\n(%s)", issue.Message, strings.Join(issue.ParameterIDs, ", "))
+}
+
+// Make a new error
+func newValidationError(rule string, message string, parameterIDs ...string) ValidationIssue {
+	return ValidationIssue{
+		Severity:     ValidationSeverity_Error,
+		Rule:         rule,
+		Message:      message,
+		ParameterIDs: parameterIDs,
+	}
+}
+
+// Make a new warning
+func newValidationWarning(rule string, message string, parameterIDs ...string) ValidationIssue {
+	return ValidationIssue{
+		Severity:     ValidationSeverity_Warning,
+		Rule:         rule,
+		Message:      message,
+		ParameterIDs: parameterIDs,
+	}
+}
+
+// Get the `section.id` name of a parameter
+func getParameterRef(section string, param *config.Parameter) string {
+	return fmt.Sprintf("%s.%s", section, param.ID)
+}
+
+// Force all Docker or all Hybrid
+func checkClientModes(cfg *RocketPoolConfig, rule string) []ValidationIssue {
+	if cfg.IsNativeMode {
+		return nil
+	}
+	ecMode := cfg.ExecutionClientMode.Value.(config.Mode)
+	ccMode := cfg.ConsensusClientMode.Value.(config.Mode)
+	refs := []string{getParameterRef(rootConfigName, &cfg.ExecutionClientMode), getParameterRef(rootConfigName, &cfg.ConsensusClientMode)}
+	if ecMode == config.Mode_Local && ccMode == config.Mode_External {
+		return []ValidationIssue{newValidationError(rule, "You are using a locally-managed Execution client and an externally-managed Consensus client.\nThis configuration is not compatible with The Merge; please select either locally-managed or externally-managed for both the EC and CC.", refs...)}
+	}
+	if ecMode == config.Mode_External && ccMode == config.Mode_Local {
+		return []ValidationIssue{newValidationError(rule, "You are using an externally-managed Execution client and a locally-managed Consensus client.\nThis configuration is not compatible with The Merge; please select either locally-managed or externally-managed for both the EC and CC.", refs...)}
+	}
+	return nil
+}
+
+// Externally-managed clients need URLs to connect to
+func checkExternalClients(cfg *RocketPoolConfig, rule string) []ValidationIssue {
+	issues := []ValidationIssue{}
+
+	// Native mode always uses the user's own clients
+	if cfg.IsNativeMode {
+		if cfg.Native.EcHttpUrl.Value.(string) == "" {
+			issues = append(issues, newValidationError(rule, "You don't have a URL set for your Execution client. Please enter the URL of its HTTP API.", getParameterRef("native", &cfg.Native.EcHttpUrl)))
+		}
+		if cfg.Native.CcHttpUrl.Value.(string) == "" {
+			issues = append(issues, newValidationError(rule, "You don't have a URL set for your Beacon Node. Please enter the URL of its HTTP API.", getParameterRef("native", &cfg.Native.CcHttpUrl)))
+		}
+		return issues
+	}
+
+	if cfg.ExecutionClientMode.Value.(config.Mode) == config.Mode_External && cfg.ExternalExecution.HttpUrl.Value.(string) == "" {
+		issues = append(issues, newValidationError(rule, "You are using an externally-managed Execution client but don't have its URL set. Please enter the URL of its HTTP API.", getParameterRef("externalExecution", &cfg.ExternalExecution.HttpUrl)))
+	}
+
+	if cfg.ConsensusClientMode.Value.(config.Mode) == config.Mode_External {
+		var section string
+		var httpUrl *config.Parameter
+		switch cfg.ExternalConsensusClient.Value.(config.ConsensusClient) {
+		case config.ConsensusClient_Lighthouse:
+			section, httpUrl = "externalLighthouse", &cfg.ExternalLighthouse.HttpUrl
+		case config.ConsensusClient_Lodestar:
+			section, httpUrl = "externalLodestar", &cfg.ExternalLodestar.HttpUrl
+		case config.ConsensusClient_Nimbus:
+			section, httpUrl = "externalNimbus", &cfg.ExternalNimbus.HttpUrl
+		case config.ConsensusClient_Prysm:
+			section, httpUrl = "externalPrysm", &cfg.ExternalPrysm.HttpUrl
+			if cfg.ExternalPrysm.JsonRpcUrl.Value.(string) == "" {
+				issues = append(issues, newValidationError(rule, "You are using an externally-managed Prysm client but don't have its JSON-RPC URL set. Please enter the URL of its gRPC endpoint.", getParameterRef(section, &cfg.ExternalPrysm.JsonRpcUrl)))
+			}
+		case config.ConsensusClient_Teku:
+			section, httpUrl = "externalTeku", &cfg.ExternalTeku.HttpUrl
+		default:
+			return append(issues, newValidationError(rule, fmt.Sprintf("Unknown external consensus client [%v] selected.", cfg.ExternalConsensusClient.Value), getParameterRef(rootConfigName, &cfg.ExternalConsensusClient)))
+		}
+		if httpUrl.Value.(string) == "" {
+			issues = append(issues, newValidationError(rule, "You are using an externally-managed Consensus client but don't have its URL set. Please enter the URL of its HTTP API.", getParameterRef(section, httpUrl)))
+		}
+	}
+
+	return issues
+}
+
+// Fallback clients need URLs for the pair that matches the primary clients
+func checkFallbackClients(cfg *RocketPoolConfig, rule string) []ValidationIssue {
+	if !cfg.UseFallbackClients.Value.(bool) {
+		return nil
+	}
+	var section string
+	var params []*config.Parameter
+	cc, _ := cfg.GetSelectedConsensusClient()
+	if cc == config.ConsensusClient_Prysm {
+		section = "fallbackPrysm"
+		params = cfg.FallbackPrysm.GetParameters()
+	} else {
+		section = "fallbackNormal"
+		params = cfg.FallbackNormal.GetParameters()
+	}
+
+	issues := []ValidationIssue{}
+	for _, param := range params {
+		if param.Value.(string) == "" {
+			issues = append(issues, newValidationError(rule, fmt.Sprintf("You have fallback clients enabled but the fallback %s is blank. Please enter it or disable fallback clients.", param.Name), getParameterRef(rootConfigName, &cfg.UseFallbackClients), getParameterRef(section, param)))
+		}
+	}
+	return issues
+}
+
+// MEV-Boost needs relays or an external URL, depending on its mode
+func checkMevBoost(cfg *RocketPoolConfig, rule string) []ValidationIssue {
+	if cfg.IsNativeMode || cfg.EnableMevBoost.Value != true {
+		return nil
+	}
+	enabledRef := getParameterRef(rootConfigName, &cfg.EnableMevBoost)
+	modeRef := getParameterRef("mevBoost", &cfg.MevBoost.Mode)
+	switch cfg.MevBoost.Mode.Value.(config.Mode) {
+	case config.Mode_Local:
+		// In local MEV-boost mode, the user has to have at least one relay
+		relays := cfg.MevBoost.GetEnabledMevRelays()
+		if len(relays) == 0 {
+			return []ValidationIssue{newValidationError(rule, "You have MEV-boost enabled in local mode but don't have any profiles or relays enabled. Please select at least one profile or relay to use MEV-boost.", enabledRef, modeRef)}
+		}
+	case config.Mode_External:
+		// In external MEV-boost mode, the user has to have an external URL if they're running Docker mode
+		if cfg.ExecutionClientMode.Value.(config.Mode) == config.Mode_Local && cfg.MevBoost.ExternalUrl.Value.(string) == "" {
+			return []ValidationIssue{newValidationError(rule, "You have MEV-boost enabled in external mode but don't have a URL set. Please enter the external MEV-boost server URL to use it.", modeRef, getParameterRef("mevBoost", &cfg.MevBoost.ExternalUrl))}
+		}
+	default:
+		return []ValidationIssue{newValidationError(rule, "You do not have a MEV-Boost mode configured. You must either select a mode in the `rocketpool service config` UI, or disable MEV-Boost.\nNote that MEV-Boost will be required in a future update, at which point you can no longer disable it.", enabledRef, modeRef)}
+	}
+	return nil
+}
+
+// Remote signers need URLs, and a valid address if one is set
+func checkSigners(cfg *RocketPoolConfig, rule string) []ValidationIssue {
+	issues := []ValidationIssue{}
+	if cfg.Smartnode.UseRemoteSigner.Value.(bool) && cfg.Smartnode.RemoteSignerUrl.Value.(string) == "" {
+		issues = append(issues, newValidationError(rule, "You have the remote signer enabled but don't have a URL set. Please enter the URL of your remote signer to use it.", getParameterRef("smartnode", &cfg.Smartnode.UseRemoteSigner), getParameterRef("smartnode", &cfg.Smartnode.RemoteSignerUrl)))
+	}
+
+	if cfg.Smartnode.NodeSigner.Value.(string) != NodeSigner_Local {
+		if cfg.Smartnode.NodeSignerUrl.Value.(string) == "" {
+			issues = append(issues, newValidationError(rule, "You have an external node account signer selected but don't have a URL set. Please enter the URL of your signer to use it.", getParameterRef("smartnode", &cfg.Smartnode.NodeSigner), getParameterRef("smartnode", &cfg.Smartnode.NodeSignerUrl)))
+		}
+		if address := cfg.Smartnode.NodeSignerAddress.Value.(string); address != "" && !common.IsHexAddress(address) {
+			issues = append(issues, newValidationError(rule, fmt.Sprintf("The node signer address [%s] is not a valid address.", address), getParameterRef("smartnode", &cfg.Smartnode.NodeSignerAddress)))
+		}
+	}
+	return issues
+}
+
+// The Rescue Node needs credentials, and can't be managed in native mode
+func checkRescueNode(cfg *RocketPoolConfig, rule string) []ValidationIssue {
+	enabled := cfg.RescueNode.GetEnabledParameter()
+	if !enabled.Value.(bool) {
+		return nil
+	}
+	issues := []ValidationIssue{}
+
+	// Technically not required since native mode doesn't support addons, but defensively check to make sure a native mode
+	// user hasn't tried to configure the rescue node via the TUI
+	if cfg.IsNativeMode {
+		issues = append(issues, newValidationError(rule, "Rescue Node add-on is incompatible with native mode.\nYou can still connect manually, visit the rescue node website for more information.", getParameterRef("addons-rescue-node", enabled)))
+	}
+
+	for _, param := range cfg.RescueNode.GetConfig().GetParameters() {
+		if param.Type != config.ParameterType_String {
+			continue
+		}
+		if param.Value.(string) == "" {
+			issues = append(issues, newValidationError(rule, "Rescue Node requires both a username and a password.", getParameterRef("addons-rescue-node", param)))
+			break
+		}
+	}
+	return issues
+}
+
+// Ensure the selected port numbers are unique
+func checkPorts(cfg *RocketPoolConfig, rule string) []ValidationIssue {
+	ports := []struct {
+		section string
+		param   *config.Parameter
+	}{
+		{"consensusCommon", &cfg.ConsensusCommon.ApiPort},
+		{"consensusCommon", &cfg.ConsensusCommon.P2pPort},
+		{"executionCommon", &cfg.ExecutionCommon.EnginePort},
+		{"executionCommon", &cfg.ExecutionCommon.WsPort},
+		{"executionCommon", &cfg.ExecutionCommon.P2pPort},
+		{"executionCommon", &cfg.ExecutionCommon.HttpPort},
+		{rootConfigName, &cfg.BnMetricsPort},
+		{rootConfigName, &cfg.EcMetricsPort},
+		{rootConfigName, &cfg.ExporterMetricsPort},
+		{rootConfigName, &cfg.NodeMetricsPort},
+		{rootConfigName, &cfg.VcMetricsPort},
+		{rootConfigName, &cfg.WatchtowerMetricsPort},
+		{"grafana", &cfg.Grafana.Port},
+		{"mevBoost", &cfg.MevBoost.Port},
+		{"prometheus", &cfg.Prometheus.Port},
+		{"alertmanager", &cfg.Alertmanager.Port},
+		{"lighthouse", &cfg.Lighthouse.P2pQuicPort},
+	}
+
+	issues := []ValidationIssue{}
+	owners := map[string]int{}
+	for i, port := range ports {
+		value := fmt.Sprint(port.param.Value)
+		if value == "" {
+			continue
+		}
+		owner, exists := owners[value]
+		if !exists {
+			owners[value] = i
+			continue
+		}
+		issues = append(issues, newValidationError(rule, fmt.Sprintf("Port %s for %s is already in use by %s.", value, port.param.Name, ports[owner].param.Name), getParameterRef(ports[owner].section, ports[owner].param), getParameterRef(port.section, port.param)))
+	}
+	return issues
+}
+
+// The checkpoint sync URL has to serve the network the node is on
+func checkCheckpointSync(cfg *RocketPoolConfig, rule string) []ValidationIssue {
+	if cfg.IsNativeMode || cfg.ConsensusClientMode.Value.(config.Mode) != config.Mode_Local {
+		return nil
+	}
+	provider := cfg.ConsensusCommon.CheckpointSyncProvider.Value.(string)
+	if provider == "" {
+		return nil
+	}
+	ref := getParameterRef("consensusCommon", &cfg.ConsensusCommon.CheckpointSyncProvider)
+
+	parsedUrl, err := url.Parse(provider)
+	if err != nil || parsedUrl.Host == "" {
+		return []ValidationIssue{newValidationError(rule, fmt.Sprintf("The checkpoint sync URL [%s] is not a valid URL. Please enter a full URL such as https://checkpoint-sync.hoodi.ethpandaops.io.", provider), ref)}
+	}
+
+	// Public providers usually name the network in the host, so flag hosts that only name a different one
+	host := strings.ToLower(parsedUrl.Host)
+	expected := publicChainNames[cfg.Smartnode.GetChainID()]
+	if expected == "" || strings.Contains(host, expected) {
+		return nil
+	}
+	for _, name := range publicChainNames {
+		if strings.Contains(host, name) {
+			return []ValidationIssue{newValidationWarning(rule, fmt.Sprintf("Your checkpoint sync URL [%s] looks like it's for %s, but your node is on %s. Your Beacon Node won't be able to sync from it if it serves a different network.", provider, name, expected), ref, getParameterRef("smartnode", &cfg.Smartnode.Network))}
+		}
+	}
+	return nil
+}
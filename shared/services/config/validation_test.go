@@ -0,0 +1,118 @@
+package config
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+// Get the issues a rule found
+func getRuleIssues(cfg *RocketPoolConfig, rule string) []ValidationIssue {
+	issues := []ValidationIssue{}
+	for _, issue := range cfg.ValidateRules() {
+		if issue.Rule == rule {
+			issues = append(issues, issue)
+		}
+	}
+	return issues
+}
+
+func newTestConfig(t *testing.T) *RocketPoolConfig {
+	cfg := NewRocketPoolConfig(t.TempDir(), false)
+	cfg.EnableMevBoost.Value = false
+	return cfg
+}
+
+func TestValidateDefaultConfig(t *testing.T) {
+	cfg := newTestConfig(t)
+	if issues := cfg.ValidateRules(); len(issues) > 0 {
+		t.Errorf("expected no issues with the default config but got %v", issues)
+	}
+}
+
+func TestValidateExternalClients(t *testing.T) {
+	cfg := newTestConfig(t)
+	cfg.ExecutionClientMode.Value = config.Mode_External
+	cfg.ConsensusClientMode.Value = config.Mode_External
+	cfg.ExternalConsensusClient.Value = config.ConsensusClient_Prysm
+	cfg.ExternalExecution.HttpUrl.Value = "http://192.168.1.10:8545"
+	cfg.ExternalPrysm.HttpUrl.Value = ""
+	cfg.ExternalPrysm.JsonRpcUrl.Value = ""
+
+	issues := getRuleIssues(cfg, "external-clients")
+	refs := []string{}
+	for _, issue := range issues {
+		if issue.Severity != ValidationSeverity_Error {
+			t.Errorf("expected an error but got a %s", issue.Severity)
+		}
+		refs = append(refs, issue.ParameterIDs...)
+	}
+	expected := []string{"externalPrysm.jsonRpcUrl", "externalPrysm.httpUrl"}
+	if !reflect.DeepEqual(refs, expected) {
+		t.Errorf("expected issues for %v but got %v", expected, refs)
+	}
+}
+
+func TestValidatePorts(t *testing.T) {
+	cfg := newTestConfig(t)
+	cfg.Grafana.Port.Value = cfg.ExecutionCommon.HttpPort.Value
+
+	issues := getRuleIssues(cfg, "ports")
+	if len(issues) != 1 {
+		t.Fatalf("expected 1 port conflict but got %v", issues)
+	}
+	expected := []string{"executionCommon.httpPort", "grafana.port"}
+	if !reflect.DeepEqual(issues[0].ParameterIDs, expected) {
+		t.Errorf("expected the conflict between %v but got %v", expected, issues[0].ParameterIDs)
+	}
+}
+
+func TestValidateCheckpointSync(t *testing.T) {
+	cfg := newTestConfig(t)
+	cfg.ChangeNetwork(config.Network_Mainnet)
+
+	tests := []struct {
+		url      string
+		severity ValidationSeverity
+	}{
+		{"https://beaconstate.info", ""},
+		{"https://mainnet.checkpoint.sigp.io", ""},
+		{"https://checkpoint-sync.hoodi.ethpandaops.io", ValidationSeverity_Warning},
+		{"checkpoint-sync.example.com", ValidationSeverity_Error},
+	}
+	for _, test := range tests {
+		cfg.ConsensusCommon.CheckpointSyncProvider.Value = test.url
+		issues := getRuleIssues(cfg, "checkpoint-sync")
+		if test.severity == "" {
+			if len(issues) > 0 {
+				t.Errorf("expected no issues for %s but got %v", test.url, issues)
+			}
+			continue
+		}
+		if len(issues) != 1 || issues[0].Severity != test.severity {
+			t.Errorf("expected a %s for %s but got %v", test.severity, test.url, issues)
+		}
+	}
+
+	// Warnings don't make the config invalid
+	cfg.ConsensusCommon.CheckpointSyncProvider.Value = "https://checkpoint-sync.hoodi.ethpandaops.io"
+	if errors := cfg.Validate(); len(errors) > 0 {
+		t.Errorf("expected no errors but got %v", errors)
+	}
+}
+
+func TestValidateFallbackClients(t *testing.T) {
+	cfg := newTestConfig(t)
+	cfg.UseFallbackClients.Value = true
+	cfg.FallbackNormal.EcHttpUrl.Value = "http://192.168.1.10:8545"
+
+	issues := getRuleIssues(cfg, "fallback-clients")
+	if len(issues) != 1 {
+		t.Fatalf("expected 1 issue but got %v", issues)
+	}
+	expected := []string{"root.useFallbackClients", "fallbackNormal.ccHttpUrl"}
+	if !reflect.DeepEqual(issues[0].ParameterIDs, expected) {
+		t.Errorf("expected issues for %v but got %v", expected, issues[0].ParameterIDs)
+	}
+}
@@ -20,6 +20,8 @@ import (
 )
 
 // This is a proxy for multiple ETH clients, providing natural fallback support if one of them fails.
+// Unlike the BeaconClientManager, it only supports a primary and a fallback client and switches only when one can't be reached;
+// it doesn't score its clients or take additional endpoints.
 type ExecutionClientManager struct {
 	primaryEcUrl    string
 	fallbackEcUrl   string
@@ -193,6 +195,17 @@ func (p *ExecutionClientManager) SuggestGasTipCap(ctx context.Context) (*big.Int
 	return result.(*big.Int), err
 }
 
+// FeeHistory retrieves the fee market history.
+func (p *ExecutionClientManager) FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error) {
+	result, err := p.runFunction(func(client *ethClient) (interface{}, error) {
+		return client.FeeHistory(ctx, blockCount, lastBlock, rewardPercentiles)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ethereum.FeeHistory), err
+}
+
 // EstimateGas tries to estimate the gas needed to execute a specific
 // transaction based on the current pending state of the backend blockchain.
 // There is no guarantee that this is the true gas limit requirement as other
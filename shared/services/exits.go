@@ -0,0 +1,56 @@
+package services
+
+import (
+	"fmt"
+
+	"github.com/rocket-pool/smartnode/bindings/types"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/wallet/keystore/web3signer"
+	"github.com/rocket-pool/smartnode/shared/utils/validator"
+)
+
+// Get a signed voluntary exit message for a validator, from the remote signer if the node uses one or with the key from the node wallet otherwise
+func GetSignedExitMessage(c *cli.Context, validatorPubkey types.ValidatorPubkey, validatorIndex string, epoch uint64, signatureDomain []byte) (types.ValidatorSignature, error) {
+
+	// Use the remote signer if it's enabled
+	signer, err := GetRemoteSigner(c)
+	if err != nil {
+		return types.ValidatorSignature{}, err
+	}
+	if signer != nil {
+		bc, err := GetBeaconClient(c)
+		if err != nil {
+			return types.ValidatorSignature{}, err
+		}
+		eth2Config, err := bc.GetEth2Config()
+		if err != nil {
+			return types.ValidatorSignature{}, err
+		}
+
+		// The signer computes the exit domain from the fork info, and according to EIP-7044 (https://eips.ethereum.org/EIPS/eip-7044)
+		// voluntary exits are always signed with the Capella fork version
+		if len(eth2Config.CapellaForkVersion) == 0 {
+			return types.ValidatorSignature{}, fmt.Errorf("the Beacon node didn't provide the Capella fork version needed to sign the exit")
+		}
+		forkInfo := web3signer.ForkInfo{
+			PreviousVersion:       eth2Config.CapellaForkVersion,
+			CurrentVersion:        eth2Config.CapellaForkVersion,
+			Epoch:                 eth2Config.CapellaForkEpoch,
+			GenesisValidatorsRoot: eth2Config.GenesisValidatorsRoot,
+		}
+		return validator.GetRemoteSignedExitMessage(signer, forkInfo, validatorPubkey, validatorIndex, epoch, signatureDomain)
+	}
+
+	// Get validator private key
+	w, err := GetWallet(c)
+	if err != nil {
+		return types.ValidatorSignature{}, err
+	}
+	validatorKey, err := w.GetValidatorKeyByPubkey(validatorPubkey)
+	if err != nil {
+		return types.ValidatorSignature{}, err
+	}
+	return validator.GetSignedExitMessage(validatorKey, validatorIndex, epoch, signatureDomain)
+
+}
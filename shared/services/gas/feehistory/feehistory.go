@@ -0,0 +1,157 @@
+package feehistory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum"
+)
+
+// Oracle config
+const (
+	// How many recent blocks to sample
+	DefaultBlockCount uint64 = 20
+
+	// The percentile of each block's priority fees (weighted by gas used) that each speed pays
+	SlowPercentile     float64 = 10
+	StandardPercentile float64 = 50
+	FastPercentile     float64 = 90
+
+	// How many consecutive full blocks each speed's max fee can absorb before the transaction is priced out
+	SlowHeadroomBlocks     int = 2
+	StandardHeadroomBlocks int = 4
+	FastHeadroomBlocks     int = 6
+
+	// The base fee can rise by at most 1/8 per block (EIP-1559)
+	baseFeeChangeDenominator int64 = 8
+
+	// The lowest priority fee that will be suggested, so transactions aren't ignored when blocks are empty
+	minPriorityFeeWei int64 = 1e7
+)
+
+// The subset of the execution client used by the oracle
+type Client interface {
+	FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error)
+}
+
+// A max fee and priority fee to use for a transaction
+type FeeSuggestion struct {
+	MaxFeeWei         *big.Int
+	MaxPriorityFeeWei *big.Int
+}
+
+// Gas price suggestions derived from the execution client's recent fee history
+type GasFeeSuggestion struct {
+	// The base fee of the next block
+	BaseFeeWei *big.Int
+
+	Slow     FeeSuggestion
+	Standard FeeSuggestion
+	Fast     FeeSuggestion
+}
+
+// Get gas price suggestions from the most recent blocks
+func GetGasPrices(client Client) (GasFeeSuggestion, error) {
+	return GetGasPricesFromBlocks(client, DefaultBlockCount)
+}
+
+// Get gas price suggestions from a number of recent blocks.
+// Priority fees are the median across the blocks of each speed's reward percentile, and max fees add that to the next
+// block's base fee after it's projected to rise for each speed's number of headroom blocks.
+func GetGasPricesFromBlocks(client Client, blockCount uint64) (GasFeeSuggestion, error) {
+	history, err := client.FeeHistory(context.Background(), blockCount, nil, []float64{SlowPercentile, StandardPercentile, FastPercentile})
+	if err != nil {
+		return GasFeeSuggestion{}, fmt.Errorf("error getting fee history: %w", err)
+	}
+	if len(history.BaseFee) == 0 {
+		return GasFeeSuggestion{}, errors.New("the fee history didn't include any base fees")
+	}
+
+	// The last base fee is the one for the next block
+	nextBaseFee := history.BaseFee[len(history.BaseFee)-1]
+	if nextBaseFee == nil {
+		return GasFeeSuggestion{}, errors.New("the fee history is missing the next block's base fee; the chain may not support EIP-1559")
+	}
+
+	// Get the priority fee for each speed, skipping empty blocks since they don't say anything about demand
+	tips := [3][]*big.Int{}
+	for i, rewards := range history.Reward {
+		if i < len(history.GasUsedRatio) && history.GasUsedRatio[i] == 0 {
+			continue
+		}
+		if len(rewards) != len(tips) {
+			continue
+		}
+		for j, reward := range rewards {
+			tips[j] = append(tips[j], reward)
+		}
+	}
+
+	suggestion := GasFeeSuggestion{
+		BaseFeeWei: new(big.Int).Set(nextBaseFee),
+	}
+	for i, target := range []struct {
+		suggestion *FeeSuggestion
+		headroom   int
+	}{
+		{&suggestion.Slow, SlowHeadroomBlocks},
+		{&suggestion.Standard, StandardHeadroomBlocks},
+		{&suggestion.Fast, FastHeadroomBlocks},
+	} {
+		priorityFee := median(tips[i])
+		if priorityFee.Cmp(big.NewInt(minPriorityFeeWei)) < 0 {
+			priorityFee = big.NewInt(minPriorityFeeWei)
+		}
+		target.suggestion.MaxPriorityFeeWei = priorityFee
+		target.suggestion.MaxFeeWei = new(big.Int).Add(ProjectBaseFee(nextBaseFee, target.headroom), priorityFee)
+	}
+
+	// Faster speeds should never be cheaper than slower ones, even if the percentiles were noisy
+	raise(&suggestion.Standard, suggestion.Slow)
+	raise(&suggestion.Fast, suggestion.Standard)
+	return suggestion, nil
+}
+
+// Get the highest the base fee could be after a number of consecutive full blocks
+func ProjectBaseFee(baseFee *big.Int, blocks int) *big.Int {
+	projected := new(big.Int).Set(baseFee)
+	for i := 0; i < blocks; i++ {
+		increase := new(big.Int).Div(projected, big.NewInt(baseFeeChangeDenominator))
+		if increase.Sign() == 0 {
+			increase.SetInt64(1)
+		}
+		projected.Add(projected, increase)
+	}
+	return projected
+}
+
+// Get the median of a list of values, or zero if it's empty
+func median(values []*big.Int) *big.Int {
+	if len(values) == 0 {
+		return big.NewInt(0)
+	}
+	sorted := make([]*big.Int, len(values))
+	copy(sorted, values)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Cmp(sorted[j]) < 0
+	})
+	middle := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return new(big.Int).Set(sorted[middle])
+	}
+	sum := new(big.Int).Add(sorted[middle-1], sorted[middle])
+	return sum.Div(sum, big.NewInt(2))
+}
+
+// Raise a suggestion's fees so they're at least as high as another's
+func raise(suggestion *FeeSuggestion, floor FeeSuggestion) {
+	if suggestion.MaxPriorityFeeWei.Cmp(floor.MaxPriorityFeeWei) < 0 {
+		suggestion.MaxPriorityFeeWei = new(big.Int).Set(floor.MaxPriorityFeeWei)
+	}
+	if suggestion.MaxFeeWei.Cmp(floor.MaxFeeWei) < 0 {
+		suggestion.MaxFeeWei = new(big.Int).Set(floor.MaxFeeWei)
+	}
+}
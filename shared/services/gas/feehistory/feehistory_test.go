@@ -0,0 +1,180 @@
+package feehistory
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth/gasprice"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// Serves a simulated chain to go-ethereum's own fee history implementation, which is what eth_feeHistory runs on Geth
+type simulatedOracleBackend struct {
+	chain *core.BlockChain
+}
+
+func (b *simulatedOracleBackend) resolve(number rpc.BlockNumber) uint64 {
+	if number < 0 {
+		return b.chain.CurrentBlock().Number.Uint64()
+	}
+	return uint64(number)
+}
+
+func (b *simulatedOracleBackend) HeaderByNumber(ctx context.Context, number rpc.BlockNumber) (*types.Header, error) {
+	return b.chain.GetHeaderByNumber(b.resolve(number)), nil
+}
+
+func (b *simulatedOracleBackend) BlockByNumber(ctx context.Context, number rpc.BlockNumber) (*types.Block, error) {
+	return b.chain.GetBlockByNumber(b.resolve(number)), nil
+}
+
+func (b *simulatedOracleBackend) GetReceipts(ctx context.Context, hash common.Hash) (types.Receipts, error) {
+	return b.chain.GetReceiptsByHash(hash), nil
+}
+
+func (b *simulatedOracleBackend) PendingBlockAndReceipts() (*types.Block, types.Receipts) {
+	return nil, nil
+}
+
+func (b *simulatedOracleBackend) ChainConfig() *params.ChainConfig {
+	return b.chain.Config()
+}
+
+func (b *simulatedOracleBackend) SubscribeChainHeadEvent(ch chan<- core.ChainHeadEvent) event.Subscription {
+	return b.chain.SubscribeChainHeadEvent(ch)
+}
+
+// Provides eth_feeHistory for a simulated chain
+type simulatedClient struct {
+	oracle *gasprice.Oracle
+}
+
+func (c *simulatedClient) FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error) {
+	last := rpc.LatestBlockNumber
+	if lastBlock != nil {
+		last = rpc.BlockNumber(lastBlock.Int64())
+	}
+	oldest, rewards, baseFees, gasUsedRatios, err := c.oracle.FeeHistory(ctx, blockCount, last, rewardPercentiles)
+	if err != nil {
+		return nil, err
+	}
+	return &ethereum.FeeHistory{
+		OldestBlock:  oldest,
+		Reward:       rewards,
+		BaseFee:      baseFees,
+		GasUsedRatio: gasUsedRatios,
+	}, nil
+}
+
+func gwei(amount int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(amount), big.NewInt(params.GWei))
+}
+
+func TestGasPricesFromSimulatedChain(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	sim := backends.NewSimulatedBackend(core.GenesisAlloc{
+		from: {Balance: new(big.Int).Mul(big.NewInt(1000), big.NewInt(params.Ether))},
+	}, 30_000_000)
+	defer sim.Close()
+	chainID := sim.Blockchain().Config().ChainID
+	to := common.HexToAddress("0xd4E96eF8eee8678dBFf4d535E033Ed1a4F7605b7")
+
+	// Fill some blocks with transactions that pay priority fees of 1 to 10 gwei
+	nonce := uint64(0)
+	for block := 0; block < 5; block++ {
+		for tip := int64(1); tip <= 10; tip++ {
+			tx, err := types.SignNewTx(key, types.LatestSignerForChainID(chainID), &types.DynamicFeeTx{
+				ChainID:   chainID,
+				Nonce:     nonce,
+				GasTipCap: gwei(tip),
+				GasFeeCap: gwei(100),
+				Gas:       21000,
+				To:        &to,
+				Value:     big.NewInt(1),
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := sim.SendTransaction(context.Background(), tx); err != nil {
+				t.Fatal(err)
+			}
+			nonce++
+		}
+		sim.Commit()
+	}
+	// An empty block shouldn't drag the suggestions down
+	sim.Commit()
+
+	client := &simulatedClient{
+		oracle: gasprice.NewOracle(&simulatedOracleBackend{chain: sim.Blockchain()}, gasprice.Config{
+			Blocks:           20,
+			Percentile:       60,
+			MaxHeaderHistory: 1024,
+			MaxBlockHistory:  1024,
+		}),
+	}
+	suggestion, err := GetGasPricesFromBlocks(client, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The base fee is the next block's
+	head := sim.Blockchain().CurrentBlock()
+	if suggestion.BaseFeeWei.Sign() <= 0 || suggestion.BaseFeeWei.Cmp(head.BaseFee) > 0 {
+		t.Fatalf("expected the next base fee to be positive and no more than the current one after an empty block, got %s (current %s)", suggestion.BaseFeeWei, head.BaseFee)
+	}
+
+	// Priority fees follow the percentiles of what the blocks paid
+	if suggestion.Slow.MaxPriorityFeeWei.Cmp(gwei(1)) != 0 {
+		t.Fatalf("expected a slow priority fee of 1 gwei, got %s", suggestion.Slow.MaxPriorityFeeWei)
+	}
+	if suggestion.Standard.MaxPriorityFeeWei.Cmp(gwei(5)) != 0 {
+		t.Fatalf("expected a standard priority fee of 5 gwei, got %s", suggestion.Standard.MaxPriorityFeeWei)
+	}
+	if suggestion.Fast.MaxPriorityFeeWei.Cmp(gwei(9)) != 0 {
+		t.Fatalf("expected a fast priority fee of 9 gwei, got %s", suggestion.Fast.MaxPriorityFeeWei)
+	}
+
+	// Max fees cover the projected base fee for each speed on top of the priority fee
+	for _, check := range []struct {
+		name       string
+		suggestion FeeSuggestion
+		headroom   int
+	}{
+		{"slow", suggestion.Slow, SlowHeadroomBlocks},
+		{"standard", suggestion.Standard, StandardHeadroomBlocks},
+		{"fast", suggestion.Fast, FastHeadroomBlocks},
+	} {
+		expected := new(big.Int).Add(ProjectBaseFee(suggestion.BaseFeeWei, check.headroom), check.suggestion.MaxPriorityFeeWei)
+		if check.suggestion.MaxFeeWei.Cmp(expected) != 0 {
+			t.Fatalf("expected a %s max fee of %s, got %s", check.name, expected, check.suggestion.MaxFeeWei)
+		}
+	}
+	if suggestion.Slow.MaxFeeWei.Cmp(suggestion.Standard.MaxFeeWei) > 0 || suggestion.Standard.MaxFeeWei.Cmp(suggestion.Fast.MaxFeeWei) > 0 {
+		t.Fatalf("expected max fees to increase with speed: %+v", suggestion)
+	}
+}
+
+func TestProjectBaseFee(t *testing.T) {
+	// Each full block raises the base fee by 12.5%
+	if projected := ProjectBaseFee(big.NewInt(800), 2); projected.Cmp(big.NewInt(1012)) != 0 {
+		t.Fatalf("expected 1012, got %s", projected)
+	}
+	// Tiny base fees still rise
+	if projected := ProjectBaseFee(big.NewInt(7), 1); projected.Cmp(big.NewInt(8)) != 0 {
+		t.Fatalf("expected 8, got %s", projected)
+	}
+}
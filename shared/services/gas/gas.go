@@ -10,7 +10,9 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services/config"
 	"github.com/rocket-pool/smartnode/shared/services/gas/etherchain"
 	"github.com/rocket-pool/smartnode/shared/services/gas/etherscan"
+	"github.com/rocket-pool/smartnode/shared/services/gas/feehistory"
 	rpsvc "github.com/rocket-pool/smartnode/shared/services/rocketpool"
+	"github.com/rocket-pool/smartnode/shared/types/api"
 	"github.com/rocket-pool/smartnode/shared/utils/cli/prompt"
 	"github.com/rocket-pool/smartnode/shared/utils/math"
 )
@@ -51,6 +53,7 @@ func GetMaxFeeAndLimit(gasInfo rocketpool.GasInfo, rp *rpsvc.Client, headless bo
 
 	// Get the current settings from the CLI arguments
 	maxFeeGwei, maxPriorityFeeGwei, gasLimit := rp.GetGasSettings()
+	priorityFeeRequested := (maxPriorityFeeGwei != 0)
 
 	// Get the max fee - prioritize the CLI arguments, default to the config file setting
 	if maxFeeGwei == 0 {
@@ -87,13 +90,36 @@ func GetMaxFeeAndLimit(gasInfo rocketpool.GasInfo, rp *rpsvc.Client, headless bo
 		fmt.Printf("Total cost: %.4f to %.4f ETH%s\n", lowLimit, highLimit, colorReset)
 
 	} else {
-		if headless {
-			maxFeeWei, err := GetHeadlessMaxFeeWei(cfg)
+		// Try to get suggestions from the Execution client's fee history first if it's enabled
+		if cfg.Smartnode.GasOracle.Value.(string) == config.GasOracle_FeeHistory {
+			suggestions, err := rp.GasSuggestions()
+			if err == nil {
+				var suggestedPriorityFeeGwei float64
+				if headless {
+					maxFeeGwei = eth.WeiToGwei(suggestions.Fast.MaxFeeWei)
+					suggestedPriorityFeeGwei = eth.WeiToGwei(suggestions.Fast.MaxPriorityFeeWei)
+				} else {
+					// Print the suggestions and ask for an amount
+					maxFeeGwei, suggestedPriorityFeeGwei = handleFeeHistoryGasPrices(suggestions, gasInfo, gasLimit)
+				}
+
+				// A priority fee from the CLI arguments takes precedence over the suggested one
+				if !priorityFeeRequested {
+					maxPriorityFeeGwei = min(suggestedPriorityFeeGwei, maxFeeGwei)
+				}
+			} else {
+				fmt.Printf("%sWarning: couldn't get gas estimates from your Execution client - %s\nFalling back to Etherchain%s\n", colorYellow, err.Error(), colorReset)
+			}
+		}
+
+		// Fall back to Etherchain and Etherscan
+		if maxFeeGwei == 0 && headless {
+			maxFeeWei, err := getExternalMaxFeeWei(cfg)
 			if err != nil {
 				return Gas{}, err
 			}
 			maxFeeGwei = eth.WeiToGwei(maxFeeWei)
-		} else {
+		} else if maxFeeGwei == 0 {
 			// Try to get the latest gas prices from Etherchain
 			etherchainData, err := etherchain.GetGasPrices(cfg)
 			if err == nil {
@@ -142,8 +168,24 @@ func GetMaxFeeAndLimit(gasInfo rocketpool.GasInfo, rp *rpsvc.Client, headless bo
 
 }
 
-// Get the suggested max fee for service operations
-func GetHeadlessMaxFeeWei(cfg *config.RocketPoolConfig) (*big.Int, error) {
+// Get the suggested max fee for service operations.
+// This is the fast suggestion from the Execution client's fee history if that oracle is enabled and ec supports it,
+// or from Etherchain / Etherscan otherwise.
+func GetHeadlessMaxFeeWei(cfg *config.RocketPoolConfig, ec rocketpool.ExecutionClient) (*big.Int, error) {
+	if cfg.Smartnode.GasOracle.Value.(string) == config.GasOracle_FeeHistory {
+		if client, ok := ec.(feehistory.Client); ok {
+			suggestion, err := feehistory.GetGasPrices(client)
+			if err == nil {
+				return suggestion.Fast.MaxFeeWei, nil
+			}
+			fmt.Printf("%sWarning: couldn't get gas estimates from the Execution client - %s\nFalling back to Etherchain%s\n", colorYellow, err.Error(), colorReset)
+		}
+	}
+	return getExternalMaxFeeWei(cfg)
+}
+
+// Get the suggested max fee for service operations from Etherchain, or Etherscan if it's unavailable
+func getExternalMaxFeeWei(cfg *config.RocketPoolConfig) (*big.Int, error) {
 	etherchainData, err := etherchain.GetGasPrices(cfg)
 	if err == nil {
 		return etherchainData.RapidWei, nil
@@ -253,6 +295,83 @@ func handleEtherchainGasPrices(gasSuggestion etherchain.GasFeeSuggestion, gasInf
 
 }
 
+func handleFeeHistoryGasPrices(suggestions api.GasSuggestionsResponse, gasInfo rocketpool.GasInfo, gasLimit uint64) (float64, float64) {
+
+	type speed struct {
+		name        string
+		maxFeeGwei  float64
+		priorityFee float64
+		lowLimit    float64
+		highLimit   float64
+	}
+	speeds := []speed{}
+	for _, suggestion := range []struct {
+		name string
+		fees api.GasSuggestion
+	}{
+		{"Fast", suggestions.Fast},
+		{"Standard", suggestions.Standard},
+		{"Slow", suggestions.Slow},
+	} {
+		maxFeeEth := eth.WeiToEth(suggestion.fees.MaxFeeWei)
+		s := speed{
+			name:        suggestion.name,
+			maxFeeGwei:  math.RoundUp(eth.WeiToGwei(suggestion.fees.MaxFeeWei), 0),
+			priorityFee: eth.WeiToGwei(suggestion.fees.MaxPriorityFeeWei),
+		}
+		if gasLimit == 0 {
+			s.lowLimit = maxFeeEth * float64(gasInfo.EstGasLimit)
+			s.highLimit = maxFeeEth * float64(gasInfo.SafeGasLimit)
+		} else {
+			s.lowLimit = maxFeeEth * float64(gasLimit)
+			s.highLimit = s.lowLimit
+		}
+		speeds = append(speeds, s)
+	}
+
+	fmt.Printf("%s+================== Suggested Gas Prices ==================+\n", colorBlue)
+	fmt.Println("|   Speed   |  Max Fee  | Priority Fee |    Total Gas Cost    |")
+	for _, s := range speeds {
+		fmt.Printf("| %-9s | %-9s | %-12s | %.4f to %.4f ETH |\n",
+			s.name, fmt.Sprintf("%d gwei", int(s.maxFeeGwei)), fmt.Sprintf("%.2f gwei", s.priorityFee), s.lowLimit, s.highLimit)
+	}
+	fmt.Printf("+==========================================================+\n\n%s", colorReset)
+
+	fmt.Printf("These prices are based on the recent fee history from your Execution client; the current base fee is %.2f gwei.\n", eth.WeiToGwei(suggestions.BaseFeeWei))
+
+	fast := speeds[0]
+	for {
+		desiredPrice := prompt.Prompt(
+			fmt.Sprintf("Please enter your max fee (including the priority fee) or leave blank for the default of %d gwei:", int(fast.maxFeeGwei)),
+			"^(?:[1-9]\\d*|0)?(?:\\.\\d+)?$",
+			"Not a valid gas price, try again:")
+
+		if desiredPrice == "" {
+			return fast.maxFeeGwei, fast.priorityFee
+		}
+
+		desiredPriceFloat, err := strconv.ParseFloat(desiredPrice, 64)
+		if err != nil {
+			fmt.Printf("Not a valid gas price (%s), try again.", err.Error())
+			fmt.Println("")
+			continue
+		}
+		if desiredPriceFloat <= 0 {
+			fmt.Println("Max fee must be greater than zero.")
+			continue
+		}
+
+		// Use the priority fee of the fastest speed that the max fee covers
+		for _, s := range speeds {
+			if desiredPriceFloat >= s.maxFeeGwei {
+				return desiredPriceFloat, s.priorityFee
+			}
+		}
+		return desiredPriceFloat, speeds[len(speeds)-1].priorityFee
+	}
+
+}
+
 func handleEtherscanGasPrices(gasSuggestion etherscan.GasFeeSuggestion, gasInfo rocketpool.GasInfo, priorityFee float64, gasLimit uint64) float64 {
 
 	fastGwei := math.RoundUp(gasSuggestion.FastGwei+priorityFee, 0)
@@ -0,0 +1,186 @@
+package policy
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// The layout of the keys that spending is grouped by
+	MonthFormat string = "2006-01"
+
+	// How many months of spending to keep in the ledger
+	DefaultMonthsToKeep int = 12
+)
+
+// The gas spent by one of the daemon's duties
+type DutySpending struct {
+	Duty         string   `json:"duty"`
+	Transactions uint64   `json:"transactions"`
+	GasUsed      uint64   `json:"gasUsed"`
+	SpentWei     *big.Int `json:"spentWei"`
+}
+
+// The gas spent by all of the daemon's duties over a calendar month (in UTC)
+type MonthlySpending struct {
+	Month    string         `json:"month"`
+	TotalWei *big.Int       `json:"totalWei"`
+	Duties   []DutySpending `json:"duties"`
+}
+
+// The ledger as saved to disk, indexed by month and then by duty
+type ledgerFile struct {
+	UpdatedAt time.Time                           `json:"updatedAt"`
+	Months    map[string]map[string]*DutySpending `json:"months"`
+}
+
+// Keeps track of how much gas the daemon's automatic transactions have cost, so it can be checked against a budget.
+// The file is only written by the node daemon, but it's read by the API to report spending.
+type Ledger struct {
+	path         string
+	monthsToKeep int
+	lock         sync.Mutex
+}
+
+// Create a new ledger backed by the file at the provided path; the file is created on first use
+func NewLedger(path string) *Ledger {
+	return &Ledger{
+		path:         path,
+		monthsToKeep: DefaultMonthsToKeep,
+	}
+}
+
+// Get the month a point in time belongs to
+func GetMonth(t time.Time) string {
+	return t.UTC().Format(MonthFormat)
+}
+
+// Add the cost of a mined transaction to a duty's spending for the month it was mined in
+func (l *Ledger) Record(duty string, gasUsed uint64, costWei *big.Int, at time.Time) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	file, err := l.load()
+	if err != nil {
+		return err
+	}
+
+	month := GetMonth(at)
+	duties, exists := file.Months[month]
+	if !exists {
+		duties = map[string]*DutySpending{}
+		file.Months[month] = duties
+	}
+	spending, exists := duties[duty]
+	if !exists {
+		spending = &DutySpending{
+			Duty:     duty,
+			SpentWei: big.NewInt(0),
+		}
+		duties[duty] = spending
+	}
+	spending.Transactions++
+	spending.GasUsed += gasUsed
+	spending.SpentWei = new(big.Int).Add(spending.SpentWei, costWei)
+
+	l.prune(file)
+	return l.save(file)
+}
+
+// Get the spending for the month a point in time belongs to
+func (l *Ledger) GetMonthlySpending(at time.Time) (MonthlySpending, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	month := GetMonth(at)
+	spending := MonthlySpending{
+		Month:    month,
+		TotalWei: big.NewInt(0),
+		Duties:   []DutySpending{},
+	}
+	file, err := l.load()
+	if err != nil {
+		return spending, err
+	}
+
+	for _, duty := range file.Months[month] {
+		spending.Duties = append(spending.Duties, *duty)
+		spending.TotalWei.Add(spending.TotalWei, duty.SpentWei)
+	}
+	sort.Slice(spending.Duties, func(i int, j int) bool {
+		return spending.Duties[i].Duty < spending.Duties[j].Duty
+	})
+	return spending, nil
+}
+
+// Get the total spent by every duty during the month a point in time belongs to
+func (l *Ledger) GetTotalSpent(at time.Time) (*big.Int, error) {
+	spending, err := l.GetMonthlySpending(at)
+	if err != nil {
+		return nil, err
+	}
+	return spending.TotalWei, nil
+}
+
+// Remove the oldest months once there are too many
+func (l *Ledger) prune(file *ledgerFile) {
+	months := make([]string, 0, len(file.Months))
+	for month := range file.Months {
+		months = append(months, month)
+	}
+	if len(months) <= l.monthsToKeep {
+		return
+	}
+	sort.Strings(months)
+	for _, month := range months[:len(months)-l.monthsToKeep] {
+		delete(file.Months, month)
+	}
+}
+
+// Load the ledger from disk; a missing file is treated as an empty ledger
+func (l *Ledger) load() (*ledgerFile, error) {
+	file := &ledgerFile{}
+	bytes, err := os.ReadFile(l.path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("error reading gas ledger [%s]: %w", l.path, err)
+	}
+	if err == nil {
+		err = json.Unmarshal(bytes, file)
+		if err != nil {
+			return nil, fmt.Errorf("error deserializing gas ledger [%s]: %w", l.path, err)
+		}
+	}
+	if file.Months == nil {
+		file.Months = map[string]map[string]*DutySpending{}
+	}
+	return file, nil
+}
+
+// Save the ledger to disk, replacing the old file atomically
+func (l *Ledger) save(file *ledgerFile) error {
+	file.UpdatedAt = time.Now()
+	bytes, err := json.Marshal(file)
+	if err != nil {
+		return fmt.Errorf("error serializing gas ledger: %w", err)
+	}
+	err = os.MkdirAll(filepath.Dir(l.path), 0755)
+	if err != nil {
+		return fmt.Errorf("error creating folder for gas ledger [%s]: %w", l.path, err)
+	}
+	tempPath := l.path + ".tmp"
+	err = os.WriteFile(tempPath, bytes, 0644)
+	if err != nil {
+		return fmt.Errorf("error writing gas ledger [%s]: %w", tempPath, err)
+	}
+	err = os.Rename(tempPath, l.path)
+	if err != nil {
+		return fmt.Errorf("error replacing gas ledger [%s]: %w", l.path, err)
+	}
+	return nil
+}
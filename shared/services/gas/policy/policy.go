@@ -0,0 +1,178 @@
+package policy
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rocket-pool/smartnode/bindings/utils/eth"
+
+	"github.com/rocket-pool/smartnode/shared/services/config"
+)
+
+// Controls when one of the daemon's duties is allowed to submit an automatic transaction
+type Policy struct {
+	// The name of the duty, which matches the task name its transactions are tracked under
+	Duty string
+
+	// The max fee (in gwei) has to be below this before the duty's deadline; 0 means the duty waits for its deadline
+	ThresholdGwei float64
+
+	// The highest max fee (in gwei) the duty will pay once its deadline has passed; 0 means there's no limit
+	DeadlineMaxFeeGwei float64
+
+	// The most the daemon's duties can spend on gas each month before this one has to wait for its deadline; nil means there's no budget
+	MonthlyBudgetWei *big.Int
+}
+
+// The thresholds a user has set for a specific duty
+type Override struct {
+	ThresholdGwei         float64
+	DeadlineMaxFeeGwei    float64
+	HasDeadlineMaxFeeGwei bool
+}
+
+// The result of checking a transaction against a policy
+type Decision struct {
+	Proceed      bool
+	PastDeadline bool
+	Reason       string
+}
+
+// Get the policy for a duty, applying any overrides the user has set for it
+func GetPolicy(cfg *config.RocketPoolConfig, duty string) (Policy, error) {
+	policy := Policy{
+		Duty:               duty,
+		ThresholdGwei:      cfg.Smartnode.AutoTxGasThreshold.Value.(float64),
+		DeadlineMaxFeeGwei: cfg.Smartnode.AutoTxDeadlineMaxFee.Value.(float64),
+		MonthlyBudgetWei:   GetMonthlyBudget(cfg),
+	}
+
+	overrides, err := ParseOverrides(cfg.Smartnode.AutoTxGasPolicies.Value.(string))
+	if err != nil {
+		return policy, err
+	}
+	override, exists := overrides[duty]
+	if exists {
+		policy.ThresholdGwei = override.ThresholdGwei
+		if override.HasDeadlineMaxFeeGwei {
+			policy.DeadlineMaxFeeGwei = override.DeadlineMaxFeeGwei
+		}
+	}
+	return policy, nil
+}
+
+// Get the monthly gas budget for the automatic transactions, or nil if there isn't one
+func GetMonthlyBudget(cfg *config.RocketPoolConfig) *big.Int {
+	budgetEth := cfg.Smartnode.AutoTxMonthlyGasBudget.Value.(float64)
+	if budgetEth <= 0 {
+		return nil
+	}
+	return eth.EthToWei(budgetEth)
+}
+
+// Parse a comma-separated list of per-duty overrides, formatted as `duty=threshold` or `duty=threshold:deadlineMaxFee` (in gwei)
+func ParseOverrides(value string) (map[string]Override, error) {
+	overrides := map[string]Override{}
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		duty, fees, found := strings.Cut(entry, "=")
+		duty = strings.TrimSpace(duty)
+		if !found || duty == "" {
+			return nil, fmt.Errorf("invalid gas policy [%s]: expected duty=threshold or duty=threshold:deadlineMaxFee", entry)
+		}
+		if _, exists := overrides[duty]; exists {
+			return nil, fmt.Errorf("duty [%s] has more than one gas policy", duty)
+		}
+
+		override := Override{}
+		threshold, deadlineMaxFee, hasDeadlineMaxFee := strings.Cut(fees, ":")
+		var err error
+		override.ThresholdGwei, err = parseGwei(threshold)
+		if err != nil {
+			return nil, fmt.Errorf("invalid threshold in gas policy [%s]: %w", entry, err)
+		}
+		if hasDeadlineMaxFee {
+			override.DeadlineMaxFeeGwei, err = parseGwei(deadlineMaxFee)
+			if err != nil {
+				return nil, fmt.Errorf("invalid deadline max fee in gas policy [%s]: %w", entry, err)
+			}
+			override.HasDeadlineMaxFeeGwei = true
+		}
+		overrides[duty] = override
+	}
+	return overrides, nil
+}
+
+// Check if a transaction can be submitted now.
+// The deadline is when the duty has to go through regardless of the threshold and budget (zero if it doesn't have one),
+// and spentWei is how much the daemon's duties have spent on gas so far this month.
+func (p Policy) Evaluate(maxFeeWei *big.Int, gasLimit uint64, deadline time.Time, spentWei *big.Int, now time.Time) Decision {
+	maxFeeGwei := eth.WeiToGwei(maxFeeWei)
+	cost := new(big.Int).Mul(maxFeeWei, new(big.Int).SetUint64(gasLimit))
+	overBudget := p.MonthlyBudgetWei != nil && new(big.Int).Add(spentWei, cost).Cmp(p.MonthlyBudgetWei) > 0
+
+	// Past the deadline, the only limit is the deadline cap
+	if !deadline.IsZero() && !now.Before(deadline) {
+		if p.DeadlineMaxFeeGwei > 0 && maxFeeGwei > p.DeadlineMaxFeeGwei {
+			return Decision{
+				PastDeadline: true,
+				Reason:       fmt.Sprintf("The %s deadline has passed, but the current max fee of %.2f gwei is higher than its deadline cap of %.2f gwei.", p.Duty, maxFeeGwei, p.DeadlineMaxFeeGwei),
+			}
+		}
+		reason := fmt.Sprintf("The %s deadline has passed, so it will proceed at the current max fee of %.2f gwei.", p.Duty, maxFeeGwei)
+		if overBudget {
+			reason += fmt.Sprintf(" This may take the month's gas spending over its budget of %.6f ETH.", eth.WeiToEth(p.MonthlyBudgetWei))
+		}
+		return Decision{
+			Proceed:      true,
+			PastDeadline: true,
+			Reason:       reason,
+		}
+	}
+
+	// Before the deadline, it has to be under the threshold and fit in the budget
+	var reason string
+	if maxFeeGwei >= p.ThresholdGwei {
+		reason = fmt.Sprintf("The current max fee of %.2f gwei is not lower than the %s threshold of %.2f gwei.", maxFeeGwei, p.Duty, p.ThresholdGwei)
+	} else if overBudget {
+		reason = fmt.Sprintf("The transaction could cost up to %.6f ETH, but only %.6f ETH of this month's %.6f ETH gas budget is left.",
+			eth.WeiToEth(cost), eth.WeiToEth(remaining(p.MonthlyBudgetWei, spentWei)), eth.WeiToEth(p.MonthlyBudgetWei))
+	} else {
+		return Decision{
+			Proceed: true,
+		}
+	}
+	if !deadline.IsZero() {
+		reason += fmt.Sprintf(" It will wait until its deadline in %s.", deadline.Sub(now).Round(time.Second))
+	}
+	return Decision{
+		Reason: reason,
+	}
+}
+
+// Parse a non-negative gwei amount
+func parseGwei(value string) (float64, error) {
+	gwei, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil {
+		return 0, err
+	}
+	if gwei < 0 {
+		return 0, fmt.Errorf("%f is negative", gwei)
+	}
+	return gwei, nil
+}
+
+// Get how much of a budget is left, floored at zero
+func remaining(budget *big.Int, spent *big.Int) *big.Int {
+	left := new(big.Int).Sub(budget, spent)
+	if left.Sign() < 0 {
+		return big.NewInt(0)
+	}
+	return left
+}
@@ -0,0 +1,157 @@
+package policy
+
+import (
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/rocket-pool/smartnode/bindings/utils/eth"
+)
+
+func TestParseOverrides(t *testing.T) {
+	overrides, err := ParseOverrides(" distribute-minipools=10, stake-prelaunch-minipools=40:300 ,")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if len(overrides) != 2 {
+		t.Fatalf("expected 2 overrides, got %d", len(overrides))
+	}
+	distribute := overrides["distribute-minipools"]
+	if distribute.ThresholdGwei != 10 || distribute.HasDeadlineMaxFeeGwei {
+		t.Errorf("unexpected distribute override: %+v", distribute)
+	}
+	stake := overrides["stake-prelaunch-minipools"]
+	if stake.ThresholdGwei != 40 || !stake.HasDeadlineMaxFeeGwei || stake.DeadlineMaxFeeGwei != 300 {
+		t.Errorf("unexpected stake override: %+v", stake)
+	}
+
+	for _, invalid := range []string{
+		"distribute-minipools",
+		"=10",
+		"distribute-minipools=ten",
+		"distribute-minipools=10:-1",
+		"distribute-minipools=10,distribute-minipools=20",
+	} {
+		_, err := ParseOverrides(invalid)
+		if err == nil {
+			t.Errorf("expected [%s] to be rejected", invalid)
+		}
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	policy := Policy{
+		Duty:               "test",
+		ThresholdGwei:      20,
+		DeadlineMaxFeeGwei: 100,
+		MonthlyBudgetWei:   eth.EthToWei(0.01),
+	}
+	gasLimit := uint64(100000)
+	nothingSpent := big.NewInt(0)
+
+	testCases := []struct {
+		name         string
+		maxFeeGwei   float64
+		deadline     time.Time
+		spent        *big.Int
+		proceed      bool
+		pastDeadline bool
+	}{
+		{"under the threshold", 10, time.Time{}, nothingSpent, true, false},
+		{"over the threshold", 30, time.Time{}, nothingSpent, false, false},
+		{"over the threshold before the deadline", 30, now.Add(time.Hour), nothingSpent, false, false},
+		{"over the threshold after the deadline", 30, now.Add(-time.Hour), nothingSpent, true, true},
+		{"over the deadline cap after the deadline", 150, now.Add(-time.Hour), nothingSpent, false, true},
+		{"over the budget", 10, time.Time{}, eth.EthToWei(0.0095), false, false},
+		{"over the budget after the deadline", 10, now, eth.EthToWei(0.0095), true, true},
+	}
+	for _, testCase := range testCases {
+		decision := policy.Evaluate(eth.GweiToWei(testCase.maxFeeGwei), gasLimit, testCase.deadline, testCase.spent, now)
+		if decision.Proceed != testCase.proceed || decision.PastDeadline != testCase.pastDeadline {
+			t.Errorf("%s: expected proceed=%t and pastDeadline=%t, got %+v", testCase.name, testCase.proceed, testCase.pastDeadline, decision)
+		}
+		if !decision.Proceed && decision.Reason == "" {
+			t.Errorf("%s: expected a reason for waiting", testCase.name)
+		}
+	}
+
+	// A threshold of 0 means the duty only goes through at its deadline
+	policy.ThresholdGwei = 0
+	if policy.Evaluate(eth.GweiToWei(1), gasLimit, time.Time{}, nothingSpent, now).Proceed {
+		t.Error("expected a threshold of 0 to block the duty before its deadline")
+	}
+
+	// Without a deadline cap, it'll pay whatever it has to
+	policy.DeadlineMaxFeeGwei = 0
+	if !policy.Evaluate(eth.GweiToWei(1000), gasLimit, now, nothingSpent, now).Proceed {
+		t.Error("expected no deadline cap to let the duty proceed at any fee")
+	}
+}
+
+func TestLedger(t *testing.T) {
+	ledger := NewLedger(filepath.Join(t.TempDir(), "gas-ledger.json"))
+	october := time.Date(2026, time.October, 18, 12, 0, 0, 0, time.UTC)
+	november := time.Date(2026, time.November, 1, 0, 0, 0, 0, time.UTC)
+
+	// Nothing has been spent before anything is recorded
+	spent, err := ledger.GetTotalSpent(october)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if spent.Sign() != 0 {
+		t.Fatalf("expected nothing to be spent, got %s", spent)
+	}
+
+	records := []struct {
+		duty string
+		cost int64
+		at   time.Time
+	}{
+		{"stake-prelaunch-minipools", 300, october},
+		{"distribute-minipools", 100, october},
+		{"distribute-minipools", 50, october.Add(time.Hour)},
+		{"distribute-minipools", 1000, november},
+	}
+	for _, record := range records {
+		err := ledger.Record(record.duty, 21000, big.NewInt(record.cost), record.at)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+	}
+
+	// Spending is grouped by month and duty
+	spending, err := ledger.GetMonthlySpending(october)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if spending.Month != "2026-10" || spending.TotalWei.Cmp(big.NewInt(450)) != 0 || len(spending.Duties) != 2 {
+		t.Fatalf("unexpected spending for October: %+v", spending)
+	}
+	distribute := spending.Duties[0]
+	if distribute.Duty != "distribute-minipools" || distribute.Transactions != 2 || distribute.GasUsed != 42000 || distribute.SpentWei.Cmp(big.NewInt(150)) != 0 {
+		t.Fatalf("unexpected distribute spending: %+v", distribute)
+	}
+	spent, err = ledger.GetTotalSpent(november)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if spent.Cmp(big.NewInt(1000)) != 0 {
+		t.Fatalf("expected 1000 wei to be spent in November, got %s", spent)
+	}
+
+	// Old months are pruned
+	ledger.monthsToKeep = 1
+	err = ledger.Record("distribute-minipools", 21000, big.NewInt(1), november)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	spending, err = ledger.GetMonthlySpending(october)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if len(spending.Duties) != 0 {
+		t.Fatalf("expected October to be pruned, got %+v", spending)
+	}
+}
@@ -0,0 +1,139 @@
+package governance
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/smartnode/bindings/dao/protocol"
+	"github.com/rocket-pool/smartnode/bindings/network"
+	"github.com/rocket-pool/smartnode/bindings/rocketpool"
+	"github.com/rocket-pool/smartnode/bindings/types"
+
+	"github.com/rocket-pool/smartnode/shared/services/proposals"
+)
+
+// The transaction used to cast an automatic vote
+type VoteAction string
+
+const (
+	// Nothing to submit
+	VoteAction_None VoteAction = ""
+
+	// A phase 1 vote with the node's delegated voting power, which needs the node's Merkle proof in the network tree
+	VoteAction_Vote VoteAction = "vote"
+
+	// A phase 2 vote that overrides the vote of the node's delegate
+	VoteAction_Override VoteAction = "override"
+)
+
+// What the voting policy would do with a Protocol DAO proposal right now
+type AutomaticVote struct {
+	ProposalID  uint64         `json:"proposalId"`
+	Message     string         `json:"message"`
+	Phase       Phase          `json:"phase"`
+	Decision    VotingDecision `json:"decision"`
+	Action      VoteAction     `json:"action"`
+	VotingPower *big.Int       `json:"votingPower"`
+
+	// Why nothing will be submitted, if the action is none
+	SkipReason string `json:"skipReason,omitempty"`
+
+	// The artifacts for a phase 1 vote
+	NodeIndex uint64                 `json:"-"`
+	Proof     []types.VotingTreeNode `json:"-"`
+}
+
+// Work out how the voting policy would vote on a proposal, and which transaction the node would use to do it.
+// Phase 1 votes need the node's voting trees, so they're built with the proposal manager if they don't exist yet.
+func PlanAutomaticVote(rp *rocketpool.RocketPool, propMgr *proposals.ProposalManager, policy *VotingPolicy, details protocol.ProtocolDaoProposalDetails, nodeAddress common.Address, opts *bind.CallOpts) (AutomaticVote, error) {
+	vote := AutomaticVote{
+		ProposalID:  details.ID,
+		Message:     details.Message,
+		Phase:       Phase_Closed,
+		VotingPower: big.NewInt(0),
+	}
+	switch details.State {
+	case types.ProtocolDaoProposalState_Pending:
+		vote.Phase = Phase_Pending
+	case types.ProtocolDaoProposalState_ActivePhase1:
+		vote.Phase = Phase_Phase1
+	case types.ProtocolDaoProposalState_ActivePhase2:
+		vote.Phase = Phase_Phase2
+	}
+
+	// Evaluate the policy
+	followedVote := types.VoteDirection_NoVote
+	if followAddress, exists := policy.GetFollowAddress(); exists && vote.Phase != Phase_Pending {
+		var err error
+		followedVote, err = protocol.GetAddressVoteDirection(rp, details.ID, followAddress, opts)
+		if err != nil {
+			return vote, fmt.Errorf("error getting the vote of %s on proposal %d: %w", followAddress.Hex(), details.ID, err)
+		}
+	}
+	vote.Decision = policy.Evaluate(details.PayloadStr, followedVote)
+	if vote.Phase != Phase_Phase1 && vote.Phase != Phase_Phase2 {
+		vote.SkipReason = "the proposal isn't in a voting phase"
+		return vote, nil
+	}
+
+	// Check if the node has already voted
+	nodeVote, err := protocol.GetAddressVoteDirection(rp, details.ID, nodeAddress, opts)
+	if err != nil {
+		return vote, fmt.Errorf("error getting node vote on proposal %d: %w", details.ID, err)
+	}
+	if nodeVote != types.VoteDirection_NoVote {
+		vote.SkipReason = fmt.Sprintf("the node already voted %s", types.VoteDirections[nodeVote])
+		return vote, nil
+	}
+	if vote.Decision.Direction == types.VoteDirection_NoVote {
+		vote.SkipReason = vote.Decision.Reason
+		return vote, nil
+	}
+
+	// Phase 1 votes use the voting power delegated to the node
+	if vote.Phase == Phase_Phase1 {
+		votingPower, nodeIndex, proof, err := propMgr.GetArtifactsForVoting(details.TargetBlock, nodeAddress)
+		if err != nil {
+			return vote, fmt.Errorf("error getting voting artifacts for proposal %d: %w", details.ID, err)
+		}
+		vote.VotingPower = votingPower
+		if votingPower.Sign() == 0 {
+			vote.SkipReason = "no voting power is delegated to the node, so it can only vote in phase 2"
+			return vote, nil
+		}
+		vote.Action = VoteAction_Vote
+		vote.NodeIndex = nodeIndex
+		vote.Proof = proof
+		return vote, nil
+	}
+
+	// Phase 2 votes override the delegate, so there's nothing to do if the delegate already voted the same way
+	delegate, err := network.GetVotingDelegate(rp, nodeAddress, details.TargetBlock, opts)
+	if err != nil {
+		return vote, fmt.Errorf("error getting voting delegate for proposal %d: %w", details.ID, err)
+	}
+	if delegate == nodeAddress {
+		vote.SkipReason = "the node is its own delegate, so it could only vote in phase 1"
+		return vote, nil
+	}
+	delegateVote, err := protocol.GetAddressVoteDirection(rp, details.ID, delegate, opts)
+	if err != nil {
+		return vote, fmt.Errorf("error getting delegate vote on proposal %d: %w", details.ID, err)
+	}
+	if delegateVote == vote.Decision.Direction {
+		vote.SkipReason = fmt.Sprintf("the node's delegate already voted %s", types.VoteDirections[delegateVote])
+		return vote, nil
+	}
+	vote.VotingPower, err = network.GetVotingPower(rp, nodeAddress, details.TargetBlock, opts)
+	if err != nil {
+		return vote, fmt.Errorf("error getting voting power for proposal %d: %w", details.ID, err)
+	}
+	if vote.VotingPower.Sign() == 0 {
+		vote.SkipReason = "the node doesn't have any voting power"
+		return vote, nil
+	}
+	vote.Action = VoteAction_Override
+	return vote, nil
+}
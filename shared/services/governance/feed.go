@@ -0,0 +1,235 @@
+package governance
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// How many timeline events to keep in the feed
+	DefaultEventsToKeep int = 500
+)
+
+// The type of a timeline event
+type EventType string
+
+const (
+	// A proposal was seen for the first time while it was still open
+	EventType_Created EventType = "created"
+
+	// A proposal moved to a new phase
+	EventType_PhaseChanged EventType = "phase-changed"
+
+	// A proposal entered a voting phase the node hasn't voted in
+	EventType_VoteNeeded EventType = "vote-needed"
+
+	// A voting phase the node hasn't voted in is about to close
+	EventType_ClosingSoon EventType = "closing-soon"
+
+	// The node voted on a proposal
+	EventType_NodeVoted EventType = "node-voted"
+
+	// The node's delegate voted on a proposal
+	EventType_DelegateVoted EventType = "delegate-voted"
+)
+
+// A single entry in the governance timeline
+type Event struct {
+	Time       time.Time `json:"time"`
+	Type       EventType `json:"type"`
+	DAO        DAO       `json:"dao"`
+	ProposalID uint64    `json:"proposalId"`
+	Phase      Phase     `json:"phase"`
+	Details    string    `json:"details"`
+}
+
+// A proposal along with the feed's bookkeeping for it
+type TrackedProposal struct {
+	Proposal
+	FirstSeen time.Time `json:"firstSeen"`
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	// True if a reminder has been sent for the current phase
+	ReminderSent bool `json:"reminderSent"`
+}
+
+// The contents of the feed
+type FeedSnapshot struct {
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	// The highest Oracle DAO / security council proposal ID that has been checked; they share a single counter
+	LastDaoProposalID uint64 `json:"lastDaoProposalId"`
+
+	// The tracked proposals, by key
+	Proposals map[string]*TrackedProposal `json:"proposals"`
+
+	// The timeline, oldest first
+	Events []Event `json:"events"`
+}
+
+// Keeps track of the proposals of every DAO and a timeline of what happened to them.
+// The file is only written by the node daemon, but it's read by the API to show the timeline.
+type Feed struct {
+	path         string
+	eventsToKeep int
+	lock         sync.Mutex
+}
+
+// Create a new feed backed by the file at the provided path; the file is created on first use
+func NewFeed(path string) *Feed {
+	return &Feed{
+		path:         path,
+		eventsToKeep: DefaultEventsToKeep,
+	}
+}
+
+// Get the contents of the feed
+func (f *Feed) Get() (*FeedSnapshot, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.load()
+}
+
+// Get the tracked proposals sorted by DAO and ID
+func (s *FeedSnapshot) GetSortedProposals() []TrackedProposal {
+	proposals := make([]TrackedProposal, 0, len(s.Proposals))
+	for _, proposal := range s.Proposals {
+		proposals = append(proposals, *proposal)
+	}
+	sort.Slice(proposals, func(i int, j int) bool {
+		if proposals[i].DAO != proposals[j].DAO {
+			return proposals[i].DAO < proposals[j].DAO
+		}
+		return proposals[i].ID < proposals[j].ID
+	})
+	return proposals
+}
+
+// Update the feed with the latest view of some of the proposals and record the events that happened since the last update.
+// Proposals that aren't provided are left as they are. Reminders are raised for voting phases that close within the reminder window.
+// Returns the new events.
+func (f *Feed) Update(proposals []Proposal, lastDaoProposalID uint64, now time.Time, reminderWindow time.Duration) ([]Event, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	file, err := f.load()
+	if err != nil {
+		return nil, err
+	}
+
+	events := []Event{}
+	addEvent := func(proposal Proposal, eventType EventType, details string) {
+		events = append(events, Event{
+			Time:       now,
+			Type:       eventType,
+			DAO:        proposal.DAO,
+			ProposalID: proposal.ID,
+			Phase:      proposal.Phase,
+			Details:    details,
+		})
+	}
+
+	for _, proposal := range proposals {
+		tracked, exists := file.Proposals[proposal.GetKey()]
+		if !exists {
+			tracked = &TrackedProposal{
+				Proposal:  proposal,
+				FirstSeen: now,
+			}
+			file.Proposals[proposal.GetKey()] = tracked
+			if proposal.Phase != Phase_Closed {
+				addEvent(proposal, EventType_Created, fmt.Sprintf("New proposal by %s: %s", proposal.Proposer.Hex(), proposal.Message))
+				if proposal.IsVoting() && proposal.NeedsVote {
+					addEvent(proposal, EventType_VoteNeeded, fmt.Sprintf("Voting is open until %s and you haven't voted", proposal.PhaseEnd.UTC().Format(time.RFC1123)))
+				}
+			}
+		} else {
+			previous := tracked.Proposal
+			if previous.Phase != proposal.Phase {
+				tracked.ReminderSent = false
+				if proposal.Phase == Phase_Closed {
+					addEvent(proposal, EventType_PhaseChanged, fmt.Sprintf("Closed as %s", proposal.State))
+				} else {
+					addEvent(proposal, EventType_PhaseChanged, fmt.Sprintf("Entered the %s phase (%s)", proposal.Phase, proposal.State))
+				}
+				if proposal.IsVoting() && proposal.NeedsVote {
+					addEvent(proposal, EventType_VoteNeeded, fmt.Sprintf("Voting is open until %s and you haven't voted", proposal.PhaseEnd.UTC().Format(time.RFC1123)))
+				}
+			}
+			if previous.NodeVote == "" && proposal.NodeVote != "" {
+				addEvent(proposal, EventType_NodeVoted, fmt.Sprintf("You voted %s", proposal.NodeVote))
+			}
+			if previous.DelegateVote == "" && proposal.DelegateVote != "" && proposal.DelegateVote != proposal.NodeVote {
+				addEvent(proposal, EventType_DelegateVoted, fmt.Sprintf("Your delegate voted %s", proposal.DelegateVote))
+			}
+			tracked.Proposal = proposal
+		}
+		tracked.UpdatedAt = now
+
+		// Send a reminder once per phase when the end is close
+		remaining := proposal.PhaseEnd.Sub(now)
+		if proposal.IsVoting() && proposal.NeedsVote && !tracked.ReminderSent && remaining > 0 && remaining <= reminderWindow {
+			addEvent(proposal, EventType_ClosingSoon, fmt.Sprintf("Voting closes in %s and you haven't voted", remaining.Round(time.Minute)))
+			tracked.ReminderSent = true
+		}
+	}
+
+	if lastDaoProposalID > file.LastDaoProposalID {
+		file.LastDaoProposalID = lastDaoProposalID
+	}
+	file.Events = append(file.Events, events...)
+	if len(file.Events) > f.eventsToKeep {
+		file.Events = file.Events[len(file.Events)-f.eventsToKeep:]
+	}
+	return events, f.save(file)
+}
+
+// Load the feed from disk; a missing file is treated as an empty feed
+func (f *Feed) load() (*FeedSnapshot, error) {
+	file := &FeedSnapshot{}
+	bytes, err := os.ReadFile(f.path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("error reading governance feed [%s]: %w", f.path, err)
+	}
+	if err == nil {
+		err = json.Unmarshal(bytes, file)
+		if err != nil {
+			return nil, fmt.Errorf("error deserializing governance feed [%s]: %w", f.path, err)
+		}
+	}
+	if file.Proposals == nil {
+		file.Proposals = map[string]*TrackedProposal{}
+	}
+	if file.Events == nil {
+		file.Events = []Event{}
+	}
+	return file, nil
+}
+
+// Save the feed to disk, replacing the old file atomically
+func (f *Feed) save(file *FeedSnapshot) error {
+	file.UpdatedAt = time.Now()
+	bytes, err := json.Marshal(file)
+	if err != nil {
+		return fmt.Errorf("error serializing governance feed: %w", err)
+	}
+	err = os.MkdirAll(filepath.Dir(f.path), 0755)
+	if err != nil {
+		return fmt.Errorf("error creating folder for governance feed [%s]: %w", f.path, err)
+	}
+	tempPath := f.path + ".tmp"
+	err = os.WriteFile(tempPath, bytes, 0644)
+	if err != nil {
+		return fmt.Errorf("error writing governance feed [%s]: %w", tempPath, err)
+	}
+	err = os.Rename(tempPath, f.path)
+	if err != nil {
+		return fmt.Errorf("error replacing governance feed [%s]: %w", f.path, err)
+	}
+	return nil
+}
@@ -0,0 +1,187 @@
+package governance
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/rocket-pool/smartnode/bindings/dao/protocol"
+	"github.com/rocket-pool/smartnode/bindings/types"
+)
+
+// Get the types of a list of events
+func getEventTypes(events []Event) []EventType {
+	eventTypes := make([]EventType, len(events))
+	for i, event := range events {
+		eventTypes[i] = event.Type
+	}
+	return eventTypes
+}
+
+// Check that a list of events has the expected types, in order
+func checkEventTypes(t *testing.T, events []Event, expected ...EventType) {
+	t.Helper()
+	actual := getEventTypes(events)
+	if len(actual) != len(expected) {
+		t.Fatalf("expected events %v but got %v", expected, actual)
+	}
+	for i := range expected {
+		if actual[i] != expected[i] {
+			t.Fatalf("expected events %v but got %v", expected, actual)
+		}
+	}
+}
+
+func TestNewProtocolDaoProposal(t *testing.T) {
+	start := time.Unix(1000, 0)
+	details := protocol.ProtocolDaoProposalDetails{
+		ID:              1,
+		VotingStartTime: start,
+		Phase1EndTime:   start.Add(time.Hour),
+		Phase2EndTime:   start.Add(2 * time.Hour),
+	}
+
+	tests := []struct {
+		name          string
+		state         types.ProtocolDaoProposalState
+		nodeVote      types.VoteDirection
+		delegateVote  types.VoteDirection
+		isOwnDelegate bool
+		phase         Phase
+		phaseEnd      time.Time
+		needsVote     bool
+	}{
+		{"pending", types.ProtocolDaoProposalState_Pending, types.VoteDirection_NoVote, types.VoteDirection_NoVote, true, Phase_Pending, start, false},
+		{"phase 1 as own delegate", types.ProtocolDaoProposalState_ActivePhase1, types.VoteDirection_NoVote, types.VoteDirection_NoVote, true, Phase_Phase1, start.Add(time.Hour), true},
+		{"phase 1 with a delegate", types.ProtocolDaoProposalState_ActivePhase1, types.VoteDirection_NoVote, types.VoteDirection_NoVote, false, Phase_Phase1, start.Add(time.Hour), false},
+		{"phase 2 without votes", types.ProtocolDaoProposalState_ActivePhase2, types.VoteDirection_NoVote, types.VoteDirection_NoVote, false, Phase_Phase2, start.Add(2 * time.Hour), true},
+		{"phase 2 after the delegate voted", types.ProtocolDaoProposalState_ActivePhase2, types.VoteDirection_NoVote, types.VoteDirection_For, false, Phase_Phase2, start.Add(2 * time.Hour), false},
+		{"closed", types.ProtocolDaoProposalState_Executed, types.VoteDirection_For, types.VoteDirection_For, true, Phase_Closed, time.Time{}, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			details.State = test.state
+			proposal := NewProtocolDaoProposal(details, test.nodeVote, test.delegateVote, test.isOwnDelegate)
+			if proposal.Phase != test.phase {
+				t.Errorf("expected phase %s but got %s", test.phase, proposal.Phase)
+			}
+			if !proposal.PhaseEnd.Equal(test.phaseEnd) {
+				t.Errorf("expected the phase to end at %s but got %s", test.phaseEnd, proposal.PhaseEnd)
+			}
+			if proposal.NeedsVote != test.needsVote {
+				t.Errorf("expected needs vote to be %t", test.needsVote)
+			}
+		})
+	}
+}
+
+func TestFeedUpdate(t *testing.T) {
+	feed := NewFeed(filepath.Join(t.TempDir(), "governance", "feed.json"))
+	now := time.Unix(1000000, 0)
+	window := 24 * time.Hour
+	proposal := Proposal{
+		DAO:       DAO_Protocol,
+		ID:        3,
+		Message:   "test",
+		State:     "Active (Phase 1)",
+		Phase:     Phase_Phase1,
+		PhaseEnd:  now.Add(3 * 24 * time.Hour),
+		NeedsVote: true,
+	}
+
+	// Closed proposals seen for the first time are tracked quietly
+	closed := Proposal{DAO: DAO_OracleDAO, ID: 3, Phase: Phase_Closed}
+	events, err := feed.Update([]Proposal{closed}, 3, now, window)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkEventTypes(t, events)
+
+	// A new open proposal
+	events, err = feed.Update([]Proposal{proposal}, 3, now, window)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkEventTypes(t, events, EventType_Created, EventType_VoteNeeded)
+
+	// Nothing happens until the end is inside the reminder window, then the reminder is only sent once
+	events, err = feed.Update([]Proposal{proposal}, 3, now.Add(time.Hour), window)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkEventTypes(t, events)
+	for i := 0; i < 2; i++ {
+		events, err = feed.Update([]Proposal{proposal}, 3, now.Add(2*24*time.Hour+time.Hour), window)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if i == 0 {
+			checkEventTypes(t, events, EventType_ClosingSoon)
+		} else {
+			checkEventTypes(t, events)
+		}
+	}
+
+	// Phase 2 starts, so there's a new voting phase to remind about
+	proposal.Phase = Phase_Phase2
+	proposal.State = "Active (Phase 2)"
+	proposal.PhaseEnd = now.Add(5 * 24 * time.Hour)
+	events, err = feed.Update([]Proposal{proposal}, 3, now.Add(3*24*time.Hour), window)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkEventTypes(t, events, EventType_PhaseChanged, EventType_VoteNeeded)
+
+	// The node votes
+	proposal.NodeVote = "In Favor"
+	proposal.DelegateVote = "In Favor"
+	proposal.NeedsVote = false
+	events, err = feed.Update([]Proposal{proposal}, 3, now.Add(4*24*time.Hour+time.Hour), window)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkEventTypes(t, events, EventType_NodeVoted)
+
+	// Check the saved state from a new feed backed by the same file
+	snapshot, err := NewFeed(feed.path).Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(snapshot.Proposals) != 2 {
+		t.Fatalf("expected 2 tracked proposals but got %d", len(snapshot.Proposals))
+	}
+	if len(snapshot.Events) != 6 {
+		t.Fatalf("expected 6 events but got %d", len(snapshot.Events))
+	}
+	if snapshot.LastDaoProposalID != 3 {
+		t.Errorf("expected the last DAO proposal ID to be 3 but got %d", snapshot.LastDaoProposalID)
+	}
+	sorted := snapshot.GetSortedProposals()
+	if sorted[0].DAO != DAO_OracleDAO || sorted[1].Phase != Phase_Phase2 {
+		t.Errorf("unexpected proposals: %v", sorted)
+	}
+}
+
+func TestFeedEventLimit(t *testing.T) {
+	feed := NewFeed(filepath.Join(t.TempDir(), "feed.json"))
+	feed.eventsToKeep = 3
+	now := time.Unix(1000000, 0)
+
+	for i := uint64(1); i <= 4; i++ {
+		proposal := Proposal{DAO: DAO_SecurityCouncil, ID: i, Phase: Phase_Pending, PhaseEnd: now.Add(time.Hour)}
+		if _, err := feed.Update([]Proposal{proposal}, i, now, time.Hour); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	snapshot, err := feed.Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(snapshot.Events) != 3 {
+		t.Fatalf("expected 3 events to be kept but got %d", len(snapshot.Events))
+	}
+	if snapshot.Events[0].ProposalID != 2 {
+		t.Errorf("expected the oldest events to be dropped but the first one is for proposal %d", snapshot.Events[0].ProposalID)
+	}
+}
@@ -0,0 +1,162 @@
+package governance
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/smartnode/bindings/dao"
+	"github.com/rocket-pool/smartnode/bindings/dao/protocol"
+	"github.com/rocket-pool/smartnode/bindings/types"
+)
+
+// The DAO a proposal belongs to
+type DAO string
+
+const (
+	DAO_Protocol        DAO = "pdao"
+	DAO_OracleDAO       DAO = "odao"
+	DAO_SecurityCouncil DAO = "security"
+)
+
+// The contract names the oDAO and security council proposals are registered under
+const (
+	OracleDAOProposalsContract       string = "rocketDAONodeTrustedProposals"
+	SecurityCouncilProposalsContract string = "rocketDAOSecurityProposals"
+)
+
+// The voting phase a proposal is in
+type Phase string
+
+const (
+	Phase_Pending Phase = "pending"
+	Phase_Voting  Phase = "voting"
+	Phase_Phase1  Phase = "phase1"
+	Phase_Phase2  Phase = "phase2"
+	Phase_Closed  Phase = "closed"
+)
+
+// A proposal and the node's part in it, as of the latest update
+type Proposal struct {
+	DAO      DAO            `json:"dao"`
+	ID       uint64         `json:"id"`
+	Message  string         `json:"message"`
+	Proposer common.Address `json:"proposer"`
+	State    string         `json:"state"`
+	Phase    Phase          `json:"phase"`
+
+	// When the current phase ends; for pending proposals this is when voting starts. Zero once the proposal is closed.
+	PhaseEnd time.Time `json:"phaseEnd"`
+
+	// How the node and its delegate voted, or blank if they haven't. Only Protocol DAO proposals have a delegate.
+	NodeVote     string `json:"nodeVote,omitempty"`
+	DelegateVote string `json:"delegateVote,omitempty"`
+
+	// True if the proposal is in a voting phase the node can vote in, and the node's voting power hasn't been used yet
+	NeedsVote bool `json:"needsVote"`
+}
+
+// Get the DAO that a proposal contract name belongs to
+func GetDAOForContractName(contractName string) (DAO, bool) {
+	switch contractName {
+	case OracleDAOProposalsContract:
+		return DAO_OracleDAO, true
+	case SecurityCouncilProposalsContract:
+		return DAO_SecurityCouncil, true
+	}
+	return "", false
+}
+
+// Get the human-readable name of a DAO
+func (d DAO) GetName() string {
+	switch d {
+	case DAO_Protocol:
+		return "Protocol DAO"
+	case DAO_OracleDAO:
+		return "Oracle DAO"
+	case DAO_SecurityCouncil:
+		return "Security Council"
+	}
+	return string(d)
+}
+
+// Get the name of the CLI command used to vote on the DAO's proposals
+func (d DAO) GetCommand() string {
+	if d == DAO_OracleDAO {
+		return "odao"
+	}
+	return string(d)
+}
+
+// Get the unique key of a proposal across all of the DAOs
+func (p Proposal) GetKey() string {
+	return fmt.Sprintf("%s-%d", p.DAO, p.ID)
+}
+
+// Check if the proposal is in one of its voting phases
+func (p Proposal) IsVoting() bool {
+	return p.Phase == Phase_Voting || p.Phase == Phase_Phase1 || p.Phase == Phase_Phase2
+}
+
+// Create a proposal from a Protocol DAO proposal, the node's vote and the vote of the node's delegate at the proposal's target block.
+// In phase 1 only delegates vote, so the node only needs to vote if it's its own delegate; in phase 2 it needs to vote if neither it nor its delegate has.
+func NewProtocolDaoProposal(details protocol.ProtocolDaoProposalDetails, nodeVote types.VoteDirection, delegateVote types.VoteDirection, isOwnDelegate bool) Proposal {
+	proposal := Proposal{
+		DAO:      DAO_Protocol,
+		ID:       details.ID,
+		Message:  details.Message,
+		Proposer: details.ProposerAddress,
+		State:    types.ProtocolDaoProposalStates[details.State],
+		Phase:    Phase_Closed,
+	}
+	if nodeVote != types.VoteDirection_NoVote {
+		proposal.NodeVote = types.VoteDirections[nodeVote]
+	}
+	if delegateVote != types.VoteDirection_NoVote {
+		proposal.DelegateVote = types.VoteDirections[delegateVote]
+	}
+
+	switch details.State {
+	case types.ProtocolDaoProposalState_Pending:
+		proposal.Phase = Phase_Pending
+		proposal.PhaseEnd = details.VotingStartTime
+	case types.ProtocolDaoProposalState_ActivePhase1:
+		proposal.Phase = Phase_Phase1
+		proposal.PhaseEnd = details.Phase1EndTime
+		proposal.NeedsVote = isOwnDelegate && nodeVote == types.VoteDirection_NoVote
+	case types.ProtocolDaoProposalState_ActivePhase2:
+		proposal.Phase = Phase_Phase2
+		proposal.PhaseEnd = details.Phase2EndTime
+		proposal.NeedsVote = nodeVote == types.VoteDirection_NoVote && delegateVote == types.VoteDirection_NoVote
+	}
+	return proposal
+}
+
+// Create a proposal from an Oracle DAO or security council proposal loaded with the node's member data
+func NewDaoProposal(daoType DAO, details dao.ProposalDetails, isMember bool) Proposal {
+	proposal := Proposal{
+		DAO:      daoType,
+		ID:       details.ID,
+		Message:  details.Message,
+		Proposer: details.ProposerAddress,
+		State:    types.ProposalStates[details.State],
+		Phase:    Phase_Closed,
+	}
+	if details.MemberVoted {
+		proposal.NodeVote = "Against"
+		if details.MemberSupported {
+			proposal.NodeVote = "In Favor"
+		}
+	}
+
+	switch details.State {
+	case types.Pending:
+		proposal.Phase = Phase_Pending
+		proposal.PhaseEnd = time.Unix(int64(details.StartTime), 0)
+	case types.Active:
+		proposal.Phase = Phase_Voting
+		proposal.PhaseEnd = time.Unix(int64(details.EndTime), 0)
+		proposal.NeedsVote = isMember && !details.MemberVoted
+	}
+	return proposal
+}
@@ -0,0 +1,239 @@
+package governance
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/smartnode/bindings/types"
+	"gopkg.in/yaml.v2"
+)
+
+// The name of the subject of a proposal that couldn't be decoded
+const UnknownProposalSubject string = "unknown"
+
+// What the policy says to do with a proposal
+type PolicyVote string
+
+const (
+	// Don't vote automatically; the node operator votes manually
+	PolicyVote_Skip PolicyVote = "skip"
+
+	// Vote the same way as the followed address
+	PolicyVote_Follow PolicyVote = "follow"
+
+	PolicyVote_Abstain PolicyVote = "abstain"
+	PolicyVote_For     PolicyVote = "for"
+	PolicyVote_Against PolicyVote = "against"
+	PolicyVote_Veto    PolicyVote = "veto"
+)
+
+// A rule that votes on the proposals whose subjects all match a pattern
+type VotingRule struct {
+	// A pattern for the setting paths (e.g. `network.submit.*`) or payload methods (e.g. `proposalTreasuryOneTimeSpend`) of a proposal, using the syntax of path.Match
+	Match string `yaml:"match"`
+
+	Vote PolicyVote `yaml:"vote"`
+}
+
+// An opt-in policy that the node daemon uses to vote on Protocol DAO proposals automatically
+type VotingPolicy struct {
+	Enabled bool `yaml:"enabled"`
+
+	// The address whose on-chain vote is copied by the `follow` vote
+	Follow string `yaml:"follow,omitempty"`
+
+	// The vote for proposals that no rule matches
+	Default PolicyVote `yaml:"default"`
+
+	// The rules, checked in order; the first one that matches is used
+	Rules []VotingRule `yaml:"rules"`
+
+	followAddress common.Address
+}
+
+// The outcome of evaluating the policy for a proposal
+type VotingDecision struct {
+	// The setting paths or payload method of the proposal
+	Subjects []string `json:"subjects"`
+
+	// The pattern of the rule that matched, or blank if the default was used
+	Rule string `json:"rule"`
+
+	Vote PolicyVote `json:"vote"`
+
+	// The direction to vote in, or no vote if the policy doesn't vote on the proposal (yet)
+	Direction types.VoteDirection `json:"direction"`
+
+	// Why the policy voted the way it did
+	Reason string `json:"reason"`
+}
+
+// Load the voting policy from the provided path, returning nil if it doesn't exist
+func LoadVotingPolicy(path string) (*VotingPolicy, error) {
+	bytes, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading voting policy [%s]: %w", path, err)
+	}
+	policy, err := ParseVotingPolicy(bytes)
+	if err != nil {
+		return nil, fmt.Errorf("error loading voting policy [%s]: %w", path, err)
+	}
+	return policy, nil
+}
+
+// Parse and validate a voting policy
+func ParseVotingPolicy(bytes []byte) (*VotingPolicy, error) {
+	policy := &VotingPolicy{}
+	err := yaml.UnmarshalStrict(bytes, policy)
+	if err != nil {
+		return nil, fmt.Errorf("error deserializing voting policy: %w", err)
+	}
+	if policy.Default == "" {
+		policy.Default = PolicyVote_Skip
+	}
+	err = policy.validate()
+	if err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// Get the address the policy follows, if it has one
+func (p *VotingPolicy) GetFollowAddress() (common.Address, bool) {
+	return p.followAddress, p.Follow != ""
+}
+
+// Evaluate the policy for a proposal, given its payload string and the vote of the followed address (if there is one)
+func (p *VotingPolicy) Evaluate(payloadStr string, followedVote types.VoteDirection) VotingDecision {
+	decision := VotingDecision{
+		Subjects: GetProposalSubjects(payloadStr),
+		Vote:     p.Default,
+	}
+	for _, rule := range p.Rules {
+		if rule.matches(decision.Subjects) {
+			decision.Rule = rule.Match
+			decision.Vote = rule.Vote
+			break
+		}
+	}
+
+	source := "the default"
+	if decision.Rule != "" {
+		source = fmt.Sprintf("rule `%s`", decision.Rule)
+	}
+	switch decision.Vote {
+	case PolicyVote_Skip:
+		decision.Reason = fmt.Sprintf("%s skips it", source)
+	case PolicyVote_Follow:
+		if followedVote == types.VoteDirection_NoVote {
+			decision.Reason = fmt.Sprintf("%s follows %s, who hasn't voted yet", source, p.followAddress.Hex())
+		} else {
+			decision.Direction = followedVote
+			decision.Reason = fmt.Sprintf("%s follows %s, who voted %s", source, p.followAddress.Hex(), types.VoteDirections[followedVote])
+		}
+	default:
+		decision.Direction = decision.Vote.getDirection()
+		decision.Reason = fmt.Sprintf("%s votes %s", source, decision.Vote)
+	}
+	return decision
+}
+
+// Get the subjects of a proposal from its payload string: the setting paths for setting proposals, or the payload method for everything else
+func GetProposalSubjects(payloadStr string) []string {
+	method, argStr, found := strings.Cut(payloadStr, "(")
+	if !found || !strings.HasSuffix(argStr, ")") || method == "" {
+		return []string{UnknownProposalSubject}
+	}
+	args := strings.Split(strings.TrimSuffix(argStr, ")"), ",")
+
+	switch method {
+	case "proposalSettingBool", "proposalSettingUint", "proposalSettingAddress", "proposalSettingAddressList":
+		if len(args) > 1 {
+			return []string{args[1]}
+		}
+	case "proposalSettingMulti":
+		// Arrays are formatted as space-separated lists in brackets
+		if len(args) > 1 {
+			paths := strings.Fields(strings.Trim(args[1], "[]"))
+			if len(paths) > 0 {
+				return paths
+			}
+		}
+	default:
+		return []string{method}
+	}
+	return []string{UnknownProposalSubject}
+}
+
+// Check the policy for mistakes
+func (p *VotingPolicy) validate() error {
+	if p.Follow != "" {
+		if !common.IsHexAddress(p.Follow) {
+			return fmt.Errorf("invalid follow address [%s]", p.Follow)
+		}
+		p.followAddress = common.HexToAddress(p.Follow)
+	}
+	err := p.validateVote(p.Default, "the default")
+	if err != nil {
+		return err
+	}
+	for i, rule := range p.Rules {
+		if rule.Match == "" {
+			return fmt.Errorf("rule %d doesn't have a pattern to match", i+1)
+		}
+		if _, err := path.Match(rule.Match, ""); err != nil {
+			return fmt.Errorf("rule %d has an invalid pattern [%s]: %w", i+1, rule.Match, err)
+		}
+		err = p.validateVote(rule.Vote, fmt.Sprintf("rule %d", i+1))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Check that a vote is valid
+func (p *VotingPolicy) validateVote(vote PolicyVote, source string) error {
+	switch vote {
+	case PolicyVote_Skip, PolicyVote_Abstain, PolicyVote_For, PolicyVote_Against, PolicyVote_Veto:
+		return nil
+	case PolicyVote_Follow:
+		if p.Follow == "" {
+			return fmt.Errorf("%s is set to follow, but there's no address to follow", source)
+		}
+		return nil
+	}
+	return fmt.Errorf("%s has an invalid vote [%s]; it must be one of skip, follow, abstain, for, against or veto", source, vote)
+}
+
+// Check if a rule matches all of a proposal's subjects
+func (r VotingRule) matches(subjects []string) bool {
+	for _, subject := range subjects {
+		matched, err := path.Match(r.Match, subject)
+		if err != nil || !matched {
+			return false
+		}
+	}
+	return len(subjects) > 0
+}
+
+// Get the on-chain vote direction of a vote
+func (v PolicyVote) getDirection() types.VoteDirection {
+	switch v {
+	case PolicyVote_Abstain:
+		return types.VoteDirection_Abstain
+	case PolicyVote_For:
+		return types.VoteDirection_For
+	case PolicyVote_Against:
+		return types.VoteDirection_Against
+	case PolicyVote_Veto:
+		return types.VoteDirection_AgainstWithVeto
+	}
+	return types.VoteDirection_NoVote
+}
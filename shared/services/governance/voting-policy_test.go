@@ -0,0 +1,123 @@
+package governance
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/rocket-pool/smartnode/bindings/types"
+)
+
+const testPolicy string = `
+enabled: true
+follow: "0x1111111111111111111111111111111111111111"
+default: skip
+rules:
+  - match: "network.submit.*"
+    vote: for
+  - match: "proposalTreasury*"
+    vote: against
+  - match: "*"
+    vote: follow
+`
+
+func TestGetProposalSubjects(t *testing.T) {
+	tests := []struct {
+		payload  string
+		subjects []string
+	}{
+		{"proposalSettingUint(rocketDAOProtocolSettingsNetwork,network.submit.balances.frequency,86400)", []string{"network.submit.balances.frequency"}},
+		{"proposalSettingAddressList(rocketDAOProtocolSettingsNetwork,network.allow.listed.controllers,[0x01 0x02])", []string{"network.allow.listed.controllers"}},
+		{"proposalSettingMulti([rocketDAOProtocolSettingsNetwork rocketDAOProtocolSettingsNode],[network.submit.prices.frequency node.registration.enabled],[0 1],[[1] [0]])", []string{"network.submit.prices.frequency", "node.registration.enabled"}},
+		{"proposalTreasuryOneTimeSpend(invoice,0x0000000000000000000000000000000000000001,100)", []string{"proposalTreasuryOneTimeSpend"}},
+		{"<error decoding: bad payload>", []string{UnknownProposalSubject}},
+	}
+	for _, test := range tests {
+		subjects := GetProposalSubjects(test.payload)
+		if !reflect.DeepEqual(subjects, test.subjects) {
+			t.Errorf("expected subjects %v for %s but got %v", test.subjects, test.payload, subjects)
+		}
+	}
+}
+
+func TestVotingPolicyEvaluate(t *testing.T) {
+	policy, err := ParseVotingPolicy([]byte(testPolicy))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name         string
+		payload      string
+		followedVote types.VoteDirection
+		rule         string
+		direction    types.VoteDirection
+	}{
+		{"setting rule", "proposalSettingUint(rocketDAOProtocolSettingsNetwork,network.submit.balances.frequency,86400)", types.VoteDirection_Against, "network.submit.*", types.VoteDirection_For},
+		{"method rule", "proposalTreasuryOneTimeSpend(invoice,0x0000000000000000000000000000000000000001,100)", types.VoteDirection_For, "proposalTreasury*", types.VoteDirection_Against},
+		{"multi with a partial match", "proposalSettingMulti([a b],[network.submit.prices.frequency node.registration.enabled],[0 1],[[1] [0]])", types.VoteDirection_AgainstWithVeto, "*", types.VoteDirection_AgainstWithVeto},
+		{"follow before the followed address votes", "proposalSecurityKick(0x0000000000000000000000000000000000000001)", types.VoteDirection_NoVote, "*", types.VoteDirection_NoVote},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			decision := policy.Evaluate(test.payload, test.followedVote)
+			if decision.Rule != test.rule {
+				t.Errorf("expected rule %s but got %s", test.rule, decision.Rule)
+			}
+			if decision.Direction != test.direction {
+				t.Errorf("expected direction %s but got %s (%s)", types.VoteDirections[test.direction], types.VoteDirections[decision.Direction], decision.Reason)
+			}
+		})
+	}
+
+	// The default applies when nothing matches
+	policy.Rules = policy.Rules[:2]
+	decision := policy.Evaluate("proposalSecurityKick(0x0000000000000000000000000000000000000001)", types.VoteDirection_For)
+	if decision.Rule != "" || decision.Vote != PolicyVote_Skip || decision.Direction != types.VoteDirection_NoVote {
+		t.Errorf("expected the default to skip the proposal but got %+v", decision)
+	}
+}
+
+func TestParseVotingPolicyErrors(t *testing.T) {
+	tests := map[string]string{
+		"unknown vote":             "default: maybe",
+		"follow without address":   "rules:\n  - match: \"*\"\n    vote: follow",
+		"invalid follow address":   "follow: nope",
+		"invalid pattern":          "rules:\n  - match: \"[\"\n    vote: for",
+		"missing pattern":          "rules:\n  - vote: for",
+		"unknown field":            "enable: true",
+		"invalid rule vote":        "rules:\n  - match: \"*\"\n    vote: yes",
+		"follow default no follow": "default: follow",
+	}
+	for name, policy := range tests {
+		if _, err := ParseVotingPolicy([]byte(policy)); err == nil {
+			t.Errorf("expected an error for the %s policy", name)
+		}
+	}
+}
+
+func TestLoadVotingPolicy(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "voting-policy.yml")
+
+	// A missing policy is not an error
+	policy, err := LoadVotingPolicy(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if policy != nil {
+		t.Fatal("expected no policy")
+	}
+
+	err = os.WriteFile(path, []byte(testPolicy), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	policy, err = LoadVotingPolicy(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !policy.Enabled || len(policy.Rules) != 3 {
+		t.Errorf("unexpected policy: %+v", policy)
+	}
+}
@@ -0,0 +1,276 @@
+package keymanager
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/smartnode/bindings/types"
+	hexutil "github.com/rocket-pool/smartnode/shared/utils/hex"
+)
+
+// Config
+const (
+	RequestKeystoresPath    = "/eth/v1/keystores"
+	RequestRemoteKeysPath   = "/eth/v1/remotekeys"
+	RequestFeeRecipientPath = "/eth/v1/validator/%s/feerecipient"
+	RequestGraffitiPath     = "/eth/v1/validator/%s/graffiti"
+
+	ImportStatus_Imported  string = "imported"
+	ImportStatus_Duplicate string = "duplicate"
+	ImportStatus_Error     string = "error"
+
+	DeleteStatus_Deleted   string = "deleted"
+	DeleteStatus_NotActive string = "not_active"
+	DeleteStatus_NotFound  string = "not_found"
+	DeleteStatus_Error     string = "error"
+
+	requestTimeout = 30 * time.Second
+)
+
+// A client for a Validator Client's standard Keymanager API
+type Client struct {
+	url        string
+	tokenPath  string
+	httpClient *http.Client
+}
+
+// The result of importing or deleting a single keystore
+type Status struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+type importKeystoresRequest struct {
+	Keystores          []string `json:"keystores"`
+	Passwords          []string `json:"passwords"`
+	SlashingProtection string   `json:"slashing_protection,omitempty"`
+}
+type importKeystoresResponse struct {
+	Data []Status `json:"data"`
+}
+type remoteKey struct {
+	Pubkey string `json:"pubkey"`
+	Url    string `json:"url"`
+}
+type listRemoteKeysResponse struct {
+	Data []remoteKey `json:"data"`
+}
+type importRemoteKeysRequest struct {
+	RemoteKeys []remoteKey `json:"remote_keys"`
+}
+type deleteKeystoresRequest struct {
+	Pubkeys []string `json:"pubkeys"`
+}
+type deleteKeystoresResponse struct {
+	Data               []Status `json:"data"`
+	SlashingProtection string   `json:"slashing_protection"`
+}
+type listKeystoresResponse struct {
+	Data []struct {
+		ValidatingPubkey string `json:"validating_pubkey"`
+		Readonly         bool   `json:"readonly"`
+	} `json:"data"`
+}
+type setFeeRecipientRequest struct {
+	EthAddress string `json:"ethaddress"`
+}
+type setGraffitiRequest struct {
+	Graffiti string `json:"graffiti"`
+}
+
+// Create a new Keymanager API client.
+// The bearer token is read from the token file on every request, since the Validator Client may regenerate it when it restarts.
+func NewClient(url string, tokenPath string) *Client {
+	return &Client{
+		url:       strings.TrimSuffix(url, "/"),
+		tokenPath: tokenPath,
+		httpClient: &http.Client{
+			Timeout: requestTimeout,
+		},
+	}
+}
+
+// Get the Keymanager API's URL
+func (c *Client) GetUrl() string {
+	return c.url
+}
+
+// Get the pubkeys of the keys the Validator Client has loaded
+func (c *Client) ListKeystores() ([]types.ValidatorPubkey, error) {
+	var response listKeystoresResponse
+	err := c.request(http.MethodGet, RequestKeystoresPath, nil, &response)
+	if err != nil {
+		return nil, fmt.Errorf("error listing keystores: %w", err)
+	}
+
+	pubkeys := make([]types.ValidatorPubkey, 0, len(response.Data))
+	for _, keystore := range response.Data {
+		pubkey, err := types.HexToValidatorPubkey(hexutil.RemovePrefix(keystore.ValidatingPubkey))
+		if err != nil {
+			return nil, fmt.Errorf("validator client returned an invalid pubkey [%s]: %w", keystore.ValidatingPubkey, err)
+		}
+		pubkeys = append(pubkeys, pubkey)
+	}
+	return pubkeys, nil
+}
+
+// Import EIP-2335 keystores into the Validator Client, returning the status of each one.
+// The slashing protection data is an optional EIP-3076 interchange document.
+func (c *Client) ImportKeystores(keystores []string, passwords []string, slashingProtection string) ([]Status, error) {
+	if len(keystores) != len(passwords) {
+		return nil, fmt.Errorf("got %d keystores but %d passwords", len(keystores), len(passwords))
+	}
+
+	var response importKeystoresResponse
+	err := c.request(http.MethodPost, RequestKeystoresPath, importKeystoresRequest{
+		Keystores:          keystores,
+		Passwords:          passwords,
+		SlashingProtection: slashingProtection,
+	}, &response)
+	if err != nil {
+		return nil, fmt.Errorf("error importing keystores: %w", err)
+	}
+	if len(response.Data) != len(keystores) {
+		return nil, fmt.Errorf("imported %d keystores but the validator client returned %d statuses", len(keystores), len(response.Data))
+	}
+	return response.Data, nil
+}
+
+// Get the pubkeys of the remote signer keys the Validator Client has loaded
+func (c *Client) ListRemoteKeys() ([]types.ValidatorPubkey, error) {
+	var response listRemoteKeysResponse
+	err := c.request(http.MethodGet, RequestRemoteKeysPath, nil, &response)
+	if err != nil {
+		return nil, fmt.Errorf("error listing remote keys: %w", err)
+	}
+
+	pubkeys := make([]types.ValidatorPubkey, 0, len(response.Data))
+	for _, key := range response.Data {
+		pubkey, err := types.HexToValidatorPubkey(hexutil.RemovePrefix(key.Pubkey))
+		if err != nil {
+			return nil, fmt.Errorf("validator client returned an invalid pubkey [%s]: %w", key.Pubkey, err)
+		}
+		pubkeys = append(pubkeys, pubkey)
+	}
+	return pubkeys, nil
+}
+
+// Register keys held by a remote signer with the Validator Client, returning the status of each one
+func (c *Client) ImportRemoteKeys(pubkeys []types.ValidatorPubkey, signerUrl string) ([]Status, error) {
+	request := importRemoteKeysRequest{
+		RemoteKeys: make([]remoteKey, len(pubkeys)),
+	}
+	for i, pubkey := range pubkeys {
+		request.RemoteKeys[i] = remoteKey{
+			Pubkey: hexutil.AddPrefix(pubkey.Hex()),
+			Url:    signerUrl,
+		}
+	}
+
+	var response importKeystoresResponse
+	err := c.request(http.MethodPost, RequestRemoteKeysPath, request, &response)
+	if err != nil {
+		return nil, fmt.Errorf("error importing remote keys: %w", err)
+	}
+	if len(response.Data) != len(pubkeys) {
+		return nil, fmt.Errorf("imported %d remote keys but the validator client returned %d statuses", len(pubkeys), len(response.Data))
+	}
+	return response.Data, nil
+}
+
+// Delete keys from the Validator Client, returning the status of each one and the EIP-3076 slashing protection data for them
+func (c *Client) DeleteKeystores(pubkeys []types.ValidatorPubkey) ([]Status, string, error) {
+	request := deleteKeystoresRequest{
+		Pubkeys: make([]string, len(pubkeys)),
+	}
+	for i, pubkey := range pubkeys {
+		request.Pubkeys[i] = hexutil.AddPrefix(pubkey.Hex())
+	}
+
+	var response deleteKeystoresResponse
+	err := c.request(http.MethodDelete, RequestKeystoresPath, request, &response)
+	if err != nil {
+		return nil, "", fmt.Errorf("error deleting keystores: %w", err)
+	}
+	if len(response.Data) != len(pubkeys) {
+		return nil, "", fmt.Errorf("deleted %d keystores but the validator client returned %d statuses", len(pubkeys), len(response.Data))
+	}
+	return response.Data, response.SlashingProtection, nil
+}
+
+// Set the fee recipient for one of the Validator Client's keys
+func (c *Client) SetFeeRecipient(pubkey types.ValidatorPubkey, feeRecipient common.Address) error {
+	err := c.request(http.MethodPost, fmt.Sprintf(RequestFeeRecipientPath, hexutil.AddPrefix(pubkey.Hex())), setFeeRecipientRequest{
+		EthAddress: feeRecipient.Hex(),
+	}, nil)
+	if err != nil {
+		return fmt.Errorf("error setting the fee recipient for validator %s: %w", pubkey.Hex(), err)
+	}
+	return nil
+}
+
+// Set the graffiti for one of the Validator Client's keys
+func (c *Client) SetGraffiti(pubkey types.ValidatorPubkey, graffiti string) error {
+	err := c.request(http.MethodPost, fmt.Sprintf(RequestGraffitiPath, hexutil.AddPrefix(pubkey.Hex())), setGraffitiRequest{
+		Graffiti: graffiti,
+	}, nil)
+	if err != nil {
+		return fmt.Errorf("error setting the graffiti for validator %s: %w", pubkey.Hex(), err)
+	}
+	return nil
+}
+
+// Send a request to the Keymanager API and decode its JSON response, if one is expected
+func (c *Client) request(method string, path string, body interface{}, response interface{}) error {
+	token, err := os.ReadFile(c.tokenPath)
+	if err != nil {
+		return fmt.Errorf("error reading the keymanager API token: %w", err)
+	}
+
+	var bodyReader io.Reader
+	if body != nil {
+		bodyBytes, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("error encoding request: %w", err)
+		}
+		bodyReader = bytes.NewReader(bodyBytes)
+	}
+
+	request, err := http.NewRequest(method, c.url+path, bodyReader)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Accept", "application/json")
+	if body != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+	request.Header.Set("Authorization", "Bearer "+strings.TrimSpace(string(token)))
+
+	httpResponse, err := c.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer httpResponse.Body.Close()
+	responseBytes, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return fmt.Errorf("error reading response: %w", err)
+	}
+	if httpResponse.StatusCode < http.StatusOK || httpResponse.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("HTTP status %d; response body: '%s'", httpResponse.StatusCode, string(responseBytes))
+	}
+
+	if response == nil {
+		return nil
+	}
+	if err := json.Unmarshal(responseBytes, response); err != nil {
+		return fmt.Errorf("error decoding response: %w", err)
+	}
+	return nil
+}
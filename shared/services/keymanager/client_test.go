@@ -0,0 +1,238 @@
+package keymanager
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/smartnode/bindings/types"
+	eth2types "github.com/wealdtech/go-eth2-types/v2"
+	eth2ks "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+
+	hexutil "github.com/rocket-pool/smartnode/shared/utils/hex"
+)
+
+const testToken = "api-token-0x1234"
+
+// A mock Validator Client that keeps its keys and their settings in memory
+type mockValidatorClient struct {
+	server        *httptest.Server
+	lock          sync.Mutex
+	keys          map[string]bool
+	feeRecipients map[string]string
+	graffiti      map[string]string
+}
+
+func newMockValidatorClient(t *testing.T) *mockValidatorClient {
+	if err := eth2types.InitBLS(); err != nil {
+		t.Fatal(err)
+	}
+
+	vc := &mockValidatorClient{
+		keys:          map[string]bool{},
+		feeRecipients: map[string]string{},
+		graffiti:      map[string]string{},
+	}
+	vc.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+testToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		vc.lock.Lock()
+		defer vc.lock.Unlock()
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == RequestKeystoresPath:
+			var request importKeystoresRequest
+			if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			response := importKeystoresResponse{}
+			for i, keystoreJson := range request.Keystores {
+				response.Data = append(response.Data, vc.importKeystore(keystoreJson, request.Passwords[i]))
+			}
+			json.NewEncoder(w).Encode(response)
+
+		case r.Method == http.MethodGet && r.URL.Path == RequestKeystoresPath:
+			var response listKeystoresResponse
+			for pubkey := range vc.keys {
+				response.Data = append(response.Data, struct {
+					ValidatingPubkey string `json:"validating_pubkey"`
+					Readonly         bool   `json:"readonly"`
+				}{ValidatingPubkey: pubkey})
+			}
+			json.NewEncoder(w).Encode(response)
+
+		case r.Method == http.MethodDelete && r.URL.Path == RequestKeystoresPath:
+			var request deleteKeystoresRequest
+			if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			response := deleteKeystoresResponse{SlashingProtection: "{}"}
+			for _, pubkey := range request.Pubkeys {
+				status := Status{Status: DeleteStatus_NotFound}
+				if vc.keys[pubkey] {
+					delete(vc.keys, pubkey)
+					status.Status = DeleteStatus_Deleted
+				}
+				response.Data = append(response.Data, status)
+			}
+			json.NewEncoder(w).Encode(response)
+
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/feerecipient"):
+			var request setFeeRecipientRequest
+			json.NewDecoder(r.Body).Decode(&request)
+			vc.feeRecipients[strings.Split(r.URL.Path, "/")[4]] = request.EthAddress
+			w.WriteHeader(http.StatusAccepted)
+
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/graffiti"):
+			var request setGraffitiRequest
+			json.NewDecoder(r.Body).Decode(&request)
+			vc.graffiti[strings.Split(r.URL.Path, "/")[4]] = request.Graffiti
+			w.WriteHeader(http.StatusAccepted)
+
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(vc.server.Close)
+	return vc
+}
+
+func (vc *mockValidatorClient) importKeystore(keystoreJson string, password string) Status {
+	var keystore ValidatorKeystore
+	if err := json.Unmarshal([]byte(keystoreJson), &keystore); err != nil {
+		return Status{Status: ImportStatus_Error, Message: err.Error()}
+	}
+	keyBytes, err := eth2ks.New().Decrypt(keystore.Crypto, password)
+	if err != nil {
+		return Status{Status: ImportStatus_Error, Message: err.Error()}
+	}
+	key, err := eth2types.BLSPrivateKeyFromBytes(keyBytes)
+	if err != nil {
+		return Status{Status: ImportStatus_Error, Message: err.Error()}
+	}
+	pubkey := hexutil.AddPrefix(hexutil.EncodeToString(key.PublicKey().Marshal()))
+	if vc.keys[pubkey] {
+		return Status{Status: ImportStatus_Duplicate}
+	}
+	vc.keys[pubkey] = true
+	return Status{Status: ImportStatus_Imported}
+}
+
+func newTestClient(t *testing.T, vc *mockValidatorClient, token string) *Client {
+	tokenPath := filepath.Join(t.TempDir(), "keymanager-api-token")
+	if err := os.WriteFile(tokenPath, []byte(token+"\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return NewClient(vc.server.URL, tokenPath)
+}
+
+func TestImportAndDeleteKeystores(t *testing.T) {
+	vc := newMockValidatorClient(t)
+	client := newTestClient(t, vc, testToken)
+
+	key, err := eth2types.GenerateBLSPrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubkey := types.BytesToValidatorPubkey(key.PublicKey().Marshal())
+	keystore, password, err := EncryptValidatorKey(key, "m/12381/3600/0/0/0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Import the key twice; the second one is a duplicate
+	for _, expected := range []string{ImportStatus_Imported, ImportStatus_Duplicate} {
+		statuses, err := client.ImportKeystores([]string{keystore}, []string{password}, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if statuses[0].Status != expected {
+			t.Fatalf("expected import status %s, got %s (%s)", expected, statuses[0].Status, statuses[0].Message)
+		}
+	}
+
+	// A bad password is reported in the key's status
+	statuses, err := client.ImportKeystores([]string{keystore}, []string{"wrong"}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if statuses[0].Status != ImportStatus_Error {
+		t.Fatalf("expected an error status for a bad password, got %s", statuses[0].Status)
+	}
+
+	// The key is listed
+	pubkeys, err := client.ListKeystores()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pubkeys) != 1 || pubkeys[0] != pubkey {
+		t.Fatalf("expected the validator client to hold %s, got %v", pubkey.Hex(), pubkeys)
+	}
+
+	// Delete it
+	statuses, slashingProtection, err := client.DeleteKeystores([]types.ValidatorPubkey{pubkey})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if statuses[0].Status != DeleteStatus_Deleted || slashingProtection == "" {
+		t.Fatalf("expected the key to be deleted with slashing protection data, got %+v and '%s'", statuses[0], slashingProtection)
+	}
+	pubkeys, err = client.ListKeystores()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pubkeys) != 0 {
+		t.Fatalf("expected no keys after deleting, got %v", pubkeys)
+	}
+}
+
+func TestSetFeeRecipientAndGraffiti(t *testing.T) {
+	vc := newMockValidatorClient(t)
+	client := newTestClient(t, vc, testToken)
+
+	key, err := eth2types.GenerateBLSPrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubkey := types.BytesToValidatorPubkey(key.PublicKey().Marshal())
+	feeRecipient := common.HexToAddress("0xd4E96eF8eee8678dBFf4d535E033Ed1a4F7605b7")
+
+	if err := client.SetFeeRecipient(pubkey, feeRecipient); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.SetGraffiti(pubkey, "RP-GX v1.0.0"); err != nil {
+		t.Fatal(err)
+	}
+
+	vcPubkey := hexutil.AddPrefix(pubkey.Hex())
+	if vc.feeRecipients[vcPubkey] != feeRecipient.Hex() {
+		t.Fatalf("expected fee recipient %s, got %s", feeRecipient.Hex(), vc.feeRecipients[vcPubkey])
+	}
+	if vc.graffiti[vcPubkey] != "RP-GX v1.0.0" {
+		t.Fatalf("expected graffiti 'RP-GX v1.0.0', got '%s'", vc.graffiti[vcPubkey])
+	}
+}
+
+func TestClientAuth(t *testing.T) {
+	vc := newMockValidatorClient(t)
+
+	// A bad token is rejected
+	if _, err := newTestClient(t, vc, "wrong").ListKeystores(); err == nil {
+		t.Fatal("expected an error for a bad token")
+	}
+
+	// So is a missing token file
+	if _, err := NewClient(vc.server.URL, filepath.Join(t.TempDir(), "missing")).ListKeystores(); err == nil {
+		t.Fatal("expected an error for a missing token file")
+	}
+}
@@ -0,0 +1,57 @@
+package keymanager
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/rocket-pool/smartnode/bindings/types"
+	eth2types "github.com/wealdtech/go-eth2-types/v2"
+	eth2ks "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+
+	"github.com/rocket-pool/smartnode/shared/services/wallet/keystore"
+)
+
+// Encrypted validator key store, in the EIP-2335 format
+type ValidatorKeystore struct {
+	Crypto  map[string]interface{} `json:"crypto"`
+	Version uint                   `json:"version"`
+	UUID    uuid.UUID              `json:"uuid"`
+	Path    string                 `json:"path"`
+	Pubkey  string                 `json:"pubkey"`
+}
+
+// Encrypt a validator key into an EIP-2335 keystore for importing through a keymanager API.
+// The key is encrypted with a one-time password, since the receiver re-encrypts it with its own; the keystore and the password are returned.
+func EncryptValidatorKey(key *eth2types.BLSPrivateKey, derivationPath string) (string, string, error) {
+
+	// Create a one-time password
+	password, err := keystore.GenerateRandomPassword()
+	if err != nil {
+		return "", "", fmt.Errorf("Could not generate random password: %w", err)
+	}
+
+	// Encrypt key
+	encryptor := eth2ks.New(eth2ks.WithCipher("scrypt"))
+	encryptedKey, err := encryptor.Encrypt(key.Marshal(), password)
+	if err != nil {
+		return "", "", fmt.Errorf("Could not encrypt validator key: %w", err)
+	}
+
+	// Encode key store
+	pubkey := types.BytesToValidatorPubkey(key.PublicKey().Marshal())
+	keystoreBytes, err := json.Marshal(ValidatorKeystore{
+		Crypto:  encryptedKey,
+		Version: encryptor.Version(),
+		UUID:    uuid.New(),
+		Path:    derivationPath,
+		Pubkey:  hex.EncodeToString(pubkey.Bytes()),
+	})
+	if err != nil {
+		return "", "", fmt.Errorf("Could not encode validator key: %w", err)
+	}
+
+	return string(keystoreBytes), password, nil
+
+}
@@ -0,0 +1,162 @@
+package passwords
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"time"
+)
+
+// Agent config
+const (
+	agentAction_Get    string = "get"
+	agentAction_Unlock string = "unlock"
+	agentAction_Lock   string = "lock"
+
+	agentTimeout = 10 * time.Second
+)
+
+type agentRequest struct {
+	Action   string `json:"action"`
+	Password string `json:"password,omitempty"`
+}
+type agentResponse struct {
+	Password string `json:"password,omitempty"`
+	Locked   bool   `json:"locked"`
+	Error    string `json:"error,omitempty"`
+}
+
+// Hold the password in memory and serve it to the other Smartnode processes on the agent's Unix socket.
+// This blocks until the socket fails, and is run by the node daemon.
+func (pm *PasswordManager) ServeAgent() error {
+
+	if pm.mode != StorageMode_Unlock {
+		return fmt.Errorf("the password agent can't be used with %s password storage", pm.mode)
+	}
+	pm.lock.Lock()
+	pm.isAgent = true
+	pm.lock.Unlock()
+
+	// Create the socket, replacing any that was left behind by a previous run
+	err := os.Remove(pm.agentPath)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("error removing old password agent socket [%s]: %w", pm.agentPath, err)
+	}
+	listener, err := net.Listen("unix", pm.agentPath)
+	if err != nil {
+		return fmt.Errorf("error listening on password agent socket [%s]: %w", pm.agentPath, err)
+	}
+	defer listener.Close()
+	if err := os.Chmod(pm.agentPath, FileMode); err != nil {
+		return fmt.Errorf("error setting permissions on password agent socket [%s]: %w", pm.agentPath, err)
+	}
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return fmt.Errorf("error accepting password agent connection: %w", err)
+		}
+		go pm.handleAgentConnection(conn)
+	}
+
+}
+
+// Handle a single request to the agent
+func (pm *PasswordManager) handleAgentConnection(conn net.Conn) {
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(agentTimeout))
+
+	var request agentRequest
+	if err := json.NewDecoder(conn).Decode(&request); err != nil {
+		json.NewEncoder(conn).Encode(agentResponse{Error: fmt.Sprintf("error decoding request: %s", err.Error())})
+		return
+	}
+
+	pm.lock.Lock()
+	response := agentResponse{}
+	switch request.Action {
+	case agentAction_Get:
+		response.Password = pm.password
+	case agentAction_Unlock:
+		if pm.password != "" && pm.password != request.Password {
+			response.Error = "The node wallet is already unlocked with a different password"
+		} else {
+			pm.password = request.Password
+		}
+	case agentAction_Lock:
+		pm.password = ""
+	default:
+		response.Error = fmt.Sprintf("unknown action [%s]", request.Action)
+	}
+	response.Locked = (pm.password == "")
+	pm.lock.Unlock()
+
+	json.NewEncoder(conn).Encode(response)
+}
+
+// Get the password from memory if this is the agent, or from the agent otherwise
+func (pm *PasswordManager) getUnlockedPassword() (string, error) {
+	pm.lock.Lock()
+	isAgent, password := pm.isAgent, pm.password
+	pm.lock.Unlock()
+
+	if !isAgent {
+		response, err := pm.callAgent(agentRequest{Action: agentAction_Get})
+		if err != nil {
+			return "", err
+		}
+		password = response.Password
+	}
+	if password == "" {
+		return "", ErrWalletLocked
+	}
+	return password, nil
+}
+
+// Give the agent the password
+func (pm *PasswordManager) setUnlockedPassword(password string) error {
+	pm.lock.Lock()
+	defer pm.lock.Unlock()
+	if pm.isAgent {
+		pm.password = password
+		return nil
+	}
+	_, err := pm.callAgent(agentRequest{Action: agentAction_Unlock, Password: password})
+	return err
+}
+
+// Have the agent forget the password
+func (pm *PasswordManager) clearUnlockedPassword() error {
+	pm.lock.Lock()
+	defer pm.lock.Unlock()
+	if pm.isAgent {
+		pm.password = ""
+		return nil
+	}
+	_, err := pm.callAgent(agentRequest{Action: agentAction_Lock})
+	return err
+}
+
+// Send a request to the agent
+func (pm *PasswordManager) callAgent(request agentRequest) (agentResponse, error) {
+	conn, err := net.DialTimeout("unix", pm.agentPath, agentTimeout)
+	if err != nil {
+		return agentResponse{}, fmt.Errorf("Could not reach the node daemon's password agent; make sure the node daemon is running: %w", err)
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(agentTimeout))
+
+	if err := json.NewEncoder(conn).Encode(request); err != nil {
+		return agentResponse{}, fmt.Errorf("error sending request to the password agent: %w", err)
+	}
+	var response agentResponse
+	if err := json.NewDecoder(conn).Decode(&response); err != nil {
+		return agentResponse{}, fmt.Errorf("error decoding response from the password agent: %w", err)
+	}
+	if response.Error != "" {
+		return agentResponse{}, errors.New(response.Error)
+	}
+	return response, nil
+}
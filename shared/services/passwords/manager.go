@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"sync"
 )
 
 // Config
@@ -12,32 +13,103 @@ const (
 	FileMode          = 0600
 )
 
+// Password storage modes
+const (
+	StorageMode_Plaintext string = "plaintext"
+	StorageMode_Sealed    string = "sealed"
+	StorageMode_Unlock    string = "unlock"
+)
+
+// Returned when the password isn't stored and hasn't been provided since the node daemon started
+var ErrWalletLocked = errors.New("The node wallet is locked. Please run 'rocketpool wallet unlock' to unlock it.")
+
 // Password manager
 type PasswordManager struct {
 	passwordPath string
+	mode         string
+
+	// Sealed storage
+	keyringPath string
+
+	// Unlock-on-start storage; the agent holds the password in memory and serves it to the other processes
+	agentPath string
+	isAgent   bool
+	password  string
+	lock      sync.Mutex
 }
 
-// Create new password manager
+// Create new password manager that stores the password as plaintext
 func NewPasswordManager(passwordPath string) *PasswordManager {
 	return &PasswordManager{
 		passwordPath: passwordPath,
+		mode:         StorageMode_Plaintext,
+	}
+}
+
+// Create a new password manager that stores the password sealed with a key encryption key.
+// The key comes from the environment if it's set, or from the keyring file otherwise.
+func NewSealedPasswordManager(passwordPath string, keyringPath string) *PasswordManager {
+	return &PasswordManager{
+		passwordPath: passwordPath,
+		mode:         StorageMode_Sealed,
+		keyringPath:  keyringPath,
+	}
+}
+
+// Create a new password manager that never stores the password. It is held in memory by the node daemon's password agent
+// after the wallet is unlocked, and other processes get it from the agent.
+func NewUnlockPasswordManager(passwordPath string, agentPath string) *PasswordManager {
+	return &PasswordManager{
+		passwordPath: passwordPath,
+		mode:         StorageMode_Unlock,
+		agentPath:    agentPath,
 	}
 }
 
+// Get the password storage mode
+func (pm *PasswordManager) GetStorageMode() string {
+	return pm.mode
+}
+
 // Check if the password has been set
 func (pm *PasswordManager) IsPasswordSet() bool {
+	if pm.mode == StorageMode_Unlock {
+		_, err := pm.GetPassword()
+		return (err == nil)
+	}
 	_, err := os.ReadFile(pm.passwordPath)
 	return (err == nil)
 }
 
+// Check if the password is waiting to be provided with an unlock
+func (pm *PasswordManager) IsLocked() bool {
+	return pm.mode == StorageMode_Unlock && !pm.IsPasswordSet()
+}
+
+// Check if a password is still stored on disk, which shouldn't be the case in unlock mode
+func (pm *PasswordManager) IsPasswordFileStored() bool {
+	_, err := os.Stat(pm.passwordPath)
+	return (err == nil)
+}
+
 // Get the password
 func (pm *PasswordManager) GetPassword() (string, error) {
 
+	switch pm.mode {
+	case StorageMode_Unlock:
+		return pm.getUnlockedPassword()
+	case StorageMode_Sealed:
+		return pm.readSealedPassword()
+	}
+
 	// Read from disk
 	password, err := os.ReadFile(pm.passwordPath)
 	if err != nil {
 		return "", fmt.Errorf("Could not read password from disk: %w", err)
 	}
+	if isSealed(password) {
+		return "", errors.New("The wallet password is sealed, but the Smartnode is set to store it as plaintext. Please change the wallet password storage mode back to sealed in the Smartnode settings.")
+	}
 
 	// Return
 	return string(password), nil
@@ -57,6 +129,13 @@ func (pm *PasswordManager) SetPassword(password string) error {
 		return fmt.Errorf("Password must be at least %d characters long", MinPasswordLength)
 	}
 
+	switch pm.mode {
+	case StorageMode_Unlock:
+		return pm.setUnlockedPassword(password)
+	case StorageMode_Sealed:
+		return pm.writeSealedPassword(password)
+	}
+
 	// Write to disk
 	if err := os.WriteFile(pm.passwordPath, []byte(password), FileMode); err != nil {
 		return fmt.Errorf("Could not write password to disk: %w", err)
@@ -70,6 +149,13 @@ func (pm *PasswordManager) SetPassword(password string) error {
 // Delete the password
 func (pm *PasswordManager) DeletePassword() error {
 
+	// Forget it if it's held in memory
+	if pm.mode == StorageMode_Unlock {
+		if err := pm.clearUnlockedPassword(); err != nil {
+			return err
+		}
+	}
+
 	// Check if it exists
 	_, err := os.Stat(pm.passwordPath)
 	if os.IsNotExist(err) {
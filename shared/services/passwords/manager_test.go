@@ -0,0 +1,185 @@
+package passwords
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testPassword = "correct horse battery staple"
+
+func TestSealedPassword(t *testing.T) {
+	t.Setenv(KekEnvVar, "")
+	passwordPath := filepath.Join(t.TempDir(), "password")
+	keyringPath := filepath.Join(t.TempDir(), "password-keyring")
+	pm := NewSealedPasswordManager(passwordPath, keyringPath)
+
+	if err := pm.SetPassword(testPassword); err != nil {
+		t.Fatal(err)
+	}
+
+	// The password isn't on disk in plaintext, and a keyring was created for it
+	bytes, err := os.ReadFile(passwordPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(bytes), testPassword) || !isSealed(bytes) {
+		t.Fatalf("expected a sealed password, got %s", string(bytes))
+	}
+	if _, err := os.Stat(keyringPath); err != nil {
+		t.Fatalf("expected a keyring to be created: %s", err.Error())
+	}
+
+	// It can be unsealed
+	password, err := pm.GetPassword()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if password != testPassword {
+		t.Fatalf("expected '%s', got '%s'", testPassword, password)
+	}
+
+	// A plaintext manager refuses to use it
+	if _, err := NewPasswordManager(passwordPath).GetPassword(); err == nil {
+		t.Fatal("expected an error reading a sealed password as plaintext")
+	}
+
+	// It can't be unsealed without the keyring, or with the wrong key
+	if err := os.Rename(keyringPath, keyringPath+".bak"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := pm.GetPassword(); err == nil {
+		t.Fatal("expected an error without the keyring")
+	}
+	t.Setenv(KekEnvVar, "not the right key")
+	if _, err := pm.GetPassword(); err == nil {
+		t.Fatal("expected an error with the wrong key encryption key")
+	}
+}
+
+func TestSealedPasswordKeyringNextToPassword(t *testing.T) {
+	t.Setenv(KekEnvVar, "")
+	dir := t.TempDir()
+	passwordPath := filepath.Join(dir, "password")
+
+	// A keyring isn't created in the password's folder, or anywhere below it
+	for _, keyringPath := range []string{filepath.Join(dir, "password-keyring"), filepath.Join(dir, "keys", "password-keyring")} {
+		pm := NewSealedPasswordManager(passwordPath, keyringPath)
+		if err := pm.SetPassword(testPassword); err == nil {
+			t.Fatalf("expected an error creating the keyring at %s", keyringPath)
+		}
+		if _, err := os.Stat(keyringPath); !os.IsNotExist(err) {
+			t.Fatalf("expected no keyring to be created at %s", keyringPath)
+		}
+		if _, err := os.Stat(passwordPath); !os.IsNotExist(err) {
+			t.Fatal("expected the password not to be written")
+		}
+	}
+
+	// It's fine when the key comes from the environment
+	t.Setenv(KekEnvVar, "a key from the environment")
+	if err := NewSealedPasswordManager(passwordPath, filepath.Join(dir, "password-keyring")).SetPassword(testPassword); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSealedPasswordFromEnvironment(t *testing.T) {
+	t.Setenv(KekEnvVar, "a key from the environment")
+	dir := t.TempDir()
+	passwordPath := filepath.Join(dir, "password")
+	keyringPath := filepath.Join(dir, "password-keyring")
+
+	// Start with a legacy plaintext password
+	if err := NewPasswordManager(passwordPath).SetPassword(testPassword); err != nil {
+		t.Fatal(err)
+	}
+
+	// Reading it seals it in place with the key from the environment, without creating a keyring
+	pm := NewSealedPasswordManager(passwordPath, keyringPath)
+	password, err := pm.GetPassword()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if password != testPassword {
+		t.Fatalf("expected '%s', got '%s'", testPassword, password)
+	}
+	bytes, err := os.ReadFile(passwordPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !isSealed(bytes) {
+		t.Fatal("expected the plaintext password to be sealed")
+	}
+	if _, err := os.Stat(keyringPath); !os.IsNotExist(err) {
+		t.Fatal("expected no keyring to be created when the key comes from the environment")
+	}
+	password, err = pm.GetPassword()
+	if err != nil || password != testPassword {
+		t.Fatalf("expected '%s', got '%s' (%v)", testPassword, password, err)
+	}
+}
+
+func TestUnlockPassword(t *testing.T) {
+	dir := t.TempDir()
+	passwordPath := filepath.Join(dir, "password")
+	agentPath := filepath.Join(dir, "agent.sock")
+	agent := NewUnlockPasswordManager(passwordPath, agentPath)
+	client := NewUnlockPasswordManager(passwordPath, agentPath)
+
+	// Clients can't get the password without the agent
+	if client.IsPasswordSet() {
+		t.Fatal("expected no password without an agent")
+	}
+
+	// Start the agent
+	errs := make(chan error, 1)
+	go func() {
+		errs <- agent.ServeAgent()
+	}()
+	for i := 0; ; i++ {
+		if _, err := os.Stat(agentPath); err == nil {
+			break
+		}
+		if i == 100 {
+			t.Fatal("the password agent didn't start")
+		}
+		select {
+		case err := <-errs:
+			t.Fatal(err)
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+
+	// It starts locked
+	if !agent.IsLocked() || !client.IsLocked() {
+		t.Fatal("expected the password to start locked")
+	}
+	if _, err := client.GetPassword(); !errors.Is(err, ErrWalletLocked) {
+		t.Fatalf("expected ErrWalletLocked, got %v", err)
+	}
+
+	// Unlock it through a client
+	if err := client.SetPassword(testPassword); err != nil {
+		t.Fatal(err)
+	}
+	for _, pm := range []*PasswordManager{agent, client} {
+		password, err := pm.GetPassword()
+		if err != nil || password != testPassword {
+			t.Fatalf("expected '%s', got '%s' (%v)", testPassword, password, err)
+		}
+	}
+	if _, err := os.Stat(passwordPath); !os.IsNotExist(err) {
+		t.Fatal("expected the password not to be written to disk")
+	}
+
+	// Lock it again
+	if err := client.DeletePassword(); err != nil {
+		t.Fatal(err)
+	}
+	if !agent.IsLocked() {
+		t.Fatal("expected the password to be locked")
+	}
+}
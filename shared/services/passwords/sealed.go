@@ -0,0 +1,231 @@
+package passwords
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+// Sealed storage config
+const (
+	// The environment variable that provides the key encryption key; it takes priority over the keyring file
+	KekEnvVar string = "ROCKETPOOL_WALLET_KEK"
+
+	sealedPasswordVersion int = 1
+	keyringKeySize        int = 32
+	saltSize              int = 16
+
+	// scrypt parameters for deriving the sealing key from the key encryption key
+	scryptN int = 1 << 15
+	scryptR int = 8
+	scryptP int = 1
+)
+
+// A wallet password sealed with AES-256-GCM, using a key derived from the key encryption key
+type sealedPassword struct {
+	Version    int    `json:"version"`
+	Salt       string `json:"salt"`
+	Nonce      string `json:"nonce"`
+	Ciphertext string `json:"ciphertext"`
+}
+
+// Read the sealed password from disk and unseal it.
+// Passwords that were stored as plaintext before sealing was enabled are sealed in place.
+func (pm *PasswordManager) readSealedPassword() (string, error) {
+
+	// Read from disk
+	bytes, err := os.ReadFile(pm.passwordPath)
+	if err != nil {
+		return "", fmt.Errorf("Could not read password from disk: %w", err)
+	}
+
+	// Seal legacy plaintext passwords
+	if !isSealed(bytes) {
+		password := string(bytes)
+		if err := pm.writeSealedPassword(password); err != nil {
+			return "", fmt.Errorf("Could not seal the existing wallet password: %w", err)
+		}
+		return password, nil
+	}
+
+	// Unseal it
+	kek, err := pm.getKeyEncryptionKey(false)
+	if err != nil {
+		return "", err
+	}
+	password, err := unseal(bytes, kek)
+	if err != nil {
+		return "", fmt.Errorf("Could not unseal the wallet password: %w", err)
+	}
+	return password, nil
+
+}
+
+// Seal the password and write it to disk
+func (pm *PasswordManager) writeSealedPassword(password string) error {
+
+	// Seal it
+	kek, err := pm.getKeyEncryptionKey(true)
+	if err != nil {
+		return err
+	}
+	bytes, err := seal(password, kek)
+	if err != nil {
+		return fmt.Errorf("Could not seal the wallet password: %w", err)
+	}
+
+	// Write it via a temporary file so a failed write can't leave a corrupted password behind
+	tempPath := pm.passwordPath + ".tmp"
+	if err := os.WriteFile(tempPath, bytes, FileMode); err != nil {
+		return fmt.Errorf("Could not write password to disk: %w", err)
+	}
+	if err := os.Rename(tempPath, pm.passwordPath); err != nil {
+		return fmt.Errorf("Could not write password to disk: %w", err)
+	}
+	return nil
+
+}
+
+// Get the key encryption key from the environment or the keyring file, optionally creating the keyring file if it doesn't exist yet
+func (pm *PasswordManager) getKeyEncryptionKey(create bool) ([]byte, error) {
+
+	// The environment takes priority
+	if kek := os.Getenv(KekEnvVar); kek != "" {
+		return []byte(kek), nil
+	}
+
+	// Read the keyring
+	bytes, err := os.ReadFile(pm.keyringPath)
+	if err == nil {
+		kek := strings.TrimSpace(string(bytes))
+		if kek == "" {
+			return nil, fmt.Errorf("The wallet password keyring [%s] is empty", pm.keyringPath)
+		}
+		return []byte(kek), nil
+	}
+	if !os.IsNotExist(err) {
+		return nil, fmt.Errorf("Could not read the wallet password keyring [%s]: %w", pm.keyringPath, err)
+	}
+	if !create {
+		return nil, fmt.Errorf("The wallet password is sealed, but %s is not set and the keyring [%s] does not exist", KekEnvVar, pm.keyringPath)
+	}
+
+	// A keyring next to the password would protect nothing, since anyone who can read one can read the other
+	if isInFolder(pm.keyringPath, filepath.Dir(pm.passwordPath)) {
+		return nil, fmt.Errorf("The wallet password keyring [%s] would be stored in the same folder as the password; set %s or store the keyring somewhere else", pm.keyringPath, KekEnvVar)
+	}
+
+	// Create a new keyring
+	key := make([]byte, keyringKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("Could not generate a wallet password keyring: %w", err)
+	}
+	kek := hex.EncodeToString(key)
+	if err := os.MkdirAll(filepath.Dir(pm.keyringPath), 0700); err != nil {
+		return nil, fmt.Errorf("Could not create the folder for the wallet password keyring: %w", err)
+	}
+	if err := os.WriteFile(pm.keyringPath, []byte(kek), FileMode); err != nil {
+		return nil, fmt.Errorf("Could not write the wallet password keyring [%s]: %w", pm.keyringPath, err)
+	}
+	return []byte(kek), nil
+
+}
+
+// Check if a path is inside a folder
+func isInFolder(path string, folder string) bool {
+	rel, err := filepath.Rel(filepath.Clean(folder), filepath.Clean(path))
+	if err != nil {
+		return false
+	}
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// Check if a stored password is sealed
+func isSealed(bytes []byte) bool {
+	var sealed sealedPassword
+	if err := json.Unmarshal(bytes, &sealed); err != nil {
+		return false
+	}
+	return sealed.Version > 0 && sealed.Ciphertext != ""
+}
+
+// Seal a password with a key encryption key
+func seal(password string, kek []byte) ([]byte, error) {
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("error generating salt: %w", err)
+	}
+	gcm, err := getCipher(kek, salt)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("error generating nonce: %w", err)
+	}
+
+	return json.Marshal(sealedPassword{
+		Version:    sealedPasswordVersion,
+		Salt:       hex.EncodeToString(salt),
+		Nonce:      hex.EncodeToString(nonce),
+		Ciphertext: hex.EncodeToString(gcm.Seal(nil, nonce, []byte(password), nil)),
+	})
+}
+
+// Unseal a password with a key encryption key
+func unseal(bytes []byte, kek []byte) (string, error) {
+	var sealed sealedPassword
+	if err := json.Unmarshal(bytes, &sealed); err != nil {
+		return "", fmt.Errorf("error decoding sealed password: %w", err)
+	}
+	if sealed.Version != sealedPasswordVersion {
+		return "", fmt.Errorf("unsupported sealed password version %d", sealed.Version)
+	}
+	salt, err := hex.DecodeString(sealed.Salt)
+	if err != nil {
+		return "", fmt.Errorf("error decoding salt: %w", err)
+	}
+	nonce, err := hex.DecodeString(sealed.Nonce)
+	if err != nil {
+		return "", fmt.Errorf("error decoding nonce: %w", err)
+	}
+	ciphertext, err := hex.DecodeString(sealed.Ciphertext)
+	if err != nil {
+		return "", fmt.Errorf("error decoding ciphertext: %w", err)
+	}
+
+	gcm, err := getCipher(kek, salt)
+	if err != nil {
+		return "", err
+	}
+	if len(nonce) != gcm.NonceSize() {
+		return "", fmt.Errorf("nonce is %d bytes instead of %d", len(nonce), gcm.NonceSize())
+	}
+	password, err := gcm.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return "", errors.New("the key encryption key is incorrect or the sealed password has been modified")
+	}
+	return string(password), nil
+}
+
+// Get the AES-256-GCM cipher for a key encryption key and salt
+func getCipher(kek []byte, salt []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key(kek, salt, scryptN, scryptR, scryptP, 32)
+	if err != nil {
+		return nil, fmt.Errorf("error deriving sealing key: %w", err)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("error creating cipher: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,273 @@
+package proposals
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/goccy/go-json"
+	"github.com/klauspost/compress/zstd"
+	"github.com/rocket-pool/smartnode/bindings/dao/protocol"
+	"github.com/rocket-pool/smartnode/bindings/types"
+)
+
+// The outcome of a simulated challenge game
+type ChallengeOutcome string
+
+const (
+	// The proposal's tree matches the local one, so there is nothing to challenge
+	ChallengeOutcome_NoMismatch ChallengeOutcome = "no-mismatch"
+
+	// The challenges reached the leaves of a node tree, which the proposer can't defend since they're verified on-chain
+	ChallengeOutcome_ProposerDefeated ChallengeOutcome = "proposer-defeated"
+)
+
+// A tree node in a submitted pollard that doesn't match the local tree
+type PollardMismatch struct {
+	Index       uint64               `json:"index"`
+	NodeAddress *common.Address      `json:"nodeAddress,omitempty"`
+	Local       types.VotingTreeNode `json:"local"`
+	Submitted   types.VotingTreeNode `json:"submitted"`
+}
+
+// The result of checking a root submission against the local voting trees
+type RootSubmissionAudit struct {
+	Index         uint64               `json:"index"`
+	NodeAddress   *common.Address      `json:"nodeAddress,omitempty"`
+	LocalRoot     types.VotingTreeNode `json:"localRoot"`
+	SubmittedRoot types.VotingTreeNode `json:"submittedRoot"`
+	Mismatches    []PollardMismatch    `json:"mismatches"`
+}
+
+// A single round of a simulated challenge game
+type ChallengeRound struct {
+	ChallengedIndex uint64               `json:"challengedIndex"`
+	NodeAddress     *common.Address      `json:"nodeAddress,omitempty"`
+	Local           types.VotingTreeNode `json:"local"`
+	Proposed        types.VotingTreeNode `json:"proposed"`
+
+	// The nodes of the proposer's response pollard that don't match the local tree
+	Mismatches []PollardMismatch `json:"mismatches"`
+
+	// True if the response is made of node tree leaves, which are checked against the chain instead of being challenged again
+	Final bool `json:"final"`
+}
+
+// The result of a simulated challenge game between a challenger and a proposer
+type ChallengeSimulation struct {
+	BlockNumber   uint32               `json:"blockNumber"`
+	DepthPerRound uint64               `json:"depthPerRound"`
+	LocalRoot     types.VotingTreeNode `json:"localRoot"`
+	ProposedRoot  types.VotingTreeNode `json:"proposedRoot"`
+	Rounds        []ChallengeRound     `json:"rounds"`
+	Outcome       ChallengeOutcome     `json:"outcome"`
+}
+
+// The voting trees built from a single voting info snapshot, created as they're needed
+type snapshotTrees struct {
+	snapshot      *VotingInfoSnapshot
+	depthPerRound uint64
+	networkTree   *VotingTree
+	nodeTrees     map[uint64]*VotingTree
+}
+
+// Load a voting info snapshot from a file, such as one saved by the node daemon. Files ending in .zst are decompressed first.
+func LoadVotingInfoSnapshotFile(path string) (*VotingInfoSnapshot, error) {
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading voting info snapshot [%s]: %w", path, err)
+	}
+	if strings.HasSuffix(path, ".zst") {
+		decoder, err := zstd.NewReader(nil)
+		if err != nil {
+			return nil, fmt.Errorf("error creating zstd decoder: %w", err)
+		}
+		defer decoder.Close()
+		bytes, err = decoder.DecodeAll(bytes, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error decompressing voting info snapshot [%s]: %w", path, err)
+		}
+	}
+
+	var snapshot VotingInfoSnapshot
+	err = json.Unmarshal(bytes, &snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("error deserializing voting info snapshot [%s]: %w", path, err)
+	}
+	return &snapshot, nil
+}
+
+// Compare every node of a RootSubmitted event's pollard against the local voting trees
+func (m *ProposalManager) AuditRootSubmission(event protocol.RootSubmitted) (*RootSubmissionAudit, error) {
+	// Load the voting info snapshot
+	blockNumber := event.BlockNumber
+	index := event.Index.Uint64()
+	snapshot, err := m.GetVotingInfoSnapshot(blockNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the proper tree
+	rpNodeIndex := getRPNodeIndexFromTreeNodeIndex(snapshot, index)
+	var tree *VotingTree
+	if rpNodeIndex == nil {
+		networkTree, err := m.GetNetworkTree(blockNumber, snapshot)
+		if err != nil {
+			return nil, err
+		}
+		tree = networkTree.VotingTree
+	} else {
+		nodeTree, err := m.GetNodeTree(blockNumber, *rpNodeIndex, snapshot)
+		if err != nil {
+			return nil, err
+		}
+		tree = nodeTree.VotingTree
+	}
+
+	// Compare the pollards
+	mismatchIndices, err := tree.GetPollardMismatches(index, event.TreeNodes)
+	if err != nil {
+		return nil, fmt.Errorf("error comparing pollard for index %d: %w", index, err)
+	}
+	return &RootSubmissionAudit{
+		Index:         index,
+		NodeAddress:   getNodeAddressForTreeNodeIndex(snapshot, index),
+		LocalRoot:     *tree.GetNode(index),
+		SubmittedRoot: event.Root,
+		Mismatches:    getPollardMismatches(snapshot, tree, index, event.TreeNodes, mismatchIndices),
+	}, nil
+}
+
+// Simulate the challenge game a challenger with the local snapshot would play against a proposer that built its trees from another snapshot,
+// challenging the first mismatch of each pollard until either the trees agree or the proposer has to defend node tree leaves.
+// This assumes the local snapshot matches the chain.
+func SimulateChallengeGame(local *VotingInfoSnapshot, proposer *VotingInfoSnapshot, depthPerRound uint64) (*ChallengeSimulation, error) {
+	if local.BlockNumber != proposer.BlockNumber {
+		return nil, fmt.Errorf("the local snapshot is for block %d but the proposer's snapshot is for block %d", local.BlockNumber, proposer.BlockNumber)
+	}
+	if local.Network != proposer.Network {
+		return nil, fmt.Errorf("the local snapshot is for network %s but the proposer's snapshot is for network %s", local.Network, proposer.Network)
+	}
+	if len(local.Info) != len(proposer.Info) {
+		return nil, fmt.Errorf("the local snapshot has %d nodes but the proposer's snapshot has %d nodes, so their trees have different shapes", len(local.Info), len(proposer.Info))
+	}
+	if len(local.Info) == 0 {
+		return nil, fmt.Errorf("the snapshots don't have any nodes")
+	}
+	if depthPerRound == 0 {
+		return nil, fmt.Errorf("the depth per round must be greater than 0")
+	}
+
+	localTrees := newSnapshotTrees(local, depthPerRound)
+	proposerTrees := newSnapshotTrees(proposer, depthPerRound)
+	simulation := &ChallengeSimulation{
+		BlockNumber:   local.BlockNumber,
+		DepthPerRound: depthPerRound,
+		LocalRoot:     *localTrees.getTree(1).GetNode(1),
+		ProposedRoot:  *proposerTrees.getTree(1).GetNode(1),
+		Rounds:        []ChallengeRound{},
+		Outcome:       ChallengeOutcome_NoMismatch,
+	}
+
+	// Start with the pollard submitted alongside the proposal
+	_, proposalPollard := proposerTrees.getTree(1).GetPollardForProposal()
+	mismatches, err := localTrees.getTree(1).GetPollardMismatches(1, derefNodes(proposalPollard))
+	if err != nil {
+		return nil, err
+	}
+
+	for len(mismatches) > 0 {
+		// Challenge the first mismatch and have the proposer respond with the pollard below it
+		challengedIndex := mismatches[0]
+		localTree := localTrees.getTree(challengedIndex)
+		proposerTree := proposerTrees.getTree(challengedIndex)
+		_, responsePollard := proposerTree.GetArtifactsForChallengeResponse(challengedIndex)
+		response := derefNodes(responsePollard)
+		mismatches, err = localTree.GetPollardMismatches(challengedIndex, response)
+		if err != nil {
+			return nil, fmt.Errorf("error comparing response pollard for index %d: %w", challengedIndex, err)
+		}
+
+		round := ChallengeRound{
+			ChallengedIndex: challengedIndex,
+			NodeAddress:     getNodeAddressForTreeNodeIndex(local, challengedIndex),
+			Local:           *localTree.GetNode(challengedIndex),
+			Proposed:        *proposerTree.GetNode(challengedIndex),
+			Mismatches:      getPollardMismatches(local, localTree, challengedIndex, response, mismatches),
+			Final:           getRPNodeIndexFromTreeNodeIndex(local, challengedIndex) != nil && localTree.isPollardAtLeaves(challengedIndex),
+		}
+		simulation.Rounds = append(simulation.Rounds, round)
+		if round.Final {
+			simulation.Outcome = ChallengeOutcome_ProposerDefeated
+			break
+		}
+	}
+
+	return simulation, nil
+}
+
+// Create a new set of voting trees for a snapshot
+func newSnapshotTrees(snapshot *VotingInfoSnapshot, depthPerRound uint64) *snapshotTrees {
+	return &snapshotTrees{
+		snapshot:      snapshot,
+		depthPerRound: depthPerRound,
+		nodeTrees:     map[uint64]*VotingTree{},
+	}
+}
+
+// Get the tree that contains the provided virtual index, creating it if it doesn't exist yet
+func (s *snapshotTrees) getTree(virtualIndex uint64) *VotingTree {
+	rpNodeIndex := getRPNodeIndexFromTreeNodeIndex(s.snapshot, virtualIndex)
+	if rpNodeIndex == nil {
+		if s.networkTree == nil {
+			s.networkTree = newNetworkVotingTree(s.snapshot, s.snapshot.Network, s.depthPerRound).VotingTree
+		}
+		return s.networkTree
+	}
+
+	tree, exists := s.nodeTrees[*rpNodeIndex]
+	if !exists {
+		treeIndex := getTreeNodeIndexFromRPNodeIndex(s.snapshot, *rpNodeIndex)
+		tree = newNodeVotingTree(s.snapshot, *rpNodeIndex, treeIndex, s.snapshot.Network, s.depthPerRound).VotingTree
+		s.nodeTrees[*rpNodeIndex] = tree
+	}
+	return tree
+}
+
+// Get the address of the node whose voting tree contains the provided virtual index, or nil if it's in the network tree
+func getNodeAddressForTreeNodeIndex(snapshot *VotingInfoSnapshot, virtualIndex uint64) *common.Address {
+	rpNodeIndex := getRPNodeIndexFromTreeNodeIndex(snapshot, virtualIndex)
+	if rpNodeIndex == nil || *rpNodeIndex >= uint64(len(snapshot.Info)) {
+		return nil
+	}
+	address := snapshot.Info[*rpNodeIndex].NodeAddress
+	return &address
+}
+
+// Get the details of each mismatched node in a pollard, given their virtual indices
+func getPollardMismatches(snapshot *VotingInfoSnapshot, tree *VotingTree, virtualRootIndex uint64, pollard []types.VotingTreeNode, mismatchIndices []uint64) []PollardMismatch {
+	// The pollard is a single row of the tree, so its virtual indices are contiguous
+	_, localPollard := tree.generatePollard(virtualRootIndex)
+	firstIndex := tree.getVirtualIndexFromLocalIndex(uint64(len(localPollard)), virtualRootIndex)
+
+	mismatches := make([]PollardMismatch, len(mismatchIndices))
+	for i, index := range mismatchIndices {
+		mismatches[i] = PollardMismatch{
+			Index:       index,
+			NodeAddress: getNodeAddressForTreeNodeIndex(snapshot, index),
+			Local:       *tree.GetNode(index),
+			Submitted:   pollard[index-firstIndex],
+		}
+	}
+	return mismatches
+}
+
+// Copy a slice of tree node pointers into a slice of tree nodes
+func derefNodes(nodePtrs []*types.VotingTreeNode) []types.VotingTreeNode {
+	nodes := make([]types.VotingTreeNode, len(nodePtrs))
+	for i := range nodePtrs {
+		nodes[i] = *nodePtrs[i]
+	}
+	return nodes
+}
@@ -0,0 +1,111 @@
+package proposals
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/smartnode/bindings/types"
+	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+// Create a snapshot where each node delegates to itself
+func createTestSnapshot(nodeCount int) *VotingInfoSnapshot {
+	info := make([]types.NodeVotingInfo, nodeCount)
+	for i := range info {
+		address := common.BigToAddress(big.NewInt(int64(i + 1)))
+		info[i] = types.NodeVotingInfo{
+			NodeAddress: address,
+			VotingPower: big.NewInt(int64(i+1) * 1e18),
+			Delegate:    address,
+		}
+	}
+	return &VotingInfoSnapshot{
+		Network:     cfgtypes.Network_Mainnet,
+		BlockNumber: 100,
+		Info:        info,
+	}
+}
+
+func TestSimulateChallengeGameMatchingSnapshots(t *testing.T) {
+	simulation, err := SimulateChallengeGame(createTestSnapshot(10), createTestSnapshot(10), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if simulation.Outcome != ChallengeOutcome_NoMismatch {
+		t.Errorf("expected outcome %s but got %s", ChallengeOutcome_NoMismatch, simulation.Outcome)
+	}
+	if len(simulation.Rounds) != 0 {
+		t.Errorf("expected no rounds but got %d", len(simulation.Rounds))
+	}
+}
+
+func TestSimulateChallengeGameDefeatsProposer(t *testing.T) {
+	local := createTestSnapshot(10)
+	proposer := createTestSnapshot(10)
+	proposer.Info[7].VotingPower = big.NewInt(5e18)
+
+	// 10 nodes make trees with 16 leaves, so the network tree takes two rounds to reach its leaves and node 7's tree takes two more
+	simulation, err := SimulateChallengeGame(local, proposer, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if simulation.Outcome != ChallengeOutcome_ProposerDefeated {
+		t.Fatalf("expected outcome %s but got %s", ChallengeOutcome_ProposerDefeated, simulation.Outcome)
+	}
+	if simulation.LocalRoot.Hash == simulation.ProposedRoot.Hash {
+		t.Error("expected the roots to differ")
+	}
+
+	expectedIndices := []uint64{5, 23, 93}
+	if len(simulation.Rounds) != len(expectedIndices) {
+		t.Fatalf("expected %d rounds but got %d", len(expectedIndices), len(simulation.Rounds))
+	}
+	for i, round := range simulation.Rounds {
+		if round.ChallengedIndex != expectedIndices[i] {
+			t.Errorf("expected round %d to challenge index %d but it challenged %d", i, expectedIndices[i], round.ChallengedIndex)
+		}
+		if round.Final != (i == len(expectedIndices)-1) {
+			t.Errorf("round %d has the wrong final flag", i)
+		}
+	}
+
+	// The last round is against node 7's own leaf
+	finalRound := simulation.Rounds[len(simulation.Rounds)-1]
+	if finalRound.NodeAddress == nil || *finalRound.NodeAddress != local.Info[7].NodeAddress {
+		t.Fatalf("expected the final round to be in node 7's tree")
+	}
+	if len(finalRound.Mismatches) != 1 || finalRound.Mismatches[0].Index != 23*16+7 {
+		t.Fatalf("expected the only mismatch to be node 7's leaf but got %v", finalRound.Mismatches)
+	}
+	if finalRound.Mismatches[0].Submitted.Sum.Cmp(big.NewInt(5e18)) != 0 {
+		t.Errorf("expected the submitted leaf to have the proposer's voting power but got %s", finalRound.Mismatches[0].Submitted.Sum)
+	}
+}
+
+func TestSimulateChallengeGameRejectsDifferentShapes(t *testing.T) {
+	_, err := SimulateChallengeGame(createTestSnapshot(10), createTestSnapshot(20), 2)
+	if err == nil {
+		t.Error("expected an error for snapshots with different node counts")
+	}
+}
+
+func TestGetPollardMismatches(t *testing.T) {
+	tree := newNetworkVotingTree(createTestSnapshot(10), cfgtypes.Network_Mainnet, 2).VotingTree
+	_, pollardPtrs := tree.GetPollardForProposal()
+	pollard := derefNodes(pollardPtrs)
+	pollard[2].Sum = big.NewInt(1)
+
+	mismatches, err := tree.GetPollardMismatches(1, pollard)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(mismatches) != 1 || mismatches[0] != 6 {
+		t.Fatalf("expected index 6 to be the only mismatch but got %v", mismatches)
+	}
+
+	_, err = tree.GetPollardMismatches(1, pollard[:2])
+	if err == nil {
+		t.Error("expected an error for a pollard with the wrong size")
+	}
+}
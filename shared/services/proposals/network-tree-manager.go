@@ -83,6 +83,12 @@ func NewNetworkTreeManager(log *log.ColorLogger, cfg *config.RocketPoolConfig) (
 
 // Create a network voting tree from a voting info snapshot
 func (m *NetworkTreeManager) CreateNetworkVotingTree(snapshot *VotingInfoSnapshot, depthPerRound uint64) *NetworkVotingTree {
+	network := m.cfg.Smartnode.Network.Value.(cfgtypes.Network)
+	return newNetworkVotingTree(snapshot, network, depthPerRound)
+}
+
+// Create a network voting tree for the provided network from a voting info snapshot
+func newNetworkVotingTree(snapshot *VotingInfoSnapshot, network cfgtypes.Network, depthPerRound uint64) *NetworkVotingTree {
 	// Create a map of the voting power of each node, accounting for delegation
 	votingPower := map[common.Address]*big.Int{}
 	for _, info := range snapshot.Info {
@@ -113,7 +119,6 @@ func (m *NetworkTreeManager) CreateNetworkVotingTree(snapshot *VotingInfoSnapsho
 	}
 
 	// Make the tree
-	network := m.cfg.Smartnode.Network.Value.(cfgtypes.Network)
 	tree := CreateTreeFromLeaves(snapshot.BlockNumber, network, leaves, 1, depthPerRound)
 	return &NetworkVotingTree{
 		VotingTree: tree,
@@ -94,6 +94,12 @@ func NewNodeTreeManager(log *log.ColorLogger, cfg *config.RocketPoolConfig) (*No
 
 // Create a node voting tree from a voting info snapshot and the node's index
 func (m *NodeTreeManager) CreateNodeVotingTree(snapshot *VotingInfoSnapshot, rpNodeIndex uint64, networkTreeNodeIndex uint64, depthPerRound uint64) *NodeVotingTree {
+	network := m.cfg.Smartnode.Network.Value.(cfgtypes.Network)
+	return newNodeVotingTree(snapshot, rpNodeIndex, networkTreeNodeIndex, network, depthPerRound)
+}
+
+// Create a node voting tree for the provided network from a voting info snapshot and the node's index
+func newNodeVotingTree(snapshot *VotingInfoSnapshot, rpNodeIndex uint64, networkTreeNodeIndex uint64, network cfgtypes.Network, depthPerRound uint64) *NodeVotingTree {
 	var address *common.Address
 	if rpNodeIndex >= uint64(len(snapshot.Info)) {
 		address = &common.MaxAddress
@@ -117,7 +123,6 @@ func (m *NodeTreeManager) CreateNodeVotingTree(snapshot *VotingInfoSnapshot, rpN
 	}
 
 	// Make the tree
-	network := m.cfg.Smartnode.Network.Value.(cfgtypes.Network)
 	tree := CreateTreeFromLeaves(snapshot.BlockNumber, network, leaves, networkTreeNodeIndex, depthPerRound)
 	return &NodeVotingTree{
 		Address:    *address,
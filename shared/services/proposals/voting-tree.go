@@ -115,6 +115,37 @@ func (t *VotingTree) CheckForChallengeableArtifacts(virtualRootIndex uint64, pro
 	return 0, nil, nil, nil
 }
 
+// Compare a pollard used in a proposal / root submission with the corresponding pollard in this tree, getting the virtual indices of every mismatch
+func (t *VotingTree) GetPollardMismatches(virtualRootIndex uint64, proposedPollard []types.VotingTreeNode) ([]uint64, error) {
+	_, localPollard := t.generatePollard(virtualRootIndex)
+	if len(localPollard) != len(proposedPollard) {
+		return nil, fmt.Errorf("pollard size mismatch: local pollard = %d nodes, proposed pollard size = %d nodes", len(localPollard), len(proposedPollard))
+	}
+
+	mismatches := []uint64{}
+	firstPollardIndex := len(localPollard) // First index is just the length of the pollard row because it's 1-indexed
+	for i, localNode := range localPollard {
+		proposedNode := proposedPollard[i]
+		if localNode.Hash != proposedNode.Hash || localNode.Sum.Cmp(proposedNode.Sum) != 0 {
+			localIndex := uint64(firstPollardIndex + i)
+			mismatches = append(mismatches, t.getVirtualIndexFromLocalIndex(localIndex, virtualRootIndex))
+		}
+	}
+	return mismatches, nil
+}
+
+// Get the tree node with the provided virtual index
+func (t *VotingTree) GetNode(virtualIndex uint64) *types.VotingTreeNode {
+	return t.Nodes[t.getLocalIndexFromVirtualIndex(virtualIndex)-1] // 0-indexed
+}
+
+// Check if the pollard under the provided virtual index is made of the tree's leaf nodes
+func (t *VotingTree) isPollardAtLeaves(virtualIndex uint64) bool {
+	index := t.getLocalIndexFromVirtualIndex(virtualIndex)
+	rootLevel := uint64(math.Floor(math.Log2(float64(index))))
+	return rootLevel+t.DepthPerRound >= t.Depth
+}
+
 // Get the challenged node and a Merkle proof for it
 func (t *VotingTree) getArtifactsForChallenge(targetIndex uint64) (*types.VotingTreeNode, []*types.VotingTreeNode) {
 	// Get the target node
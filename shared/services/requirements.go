@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"os"
 	"sync"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/rocket-pool/smartnode/bindings/rocketpool"
 	"github.com/rocket-pool/smartnode/shared/services/alerting"
 	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/passwords"
 	"github.com/urfave/cli"
 )
 
@@ -35,6 +37,9 @@ var ethClientStatusRefreshInterval, _ = time.ParseDuration("60s")
 //
 
 func RequireNodeWallet(c *cli.Context) error {
+	if err := RequireNodeWalletUnlocked(c); err != nil {
+		return err
+	}
 	nodeWalletInitialized, err := getNodeWalletInitialized(c)
 	if err != nil {
 		return err
@@ -45,6 +50,17 @@ func RequireNodeWallet(c *cli.Context) error {
 	return nil
 }
 
+func RequireNodeWalletUnlocked(c *cli.Context) error {
+	locked, err := IsNodeWalletLocked(c)
+	if err != nil {
+		return err
+	}
+	if locked {
+		return passwords.ErrWalletLocked
+	}
+	return nil
+}
+
 func RequireEthClientSynced(c *cli.Context) error {
 	ethClientSynced, err := waitEthClientSynced(c, false, EthClientSyncTimeout)
 	if err != nil {
@@ -146,7 +162,15 @@ func WaitNodeHdPassword(c *cli.Context, verbose bool) error {
 			return nil
 		}
 		if verbose {
-			log.Printf("The node password has not been set, retrying in %s...\n", checkNodePasswordInterval.String())
+			pm, err := GetPasswordManager(c)
+			if err != nil {
+				return err
+			}
+			if pm.GetStorageMode() == passwords.StorageMode_Unlock {
+				log.Printf("The node wallet is locked; waiting for 'rocketpool wallet unlock', retrying in %s...\n", checkNodePasswordInterval.String())
+			} else {
+				log.Printf("The node password has not been set, retrying in %s...\n", checkNodePasswordInterval.String())
+			}
 		}
 		time.Sleep(checkNodePasswordInterval)
 	}
@@ -236,6 +260,20 @@ func getNodeHdPasswordSet(c *cli.Context) (bool, error) {
 	return pm.IsPasswordSet(), nil
 }
 
+// Check if the node wallet exists but can't be loaded until it's unlocked
+func IsNodeWalletLocked(c *cli.Context) (bool, error) {
+	cfg, err := GetConfig(c)
+	if err != nil {
+		return false, err
+	}
+	pm := getPasswordManager(cfg)
+	if !pm.IsLocked() {
+		return false, nil
+	}
+	_, err = os.Stat(os.ExpandEnv(cfg.Smartnode.GetWalletPath()))
+	return (err == nil), nil
+}
+
 // Check if the node wallet is initialized
 func getNodeWalletInitialized(c *cli.Context) (bool, error) {
 	w, err := GetWallet(c)
@@ -387,12 +425,12 @@ func checkBeaconClientStatus(bcMgr *BeaconClientManager) (bool, error) {
 
 	// Check the BC status
 	mgrStatus := bcMgr.CheckStatus()
-	if bcMgr.primaryReady {
+	if bcMgr.isPrimaryReady() {
 		return true, nil
 	}
 
 	// If the primary isn't synced but there's a fallback and it is, return true
-	if bcMgr.fallbackReady {
+	if bcMgr.isFallbackReady() {
 		if mgrStatus.PrimaryClientStatus.Error != "" {
 			log.Printf("Primary consensus client is unavailable (%s), using fallback consensus client...\n", mgrStatus.PrimaryClientStatus.Error)
 		} else {
@@ -0,0 +1,164 @@
+package rewards
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// The format of the checkpoint file; checkpoints in any other format are ignored
+	generatorCheckpointVersion uint64 = 1
+)
+
+// A tree generator's progress through the epochs of an interval, saved so generation can resume from it
+type GeneratorCheckpoint struct {
+	Version        uint64    `json:"version"`
+	Index          uint64    `json:"index"`
+	RulesetVersion uint64    `json:"rulesetVersion"`
+	Updated        time.Time `json:"updated"`
+
+	// The Beacon slot the interval's duties start at
+	ConsensusStartBlock uint64 `json:"consensusStartBlock"`
+
+	// A hash of the network state each validator's epoch processing depended on, by validator index
+	Validators map[string]common.Hash `json:"validators"`
+
+	// The first epoch that hasn't been processed yet
+	NextEpoch uint64 `json:"nextEpoch"`
+
+	// The progress of each minipool with missed or completed attestations
+	Minipools map[common.Address]*MinipoolCheckpoint `json:"minipools"`
+
+	// The slots whose duties can still be fulfilled by attestations in the next epoch
+	PendingSlots []*SlotCheckpoint `json:"pendingSlots"`
+
+	// The eligible withdrawals made by each minipool's validator so far
+	Withdrawals map[common.Address]*QuotedBigInt `json:"withdrawals"`
+}
+
+// A minipool's attestation performance as of a checkpoint
+type MinipoolCheckpoint struct {
+	MissingAttestationSlots []uint64            `json:"missingAttestationSlots"`
+	AttestationTallies      []*AttestationTally `json:"attestationTallies"`
+}
+
+// The outstanding attestation duties of a slot as of a checkpoint
+type SlotCheckpoint struct {
+	Index          uint64                            `json:"index"`
+	CommitteeSizes map[uint64]int                    `json:"committeeSizes"`
+	Committees     map[uint64]map[int]common.Address `json:"committees"`
+}
+
+// Saves and loads the checkpoint of a single rewards interval
+type Checkpointer struct {
+	path string
+
+	// How often to save the generator's progress, in epochs
+	interval uint64
+}
+
+// Create a new checkpointer for the file at the provided path, saving progress every interval epochs
+func NewCheckpointer(path string, interval uint64) *Checkpointer {
+	return &Checkpointer{
+		path:     path,
+		interval: interval,
+	}
+}
+
+// Get the path of the checkpoint file
+func (c *Checkpointer) GetPath() string {
+	return c.path
+}
+
+// Load the checkpoint. Returns false if there isn't one.
+func (c *Checkpointer) Load() (*GeneratorCheckpoint, bool, error) {
+	file, err := os.Open(c.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("error opening rewards checkpoint [%s]: %w", c.path, err)
+	}
+	defer file.Close()
+
+	reader, err := gzip.NewReader(file)
+	if err != nil {
+		return nil, false, fmt.Errorf("error decompressing rewards checkpoint [%s]: %w", c.path, err)
+	}
+	defer reader.Close()
+
+	var checkpoint GeneratorCheckpoint
+	err = json.NewDecoder(reader).Decode(&checkpoint)
+	if err != nil {
+		return nil, false, fmt.Errorf("error decoding rewards checkpoint [%s]: %w", c.path, err)
+	}
+	return &checkpoint, true, nil
+}
+
+// Save the checkpoint, replacing the previous one
+func (c *Checkpointer) Save(checkpoint *GeneratorCheckpoint) error {
+	err := os.MkdirAll(filepath.Dir(c.path), 0755)
+	if err != nil {
+		return fmt.Errorf("error creating rewards checkpoint folder: %w", err)
+	}
+
+	// Write to a temporary file first so a crash can't leave a partial checkpoint behind
+	checkpoint.Version = generatorCheckpointVersion
+	checkpoint.Updated = time.Now()
+	tempPath := c.path + ".tmp"
+	file, err := os.OpenFile(tempPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("error creating rewards checkpoint file: %w", err)
+	}
+	writer := gzip.NewWriter(file)
+	err = json.NewEncoder(writer).Encode(checkpoint)
+	if err == nil {
+		err = writer.Close()
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tempPath)
+		return fmt.Errorf("error writing rewards checkpoint for interval %d: %w", checkpoint.Index, err)
+	}
+	err = os.Rename(tempPath, c.path)
+	if err != nil {
+		return fmt.Errorf("error saving rewards checkpoint for interval %d: %w", checkpoint.Index, err)
+	}
+	return nil
+}
+
+// Delete the checkpoint, if there is one
+func (c *Checkpointer) Delete() error {
+	err := os.Remove(c.path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("error deleting rewards checkpoint [%s]: %w", c.path, err)
+	}
+	return nil
+}
+
+// Check if a checkpoint was made for the same interval and ruleset as a new generation run
+func (cp *GeneratorCheckpoint) isCompatible(index uint64, rulesetVersion uint64, consensusStartBlock uint64) error {
+	if cp.Version != generatorCheckpointVersion {
+		return fmt.Errorf("it has format version %d instead of %d", cp.Version, generatorCheckpointVersion)
+	}
+	if cp.Index != index {
+		return fmt.Errorf("it is for interval %d instead of %d", cp.Index, index)
+	}
+	if cp.RulesetVersion != rulesetVersion {
+		return fmt.Errorf("it was made with ruleset v%d instead of v%d", cp.RulesetVersion, rulesetVersion)
+	}
+	if cp.ConsensusStartBlock != consensusStartBlock {
+		return fmt.Errorf("it starts at slot %d instead of %d", cp.ConsensusStartBlock, consensusStartBlock)
+	}
+	return nil
+}
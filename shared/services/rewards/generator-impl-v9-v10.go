@@ -11,6 +11,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ipfs/go-cid"
 	"github.com/rocket-pool/smartnode/bindings/rewards"
 	rptypes "github.com/rocket-pool/smartnode/bindings/types"
@@ -63,6 +64,12 @@ type treeGeneratorImpl_v9_v10 struct {
 	// fields for RPIP-62 bonus calculations
 	// Withdrawals made by a minipool's validator.
 	minipoolWithdrawals map[common.Address]*big.Int
+
+	// Saves the progress of the epoch processing so it can be resumed later, if set
+	checkpointer *Checkpointer
+
+	// The inputs each validator's epoch processing depends on, used to check if a checkpoint can be resumed from
+	validatorInputs map[string]common.Hash
 }
 
 // Create a new tree generator
@@ -109,6 +116,11 @@ func (r *treeGeneratorImpl_v9_v10) getRulesetVersion() uint64 {
 	return r.rewardsFile.RulesetVersion
 }
 
+// Save the epoch processing progress with the provided checkpointer, resuming from its last checkpoint if possible
+func (r *treeGeneratorImpl_v9_v10) setCheckpointer(checkpointer *Checkpointer) {
+	r.checkpointer = checkpointer
+}
+
 func (r *treeGeneratorImpl_v9_v10) generateTree(rp RewardsExecutionClient, networkName string, previousRewardsPoolAddresses []common.Address, bc RewardsBeaconClient) (*GenerateTreeResult, error) {
 
 	r.log.Printlnf("%s Generating tree using Ruleset v%d.", r.logPrefix, r.rewardsFile.RulesetVersion)
@@ -184,6 +196,14 @@ func (r *treeGeneratorImpl_v9_v10) generateTree(rp RewardsExecutionClient, netwo
 		})
 	}
 
+	// The interval is done, so its checkpoint isn't needed anymore
+	if r.checkpointer != nil {
+		err = r.checkpointer.Delete()
+		if err != nil {
+			r.log.Printlnf("%s WARNING: %s", r.logPrefix, err.Error())
+		}
+	}
+
 	return &GenerateTreeResult{
 		RewardsFile:             r.rewardsFile,
 		InvalidNetworkNodes:     r.invalidNetworkNodes,
@@ -504,34 +524,18 @@ func (r *treeGeneratorImpl_v9_v10) calculateEthRewards(checkBeaconPerformance bo
 	} else {
 		// Attestation processing is disabled, just give each minipool 1 good attestation and complete slot activity so they're all scored the same
 		// Used for approximating rETH's share during balances calculation
-		validatorReq := big.NewInt(0).Set(thirtyTwoEth)
 		for _, nodeInfo := range r.nodeDetails {
 			// Check if the node is currently opted in for simplicity
 			if nodeInfo.IsEligible && nodeInfo.IsOptedIn && r.elEndTime.After(nodeInfo.OptInTime) {
-				eligibleBorrowedEth := nodeInfo.EligibleBorrowedEth
-				_, percentOfBorrowedEth := r.networkState.GetStakedRplValueInEthAndPercentOfBorrowedEth(eligibleBorrowedEth, nodeInfo.RplStake)
 				for _, minipool := range nodeInfo.Minipools {
-					minipool.CompletedAttestations = map[uint64]bool{0: true}
-
 					// Make up an attestation
 					details := r.networkState.MinipoolDetailsByAddress[minipool.Address]
 					bond, fee := details.GetMinipoolBondAndNodeFee(r.elEndTime)
-					if r.rewardsFile.RulesetVersion >= 10 {
-						fee = fees.GetMinipoolFeeWithBonus(bond, fee, percentOfBorrowedEth)
-					}
-					minipoolScore := big.NewInt(0).Sub(oneEth, fee) // 1 - fee
-					minipoolScore.Mul(minipoolScore, bond)          // Multiply by bond
-					minipoolScore.Div(minipoolScore, validatorReq)  // Divide by 32 to get the bond as a fraction of a total validator
-					minipoolScore.Add(minipoolScore, fee)           // Total = fee + (bond/32)(1 - fee)
-
-					// Add it to the minipool's score and the total score
-					minipool.AttestationScore.Add(&minipool.AttestationScore.Int, minipoolScore)
-					r.totalAttestationScore.Add(r.totalAttestationScore, minipoolScore)
-
-					r.successfulAttestations++
+					minipool.tallyAttestation(bond, fee)
 				}
 			}
 		}
+		r.scoreAttestations()
 	}
 
 	// Determine how much ETH each node gets and how much the pool stakers get
@@ -568,7 +572,7 @@ func (r *treeGeneratorImpl_v9_v10) calculateEthRewards(checkBeaconPerformance bo
 
 			// Add minipool rewards to the JSON
 			for _, minipoolInfo := range nodeInfo.Minipools {
-				successfulAttestations := uint64(len(minipoolInfo.CompletedAttestations))
+				successfulAttestations := minipoolInfo.getCompletedAttestationCount()
 				missingAttestations := uint64(len(minipoolInfo.MissingAttestationSlots))
 				performance := &SmoothingPoolMinipoolPerformance_v2{
 					Pubkey:                  minipoolInfo.ValidatorPubkey.Hex(),
@@ -710,7 +714,7 @@ func (r *treeGeneratorImpl_v9_v10) calculateNodeRewards() (*big.Int, *big.Int, *
 			continue
 		}
 		for _, minipool := range nodeInfo.Minipools {
-			if len(minipool.CompletedAttestations)+len(minipool.MissingAttestationSlots) == 0 || !minipool.WasActive {
+			if minipool.getCompletedAttestationCount()+uint64(len(minipool.MissingAttestationSlots)) == 0 || !minipool.WasActive {
 				// Ignore minipools that weren't active for the interval
 				minipool.WasActive = false
 				minipool.MinipoolShare = big.NewInt(0)
@@ -792,16 +796,46 @@ func (r *treeGeneratorImpl_v9_v10) processAttestationsBalancesAndWithdrawalsForI
 		return err
 	}
 
+	// Resume from the last checkpoint if there's a compatible one
+	firstEpoch, err := r.restoreCheckpoint(startEpoch, endEpoch)
+	if err != nil {
+		return err
+	}
+
 	// Check all of the attestations for each epoch
-	r.log.Printlnf("%s Checking participation of %d minipools for epochs %d to %d", r.logPrefix, len(r.validatorIndexMap), startEpoch, endEpoch)
+	r.log.Printlnf("%s Checking participation of %d minipools for epochs %d to %d", r.logPrefix, len(r.validatorIndexMap), firstEpoch, endEpoch)
 	r.log.Printlnf("%s NOTE: this will take a long time, progress is reported every 100 epochs", r.logPrefix)
 
+	reportStartTime := time.Now()
+	err = r.processEpochs(firstEpoch, endEpoch)
+	if err != nil {
+		return err
+	}
+
+	// Check the epoch after the end of the interval for any lingering attestations
+	epoch := endEpoch + 1
+	err = r.processEpoch(false, epoch)
+	if err != nil {
+		return err
+	}
+
+	// Now that all of the attestations are in, score them
+	r.scoreAttestations()
+
+	r.log.Printlnf("%s Finished participation check (total time = %s)", r.logPrefix, time.Since(reportStartTime))
+	return nil
+
+}
+
+// Process the duties and attestations of a range of epochs, saving checkpoints along the way if enabled
+func (r *treeGeneratorImpl_v9_v10) processEpochs(firstEpoch uint64, lastEpoch uint64) error {
+
 	epochsDone := 0
 	reportStartTime := time.Now()
-	for epoch := startEpoch; epoch < endEpoch+1; epoch++ {
+	for epoch := firstEpoch; epoch < lastEpoch+1; epoch++ {
 		if epochsDone == 100 {
 			timeTaken := time.Since(reportStartTime)
-			r.log.Printlnf("%s On Epoch %d of %d (%.2f%%)... (%s so far)", r.logPrefix, epoch, endEpoch, float64(epoch-startEpoch)/float64(endEpoch-startEpoch)*100.0, timeTaken)
+			r.log.Printlnf("%s On Epoch %d of %d (%.2f%%)... (%s so far)", r.logPrefix, epoch, lastEpoch, float64(epoch-firstEpoch)/float64(lastEpoch-firstEpoch)*100.0, timeTaken)
 			epochsDone = 0
 		}
 
@@ -810,21 +844,54 @@ func (r *treeGeneratorImpl_v9_v10) processAttestationsBalancesAndWithdrawalsForI
 			return err
 		}
 
-		epochsDone++
-	}
+		// Save the progress every checkpoint interval; the last epoch is left to the caller
+		if r.checkpointer != nil && r.checkpointer.interval > 0 && epoch < lastEpoch && (epoch+1)%r.checkpointer.interval == 0 {
+			err = r.saveCheckpoint(epoch + 1)
+			if err != nil {
+				r.log.Printlnf("%s WARNING: %s", r.logPrefix, err.Error())
+			}
+		}
 
-	// Check the epoch after the end of the interval for any lingering attestations
-	epoch := endEpoch + 1
-	err = r.processEpoch(false, epoch)
-	if err != nil {
-		return err
+		epochsDone++
 	}
 
-	r.log.Printlnf("%s Finished participation check (total time = %s)", r.logPrefix, time.Since(reportStartTime))
 	return nil
 
 }
 
+// Score the attestations each minipool completed, now that the final RPL stake of each node is known
+func (r *treeGeneratorImpl_v9_v10) scoreAttestations() {
+	for _, nodeDetails := range r.nodeDetails {
+		if !nodeDetails.IsEligible {
+			continue
+		}
+
+		eligibleBorrowedEth := nodeDetails.EligibleBorrowedEth
+		_, percentOfBorrowedEth := r.networkState.GetStakedRplValueInEthAndPercentOfBorrowedEth(eligibleBorrowedEth, nodeDetails.RplStake)
+		for _, minipool := range nodeDetails.Minipools {
+			for _, tally := range minipool.AttestationTallies {
+				bond := &tally.Bond.Int
+				fee := &tally.Fee.Int
+				if r.rewardsFile.RulesetVersion >= 10 {
+					fee = fees.GetMinipoolFeeWithBonus(bond, fee, percentOfBorrowedEth)
+				}
+
+				// Get the pseudoscore for one of these attestations
+				minipoolScore := big.NewInt(0).Sub(oneEth, fee) // 1 - fee
+				minipoolScore.Mul(minipoolScore, bond)          // Multiply by bond
+				minipoolScore.Div(minipoolScore, thirtyTwoEth)  // Divide by 32 to get the bond as a fraction of a total validator
+				minipoolScore.Add(minipoolScore, fee)           // Total = fee + (bond/32)(1 - fee)
+				minipoolScore.Mul(minipoolScore, big.NewInt(int64(tally.Count)))
+
+				// Add it to the minipool's score and the total score
+				minipool.AttestationScore.Add(&minipool.AttestationScore.Int, minipoolScore)
+				r.totalAttestationScore.Add(r.totalAttestationScore, minipoolScore)
+				r.successfulAttestations += tally.Count
+			}
+		}
+	}
+}
+
 // Process an epoch, optionally getting the duties for all eligible minipools in it and checking each one's attestation performance
 func (r *treeGeneratorImpl_v9_v10) processEpoch(duringInterval bool, epoch uint64) error {
 
@@ -979,29 +1046,10 @@ func (r *treeGeneratorImpl_v9_v10) checkAttestations(attestations []beacon.Attes
 					continue
 				}
 
-				eligibleBorrowedEth := nodeDetails.EligibleBorrowedEth
-				_, percentOfBorrowedEth := r.networkState.GetStakedRplValueInEthAndPercentOfBorrowedEth(eligibleBorrowedEth, nodeDetails.RplStake)
-
-				// Mark this duty as completed
-				validator.CompletedAttestations[attestation.SlotIndex] = true
-
-				// Get the pseudoscore for this attestation
+				// Mark this duty as completed; it's scored once the interval is done since the score depends on the node's final RPL stake
 				details := r.networkState.MinipoolDetailsByAddress[validator.Address]
 				bond, fee := details.GetMinipoolBondAndNodeFee(blockTime)
-
-				if r.rewardsFile.RulesetVersion >= 10 {
-					fee = fees.GetMinipoolFeeWithBonus(bond, fee, percentOfBorrowedEth)
-				}
-
-				minipoolScore := big.NewInt(0).Sub(oneEth, fee) // 1 - fee
-				minipoolScore.Mul(minipoolScore, bond)          // Multiply by bond
-				minipoolScore.Div(minipoolScore, thirtyTwoEth)  // Divide by 32 to get the bond as a fraction of a total validator
-				minipoolScore.Add(minipoolScore, fee)           // Total = fee + (bond/32)(1 - fee)
-
-				// Add it to the minipool's score and the total score
-				validator.AttestationScore.Add(&validator.AttestationScore.Int, minipoolScore)
-				r.totalAttestationScore.Add(r.totalAttestationScore, minipoolScore)
-				r.successfulAttestations++
+				validator.tallyAttestation(bond, fee)
 			}
 		}
 	}
@@ -1198,7 +1246,6 @@ func (r *treeGeneratorImpl_v9_v10) getSmoothingPoolNodeDetails() error {
 							//MissedAttestations:      0,
 							//GoodAttestations:        0,
 							MissingAttestationSlots: map[uint64]bool{},
-							CompletedAttestations:   map[uint64]bool{},
 							WasActive:               true,
 							AttestationScore:        NewQuotedBigInt(0),
 							NodeOperatorBond:        nativeMinipoolDetails.NodeDepositBalance,
@@ -1315,3 +1362,296 @@ func (r *treeGeneratorImpl_v9_v10) getBlocksAndTimesForInterval(previousInterval
 func (r *treeGeneratorImpl_v9_v10) saveFiles(smartnode *config.SmartnodeConfig, treeResult *GenerateTreeResult, nodeTrusted bool) (cid.Cid, map[string]cid.Cid, error) {
 	return saveRewardsArtifacts(smartnode, treeResult, nodeTrusted)
 }
+
+// Process the epochs of the interval that have finished so far and save the progress in a checkpoint,
+// so generating the tree at the end of the interval only needs to process the remaining epochs
+func (r *treeGeneratorImpl_v9_v10) updateCheckpoint(rp RewardsExecutionClient, networkName string, previousRewardsPoolAddresses []common.Address, bc RewardsBeaconClient) error {
+
+	if r.checkpointer == nil {
+		return fmt.Errorf("checkpoints haven't been enabled")
+	}
+	if r.rewardsFile.Index == 0 {
+		// The first interval doesn't have Smoothing Pool rewards, so there's nothing to checkpoint
+		return nil
+	}
+	r.log.Printlnf("%s Updating rewards checkpoint using Ruleset v%d.", r.logPrefix, r.rewardsFile.RulesetVersion)
+
+	// Provision some struct params
+	r.rp = rp
+	r.previousRewardsPoolAddresses = previousRewardsPoolAddresses
+	r.bc = bc
+	r.rewardsFile.Network, _ = ssz_types.NetworkFromString(networkName)
+	r.beaconConfig = r.networkState.BeaconConfig
+	r.slotsPerEpoch = r.beaconConfig.SlotsPerEpoch
+	r.genesisTime = time.Unix(int64(r.beaconConfig.GenesisTime), 0)
+	r.opts = &bind.CallOpts{
+		BlockNumber: r.elSnapshotHeader.Number,
+	}
+
+	// Get the interval's start and the nodes that are eligible so far
+	previousIntervalEvent, err := r.rp.GetRewardSnapshotEvent(r.previousRewardsPoolAddresses, r.rewardsFile.Index-1, r.opts)
+	if err != nil {
+		return err
+	}
+	_, err = r.getBlocksAndTimesForInterval(previousIntervalEvent)
+	if err != nil {
+		return err
+	}
+	err = r.getSmoothingPoolNodeDetails()
+	if err != nil {
+		return err
+	}
+	r.intervalDutiesInfo = &IntervalDutiesInfo{
+		Index: r.rewardsFile.Index,
+		Slots: map[uint64]*SlotInfo{},
+	}
+	err = r.createMinipoolIndexMap()
+	if err != nil {
+		return err
+	}
+
+	// Only process epochs that are complete as of the snapshot
+	startEpoch := r.rewardsFile.ConsensusStartBlock / r.slotsPerEpoch
+	lastEpoch := (r.rewardsFile.ConsensusEndBlock+1)/r.slotsPerEpoch - 1
+	if lastEpoch < startEpoch {
+		r.log.Printlnf("%s The interval doesn't have any complete epochs yet.", r.logPrefix)
+		return nil
+	}
+	firstEpoch, err := r.restoreCheckpoint(startEpoch, lastEpoch+1)
+	if err != nil {
+		return err
+	}
+	if firstEpoch > lastEpoch {
+		r.log.Printlnf("%s The checkpoint is already up to date.", r.logPrefix)
+		return nil
+	}
+
+	r.log.Printlnf("%s Checking participation of %d minipools for epochs %d to %d", r.logPrefix, len(r.validatorIndexMap), firstEpoch, lastEpoch)
+	reportStartTime := time.Now()
+	err = r.processEpochs(firstEpoch, lastEpoch)
+	if err != nil {
+		return err
+	}
+	err = r.saveCheckpoint(lastEpoch + 1)
+	if err != nil {
+		return err
+	}
+
+	r.log.Printlnf("%s Finished updating the checkpoint (total time = %s)", r.logPrefix, time.Since(reportStartTime))
+	return nil
+
+}
+
+// Get a hash of the network state that each validator's epoch processing depends on.
+// The validator's withdrawable epoch isn't included since it can only change from the far future to a future epoch,
+// which doesn't affect the withdrawals that have already been processed.
+func (r *treeGeneratorImpl_v9_v10) getValidatorInputs() map[string]common.Hash {
+	inputs := make(map[string]common.Hash, len(r.validatorIndexMap))
+	for validatorIndex, minipoolInfo := range r.validatorIndexMap {
+		nnd := r.networkState.NodeDetailsByAddress[minipoolInfo.NodeAddress]
+		mpd := r.networkState.MinipoolDetailsByAddress[minipoolInfo.Address]
+		optedIn := byte(0)
+		if nnd.SmoothingPoolRegistrationState {
+			optedIn = 1
+		}
+		inputs[validatorIndex] = crypto.Keccak256Hash(
+			minipoolInfo.Address.Bytes(),
+			minipoolInfo.NodeAddress.Bytes(),
+			[]byte{optedIn, byte(mpd.Status)},
+			getCheckpointBigBytes(nnd.SmoothingPoolRegistrationChanged),
+			getCheckpointBigBytes(mpd.StatusTime),
+			getCheckpointBigBytes(mpd.NodeDepositBalance),
+			getCheckpointBigBytes(mpd.NodeFee),
+			getCheckpointBigBytes(mpd.LastBondReductionTime),
+			getCheckpointBigBytes(mpd.LastBondReductionPrevValue),
+			getCheckpointBigBytes(mpd.LastBondReductionPrevNodeFee),
+		)
+	}
+	return inputs
+}
+
+// Check if a checkpoint can be resumed from with the current network state
+func (r *treeGeneratorImpl_v9_v10) checkCheckpoint(checkpoint *GeneratorCheckpoint, startEpoch uint64, maxNextEpoch uint64) error {
+	err := checkpoint.isCompatible(r.rewardsFile.Index, r.rewardsFile.RulesetVersion, r.rewardsFile.ConsensusStartBlock)
+	if err != nil {
+		return err
+	}
+	if checkpoint.NextEpoch < startEpoch || checkpoint.NextEpoch > maxNextEpoch {
+		return fmt.Errorf("it ends at epoch %d, which is outside of epochs %d to %d", checkpoint.NextEpoch, startEpoch, maxNextEpoch)
+	}
+
+	// Every validator that was processed must still have the same inputs
+	for validatorIndex, inputs := range checkpoint.Validators {
+		currentInputs, exists := r.validatorInputs[validatorIndex]
+		if !exists {
+			return fmt.Errorf("validator %s is no longer eligible", validatorIndex)
+		}
+		if currentInputs != inputs {
+			return fmt.Errorf("the minipool, node or Smoothing Pool status of validator %s has changed", validatorIndex)
+		}
+	}
+
+	// Validators that weren't processed can't have had any duties in the processed epochs
+	lastProcessedTime := r.beaconConfig.GetSlotTime(checkpoint.NextEpoch*r.slotsPerEpoch - 1)
+	for validatorIndex, minipoolInfo := range r.validatorIndexMap {
+		if _, exists := checkpoint.Validators[validatorIndex]; exists {
+			continue
+		}
+		nnd := r.networkState.NodeDetailsByAddress[minipoolInfo.NodeAddress]
+		mpd := r.networkState.MinipoolDetailsByAddress[minipoolInfo.Address]
+		if mpd.Status != rptypes.Staking || time.Unix(mpd.StatusTime.Int64(), 0).After(lastProcessedTime) {
+			continue
+		}
+		if nnd.SmoothingPoolRegistrationState && time.Unix(nnd.SmoothingPoolRegistrationChanged.Int64(), 0).After(lastProcessedTime) {
+			continue
+		}
+		return fmt.Errorf("validator %s may have had duties in the processed epochs", validatorIndex)
+	}
+	return nil
+}
+
+// Restore the epoch processing progress from the last checkpoint if there is a compatible one, returning the first epoch that still needs to be processed
+func (r *treeGeneratorImpl_v9_v10) restoreCheckpoint(startEpoch uint64, maxNextEpoch uint64) (uint64, error) {
+	if r.checkpointer == nil {
+		return startEpoch, nil
+	}
+	r.validatorInputs = r.getValidatorInputs()
+
+	checkpoint, exists, err := r.checkpointer.Load()
+	if err != nil {
+		r.log.Printlnf("%s WARNING: %s; starting from the beginning of the interval.", r.logPrefix, err.Error())
+		return startEpoch, nil
+	}
+	if !exists {
+		return startEpoch, nil
+	}
+	err = r.checkCheckpoint(checkpoint, startEpoch, maxNextEpoch)
+	if err != nil {
+		r.log.Printlnf("%s Ignoring the rewards checkpoint because %s; starting from the beginning of the interval.", r.logPrefix, err.Error())
+		return startEpoch, nil
+	}
+
+	// Restore each minipool's attestation performance
+	minipools := make(map[common.Address]*MinipoolInfo, len(r.validatorIndexMap))
+	for _, minipoolInfo := range r.validatorIndexMap {
+		minipools[minipoolInfo.Address] = minipoolInfo
+	}
+	for address, minipoolCheckpoint := range checkpoint.Minipools {
+		minipoolInfo, exists := minipools[address]
+		if !exists {
+			return 0, fmt.Errorf("rewards checkpoint has minipool %s which isn't eligible", address.Hex())
+		}
+		for _, slot := range minipoolCheckpoint.MissingAttestationSlots {
+			minipoolInfo.MissingAttestationSlots[slot] = true
+		}
+		minipoolInfo.AttestationTallies = minipoolCheckpoint.AttestationTallies
+	}
+
+	// Restore the duties that can still be attested to
+	for _, slotCheckpoint := range checkpoint.PendingSlots {
+		slotInfo := &SlotInfo{
+			Index:          slotCheckpoint.Index,
+			Committees:     map[uint64]*CommitteeInfo{},
+			CommitteeSizes: slotCheckpoint.CommitteeSizes,
+		}
+		for committeeIndex, positions := range slotCheckpoint.Committees {
+			committee := &CommitteeInfo{
+				Index:     committeeIndex,
+				Positions: map[int]*MinipoolInfo{},
+			}
+			for position, address := range positions {
+				minipoolInfo, exists := minipools[address]
+				if !exists {
+					return 0, fmt.Errorf("rewards checkpoint has a duty for minipool %s which isn't eligible", address.Hex())
+				}
+				committee.Positions[position] = minipoolInfo
+			}
+			slotInfo.Committees[committeeIndex] = committee
+		}
+		r.intervalDutiesInfo.Slots[slotInfo.Index] = slotInfo
+	}
+
+	// Restore the withdrawals
+	for address, amount := range checkpoint.Withdrawals {
+		r.minipoolWithdrawals[address] = big.NewInt(0).Set(&amount.Int)
+	}
+
+	r.log.Printlnf("%s Resuming from the rewards checkpoint at epoch %d (saved %s).", r.logPrefix, checkpoint.NextEpoch, checkpoint.Updated.Format(time.RFC822))
+	return checkpoint.NextEpoch, nil
+}
+
+// Save the epoch processing progress, up to but not including the provided epoch, in a checkpoint
+func (r *treeGeneratorImpl_v9_v10) saveCheckpoint(nextEpoch uint64) error {
+	checkpoint := &GeneratorCheckpoint{
+		Index:               r.rewardsFile.Index,
+		RulesetVersion:      r.rewardsFile.RulesetVersion,
+		ConsensusStartBlock: r.rewardsFile.ConsensusStartBlock,
+		Validators:          r.validatorInputs,
+		NextEpoch:           nextEpoch,
+		Minipools:           map[common.Address]*MinipoolCheckpoint{},
+		PendingSlots:        []*SlotCheckpoint{},
+		Withdrawals:         map[common.Address]*QuotedBigInt{},
+	}
+
+	// Add each minipool's attestation performance
+	for _, minipoolInfo := range r.validatorIndexMap {
+		if len(minipoolInfo.MissingAttestationSlots) == 0 && len(minipoolInfo.AttestationTallies) == 0 {
+			continue
+		}
+		minipoolCheckpoint := &MinipoolCheckpoint{
+			MissingAttestationSlots: make([]uint64, 0, len(minipoolInfo.MissingAttestationSlots)),
+			AttestationTallies:      minipoolInfo.AttestationTallies,
+		}
+		for slot := range minipoolInfo.MissingAttestationSlots {
+			minipoolCheckpoint.MissingAttestationSlots = append(minipoolCheckpoint.MissingAttestationSlots, slot)
+		}
+		sort.Slice(minipoolCheckpoint.MissingAttestationSlots, func(i, j int) bool {
+			return minipoolCheckpoint.MissingAttestationSlots[i] < minipoolCheckpoint.MissingAttestationSlots[j]
+		})
+		checkpoint.Minipools[minipoolInfo.Address] = minipoolCheckpoint
+	}
+
+	// Add the duties that can still be attested to by attestations in the next epoch
+	firstPendingSlot := (nextEpoch - 1) * r.slotsPerEpoch
+	for slotIndex, slotInfo := range r.intervalDutiesInfo.Slots {
+		if slotIndex < firstPendingSlot || len(slotInfo.Committees) == 0 {
+			continue
+		}
+		slotCheckpoint := &SlotCheckpoint{
+			Index:          slotIndex,
+			CommitteeSizes: slotInfo.CommitteeSizes,
+			Committees:     map[uint64]map[int]common.Address{},
+		}
+		for committeeIndex, committee := range slotInfo.Committees {
+			positions := map[int]common.Address{}
+			for position, minipoolInfo := range committee.Positions {
+				positions[position] = minipoolInfo.Address
+			}
+			slotCheckpoint.Committees[committeeIndex] = positions
+		}
+		checkpoint.PendingSlots = append(checkpoint.PendingSlots, slotCheckpoint)
+	}
+	sort.Slice(checkpoint.PendingSlots, func(i, j int) bool {
+		return checkpoint.PendingSlots[i].Index < checkpoint.PendingSlots[j].Index
+	})
+
+	// Add the withdrawals
+	for address, amount := range r.minipoolWithdrawals {
+		checkpoint.Withdrawals[address] = QuotedBigIntFromBigInt(amount)
+	}
+
+	err := r.checkpointer.Save(checkpoint)
+	if err != nil {
+		return err
+	}
+	r.log.Printlnf("%s Saved rewards checkpoint at epoch %d.", r.logPrefix, nextEpoch)
+	return nil
+}
+
+// Get the bytes of a big.Int for hashing, treating nil as zero
+func getCheckpointBigBytes(value *big.Int) []byte {
+	if value == nil {
+		return common.Hash{}.Bytes()
+	}
+	return common.BigToHash(value).Bytes()
+}
@@ -113,6 +113,12 @@ type treeGeneratorImpl interface {
 	saveFiles(smartnode *config.SmartnodeConfig, treeResult *GenerateTreeResult, nodeTrusted bool) (cid.Cid, map[string]cid.Cid, error)
 }
 
+// A tree generator that can save its progress through the interval in checkpoints
+type checkpointingTreeGeneratorImpl interface {
+	setCheckpointer(checkpointer *Checkpointer)
+	updateCheckpoint(rp RewardsExecutionClient, networkName string, previousRewardsPoolAddresses []common.Address, bc RewardsBeaconClient) error
+}
+
 func NewTreeGenerator(logger *log.ColorLogger, logPrefix string, rp RewardsExecutionClient, cfg *config.RocketPoolConfig, bc beacon.Client, index uint64, startTime time.Time, endTime time.Time, snapshotEnd *SnapshotEnd, elSnapshotHeader *types.Header, intervalsPassed uint64, state *state.NetworkState) (*TreeGenerator, error) {
 	t := &TreeGenerator{
 		logger:           logger,
@@ -259,6 +265,27 @@ func (t *TreeGenerator) ApproximateStakerShareOfSmoothingPoolWithRuleset(ruleset
 	return info.generator.approximateStakerShareOfSmoothingPool(t.rp, fmt.Sprint(t.cfg.Smartnode.Network.Value), t.bc)
 }
 
+// Save the progress of tree generation in a checkpoint file at the provided path every checkpointInterval epochs.
+// Generation resumes from the checkpoint if it's compatible with the interval, and the file is deleted once the tree is generated.
+func (t *TreeGenerator) EnableCheckpoints(path string, checkpointInterval uint64) {
+	checkpointer := NewCheckpointer(path, checkpointInterval)
+	for _, info := range t.rewardsIntervalInfos {
+		if generator, ok := info.generator.(checkpointingTreeGeneratorImpl); ok {
+			generator.setCheckpointer(checkpointer)
+		}
+	}
+}
+
+// Process the epochs of the interval that have finished as of the snapshot and save them in the checkpoint, without generating the tree.
+// Requires checkpoints to be enabled.
+func (t *TreeGenerator) UpdateCheckpoint() error {
+	generator, ok := t.generatorImpl.(checkpointingTreeGeneratorImpl)
+	if !ok {
+		return fmt.Errorf("ruleset v%d does not support checkpoints", t.generatorImpl.getRulesetVersion())
+	}
+	return generator.updateCheckpoint(t.rp, fmt.Sprint(t.cfg.Smartnode.Network.Value), t.cfg.Smartnode.GetPreviousRewardsPoolAddresses(), t.bc)
+}
+
 func (t *TreeGenerator) SaveFiles(treeResult *GenerateTreeResult, nodeTrusted bool) (cid.Cid, map[string]cid.Cid, error) {
 	return t.generatorImpl.saveFiles(t.cfg.Smartnode, treeResult, nodeTrusted)
 }
@@ -7,6 +7,7 @@ package rewards
 import (
 	"fmt"
 	"math/big"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -862,3 +863,110 @@ func TestMockWithdrawableEpoch(tt *testing.T) {
 		t.Fatalf("Node two minipool one consensus income does not match expected value: %s != %d", perfTwo.GetConsensusIncome().String(), 1000000000000000000)
 	}
 }
+
+func TestMockCheckpointResume(tt *testing.T) {
+
+	history := test.NewDefaultMockHistory()
+	state := history.GetEndNetworkState()
+
+	t := newV8Test(tt, state.NetworkDetails.RewardIndex)
+
+	t.bc.SetState(state)
+
+	consensusStartBlock := history.GetConsensusStartBlock()
+	executionStartBlock := history.GetExecutionStartBlock()
+	consensusEndBlock := history.GetConsensusEndBlock()
+	executionEndBlock := history.GetExecutionEndBlock()
+
+	logger := log.NewColorLogger(color.Faint)
+
+	t.rp.SetRewardSnapshotEvent(history.GetPreviousRewardSnapshotEvent())
+	t.bc.SetBeaconBlock(fmt.Sprint(consensusStartBlock-1), beacon.BeaconBlock{ExecutionBlockNumber: executionStartBlock - 1})
+	t.bc.SetBeaconBlock(fmt.Sprint(consensusStartBlock), beacon.BeaconBlock{ExecutionBlockNumber: executionStartBlock})
+	t.rp.SetHeaderByNumber(big.NewInt(int64(executionStartBlock)), &types.Header{Time: uint64(history.GetStartTime().Unix())})
+
+	for _, validator := range state.MinipoolValidatorDetails {
+		t.bc.SetMinipoolPerformance(validator.Index, make([]uint64, 0))
+	}
+	nodeSummary := history.GetNodeSummary()
+	for _, node := range nodeSummary["single_eight_eth_opted_in_quarter"] {
+		node.Minipools[0].SPWithdrawals = eth.EthToWei(0.75)
+	}
+	history.SetWithdrawals(t.bc)
+
+	newGenerator := func(name string, snapshotSlot uint64) *treeGeneratorImpl_v9_v10 {
+		return newTreeGeneratorImpl_v9_v10(
+			10,
+			&logger,
+			t.Name()+"-"+name,
+			state.NetworkDetails.RewardIndex,
+			&SnapshotEnd{
+				Slot:           snapshotSlot,
+				ConsensusBlock: snapshotSlot,
+				ExecutionBlock: snapshotSlot + history.BlockOffset,
+			},
+			&types.Header{
+				Number: big.NewInt(int64(executionEndBlock)),
+				Time:   assets.Mainnet20ELHeaderTime,
+			},
+			/* intervalsPassed= */ 1,
+			state,
+		)
+	}
+
+	// Generate the tree in one go
+	expectedArtifacts, err := newGenerator("full", consensusEndBlock).generateTree(t.rp, "mainnet", make([]common.Address, 0), t.bc)
+	t.failIf(err)
+
+	// Process the first half of the interval into a checkpoint
+	checkpointer := NewCheckpointer(filepath.Join(tt.TempDir(), "checkpoint.json.gz"), 10)
+	partialEpoch := history.StartEpoch + (history.EndEpoch-history.StartEpoch)/2
+	partialGenerator := newGenerator("partial", history.BeaconConfig.LastSlotOfEpoch(partialEpoch))
+	partialGenerator.setCheckpointer(checkpointer)
+	err = partialGenerator.updateCheckpoint(t.rp, "mainnet", make([]common.Address, 0), t.bc)
+	t.failIf(err)
+
+	checkpoint, exists, err := checkpointer.Load()
+	t.failIf(err)
+	if !exists {
+		t.Fatalf("Checkpoint was not saved")
+	}
+	if checkpoint.NextEpoch != partialEpoch+1 {
+		t.Fatalf("Checkpoint should resume at epoch %d but resumes at %d", partialEpoch+1, checkpoint.NextEpoch)
+	}
+
+	// Resume from the checkpoint and make sure the tree is the same
+	resumedGenerator := newGenerator("resumed", consensusEndBlock)
+	resumedGenerator.setCheckpointer(checkpointer)
+	resumedArtifacts, err := resumedGenerator.generateTree(t.rp, "mainnet", make([]common.Address, 0), t.bc)
+	t.failIf(err)
+
+	expectedMerkleRoot := expectedArtifacts.RewardsFile.GetMerkleRoot()
+	resumedMerkleRoot := resumedArtifacts.RewardsFile.GetMerkleRoot()
+	if !strings.EqualFold(expectedMerkleRoot, resumedMerkleRoot) {
+		t.Fatalf("Merkle root of the resumed tree does not match the full tree %s != %s", resumedMerkleRoot, expectedMerkleRoot)
+	}
+	for _, node := range history.Nodes {
+		for _, minipool := range node.Minipools {
+			expectedPerformance, expectedExists := expectedArtifacts.MinipoolPerformanceFile.GetSmoothingPoolPerformance(minipool.Address)
+			resumedPerformance, resumedExists := resumedArtifacts.MinipoolPerformanceFile.GetSmoothingPoolPerformance(minipool.Address)
+			if expectedExists != resumedExists {
+				t.Fatalf("Minipool performance of node %s does not match after resuming", node.Notes)
+			}
+			if !expectedExists {
+				continue
+			}
+			if expectedPerformance.GetSuccessfulAttestationCount() != resumedPerformance.GetSuccessfulAttestationCount() ||
+				expectedPerformance.GetMissedAttestationCount() != resumedPerformance.GetMissedAttestationCount() {
+				t.Fatalf("Minipool performance of node %s does not match after resuming", node.Notes)
+			}
+		}
+	}
+
+	// The checkpoint is removed once the tree is generated
+	_, exists, err = checkpointer.Load()
+	t.failIf(err)
+	if exists {
+		t.Fatalf("Checkpoint was not deleted after generating the tree")
+	}
+}
@@ -219,6 +219,7 @@ type MinipoolInfo struct {
 	EndSlot                 uint64                `json:"-"`
 	AttestationScore        *QuotedBigInt         `json:"attestationScore"`
 	CompletedAttestations   map[uint64]bool       `json:"-"`
+	AttestationTallies      []*AttestationTally   `json:"-"`
 	AttestationCount        int                   `json:"attestationCount"`
 	TotalFee                *big.Int              `json:"-"`
 	MinipoolBonus           *big.Int              `json:"-"`
@@ -226,6 +227,38 @@ type MinipoolInfo struct {
 	ConsensusIncome         *QuotedBigInt         `json:"consensusIncome"`
 }
 
+// The number of attestations a minipool completed while it had a specific bond and commission.
+// Tallying attestations this way lets their scores be calculated after the interval ends.
+type AttestationTally struct {
+	Bond  *QuotedBigInt `json:"bond"`
+	Fee   *QuotedBigInt `json:"fee"`
+	Count uint64        `json:"count"`
+}
+
+// Add a completed attestation to the tally for the bond and commission the minipool had at the time
+func (m *MinipoolInfo) tallyAttestation(bond *big.Int, fee *big.Int) {
+	for _, tally := range m.AttestationTallies {
+		if tally.Bond.Cmp(bond) == 0 && tally.Fee.Cmp(fee) == 0 {
+			tally.Count++
+			return
+		}
+	}
+	m.AttestationTallies = append(m.AttestationTallies, &AttestationTally{
+		Bond:  QuotedBigIntFromBigInt(bond),
+		Fee:   QuotedBigIntFromBigInt(fee),
+		Count: 1,
+	})
+}
+
+// Get the number of attestations the minipool completed, across all of its tallies
+func (m *MinipoolInfo) getCompletedAttestationCount() uint64 {
+	count := uint64(0)
+	for _, tally := range m.AttestationTallies {
+		count += tally.Count
+	}
+	return count
+}
+
 var sixteenEth = big.NewInt(0).Mul(oneEth, big.NewInt(16))
 
 type IntervalDutiesInfo struct {
@@ -0,0 +1,96 @@
+package rocketpool
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/goccy/go-json"
+
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+const (
+	// How long to wait when connecting to the API server before falling back to running commands directly
+	apiServerDialTimeout time.Duration = 2 * time.Second
+
+	// The URL API calls are sent to; the host is ignored since the connection goes over the Unix socket
+	apiServerCallUrl string = "http://rocketpool/v1/api"
+)
+
+// Run an API call through the API server if it's running.
+// Returns false if the server isn't available, in which case the caller should run the API command directly instead.
+func (c *Client) callApiServer(args string, otherArgs ...string) ([]byte, bool, error) {
+	cfg, _, err := c.LoadConfig()
+	if err != nil || !cfg.Smartnode.EnableApiServer.Value.(bool) {
+		return nil, false, nil
+	}
+	socketPath := os.ExpandEnv(cfg.Smartnode.GetApiSocketPathInCLI())
+	if _, err := os.Stat(socketPath); err != nil {
+		return nil, false, nil
+	}
+
+	// Build the request
+	request := api.ApiServerRequest{
+		Args:            append(strings.Fields(args), otherArgs...),
+		MaxFee:          c.maxFee,
+		MaxPrioFee:      c.maxPrioFee,
+		GasLimit:        c.gasLimit,
+		IgnoreSyncCheck: c.ignoreSyncCheck,
+		ForceFallbacks:  c.forceFallbacks,
+		Unsigned:        c.unsignedOut != "",
+	}
+	if c.customNonce != nil {
+		request.Nonce = c.customNonce.String()
+	}
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, true, fmt.Errorf("error serializing API server request: %w", err)
+	}
+	if c.debugPrint {
+		fmt.Printf("To API server (%s):\n", socketPath)
+		fmt.Println(strings.Join(request.Args, " "))
+	}
+
+	// Send it over the socket
+	client := http.Client{
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _ string, _ string) (net.Conn, error) {
+				dialer := net.Dialer{Timeout: apiServerDialTimeout}
+				return dialer.DialContext(ctx, "unix", socketPath)
+			},
+		},
+	}
+	response, err := client.Post(apiServerCallUrl, "application/json", bytes.NewReader(body))
+	if err != nil {
+		// The socket was left behind or can't be used by this user, so run the command directly instead
+		var opErr *net.OpError
+		if errors.As(err, &opErr) && opErr.Op == "dial" {
+			if c.debugPrint {
+				fmt.Printf("API server unavailable, falling back to running the command directly: %s\n", err.Error())
+			}
+			return nil, false, nil
+		}
+		c.resetGasSettings()
+		return nil, true, fmt.Errorf("error calling the API server: %w", err)
+	}
+	defer response.Body.Close()
+
+	output, err := io.ReadAll(response.Body)
+	if c.debugPrint {
+		fmt.Println("API Out:")
+		fmt.Println(string(output))
+	}
+	c.resetGasSettings()
+	if err != nil {
+		return nil, true, fmt.Errorf("error reading API server response: %w", err)
+	}
+	return output, true, nil
+}
@@ -1,13 +1,44 @@
 package rocketpool
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/goccy/go-json"
+
+	"github.com/rocket-pool/smartnode/client"
 	"github.com/rocket-pool/smartnode/shared/types/api"
 )
 
+// Sends the calls of the typed API client through the CLI's client, so they use the API server or docker
+// the same way the CLI's own calls do
+type apiTransport struct {
+	client *Client
+}
+
+func (t *apiTransport) Call(ctx context.Context, request *api.ApiServerRequest) ([]byte, error) {
+	// The CLI's gas settings are applied by callAPI; only the sync flags can be set per call, so they're put back afterwards
+	ignoreSyncCheck := t.client.ignoreSyncCheck
+	forceFallbacks := t.client.forceFallbacks
+	defer func() {
+		t.client.ignoreSyncCheck = ignoreSyncCheck
+		t.client.forceFallbacks = forceFallbacks
+	}()
+	if request.IgnoreSyncCheck {
+		t.client.ignoreSyncCheck = true
+	}
+	if request.ForceFallbacks {
+		t.client.forceFallbacks = true
+	}
+	return t.client.callAPI("", request.Args...)
+}
+
+// Get the typed API client that the CLI's API methods wrap
+func (c *Client) apiClient() *client.Client {
+	return client.NewClient(&apiTransport{client: c})
+}
+
 // Wait for a transaction
 func (c *Client) WaitForTransaction(txHash common.Hash) (api.APIResponse, error) {
 	responseBytes, err := c.callAPI(fmt.Sprintf("wait %s", txHash.String()))
@@ -30,6 +30,12 @@ if [ ! -f "/validators/$FEE_RECIPIENT_FILE" ]; then
     exit 1
 fi
 
+# Create the Keymanager API token the Smartnode uses to load new keys without restarting the VC
+KEYMANAGER_API_TOKEN_FILE="/validators/keymanager-api-token"
+if [ ! -z "$KEYMANAGER_API_PORT" ] && [ ! -f "$KEYMANAGER_API_TOKEN_FILE" ]; then
+    echo "api-token-0x$(head -c 32 /dev/urandom | od -An -tx1 | tr -d ' \n')" > $KEYMANAGER_API_TOKEN_FILE
+fi
+
 
 # Lighthouse startup
 if [ "$CC_CLIENT" = "lighthouse" ]; then
@@ -61,6 +67,12 @@ if [ "$CC_CLIENT" = "lighthouse" ]; then
         CMD="$CMD --builder-proposals --prefer-builder-proposals"
     fi
 
+    # Lighthouse finds the keys in the remote signer through the validator definitions the Smartnode writes for it
+
+    if [ ! -z "$KEYMANAGER_API_PORT" ]; then
+        CMD="$CMD --http --http-address 0.0.0.0 --http-port $KEYMANAGER_API_PORT --unencrypted-http-transport --http-token-path $KEYMANAGER_API_TOKEN_FILE"
+    fi
+
     if [ "$ENABLE_METRICS" = "true" ]; then
         CMD="$CMD --metrics --metrics-address 0.0.0.0 --metrics-port $VC_METRICS_PORT"
     fi
@@ -112,6 +124,14 @@ if [ ! -z "$VC_SUGGESTED_BLOCK_GAS_LIMIT" ]; then
         CMD="$CMD --builder"
     fi
 
+    if [ ! -z "$REMOTE_SIGNER_URL" ]; then
+        CMD="$CMD --externalSigner.url $REMOTE_SIGNER_URL --externalSigner.fetch"
+    fi
+
+    if [ ! -z "$KEYMANAGER_API_PORT" ]; then
+        CMD="$CMD --keymanager --keymanager.address 0.0.0.0 --keymanager.port $KEYMANAGER_API_PORT --keymanager.tokenFile $KEYMANAGER_API_TOKEN_FILE"
+    fi
+
     if [ "$ENABLE_METRICS" = "true" ]; then
         CMD="$CMD --metrics --metrics.address 0.0.0.0 --metrics.port $VC_METRICS_PORT"
     fi
@@ -152,6 +172,14 @@ if [ "$CC_CLIENT" = "nimbus" ]; then
         CMD="$CMD --payload-builder"
     fi
 
+    if [ ! -z "$REMOTE_SIGNER_URL" ]; then
+        CMD="$CMD --web3-signer-url=$REMOTE_SIGNER_URL"
+    fi
+
+    if [ ! -z "$KEYMANAGER_API_PORT" ]; then
+        CMD="$CMD --keymanager --keymanager-address=0.0.0.0 --keymanager-port=$KEYMANAGER_API_PORT --keymanager-token-file=$KEYMANAGER_API_TOKEN_FILE"
+    fi
+
     if [ "$ENABLE_METRICS" = "true" ]; then
         CMD="$CMD --metrics --metrics-address=0.0.0.0 --metrics-port=$VC_METRICS_PORT"
     fi
@@ -192,6 +220,14 @@ if [ "$CC_CLIENT" = "prysm" ]; then
         CMD="$CMD --enable-builder"
     fi
 
+    if [ ! -z "$REMOTE_SIGNER_URL" ]; then
+        CMD="$CMD --validators-external-signer-url=$REMOTE_SIGNER_URL --validators-external-signer-public-keys=$REMOTE_SIGNER_URL/api/v1/eth2/publicKeys"
+    fi
+
+    if [ ! -z "$KEYMANAGER_API_PORT" ]; then
+        CMD="$CMD --rpc --http-host 0.0.0.0 --http-port $KEYMANAGER_API_PORT --keymanager-token-file $KEYMANAGER_API_TOKEN_FILE"
+    fi
+
     if [ "$DOPPELGANGER_DETECTION" = "true" ]; then
         CMD="$CMD --enable-doppelganger"
     fi
@@ -253,6 +289,10 @@ if [ "$CC_CLIENT" = "teku" ]; then
         CMD="$CMD --shut-down-when-validator-slashed-enabled=true"
     fi
 
+    if [ ! -z "$REMOTE_SIGNER_URL" ]; then
+        CMD="$CMD --validators-external-signer-url=$REMOTE_SIGNER_URL --validators-external-signer-public-keys=external-signer"
+    fi
+
     if [ "$ENABLE_METRICS" = "true" ]; then
         CMD="$CMD --metrics-enabled=true --metrics-interface=0.0.0.0 --metrics-port=$VC_METRICS_PORT --metrics-host-allowlist=*"
     fi
@@ -79,6 +79,8 @@ type Client struct {
 	debugPrint         bool
 	ignoreSyncCheck    bool
 	forceFallbacks     bool
+	unsignedOut        string
+	unsignedSaved      bool
 }
 
 func getClientStatusString(clientStatus api.ClientStatus) string {
@@ -155,6 +157,7 @@ func NewClientFromCtx(c *cli.Context) *Client {
 		debugPrint:         c.GlobalBool("debug"),
 		forceFallbacks:     false,
 		ignoreSyncCheck:    false,
+		unsignedOut:        c.GlobalString("unsigned-out"),
 	}
 
 	if nonce, ok := c.App.Metadata["nonce"]; ok {
@@ -1248,6 +1251,20 @@ func (c *Client) composeAddons(cfg *config.RocketPoolConfig, rocketpoolDir strin
 
 // Call the Rocket Pool API
 func (c *Client) callAPI(args string, otherArgs ...string) ([]byte, error) {
+	// Once a transaction has been saved for offline signing, the command has to stop until it's been broadcast
+	if c.unsignedSaved {
+		return []byte{}, ErrTransactionSavedForSigning
+	}
+
+	// Use the API server if it's running
+	output, served, err := c.callApiServer(args, otherArgs...)
+	if served {
+		if err == nil {
+			err = c.saveUnsignedTransactions(output)
+		}
+		return output, err
+	}
+
 	// Sanitize and parse the args
 	ignoreSyncCheckFlag, forceFallbackECFlag, args := c.getApiCallArgs(args, otherArgs...)
 
@@ -1258,20 +1275,25 @@ func (c *Client) callAPI(args string, otherArgs ...string) ([]byte, error) {
 		if err != nil {
 			return []byte{}, err
 		}
-		cmd = fmt.Sprintf("docker exec %s %s %s %s %s %s api %s", shellescape.Quote(containerName), shellescape.Quote(APIBinPath), ignoreSyncCheckFlag, forceFallbackECFlag, c.getGasOpts(), c.getCustomNonce(), args)
+		cmd = fmt.Sprintf("docker exec %s %s %s %s %s %s %s api %s", shellescape.Quote(containerName), shellescape.Quote(APIBinPath), ignoreSyncCheckFlag, forceFallbackECFlag, c.getGasOpts(), c.getCustomNonce(), c.getUnsignedFlag(), args)
 	} else {
-		cmd = fmt.Sprintf("%s --settings %s %s %s %s %s api %s",
+		cmd = fmt.Sprintf("%s --settings %s %s %s %s %s %s api %s",
 			c.daemonPath,
 			shellescape.Quote(fmt.Sprintf("%s/%s", c.configPath, SettingsFile)),
 			ignoreSyncCheckFlag,
 			forceFallbackECFlag,
 			c.getGasOpts(),
 			c.getCustomNonce(),
+			c.getUnsignedFlag(),
 			args)
 	}
 
 	// Run the command
-	return c.runApiCall(cmd)
+	output, err = c.runApiCall(cmd)
+	if err == nil {
+		err = c.saveUnsignedTransactions(output)
+	}
+	return output, err
 }
 
 // Call the Rocket Pool API with some custom environment variables
@@ -1358,12 +1380,15 @@ func (c *Client) runApiCall(cmd string) ([]byte, error) {
 		}
 	}
 
-	// Reset the gas settings after the call
+	c.resetGasSettings()
+	return output, err
+}
+
+// Reset the gas settings after an API call
+func (c *Client) resetGasSettings() {
 	c.maxFee = c.originalMaxFee
 	c.maxPrioFee = c.originalMaxPrioFee
 	c.gasLimit = c.originalGasLimit
-
-	return output, err
 }
 
 // Get the API container name
@@ -1387,6 +1412,14 @@ func (c *Client) getGasOpts() string {
 	return opts
 }
 
+// Get the flag that has the API prepare transactions for offline signing, if requested
+func (c *Client) getUnsignedFlag() string {
+	if c.unsignedOut == "" {
+		return ""
+	}
+	return "--unsigned"
+}
+
 func (c *Client) getCustomNonce() string {
 	// Set the custom nonce
 	nonce := ""
@@ -1,172 +1,76 @@
 package rocketpool
 
 import (
+	"context"
 	"fmt"
-	"math/big"
 
 	"github.com/goccy/go-json"
+
 	"github.com/rocket-pool/smartnode/shared/types/api"
 )
 
 // Get network node fee
 func (c *Client) NodeFee() (api.NodeFeeResponse, error) {
-	responseBytes, err := c.callAPI("network node-fee")
+	return c.apiClient().NodeFee(context.Background())
+}
+
+// Get gas price suggestions from the Execution client's fee history
+func (c *Client) GasSuggestions() (api.GasSuggestionsResponse, error) {
+	responseBytes, err := c.callAPI("network gas-suggestions")
 	if err != nil {
-		return api.NodeFeeResponse{}, fmt.Errorf("Could not get network node fee: %w", err)
+		return api.GasSuggestionsResponse{}, fmt.Errorf("Could not get gas suggestions: %w", err)
 	}
-	var response api.NodeFeeResponse
+	var response api.GasSuggestionsResponse
 	if err := json.Unmarshal(responseBytes, &response); err != nil {
-		return api.NodeFeeResponse{}, fmt.Errorf("Could not decode network node fee response: %w", err)
+		return api.GasSuggestionsResponse{}, fmt.Errorf("Could not decode gas suggestions response: %w", err)
 	}
 	if response.Error != "" {
-		return api.NodeFeeResponse{}, fmt.Errorf("Could not get network node fee: %s", response.Error)
+		return api.GasSuggestionsResponse{}, fmt.Errorf("Could not get gas suggestions: %s", response.Error)
 	}
 	return response, nil
 }
 
 // Get network RPL price
 func (c *Client) RplPrice() (api.RplPriceResponse, error) {
-	responseBytes, err := c.callAPI("network rpl-price")
-	if err != nil {
-		return api.RplPriceResponse{}, fmt.Errorf("Could not get network RPL price: %w", err)
-	}
-	var response api.RplPriceResponse
-	if err := json.Unmarshal(responseBytes, &response); err != nil {
-		return api.RplPriceResponse{}, fmt.Errorf("Could not decode network RPL price response: %w", err)
-	}
-	if response.Error != "" {
-		return api.RplPriceResponse{}, fmt.Errorf("Could not get network RPL price: %s", response.Error)
-	}
-	if response.RplPrice == nil {
-		response.RplPrice = big.NewInt(0)
-	}
-	return response, nil
+	return c.apiClient().RplPrice(context.Background())
 }
 
 // Get network stats
 func (c *Client) NetworkStats() (api.NetworkStatsResponse, error) {
-	responseBytes, err := c.callAPI("network stats")
-	if err != nil {
-		return api.NetworkStatsResponse{}, fmt.Errorf("Could not get network stats: %w", err)
-	}
-	var response api.NetworkStatsResponse
-	if err := json.Unmarshal(responseBytes, &response); err != nil {
-		return api.NetworkStatsResponse{}, fmt.Errorf("Could not decode network stats response: %w", err)
-	}
-	if response.Error != "" {
-		return api.NetworkStatsResponse{}, fmt.Errorf("Could not get network stats: %s", response.Error)
-	}
-	return response, nil
+	return c.apiClient().NetworkStats(context.Background())
 }
 
 // Get the timezone map
 func (c *Client) TimezoneMap() (api.NetworkTimezonesResponse, error) {
-	responseBytes, err := c.callAPI("network timezone-map")
-	if err != nil {
-		return api.NetworkTimezonesResponse{}, fmt.Errorf("Could not get network timezone map: %w", err)
-	}
-	var response api.NetworkTimezonesResponse
-	if err := json.Unmarshal(responseBytes, &response); err != nil {
-		return api.NetworkTimezonesResponse{}, fmt.Errorf("Could not decode network timezone map response: %w", err)
-	}
-	if response.Error != "" {
-		return api.NetworkTimezonesResponse{}, fmt.Errorf("Could not get network timezone map: %s", response.Error)
-	}
-	return response, nil
+	return c.apiClient().TimezoneMap(context.Background())
 }
 
 // Check if the rewards tree for the provided interval can be generated
 func (c *Client) CanGenerateRewardsTree(index uint64) (api.CanNetworkGenerateRewardsTreeResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("network can-generate-rewards-tree %d", index))
-	if err != nil {
-		return api.CanNetworkGenerateRewardsTreeResponse{}, fmt.Errorf("Could not check rewards tree generation status: %w", err)
-	}
-	var response api.CanNetworkGenerateRewardsTreeResponse
-	if err := json.Unmarshal(responseBytes, &response); err != nil {
-		return api.CanNetworkGenerateRewardsTreeResponse{}, fmt.Errorf("Could not decode rewards tree generation status response: %w", err)
-	}
-	if response.Error != "" {
-		return api.CanNetworkGenerateRewardsTreeResponse{}, fmt.Errorf("Could not check rewards tree generation status: %s", response.Error)
-	}
-	return response, nil
+	return c.apiClient().CanGenerateRewardsTree(context.Background(), index)
 }
 
 // Set a request marker for the watchtower to generate the rewards tree for the given interval
 func (c *Client) GenerateRewardsTree(index uint64) (api.NetworkGenerateRewardsTreeResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("network generate-rewards-tree %d", index))
-	if err != nil {
-		return api.NetworkGenerateRewardsTreeResponse{}, fmt.Errorf("Could not initialize rewards tree generation: %w", err)
-	}
-	var response api.NetworkGenerateRewardsTreeResponse
-	if err := json.Unmarshal(responseBytes, &response); err != nil {
-		return api.NetworkGenerateRewardsTreeResponse{}, fmt.Errorf("Could not decode rewards tree generation response: %w", err)
-	}
-	if response.Error != "" {
-		return api.NetworkGenerateRewardsTreeResponse{}, fmt.Errorf("Could not initialize rewards tree generation: %s", response.Error)
-	}
-	return response, nil
+	return c.apiClient().GenerateRewardsTree(context.Background(), index)
 }
 
 // GetActiveDAOProposals fetches information about active DAO proposals
 func (c *Client) GetActiveDAOProposals() (api.NetworkDAOProposalsResponse, error) {
-	responseBytes, err := c.callAPI("network dao-proposals")
-	if err != nil {
-		return api.NetworkDAOProposalsResponse{}, fmt.Errorf("could not request active DAO proposals: %w", err)
-	}
-	var response api.NetworkDAOProposalsResponse
-	if err := json.Unmarshal(responseBytes, &response); err != nil {
-		return api.NetworkDAOProposalsResponse{}, fmt.Errorf("could not decode dao proposals response: %w", err)
-	}
-	if response.Error != "" {
-		return api.NetworkDAOProposalsResponse{}, fmt.Errorf("error after requesting dao proposals: %s", response.Error)
-	}
-	return response, nil
+	return c.apiClient().GetActiveDAOProposals(context.Background())
 }
 
 // Download a rewards info file from IPFS for the given interval
 func (c *Client) DownloadRewardsFile(interval uint64) (api.DownloadRewardsFileResponse, error) {
-	responseBytes, err := c.callAPI(fmt.Sprintf("network download-rewards-file %d", interval))
-	if err != nil {
-		return api.DownloadRewardsFileResponse{}, fmt.Errorf("could not download rewards file: %w", err)
-	}
-	var response api.DownloadRewardsFileResponse
-	if err := json.Unmarshal(responseBytes, &response); err != nil {
-		return api.DownloadRewardsFileResponse{}, fmt.Errorf("could not decode download-rewards-file response: %w", err)
-	}
-	if response.Error != "" {
-		return api.DownloadRewardsFileResponse{}, fmt.Errorf("error after downloading rewards file: %s", response.Error)
-	}
-	return response, nil
+	return c.apiClient().DownloadRewardsFile(context.Background(), interval)
 }
 
 // Check if Saturn 1.4 has been deployed yet
 func (c *Client) IsSaturnDeployed() (api.IsSaturnDeployedResponse, error) {
-	responseBytes, err := c.callAPI("network is-saturn-deployed")
-	if err != nil {
-		return api.IsSaturnDeployedResponse{}, fmt.Errorf("could not check if Saturn is deployed: %w", err)
-	}
-	var response api.IsSaturnDeployedResponse
-	if err := json.Unmarshal(responseBytes, &response); err != nil {
-		return api.IsSaturnDeployedResponse{}, fmt.Errorf("could not decode is-saturn-deployed response: %w", err)
-	}
-	if response.Error != "" {
-		return api.IsSaturnDeployedResponse{}, fmt.Errorf("could not check if Saturn is deployed: %s", response.Error)
-	}
-	return response, nil
+	return c.apiClient().IsSaturnDeployed(context.Background())
 }
 
 // Get the address of the latest minipool delegate contract
 func (c *Client) GetLatestDelegate() (api.GetLatestDelegateResponse, error) {
-	responseBytes, err := c.callAPI("network latest-delegate")
-	if err != nil {
-		return api.GetLatestDelegateResponse{}, fmt.Errorf("could not get latest delegate: %w", err)
-	}
-	var response api.GetLatestDelegateResponse
-	if err := json.Unmarshal(responseBytes, &response); err != nil {
-		return api.GetLatestDelegateResponse{}, fmt.Errorf("could not decode get-latest-delegate response: %w", err)
-	}
-	if response.Error != "" {
-		return api.GetLatestDelegateResponse{}, fmt.Errorf("could not get latest delegate: %s", response.Error)
-	}
-	return response, nil
+	return c.apiClient().GetLatestDelegate(context.Background())
 }
@@ -0,0 +1,54 @@
+package rocketpool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/goccy/go-json"
+
+	"github.com/rocket-pool/smartnode/shared/services/wallet/offline"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// Sign a transaction that was prepared for offline signing
+func (c *Client) SignTransaction(tx offline.UnsignedTransaction) (api.SignTransactionResponse, error) {
+	return c.apiClient().SignTransaction(context.Background(), tx)
+}
+
+// Submit a transaction that was signed offline
+func (c *Client) BroadcastTransaction(tx offline.SignedTransaction) (api.NodeBroadcastTransactionResponse, error) {
+	return c.apiClient().BroadcastTransaction(context.Background(), tx)
+}
+
+// Returned by API calls once a transaction has been prepared for offline signing and saved to the requested file.
+// Commands can't continue past it since the transaction won't exist on-chain until it's signed and broadcast,
+// so the CLI stops the command and treats it as a success.
+var ErrTransactionSavedForSigning = errors.New("the transaction was saved for offline signing")
+
+// If the API prepared a transaction for offline signing instead of submitting it, save it to the requested file and return ErrTransactionSavedForSigning.
+// Commands that need several transactions are run again afterwards to prepare the next one.
+func (c *Client) saveUnsignedTransactions(output []byte) error {
+	if c.unsignedOut == "" {
+		return nil
+	}
+	var response api.UnsignedTransactionsResponse
+	if err := json.Unmarshal(output, &response); err != nil || len(response.UnsignedTxs) == 0 {
+		return nil
+	}
+
+	if err := offline.SaveUnsignedTransactions(c.unsignedOut, response.UnsignedTxs); err != nil {
+		return fmt.Errorf("Could not save the unsigned transaction: %w", err)
+	}
+	c.unsignedSaved = true
+
+	fmt.Println()
+	for _, tx := range response.UnsignedTxs {
+		fmt.Printf("Prepared an unsigned transaction from %s with nonce %d:\n", tx.From.Hex(), uint64(tx.Nonce))
+		fmt.Print(tx.Summary.String())
+	}
+	fmt.Printf("\nThe unsigned transaction was saved to %s.\n", c.unsignedOut)
+	fmt.Println("Copy it to the machine that holds your node key and run `rocketpool wallet sign-tx` on it, then bring the signed file back and run `rocketpool node broadcast-tx` to submit it.")
+	fmt.Printf("%sNOTE: if this command normally submits more than one transaction, run it again with `--unsigned-out` after this one has been mined to prepare the next.%s\n", colorYellow, colorReset)
+	return ErrTransactionSavedForSigning
+}
@@ -0,0 +1,360 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/rocket-pool/smartnode/bindings/rocketpool"
+	"github.com/rocket-pool/smartnode/bindings/utils/eth"
+	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+// Settings
+const (
+	DefaultTaskTimeout time.Duration = 10 * time.Minute
+)
+
+// A snapshot of the chain head at the time the scheduler evaluates its tasks
+type Tick struct {
+	Time        time.Time
+	BlockNumber uint64
+	Epoch       uint64
+
+	// The logs emitted by watched contracts since the previous tick
+	Logs []types.Log
+}
+
+// A unit of work run by the scheduler
+type Task struct {
+	// The name of the task, used for logging and metrics
+	Name string
+
+	// Determines when the task should run
+	Trigger Trigger
+
+	// The maximum amount of time a single run is allowed to take before its context is cancelled
+	Timeout time.Duration
+
+	// Tasks that share a non-empty group never run at the same time (e.g. tasks that submit transactions from the node account)
+	Group string
+
+	// The function to run; the context is cancelled once the run takes longer than the timeout
+	Run func(ctx context.Context, state *state.NetworkState) error
+}
+
+// The runtime status of a task
+type TaskStatus struct {
+	Name         string
+	Running      bool
+	LastRun      time.Time
+	LastDuration time.Duration
+	LastError    string
+	LastErrorAt  time.Time
+	RunCount     uint64
+	ErrorCount   uint64
+	TimeoutCount uint64
+}
+
+// Refreshes the network state when a task needs a newer snapshot
+type StateRefresher func() (*state.NetworkState, error)
+
+// Bookkeeping for a scheduled task
+type taskEntry struct {
+	task     Task
+	lastTick *Tick
+	status   TaskStatus
+
+	// Logs that arrived while the task couldn't be started, which are handed to its next run
+	pendingLogs []types.Log
+}
+
+// A task that's due to run, and the tick it's running for
+type dueTask struct {
+	entry *taskEntry
+	tick  *Tick
+}
+
+// Runs tasks on their own cadence, sharing a single network state snapshot between them
+type Scheduler struct {
+	rp           *rocketpool.RocketPool
+	log          *log.ColorLogger
+	errLog       *log.ColorLogger
+	refreshState StateRefresher
+	intervalSize *big.Int
+
+	tasks     []*taskEntry
+	state     *state.NetworkState
+	lastBlock uint64
+	watched   []common.Address
+
+	lock *sync.Mutex
+}
+
+// Create a new scheduler
+func NewScheduler(rp *rocketpool.RocketPool, refreshState StateRefresher, intervalSize *big.Int, logger *log.ColorLogger, errLog *log.ColorLogger) *Scheduler {
+	return &Scheduler{
+		rp:           rp,
+		log:          logger,
+		errLog:       errLog,
+		refreshState: refreshState,
+		intervalSize: intervalSize,
+		tasks:        []*taskEntry{},
+		lock:         &sync.Mutex{},
+	}
+}
+
+// Add a task to the scheduler
+func (s *Scheduler) AddTask(task Task) {
+	if task.Timeout == 0 {
+		task.Timeout = DefaultTaskTimeout
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.tasks = append(s.tasks, &taskEntry{
+		task: task,
+		status: TaskStatus{
+			Name: task.Name,
+		},
+	})
+}
+
+// Resolve the contract addresses for every event-driven task
+func (s *Scheduler) ResolveEventTriggers() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	watched := map[common.Address]bool{}
+	for _, entry := range s.tasks {
+		for _, trigger := range getEventTriggers(entry.task.Trigger) {
+			trigger.addresses = []common.Address{}
+			for _, contractName := range trigger.ContractNames {
+				address, err := s.rp.GetAddress(contractName, nil)
+				if err != nil {
+					return fmt.Errorf("error getting address of %s for task %s: %w", contractName, entry.task.Name, err)
+				}
+				trigger.addresses = append(trigger.addresses, *address)
+				watched[*address] = true
+			}
+		}
+	}
+
+	s.watched = make([]common.Address, 0, len(watched))
+	for address := range watched {
+		s.watched = append(s.watched, address)
+	}
+	if len(s.watched) > 0 {
+		s.log.Printlnf("Watching %d contracts for event-driven tasks.", len(s.watched))
+	}
+	return nil
+}
+
+// Evaluate every task against the chain head and start the ones that are due.
+// Tasks run in their own goroutines; a task that is still running is never started again until it returns.
+func (s *Scheduler) Tick() error {
+	tick, err := s.getTick()
+	if err != nil {
+		return err
+	}
+
+	s.lock.Lock()
+	busyGroups := map[string]bool{}
+	for _, entry := range s.tasks {
+		if entry.status.Running && entry.task.Group != "" {
+			busyGroups[entry.task.Group] = true
+		}
+	}
+	due := []dueTask{}
+	for _, entry := range s.tasks {
+		entryTick := entry.getTick(tick)
+		if entry.status.Running || busyGroups[entry.task.Group] {
+			// Hold on to the logs the task cares about so it still sees them once it's free
+			entry.pendingLogs = filterLogs(entry.task.Trigger, entryTick.Logs)
+			continue
+		}
+		entry.pendingLogs = nil
+		if entry.task.Trigger.IsDue(entry.lastTick, entryTick) {
+			due = append(due, dueTask{entry: entry, tick: entryTick})
+			if entry.task.Group != "" {
+				// Tasks left behind will be picked up on a later tick once the group is free
+				busyGroups[entry.task.Group] = true
+			}
+		}
+	}
+	currentState := s.state
+	s.lock.Unlock()
+
+	// Only rebuild the network state if something actually needs to run
+	if len(due) == 0 {
+		return nil
+	}
+	if currentState == nil || currentState.ElBlockNumber < tick.BlockNumber {
+		newState, err := s.refreshState()
+		if err != nil {
+			// The tasks didn't run, so give them their logs back for the next tick
+			s.lock.Lock()
+			for _, task := range due {
+				task.entry.pendingLogs = filterLogs(task.entry.task.Trigger, task.tick.Logs)
+			}
+			s.lock.Unlock()
+			return err
+		}
+		s.lock.Lock()
+		s.state = newState
+		s.lock.Unlock()
+		currentState = newState
+	}
+
+	for _, task := range due {
+		s.start(task.entry, task.tick, currentState)
+	}
+	return nil
+}
+
+// Get the status of each task, sorted by name
+func (s *Scheduler) GetTaskStatuses() []TaskStatus {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	statuses := make([]TaskStatus, 0, len(s.tasks))
+	for _, entry := range s.tasks {
+		statuses = append(statuses, entry.status)
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Name < statuses[j].Name
+	})
+	return statuses
+}
+
+// Start a task in its own goroutine
+func (s *Scheduler) start(entry *taskEntry, tick *Tick, networkState *state.NetworkState) {
+	s.lock.Lock()
+	entry.lastTick = tick
+	entry.status.Running = true
+	entry.status.LastRun = tick.Time
+	s.lock.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), entry.task.Timeout)
+	done := make(chan error, 1)
+	go func() {
+		done <- entry.task.Run(ctx, networkState)
+	}()
+
+	go func() {
+		defer cancel()
+		start := time.Now()
+
+		var err error
+		select {
+		case err = <-done:
+		case <-ctx.Done():
+			s.lock.Lock()
+			entry.status.TimeoutCount++
+			s.lock.Unlock()
+			s.errLog.Printlnf("Task %s has been running for more than %s, cancelling it.", entry.task.Name, entry.task.Timeout)
+
+			// Keep the task flagged as running until it notices the cancellation and returns
+			err = <-done
+		}
+
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		entry.status.Running = false
+		entry.status.LastDuration = time.Since(start)
+		entry.status.RunCount++
+		if err != nil {
+			entry.status.ErrorCount++
+			entry.status.LastError = err.Error()
+			entry.status.LastErrorAt = time.Now()
+			s.errLog.Printlnf("Task %s failed: %s", entry.task.Name, err.Error())
+		} else {
+			entry.status.LastError = ""
+		}
+	}()
+}
+
+// Get the tick a task should be evaluated against, including the logs it missed while it couldn't be started
+func (entry *taskEntry) getTick(tick *Tick) *Tick {
+	if len(entry.pendingLogs) == 0 {
+		return tick
+	}
+	entryTick := *tick
+	entryTick.Logs = make([]types.Log, 0, len(entry.pendingLogs)+len(tick.Logs))
+	entryTick.Logs = append(entryTick.Logs, entry.pendingLogs...)
+	entryTick.Logs = append(entryTick.Logs, tick.Logs...)
+	return &entryTick
+}
+
+// Get the logs that match one of a trigger's event triggers
+func filterLogs(trigger Trigger, logs []types.Log) []types.Log {
+	eventTriggers := getEventTriggers(trigger)
+	if len(eventTriggers) == 0 {
+		return nil
+	}
+	matches := []types.Log{}
+	for _, log := range logs {
+		for _, eventTrigger := range eventTriggers {
+			if eventTrigger.matchesLog(log) {
+				matches = append(matches, log)
+				break
+			}
+		}
+	}
+	return matches
+}
+
+// Build a tick from the current chain head
+func (s *Scheduler) getTick() (*Tick, error) {
+	blockNumber, err := s.rp.Client.BlockNumber(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("error getting latest block number: %w", err)
+	}
+
+	tick := &Tick{
+		Time:        time.Now(),
+		BlockNumber: blockNumber,
+	}
+
+	s.lock.Lock()
+	currentState := s.state
+	lastBlock := s.lastBlock
+	watched := s.watched
+	s.lock.Unlock()
+
+	// Derive the epoch from the Beacon config once a state is available
+	if currentState != nil && currentState.BeaconConfig.SecondsPerSlot > 0 {
+		slot := currentState.BeaconConfig.FirstSlotAtLeast(tick.Time.Unix())
+		tick.Epoch = currentState.BeaconConfig.SlotToEpoch(slot)
+	}
+
+	// Get the logs for event-driven tasks since the previous tick
+	if len(watched) > 0 && lastBlock > 0 && blockNumber > lastBlock {
+		logs, err := eth.GetLogs(s.rp, watched, nil, s.intervalSize, big.NewInt(int64(lastBlock+1)), big.NewInt(int64(blockNumber)), nil)
+		if err != nil {
+			return nil, fmt.Errorf("error getting logs for blocks %d to %d: %w", lastBlock+1, blockNumber, err)
+		}
+		tick.Logs = logs
+	}
+
+	s.lock.Lock()
+	if blockNumber > s.lastBlock {
+		s.lastBlock = blockNumber
+	}
+	s.lock.Unlock()
+
+	return tick, nil
+}
+
+// Get the most recent network state used by the scheduler
+func (s *Scheduler) GetState() *state.NetworkState {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.state
+}
@@ -0,0 +1,175 @@
+package scheduler
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// A trigger decides whether a task is due to run on the current tick
+type Trigger interface {
+	// Returns true if the task should run on the current tick. last is the tick the task last started on, or nil if it has never run.
+	IsDue(last *Tick, current *Tick) bool
+}
+
+// Runs a task whenever the given amount of wall-clock time has passed since its last run
+type intervalTrigger struct {
+	interval time.Duration
+}
+
+// Create a trigger that fires once per interval
+func Every(interval time.Duration) Trigger {
+	return &intervalTrigger{
+		interval: interval,
+	}
+}
+
+func (t *intervalTrigger) IsDue(last *Tick, current *Tick) bool {
+	if last == nil {
+		return true
+	}
+	return current.Time.Sub(last.Time) >= t.interval
+}
+
+// Runs a task once every N Beacon epochs
+type epochTrigger struct {
+	epochs uint64
+}
+
+// Create a trigger that fires once every N epochs
+func EveryEpochs(epochs uint64) Trigger {
+	if epochs == 0 {
+		epochs = 1
+	}
+	return &epochTrigger{
+		epochs: epochs,
+	}
+}
+
+func (t *epochTrigger) IsDue(last *Tick, current *Tick) bool {
+	if last == nil {
+		return true
+	}
+	return current.Epoch >= last.Epoch+t.epochs
+}
+
+// Runs a task once every N execution layer blocks
+type blockTrigger struct {
+	blocks uint64
+}
+
+// Create a trigger that fires once every N blocks
+func EveryBlocks(blocks uint64) Trigger {
+	if blocks == 0 {
+		blocks = 1
+	}
+	return &blockTrigger{
+		blocks: blocks,
+	}
+}
+
+func (t *blockTrigger) IsDue(last *Tick, current *Tick) bool {
+	if last == nil {
+		return true
+	}
+	return current.BlockNumber >= last.BlockNumber+t.blocks
+}
+
+// Runs a task whenever one of the watched contracts emits a log since the previous tick
+type EventTrigger struct {
+	// The names of the Rocket Pool contracts to watch, as registered in RocketStorage
+	ContractNames []string
+
+	// Optional event topics (the first topic of the log) to filter on; if empty, any log from the contracts will fire the trigger
+	Topics []common.Hash
+
+	// The resolved contract addresses; these are populated by the scheduler
+	addresses []common.Address
+}
+
+// Create a trigger that fires when any of the given contracts emits an event
+func OnEvents(contractNames ...string) *EventTrigger {
+	return &EventTrigger{
+		ContractNames: contractNames,
+	}
+}
+
+// Restrict the trigger to the provided event topics
+func (t *EventTrigger) WithTopics(topics ...common.Hash) *EventTrigger {
+	t.Topics = append(t.Topics, topics...)
+	return t
+}
+
+func (t *EventTrigger) IsDue(last *Tick, current *Tick) bool {
+	for _, log := range current.Logs {
+		if t.matchesLog(log) {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *EventTrigger) matchesLog(log types.Log) bool {
+	if !t.matchesAddress(log.Address) {
+		return false
+	}
+	if len(t.Topics) == 0 {
+		return true
+	}
+	if len(log.Topics) == 0 {
+		return false
+	}
+	for _, topic := range t.Topics {
+		if log.Topics[0] == topic {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *EventTrigger) matchesAddress(address common.Address) bool {
+	for _, watched := range t.addresses {
+		if watched == address {
+			return true
+		}
+	}
+	return false
+}
+
+// Fires if any of the provided triggers fire
+type anyTrigger struct {
+	triggers []Trigger
+}
+
+// Create a trigger that fires when any of the provided triggers fire
+func Any(triggers ...Trigger) Trigger {
+	return &anyTrigger{
+		triggers: triggers,
+	}
+}
+
+func (t *anyTrigger) IsDue(last *Tick, current *Tick) bool {
+	for _, trigger := range t.triggers {
+		if trigger.IsDue(last, current) {
+			return true
+		}
+	}
+	return false
+}
+
+// Get all of the event triggers contained within a trigger
+func getEventTriggers(trigger Trigger) []*EventTrigger {
+	switch t := trigger.(type) {
+	case *EventTrigger:
+		return []*EventTrigger{t}
+	case *anyTrigger:
+		eventTriggers := []*EventTrigger{}
+		for _, subtrigger := range t.triggers {
+			eventTriggers = append(eventTriggers, getEventTriggers(subtrigger)...)
+		}
+		return eventTriggers
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,101 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func TestIntervalTrigger(t *testing.T) {
+	trigger := Every(5 * time.Minute)
+	now := time.Now()
+	last := &Tick{Time: now}
+
+	if !trigger.IsDue(nil, &Tick{Time: now}) {
+		t.Fatal("expected a task that never ran to be due")
+	}
+	if trigger.IsDue(last, &Tick{Time: now.Add(time.Minute)}) {
+		t.Fatal("expected the task not to be due before the interval passed")
+	}
+	if !trigger.IsDue(last, &Tick{Time: now.Add(5 * time.Minute)}) {
+		t.Fatal("expected the task to be due once the interval passed")
+	}
+}
+
+func TestEpochAndBlockTriggers(t *testing.T) {
+	last := &Tick{Epoch: 100, BlockNumber: 1000}
+
+	epochs := EveryEpochs(2)
+	if epochs.IsDue(last, &Tick{Epoch: 101}) {
+		t.Fatal("expected the epoch trigger not to fire after 1 epoch")
+	}
+	if !epochs.IsDue(last, &Tick{Epoch: 102}) {
+		t.Fatal("expected the epoch trigger to fire after 2 epochs")
+	}
+
+	blocks := EveryBlocks(10)
+	if blocks.IsDue(last, &Tick{BlockNumber: 1009}) {
+		t.Fatal("expected the block trigger not to fire after 9 blocks")
+	}
+	if !blocks.IsDue(last, &Tick{BlockNumber: 1010}) {
+		t.Fatal("expected the block trigger to fire after 10 blocks")
+	}
+}
+
+func TestEventTrigger(t *testing.T) {
+	watched := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	other := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	topic := common.HexToHash("0xaa")
+
+	trigger := OnEvents("rocketMegapoolManager").WithTopics(topic)
+	trigger.addresses = []common.Address{watched}
+	last := &Tick{}
+
+	if trigger.IsDue(last, &Tick{}) {
+		t.Fatal("expected the event trigger not to fire without logs")
+	}
+	if trigger.IsDue(last, &Tick{Logs: []types.Log{{Address: other, Topics: []common.Hash{topic}}}}) {
+		t.Fatal("expected the event trigger to ignore logs from other contracts")
+	}
+	if trigger.IsDue(last, &Tick{Logs: []types.Log{{Address: watched, Topics: []common.Hash{common.HexToHash("0xbb")}}}}) {
+		t.Fatal("expected the event trigger to ignore other events")
+	}
+	if !trigger.IsDue(last, &Tick{Logs: []types.Log{{Address: watched, Topics: []common.Hash{topic}}}}) {
+		t.Fatal("expected the event trigger to fire on a matching log")
+	}
+
+	combined := Any(Every(time.Hour), trigger)
+	if len(getEventTriggers(combined)) != 1 {
+		t.Fatal("expected the combined trigger to expose its event trigger")
+	}
+	if !combined.IsDue(&Tick{Time: time.Now()}, &Tick{Time: time.Now(), Logs: []types.Log{{Address: watched, Topics: []common.Hash{topic}}}}) {
+		t.Fatal("expected the combined trigger to fire on a matching log")
+	}
+}
+
+func TestPendingLogs(t *testing.T) {
+	watched := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	other := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	trigger := OnEvents("rocketMegapoolManager")
+	trigger.addresses = []common.Address{watched}
+	entry := &taskEntry{task: Task{Trigger: Any(Every(time.Hour), trigger)}}
+
+	// Only the logs the task cares about are held on to
+	missed := []types.Log{{Address: watched, BlockNumber: 10}, {Address: other, BlockNumber: 10}}
+	entry.pendingLogs = filterLogs(entry.task.Trigger, missed)
+	if len(entry.pendingLogs) != 1 {
+		t.Fatalf("expected 1 pending log but got %d", len(entry.pendingLogs))
+	}
+
+	// The next tick fires the task with the missed log, even if it has none of its own
+	last := &Tick{Time: time.Now()}
+	tick := entry.getTick(&Tick{Time: time.Now(), BlockNumber: 20})
+	if !entry.task.Trigger.IsDue(last, tick) {
+		t.Fatal("expected the missed log to fire the task")
+	}
+	if len(tick.Logs) != 1 || tick.Logs[0].BlockNumber != 10 {
+		t.Fatalf("unexpected logs on the tick: %v", tick.Logs)
+	}
+}
@@ -8,7 +8,9 @@ import (
 
 	"github.com/docker/docker/client"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/fatih/color"
 	"github.com/rocket-pool/smartnode/bindings/rocketpool"
 	"github.com/rocket-pool/smartnode/bindings/utils/eth"
 	"github.com/urfave/cli"
@@ -16,21 +18,59 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services/beacon"
 	"github.com/rocket-pool/smartnode/shared/services/config"
 	"github.com/rocket-pool/smartnode/shared/services/contracts"
+	"github.com/rocket-pool/smartnode/shared/services/keymanager"
 	"github.com/rocket-pool/smartnode/shared/services/passwords"
+	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/services/store"
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
 	"github.com/rocket-pool/smartnode/shared/services/wallet"
 	lhkeystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore/lighthouse"
 	lokeystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore/lodestar"
 	nmkeystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore/nimbus"
 	prkeystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore/prysm"
 	tkkeystore "github.com/rocket-pool/smartnode/shared/services/wallet/keystore/teku"
+	"github.com/rocket-pool/smartnode/shared/services/wallet/keystore/web3signer"
+	"github.com/rocket-pool/smartnode/shared/services/wallet/offline"
+	"github.com/rocket-pool/smartnode/shared/services/wallet/signer"
+	apiutils "github.com/rocket-pool/smartnode/shared/utils/api"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
 	"github.com/rocket-pool/smartnode/shared/utils/rp"
 )
 
 // Config
 const (
 	dockerAPIVersion string = "1.40"
+
+	// The key a request's own wallet is stored under in the app's metadata
+	requestWalletKey string = "requestWallet"
 )
 
+// The contracts that transactions prepared for offline signing are decoded against
+var offlineSummaryContracts = []string{
+	"rocketNodeManager",
+	"rocketNodeDeposit",
+	"rocketNodeStaking",
+	"rocketNodeDistributorFactory",
+	"rocketNodeDistributorDelegate",
+	"rocketMegapoolFactory",
+	"rocketMegapoolManager",
+	"rocketMegapoolDelegate",
+	"rocketMinipoolManager",
+	"rocketMinipoolDelegate",
+	"rocketMerkleDistributorMainnet",
+	"rocketDepositPool",
+	"rocketTokenRPL",
+	"rocketTokenRPLFixedSupply",
+	"rocketTokenRETH",
+	"rocketDAOProtocolProposal",
+	"rocketDAOProtocolVerifier",
+	"rocketDAONodeTrusted",
+	"rocketDAONodeTrustedActions",
+	"rocketDAONodeTrustedProposals",
+	"rocketDAOSecurityActions",
+	"rocketDAOSecurityProposals",
+}
+
 // Service instances & initializers
 var (
 	cfg                  *config.RocketPoolConfig
@@ -43,6 +83,13 @@ var (
 	rocketSignerRegistry *contracts.RocketSignerRegistry
 	beaconClient         beacon.Client
 	docker               *client.Client
+	dutyStore            *store.DutyStore
+	txManager            *txmanager.TransactionManager
+	txManagerErr         error
+	snapshotStore        *state.SnapshotStore
+	remoteSigner         *web3signer.Client
+	keymanagerClient     *keymanager.Client
+	nodeSigner           signer.Signer
 
 	initCfg                  sync.Once
 	initPasswordManager      sync.Once
@@ -55,6 +102,15 @@ var (
 	initRocketSignerRegistry sync.Once
 	initBeaconClient         sync.Once
 	initDocker               sync.Once
+	initDutyStore            sync.Once
+	initTxManager            sync.Once
+	initSnapshotStore        sync.Once
+	initRemoteSigner         sync.Once
+	initKeymanagerClient     sync.Once
+	initNodeSigner           sync.Once
+
+	// Whether the current API server request switched the bindings to the protected RPC
+	apiRequestProtected bool
 )
 
 //
@@ -160,6 +216,159 @@ func GetDocker(c *cli.Context) (*client.Client, error) {
 	return docker, err
 }
 
+func GetDutyStore(c *cli.Context) (*store.DutyStore, error) {
+	cfg, err := getConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	initDutyStore.Do(func() {
+		dutyStore = store.NewDutyStore(cfg.Smartnode.GetDaemonStatePath())
+	})
+	return dutyStore, nil
+}
+
+func GetSnapshotStore(c *cli.Context) (*state.SnapshotStore, error) {
+	cfg, err := getConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	initSnapshotStore.Do(func() {
+		snapshotStore = state.NewSnapshotStore(cfg.Smartnode.GetStateSnapshotsPath(), cfg.Smartnode.StateSnapshotRetention.Value.(uint64))
+	})
+	return snapshotStore, nil
+}
+
+// Get the remote signer client; returns nil if the remote signer isn't enabled
+func GetRemoteSigner(c *cli.Context) (*web3signer.Client, error) {
+	cfg, err := getConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	return getRemoteSigner(cfg), nil
+}
+
+// Get the Validator Client's Keymanager API client; returns nil if the API isn't available
+func GetKeymanagerClient(c *cli.Context) (*keymanager.Client, error) {
+	cfg, err := getConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	return getKeymanagerClient(cfg), nil
+}
+
+func GetTransactionManager(c *cli.Context) (*txmanager.TransactionManager, error) {
+	cfg, err := getConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := GetHdWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	ec, err := getEthClient(c, cfg)
+	if err != nil {
+		return nil, err
+	}
+	ds, err := GetDutyStore(c)
+	if err != nil {
+		return nil, err
+	}
+	// Keep the error so every caller gets it, not just the first one
+	initTxManager.Do(func() {
+		logger := log.NewColorLogger(color.FgHiMagenta)
+		txManager, txManagerErr = txmanager.NewTransactionManager(cfg, ec, w.GetNodeAccountTransactor, ds, &logger)
+	})
+	return txManager, txManagerErr
+}
+
+// Prepare the shared services for an API request served by a long-running process, such as the API server.
+// The clients are kept between requests; requests that use the protected RPC or change the client managers' sync flags
+// change them for the whole process, so they must not run alongside other requests and must be followed by
+// FinishApiRequest. Requests without those flags don't change anything here.
+// Forcing the Beacon fallbacks doesn't touch the shared manager; GetBeaconClient hands such requests a view without the primary.
+func PrepareForApiRequest(c *cli.Context) {
+	// Rebuild the bindings on the protected RPC
+	if c.GlobalBool("use-protected-api") {
+		initRocketPool = sync.Once{}
+		rocketPool = nil
+		apiRequestProtected = true
+	}
+
+	ignoreSyncCheck := c.GlobalBool("ignore-sync-check")
+	forceFallbacks := c.GlobalBool("force-fallbacks")
+	if !ignoreSyncCheck && !forceFallbacks {
+		return
+	}
+	if ecManager != nil {
+		ecManager.ignoreSyncCheck = ignoreSyncCheck
+		ecManager.primaryReady = !forceFallbacks
+	}
+	if bcManager != nil {
+		bcManager.ignoreSyncCheck = ignoreSyncCheck
+	}
+}
+
+// Undo the changes an API request made to the shared services, once nothing else is using them.
+// The wallet is reloaded too, so it picks up any changes the request made to the wallet files.
+func FinishApiRequest() {
+	initNodeWallet = sync.Once{}
+	nodeWallet = nil
+
+	if apiRequestProtected {
+		initRocketPool = sync.Once{}
+		rocketPool = nil
+		apiRequestProtected = false
+	}
+	if ecManager != nil {
+		ecManager.ignoreSyncCheck = false
+		ecManager.primaryReady = true
+	}
+	if bcManager != nil {
+		bcManager.ignoreSyncCheck = false
+	}
+}
+
+// Drop every service so they're all rebuilt from the settings file the next time they're used.
+// Long-running processes use this when the settings file changes; nothing else can be using the services while it runs.
+func ReloadConfig() {
+	cfg = nil
+	passwordManager = nil
+	addressManager = nil
+	nodeWallet = nil
+	ecManager = nil
+	bcManager = nil
+	rocketPool = nil
+	rocketSignerRegistry = nil
+	beaconClient = nil
+	docker = nil
+	dutyStore = nil
+	txManager = nil
+	txManagerErr = nil
+	snapshotStore = nil
+	remoteSigner = nil
+	keymanagerClient = nil
+	nodeSigner = nil
+
+	initCfg = sync.Once{}
+	initPasswordManager = sync.Once{}
+	initAddressManager = sync.Once{}
+	initNodeWallet = sync.Once{}
+	initECManager = sync.Once{}
+	initBCManager = sync.Once{}
+	initRocketPool = sync.Once{}
+	initOneInchOracle = sync.Once{}
+	initRocketSignerRegistry = sync.Once{}
+	initBeaconClient = sync.Once{}
+	initDocker = sync.Once{}
+	initDutyStore = sync.Once{}
+	initTxManager = sync.Once{}
+	initSnapshotStore = sync.Once{}
+	initRemoteSigner = sync.Once{}
+	initKeymanagerClient = sync.Once{}
+	initNodeSigner = sync.Once{}
+	apiRequestProtected = false
+}
+
 //
 // Service instance getters
 //
@@ -178,7 +387,15 @@ func getConfig(c *cli.Context) (*config.RocketPoolConfig, error) {
 
 func getPasswordManager(cfg *config.RocketPoolConfig) *passwords.PasswordManager {
 	initPasswordManager.Do(func() {
-		passwordManager = passwords.NewPasswordManager(os.ExpandEnv(cfg.Smartnode.GetPasswordPath()))
+		passwordPath := os.ExpandEnv(cfg.Smartnode.GetPasswordPath())
+		switch cfg.Smartnode.WalletPasswordStorage.Value.(string) {
+		case passwords.StorageMode_Sealed:
+			passwordManager = passwords.NewSealedPasswordManager(passwordPath, os.ExpandEnv(cfg.Smartnode.GetPasswordKeyringPath()))
+		case passwords.StorageMode_Unlock:
+			passwordManager = passwords.NewUnlockPasswordManager(passwordPath, os.ExpandEnv(cfg.Smartnode.GetPasswordAgentSocketPath()))
+		default:
+			passwordManager = passwords.NewPasswordManager(passwordPath)
+		}
 	})
 	return passwordManager
 }
@@ -191,50 +408,161 @@ func getAddressManager(cfg *config.RocketPoolConfig) *wallet.AddressManager {
 }
 
 func getWallet(c *cli.Context, cfg *config.RocketPoolConfig, pm *passwords.PasswordManager, am *wallet.AddressManager, ignoreMasquerade bool) (wallet.Wallet, error) {
-	var err error
-	initNodeWallet.Do(func() {
-		var maxFee *big.Int
-		maxFeeFloat := c.GlobalFloat64("maxFee")
-		if maxFeeFloat == 0 {
-			maxFeeFloat = cfg.Smartnode.ManualMaxFee.Value.(float64)
+	// Gas settings and offline signing only apply to the request that asked for them, so requests with them get their
+	// own wallet instead of changing the one a long-running process like the API server shares between requests
+	if c.GlobalFloat64("maxFee") != 0 || c.GlobalFloat64("maxPrioFee") != 0 || c.GlobalBool("unsigned") {
+		if requestWallet, exists := c.App.Metadata[requestWalletKey].(wallet.Wallet); exists {
+			return requestWallet, nil
 		}
-		if maxFeeFloat != 0 {
-			maxFee = eth.GweiToWei(maxFeeFloat)
+		requestWallet, err := newWallet(c, cfg, pm, am, ignoreMasquerade)
+		if err != nil {
+			return nil, err
 		}
-
-		var maxPriorityFee *big.Int
-		maxPriorityFeeFloat := c.GlobalFloat64("maxPrioFee")
-		if maxPriorityFeeFloat == 0 {
-			maxPriorityFeeFloat = cfg.Smartnode.PriorityFee.Value.(float64)
+		if c.App.Metadata != nil {
+			c.App.Metadata[requestWalletKey] = requestWallet
 		}
-		if maxPriorityFeeFloat != 0 {
-			maxPriorityFee = eth.GweiToWei(maxPriorityFeeFloat)
+		return requestWallet, nil
+	}
+
+	var err error
+	initNodeWallet.Do(func() {
+		nodeWallet, err = newWallet(c, cfg, pm, am, ignoreMasquerade)
+	})
+	return nodeWallet, err
+}
+
+// Create the node wallet with the gas settings of the provided context
+func newWallet(c *cli.Context, cfg *config.RocketPoolConfig, pm *passwords.PasswordManager, am *wallet.AddressManager, ignoreMasquerade bool) (wallet.Wallet, error) {
+	var maxFee *big.Int
+	maxFeeFloat := c.GlobalFloat64("maxFee")
+	if maxFeeFloat == 0 {
+		maxFeeFloat = cfg.Smartnode.ManualMaxFee.Value.(float64)
+	}
+	if maxFeeFloat != 0 {
+		maxFee = eth.GweiToWei(maxFeeFloat)
+	}
+
+	var maxPriorityFee *big.Int
+	maxPriorityFeeFloat := c.GlobalFloat64("maxPrioFee")
+	if maxPriorityFeeFloat == 0 {
+		maxPriorityFeeFloat = cfg.Smartnode.PriorityFee.Value.(float64)
+	}
+	if maxPriorityFeeFloat != 0 {
+		maxPriorityFee = eth.GweiToWei(maxPriorityFeeFloat)
+	}
+
+	chainId := cfg.Smartnode.GetChainID()
+
+	var w wallet.Wallet
+	var err error
+	if ignoreMasquerade {
+		w, err = wallet.NewHdWallet(os.ExpandEnv(cfg.Smartnode.GetWalletPath()), chainId, maxFee, maxPriorityFee, 0, pm, am)
+	} else {
+		w, err = wallet.NewWallet(os.ExpandEnv(cfg.Smartnode.GetNodeAddressPath()), os.ExpandEnv(cfg.Smartnode.GetWalletPath()), chainId, maxFee, maxPriorityFee, 0, pm, am)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// External node account signer
+	ns, err := getNodeSigner(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if ns != nil {
+		w.SetNodeSigner(ns)
+	}
+
+	// Prepare transactions for offline signing instead of submitting them
+	if c.GlobalBool("unsigned") {
+		w.SetTransactionCapture(captureUnsignedTransaction)
+	}
+
+	// Keystores; with a remote signer, keys are imported into it instead of being written to disk
+	if rs := getRemoteSigner(cfg); rs != nil {
+		w.AddKeystore("web3signer", web3signer.NewKeystore(os.ExpandEnv(cfg.Smartnode.GetValidatorKeychainPath()), rs))
+		return w, nil
+	}
+	lighthouseKeystore := lhkeystore.NewKeystore(os.ExpandEnv(cfg.Smartnode.GetValidatorKeychainPath()), pm)
+	lodestarKeystore := lokeystore.NewKeystore(os.ExpandEnv(cfg.Smartnode.GetValidatorKeychainPath()), pm)
+	nimbusKeystore := nmkeystore.NewKeystore(os.ExpandEnv(cfg.Smartnode.GetValidatorKeychainPath()), pm)
+	prysmKeystore := prkeystore.NewKeystore(os.ExpandEnv(cfg.Smartnode.GetValidatorKeychainPath()), pm)
+	tekuKeystore := tkkeystore.NewKeystore(os.ExpandEnv(cfg.Smartnode.GetValidatorKeychainPath()), pm)
+	w.AddKeystore("lighthouse", lighthouseKeystore)
+	w.AddKeystore("lodestar", lodestarKeystore)
+	w.AddKeystore("nimbus", nimbusKeystore)
+	w.AddKeystore("prysm", prysmKeystore)
+	w.AddKeystore("teku", tekuKeystore)
+	return w, nil
+}
+
+// Add a node account transaction to the API response for offline signing, with a summary of what it does
+func captureUnsignedTransaction(from common.Address, tx *types.Transaction) error {
+	unsignedTx, err := offline.NewUnsignedTransaction(from, new(big.Int).SetUint64(uint64(cfg.Smartnode.GetChainID())), tx)
+	if err != nil {
+		return err
+	}
+
+	// The bindings have already been created by the command that built the transaction, so its contracts can be decoded
+	knownContracts := []offline.KnownContract{}
+	if rocketPool != nil {
+		for _, name := range offlineSummaryContracts {
+			contract, err := rocketPool.GetContract(name, nil)
+			if err != nil {
+				continue
+			}
+			knownContracts = append(knownContracts, offline.KnownContract{
+				Name:    name,
+				Address: contract.Address,
+				ABI:     contract.ABI,
+			})
 		}
+	}
+	unsignedTx.Summary = offline.Summarize(tx, knownContracts)
 
-		chainId := cfg.Smartnode.GetChainID()
+	apiutils.AddUnsignedTransaction(unsignedTx)
+	return nil
+}
 
-		if ignoreMasquerade {
-			nodeWallet, err = wallet.NewHdWallet(os.ExpandEnv(cfg.Smartnode.GetWalletPath()), chainId, maxFee, maxPriorityFee, 0, pm, am)
-		} else {
-			nodeWallet, err = wallet.NewWallet(os.ExpandEnv(cfg.Smartnode.GetNodeAddressPath()), os.ExpandEnv(cfg.Smartnode.GetWalletPath()), chainId, maxFee, maxPriorityFee, 0, pm, am)
+func getRemoteSigner(cfg *config.RocketPoolConfig) *web3signer.Client {
+	initRemoteSigner.Do(func() {
+		if cfg.Smartnode.UseRemoteSigner.Value.(bool) {
+			remoteSigner = web3signer.NewClient(cfg.Smartnode.RemoteSignerUrl.Value.(string), cfg.Smartnode.RemoteSignerAuthToken.Value.(string))
 		}
-		if err != nil {
+	})
+	return remoteSigner
+}
+
+func getNodeSigner(cfg *config.RocketPoolConfig) (signer.Signer, error) {
+	var err error
+	initNodeSigner.Do(func() {
+		url := cfg.Smartnode.NodeSignerUrl.Value.(string)
+		authToken := cfg.Smartnode.NodeSignerAuthToken.Value.(string)
+		address := common.HexToAddress(cfg.Smartnode.NodeSignerAddress.Value.(string))
+		var rs *signer.RemoteSigner
+		switch cfg.Smartnode.NodeSigner.Value.(string) {
+		case config.NodeSigner_Clef:
+			rs, err = signer.NewClefSigner(url, authToken, address)
+		case config.NodeSigner_Eip1193:
+			rs, err = signer.NewEip1193Signer(url, authToken, address)
+		default:
 			return
 		}
+		if err == nil {
+			nodeSigner = rs
+		}
+	})
+	return nodeSigner, err
+}
 
-		// Keystores
-		lighthouseKeystore := lhkeystore.NewKeystore(os.ExpandEnv(cfg.Smartnode.GetValidatorKeychainPath()), pm)
-		lodestarKeystore := lokeystore.NewKeystore(os.ExpandEnv(cfg.Smartnode.GetValidatorKeychainPath()), pm)
-		nimbusKeystore := nmkeystore.NewKeystore(os.ExpandEnv(cfg.Smartnode.GetValidatorKeychainPath()), pm)
-		prysmKeystore := prkeystore.NewKeystore(os.ExpandEnv(cfg.Smartnode.GetValidatorKeychainPath()), pm)
-		tekuKeystore := tkkeystore.NewKeystore(os.ExpandEnv(cfg.Smartnode.GetValidatorKeychainPath()), pm)
-		nodeWallet.AddKeystore("lighthouse", lighthouseKeystore)
-		nodeWallet.AddKeystore("lodestar", lodestarKeystore)
-		nodeWallet.AddKeystore("nimbus", nimbusKeystore)
-		nodeWallet.AddKeystore("prysm", prysmKeystore)
-		nodeWallet.AddKeystore("teku", tekuKeystore)
+func getKeymanagerClient(cfg *config.RocketPoolConfig) *keymanager.Client {
+	initKeymanagerClient.Do(func() {
+		url := cfg.GetKeymanagerApiUrl()
+		if url != "" {
+			keymanagerClient = keymanager.NewClient(url, os.ExpandEnv(cfg.Smartnode.GetKeymanagerApiTokenPath()))
+		}
 	})
-	return nodeWallet, err
+	return keymanagerClient
 }
 
 func getEthClient(c *cli.Context, cfg *config.RocketPoolConfig) (*ExecutionClientManager, error) {
@@ -284,10 +612,12 @@ func getBeaconClient(c *cli.Context, cfg *config.RocketPoolConfig) (*BeaconClien
 			if c.GlobalBool("ignore-sync-check") {
 				bcManager.ignoreSyncCheck = true
 			}
-			if c.GlobalBool("force-fallbacks") {
-				bcManager.primaryReady = false
-			}
 		}
 	})
+
+	// Forcing the fallbacks only applies to the current command, so use a view of the shared manager
+	if bcManager != nil && c.GlobalBool("force-fallbacks") {
+		return bcManager.withoutPrimary(), err
+	}
 	return bcManager, err
 }
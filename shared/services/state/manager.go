@@ -23,6 +23,9 @@ type NetworkStateManager struct {
 	// Multicaller and batch balance contract addresses
 	multicaller    common.Address
 	balanceBatcher common.Address
+
+	// Optional cache of network state snapshots
+	snapshots *SnapshotStore
 }
 
 // Create a new manager for the network state
@@ -43,6 +46,11 @@ func NewNetworkStateManager(
 	}
 }
 
+// Set the snapshot store used to serve states for past slots without rebuilding them
+func (m *NetworkStateManager) SetSnapshotStore(snapshots *SnapshotStore) {
+	m.snapshots = snapshots
+}
+
 func (m *NetworkStateManager) getBeaconConfig() (*beacon.Eth2Config, error) {
 	if m.beaconConfig != nil {
 		return m.beaconConfig, nil
@@ -76,9 +84,40 @@ func (m *NetworkStateManager) GetHeadStateForNode(nodeAddress common.Address) (*
 	return m.createNetworkStateForNode(targetSlot, nodeAddress)
 }
 
-// Get the state of the network at the provided Beacon slot
+// Get the state of the network at the provided Beacon slot, using the snapshot store if it has one for the slot
 func (m *NetworkStateManager) GetStateForSlot(slotNumber uint64) (*NetworkState, error) {
-	return m.createNetworkState(slotNumber)
+	state, _, err := m.GetStateForSlotWithSource(slotNumber)
+	return state, err
+}
+
+// Get the state of the network at the provided Beacon slot, and whether it came from the snapshot store
+func (m *NetworkStateManager) GetStateForSlotWithSource(slotNumber uint64) (*NetworkState, bool, error) {
+	if m.snapshots != nil {
+		state, exists, err := m.snapshots.Load(slotNumber)
+		if err == nil && exists {
+			err = m.validateSnapshot(state, slotNumber)
+		}
+		if err != nil {
+			m.logLine("WARNING: couldn't use the network state snapshot for slot %d, rebuilding it: %s", slotNumber, err.Error())
+		} else if exists {
+			m.logLine("Loaded the network state for slot %d from a snapshot", slotNumber)
+			return state, true, nil
+		}
+	}
+	state, err := m.createNetworkState(slotNumber)
+	return state, false, err
+}
+
+// Check a snapshot against the Beacon block for its slot
+func (m *NetworkStateManager) validateSnapshot(state *NetworkState, slotNumber uint64) error {
+	block, exists, err := m.bc.GetBeaconBlock(fmt.Sprintf("%d", slotNumber))
+	if err != nil {
+		return fmt.Errorf("error getting Beacon block for slot %d: %w", slotNumber, err)
+	}
+	if !exists {
+		return fmt.Errorf("slot %d did not have a Beacon block", slotNumber)
+	}
+	return ValidateSnapshot(state, block)
 }
 
 // Gets the latest valid block
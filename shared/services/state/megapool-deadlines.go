@@ -0,0 +1,158 @@
+package state
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/smartnode/bindings/megapool"
+	"github.com/rocket-pool/smartnode/bindings/rocketpool"
+	"github.com/rocket-pool/smartnode/bindings/settings/protocol"
+	"github.com/rocket-pool/smartnode/bindings/types"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+)
+
+// The withdrawable epoch of a validator that hasn't started exiting
+const farFutureEpoch uint64 = 0xffffffffffffffff
+
+// A duty a megapool validator has to perform before a deadline
+type MegapoolDeadlineType string
+
+const (
+	// A prestaked validator has to be staked before it can be dissolved
+	MegapoolDeadline_Dissolve MegapoolDeadlineType = "dissolve"
+
+	// An exiting validator's exit has to be notified before it can be challenged
+	MegapoolDeadline_ExitNotification MegapoolDeadlineType = "exit-notification"
+
+	// A withdrawn validator's final balance has to be notified before the user distribute window ends
+	MegapoolDeadline_FinalBalance MegapoolDeadlineType = "final-balance"
+)
+
+// Get a description of the duty, phrased to follow a validator ID
+func (t MegapoolDeadlineType) Description() string {
+	switch t {
+	case MegapoolDeadline_Dissolve:
+		return "must be staked before it can be dissolved"
+	case MegapoolDeadline_ExitNotification:
+		return "must have its exit notified before it can be challenged"
+	case MegapoolDeadline_FinalBalance:
+		return "must have its final balance notified"
+	default:
+		return string(t)
+	}
+}
+
+// The megapool settings used to calculate deadlines
+type MegapoolDeadlineSettings struct {
+	TimeBeforeDissolve   time.Duration `json:"timeBeforeDissolve"`
+	NotifyThreshold      time.Duration `json:"notifyThreshold"`
+	UserDistributeWindow time.Duration `json:"userDistributeWindow"`
+}
+
+// An upcoming deadline for a megapool validator
+type MegapoolDeadline struct {
+	Type     MegapoolDeadlineType `json:"type"`
+	Deadline time.Time            `json:"deadline"`
+}
+
+// An upcoming deadline for one of the validators in the network state
+type MegapoolValidatorDeadline struct {
+	MegapoolDeadline
+	MegapoolAddress common.Address        `json:"megapoolAddress"`
+	ValidatorId     uint32                `json:"validatorId"`
+	Pubkey          types.ValidatorPubkey `json:"pubkey"`
+}
+
+// Get the megapool settings used to calculate deadlines
+func GetMegapoolDeadlineSettings(rp *rocketpool.RocketPool, opts *bind.CallOpts) (MegapoolDeadlineSettings, error) {
+	settings := MegapoolDeadlineSettings{}
+	timeBeforeDissolve, err := protocol.GetMegapoolTimeBeforeDissolve(rp, opts)
+	if err != nil {
+		return settings, fmt.Errorf("error getting time before dissolve: %w", err)
+	}
+	notifyThreshold, err := protocol.GetNotifyThreshold(rp, opts)
+	if err != nil {
+		return settings, fmt.Errorf("error getting notify threshold: %w", err)
+	}
+	userDistributeWindow, err := protocol.GetUserDistributeWindowLength(rp, opts)
+	if err != nil {
+		return settings, fmt.Errorf("error getting user distribute window length: %w", err)
+	}
+	settings.TimeBeforeDissolve = time.Duration(timeBeforeDissolve) * time.Second
+	settings.NotifyThreshold = time.Duration(notifyThreshold) * time.Second
+	settings.UserDistributeWindow = time.Duration(userDistributeWindow) * time.Second
+	return settings, nil
+}
+
+// Get the next deadline for a megapool validator, given its withdrawable epoch on the Beacon Chain.
+// Returns false if the validator doesn't have anything pending.
+func GetMegapoolValidatorDeadline(validator megapool.ValidatorInfo, withdrawableEpoch uint64, beaconConfig *beacon.Eth2Config, settings *MegapoolDeadlineSettings) (MegapoolDeadline, bool) {
+	// Prestaked validators are dissolved if they aren't staked in time
+	if validator.InPrestake {
+		assignmentTime := time.Unix(int64(validator.LastAssignmentTime), 0)
+		return MegapoolDeadline{
+			Type:     MegapoolDeadline_Dissolve,
+			Deadline: assignmentTime.Add(settings.TimeBeforeDissolve),
+		}, true
+	}
+
+	// Everything else only applies to validators that are exiting on the Beacon Chain
+	if !validator.Staked || validator.Exited || validator.Dissolved || withdrawableEpoch == 0 || withdrawableEpoch == farFutureEpoch {
+		return MegapoolDeadline{}, false
+	}
+	withdrawableTime := beaconConfig.GetSlotTime(beaconConfig.EpochToSlot(withdrawableEpoch))
+
+	// Locked validators have already been challenged, which is handled by the challenge defense
+	if !validator.Exiting && !validator.Locked {
+		return MegapoolDeadline{
+			Type:     MegapoolDeadline_ExitNotification,
+			Deadline: withdrawableTime.Add(-settings.NotifyThreshold),
+		}, true
+	}
+	if validator.Exiting {
+		return MegapoolDeadline{
+			Type:     MegapoolDeadline_FinalBalance,
+			Deadline: withdrawableTime.Add(settings.UserDistributeWindow),
+		}, true
+	}
+	return MegapoolDeadline{}, false
+}
+
+// Get the next deadline for each validator of the megapools in the state, sorted by deadline
+func (s *NetworkState) GetMegapoolDeadlines(settings *MegapoolDeadlineSettings) []MegapoolValidatorDeadline {
+	deadlines := []MegapoolValidatorDeadline{}
+	for _, validator := range s.MegapoolValidatorGlobalIndex {
+		if _, exists := s.MegapoolDetails[validator.MegapoolAddress]; !exists {
+			continue
+		}
+
+		var pubkey types.ValidatorPubkey
+		withdrawableEpoch := farFutureEpoch
+		if len(validator.Pubkey) > 0 {
+			pubkey = types.BytesToValidatorPubkey(validator.Pubkey)
+			if status, exists := s.MegapoolValidatorDetails[pubkey]; exists && status.Exists {
+				withdrawableEpoch = status.WithdrawableEpoch
+			}
+		}
+
+		deadline, exists := GetMegapoolValidatorDeadline(validator.ValidatorInfo, withdrawableEpoch, &s.BeaconConfig, settings)
+		if !exists {
+			continue
+		}
+		deadlines = append(deadlines, MegapoolValidatorDeadline{
+			MegapoolDeadline: deadline,
+			MegapoolAddress:  validator.MegapoolAddress,
+			ValidatorId:      validator.ValidatorId,
+			Pubkey:           pubkey,
+		})
+	}
+
+	sort.Slice(deadlines, func(i, j int) bool {
+		return deadlines[i].Deadline.Before(deadlines[j].Deadline)
+	})
+	return deadlines
+}
@@ -0,0 +1,115 @@
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/smartnode/bindings/megapool"
+	rpstate "github.com/rocket-pool/smartnode/bindings/utils/state"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+)
+
+var testBeaconConfig = beacon.Eth2Config{
+	GenesisTime:    1606824023,
+	SecondsPerSlot: 12,
+	SlotsPerEpoch:  32,
+}
+
+var testDeadlineSettings = MegapoolDeadlineSettings{
+	TimeBeforeDissolve:   14 * 24 * time.Hour,
+	NotifyThreshold:      12 * time.Hour,
+	UserDistributeWindow: 7 * 24 * time.Hour,
+}
+
+func TestMegapoolValidatorDeadline(t *testing.T) {
+	withdrawableEpoch := uint64(300000)
+	withdrawableTime := time.Unix(int64(testBeaconConfig.GenesisTime+withdrawableEpoch*32*12), 0)
+
+	tests := []struct {
+		name              string
+		validator         megapool.ValidatorInfo
+		withdrawableEpoch uint64
+		expectedType      MegapoolDeadlineType
+		expectedDeadline  time.Time
+	}{
+		{
+			name:             "prestake",
+			validator:        megapool.ValidatorInfo{InPrestake: true, LastAssignmentTime: 1700000000},
+			expectedType:     MegapoolDeadline_Dissolve,
+			expectedDeadline: time.Unix(1700000000, 0).Add(testDeadlineSettings.TimeBeforeDissolve),
+		},
+		{
+			name:              "exit not notified",
+			validator:         megapool.ValidatorInfo{Staked: true},
+			withdrawableEpoch: withdrawableEpoch,
+			expectedType:      MegapoolDeadline_ExitNotification,
+			expectedDeadline:  withdrawableTime.Add(-testDeadlineSettings.NotifyThreshold),
+		},
+		{
+			name:              "final balance not notified",
+			validator:         megapool.ValidatorInfo{Staked: true, Exiting: true},
+			withdrawableEpoch: withdrawableEpoch,
+			expectedType:      MegapoolDeadline_FinalBalance,
+			expectedDeadline:  withdrawableTime.Add(testDeadlineSettings.UserDistributeWindow),
+		},
+		{
+			name:              "staking",
+			validator:         megapool.ValidatorInfo{Staked: true},
+			withdrawableEpoch: farFutureEpoch,
+		},
+		{
+			name:              "challenged",
+			validator:         megapool.ValidatorInfo{Staked: true, Locked: true},
+			withdrawableEpoch: withdrawableEpoch,
+		},
+		{
+			name:              "exited",
+			validator:         megapool.ValidatorInfo{Staked: true, Exiting: true, Exited: true},
+			withdrawableEpoch: withdrawableEpoch,
+		},
+	}
+
+	for _, test := range tests {
+		deadline, exists := GetMegapoolValidatorDeadline(test.validator, test.withdrawableEpoch, &testBeaconConfig, &testDeadlineSettings)
+		if test.expectedType == "" {
+			if exists {
+				t.Errorf("%s: expected no deadline, got %+v", test.name, deadline)
+			}
+			continue
+		}
+		if !exists {
+			t.Errorf("%s: expected a deadline", test.name)
+			continue
+		}
+		if deadline.Type != test.expectedType || !deadline.Deadline.Equal(test.expectedDeadline) {
+			t.Errorf("%s: expected %s at %s, got %s at %s", test.name, test.expectedType, test.expectedDeadline, deadline.Type, deadline.Deadline)
+		}
+	}
+}
+
+func TestNetworkStateMegapoolDeadlines(t *testing.T) {
+	megapoolAddress := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	otherAddress := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	state := &NetworkState{
+		BeaconConfig: testBeaconConfig,
+		MegapoolDetails: map[common.Address]rpstate.NativeMegapoolDetails{
+			megapoolAddress: {Address: megapoolAddress, Deployed: true},
+		},
+		MegapoolValidatorGlobalIndex: []megapool.ValidatorInfoFromGlobalIndex{
+			{MegapoolAddress: megapoolAddress, ValidatorId: 0, ValidatorInfo: megapool.ValidatorInfo{InPrestake: true, LastAssignmentTime: 1700100000}},
+			{MegapoolAddress: megapoolAddress, ValidatorId: 1, ValidatorInfo: megapool.ValidatorInfo{InPrestake: true, LastAssignmentTime: 1700000000}},
+			{MegapoolAddress: megapoolAddress, ValidatorId: 2, ValidatorInfo: megapool.ValidatorInfo{InQueue: true}},
+			{MegapoolAddress: otherAddress, ValidatorId: 0, ValidatorInfo: megapool.ValidatorInfo{InPrestake: true, LastAssignmentTime: 1700000000}},
+		},
+	}
+
+	deadlines := state.GetMegapoolDeadlines(&testDeadlineSettings)
+	if len(deadlines) != 2 {
+		t.Fatalf("expected 2 deadlines, got %d", len(deadlines))
+	}
+	if deadlines[0].ValidatorId != 1 || deadlines[1].ValidatorId != 0 {
+		t.Fatalf("expected the deadlines to be sorted soonest first, got validators %d and %d", deadlines[0].ValidatorId, deadlines[1].ValidatorId)
+	}
+}
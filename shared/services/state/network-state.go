@@ -67,6 +67,7 @@ type NetworkState struct {
 
 	// Block / slot for this state
 	ElBlockNumber    uint64            `json:"el_block_number"`
+	ElBlockHash      common.Hash       `json:"el_block_hash"`
 	BeaconSlotNumber uint64            `json:"beacon_slot_number"`
 	BeaconConfig     beacon.Eth2Config `json:"beacon_config"`
 
@@ -85,10 +86,11 @@ type NetworkState struct {
 	// Stores validator details from all megapools
 	MegapoolValidatorGlobalIndex []megapool.ValidatorInfoFromGlobalIndex `json:"megapool_validator_global_index"`
 
-	// Map megapool addresses to the pubkeys of its validators
+	// Map megapool addresses to the pubkeys of its validators. This is an index over MegapoolValidatorGlobalIndex
+	// and is ignored when marshaling to JSON; it is rebuilt when unmarshaling from JSON.
 	MegapoolToPubkeysMap map[common.Address][]types.ValidatorPubkey `json:"-"`
 
-	MegapoolDetails map[common.Address]rpstate.NativeMegapoolDetails `json:"-"`
+	MegapoolDetails map[common.Address]rpstate.NativeMegapoolDetails `json:"megapool_details,omitempty"`
 
 	// These next two fields are indexes over MinipoolDetails and are ignored when marshaling to JSON
 	// they are rebuilt when unmarshaling from JSON.
@@ -163,6 +165,14 @@ func (ns *NetworkState) UnmarshalJSON(data []byte) error {
 		ns.MinipoolDetailsByNode[details.NodeAddress] = append(nodeList, currentDetails)
 	}
 
+	// Rebuild the megapool pubkeys index
+	ns.MegapoolToPubkeysMap = make(map[common.Address][]types.ValidatorPubkey)
+	for _, validator := range ns.MegapoolValidatorGlobalIndex {
+		if len(validator.Pubkey) > 0 {
+			ns.MegapoolToPubkeysMap[validator.MegapoolAddress] = append(ns.MegapoolToPubkeysMap[validator.MegapoolAddress], types.BytesToValidatorPubkey(validator.Pubkey))
+		}
+	}
+
 	return nil
 }
 
@@ -200,6 +210,7 @@ func (m *NetworkStateManager) createNetworkState(slotNumber uint64) (*NetworkSta
 		MinipoolDetailsByNode:    map[common.Address][]*rpstate.NativeMinipoolDetails{},
 		BeaconSlotNumber:         slotNumber,
 		ElBlockNumber:            elBlockNumber,
+		ElBlockHash:              beaconBlock.ExecutionBlockHash,
 		BeaconConfig:             *beaconConfig,
 		IsSaturnDeployed:         isSaturnDeployed,
 	}
@@ -298,7 +309,7 @@ func (m *NetworkStateManager) createNetworkState(slotNumber uint64) (*NetworkSta
 				if err != nil {
 					return err
 				}
-				megapoolDetails, err := rpstate.GetNodeMegapoolDetails(m.rp, nodeAddress)
+				megapoolDetails, err := rpstate.GetNodeMegapoolDetails(m.rp, nodeAddress, opts)
 				if err != nil {
 					return err
 				}
@@ -357,7 +368,7 @@ func (m *NetworkStateManager) createNetworkState(slotNumber uint64) (*NetworkSta
 
 // Creates a snapshot of the Rocket Pool network, but only for a single node
 func (m *NetworkStateManager) createNetworkStateForNode(slotNumber uint64, nodeAddress common.Address) (*NetworkState, error) {
-	steps := 5
+	steps := 6
 
 	// Get the execution block for the given slot
 	beaconBlock, exists, err := m.bc.GetBeaconBlock(fmt.Sprintf("%d", slotNumber))
@@ -378,6 +389,10 @@ func (m *NetworkStateManager) createNetworkStateForNode(slotNumber uint64, nodeA
 	if err != nil {
 		return nil, err
 	}
+	if isSaturnDeployed {
+		// The node's megapool is an extra step
+		steps++
+	}
 	beaconConfig, err := m.getBeaconConfig()
 	if err != nil {
 		return nil, fmt.Errorf("error getting Beacon config: %w", err)
@@ -390,6 +405,7 @@ func (m *NetworkStateManager) createNetworkStateForNode(slotNumber uint64, nodeA
 		MinipoolDetailsByNode:    map[common.Address][]*rpstate.NativeMinipoolDetails{},
 		BeaconSlotNumber:         slotNumber,
 		ElBlockNumber:            elBlockNumber,
+		ElBlockHash:              beaconBlock.ExecutionBlockHash,
 		BeaconConfig:             *beaconConfig,
 		IsSaturnDeployed:         isSaturnDeployed,
 	}
@@ -493,9 +509,54 @@ func (m *NetworkStateManager) createNetworkStateForNode(slotNumber uint64, nodeA
 	m.logLine("%d/%d - Retrieved Protocol DAO proposals (total time: %s)", currentStep, steps, time.Since(start))
 	currentStep++
 
+	// Get the node's megapool
+	if isSaturnDeployed {
+		err = m.getNodeMegapoolDetails(state, nodeAddress, opts)
+		if err != nil {
+			return nil, fmt.Errorf("error getting megapool details: %w", err)
+		}
+		m.logLine("%d/%d - Retrieved megapool details (total time: %s)", currentStep, steps, time.Since(start))
+		currentStep++
+	}
+
 	return state, nil
 }
 
+// Get the details of a node's megapool and its validators, if it has one. The megapool lookups only contain this megapool.
+func (m *NetworkStateManager) getNodeMegapoolDetails(state *NetworkState, nodeAddress common.Address, opts *bind.CallOpts) error {
+	state.MegapoolDetails = map[common.Address]rpstate.NativeMegapoolDetails{}
+	state.MegapoolToPubkeysMap = map[common.Address][]types.ValidatorPubkey{}
+
+	megapoolDetails, err := rpstate.GetNodeMegapoolDetails(m.rp, nodeAddress, opts)
+	if err != nil {
+		return err
+	}
+	if !megapoolDetails.Deployed {
+		return nil
+	}
+	state.MegapoolDetails[megapoolDetails.Address] = megapoolDetails
+
+	// Get the validators and their Beacon statuses
+	state.MegapoolValidatorGlobalIndex, err = rpstate.GetMegapoolValidators(m.rp, megapoolDetails.Address, opts)
+	if err != nil {
+		return err
+	}
+	pubkeys := make([]types.ValidatorPubkey, 0, len(state.MegapoolValidatorGlobalIndex))
+	for _, validator := range state.MegapoolValidatorGlobalIndex {
+		if len(validator.Pubkey) > 0 {
+			pubkeys = append(pubkeys, types.ValidatorPubkey(validator.Pubkey))
+		}
+	}
+	state.MegapoolToPubkeysMap[megapoolDetails.Address] = pubkeys
+	state.MegapoolValidatorDetails, err = m.bc.GetValidatorStatuses(pubkeys, &beacon.ValidatorStatusOptions{
+		Slot: &state.BeaconSlotNumber,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 func (s *NetworkState) GetStakedRplValueInEthAndPercentOfBorrowedEth(eligibleBorrowedEth *big.Int, nodeStake *big.Int) (*big.Int, *big.Int) {
 
 	rplPrice := s.NetworkDetails.RplPrice
@@ -0,0 +1,207 @@
+package state
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+)
+
+const (
+	snapshotFilePrefix string = "network-state-"
+	snapshotFileSuffix string = ".json.gz"
+	checksumFileSuffix string = ".sha256"
+)
+
+// Stores compressed network state snapshots in a folder, one file per Beacon slot
+type SnapshotStore struct {
+	path string
+
+	// The number of snapshots to keep; 0 keeps all of them
+	retention uint64
+}
+
+// Create a new snapshot store in the provided folder
+func NewSnapshotStore(path string, retention uint64) *SnapshotStore {
+	return &SnapshotStore{
+		path:      path,
+		retention: retention,
+	}
+}
+
+// Get the path of the snapshot for a slot
+func (s *SnapshotStore) getSnapshotPath(slot uint64) string {
+	return filepath.Join(s.path, fmt.Sprintf("%s%d%s", snapshotFilePrefix, slot, snapshotFileSuffix))
+}
+
+// Get the path of the checksum file for a slot's snapshot
+func (s *SnapshotStore) getChecksumPath(slot uint64) string {
+	return s.getSnapshotPath(slot) + checksumFileSuffix
+}
+
+// Check if there's a snapshot for a slot
+func (s *SnapshotStore) Has(slot uint64) bool {
+	_, err := os.Stat(s.getSnapshotPath(slot))
+	return err == nil
+}
+
+// Load the snapshot for a slot. Returns false if there isn't one.
+// The snapshot's checksum and slot are verified; use ValidateSnapshot to check it against the chain.
+func (s *SnapshotStore) Load(slot uint64) (*NetworkState, bool, error) {
+	data, err := os.ReadFile(s.getSnapshotPath(slot))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("error reading snapshot for slot %d: %w", slot, err)
+	}
+
+	// Make sure the file hasn't been modified or truncated since it was saved
+	checksum, err := os.ReadFile(s.getChecksumPath(slot))
+	if err != nil {
+		return nil, false, fmt.Errorf("error reading checksum of snapshot for slot %d: %w", slot, err)
+	}
+	hash := sha256.Sum256(data)
+	if strings.TrimSpace(string(checksum)) != hex.EncodeToString(hash[:]) {
+		return nil, false, fmt.Errorf("checksum mismatch for snapshot of slot %d", slot)
+	}
+
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, false, fmt.Errorf("error decompressing snapshot for slot %d: %w", slot, err)
+	}
+	defer reader.Close()
+
+	var state NetworkState
+	err = json.NewDecoder(reader).Decode(&state)
+	if err != nil {
+		return nil, false, fmt.Errorf("error decoding snapshot for slot %d: %w", slot, err)
+	}
+	if state.BeaconSlotNumber != slot {
+		return nil, false, fmt.Errorf("snapshot for slot %d contains the state for slot %d", slot, state.BeaconSlotNumber)
+	}
+	return &state, true, nil
+}
+
+// Check that a snapshot was built from the provided Beacon block and its Execution block
+func ValidateSnapshot(state *NetworkState, block beacon.BeaconBlock) error {
+	if state.BeaconSlotNumber != block.Slot {
+		return fmt.Errorf("snapshot is for slot %d but the block is for slot %d", state.BeaconSlotNumber, block.Slot)
+	}
+	if state.ElBlockNumber != block.ExecutionBlockNumber {
+		return fmt.Errorf("snapshot for slot %d is for EL block %d but the slot's EL block is %d", block.Slot, state.ElBlockNumber, block.ExecutionBlockNumber)
+	}
+	if state.ElBlockHash != block.ExecutionBlockHash {
+		return fmt.Errorf("snapshot for slot %d has EL block hash %s but the slot's EL block hash is %s", block.Slot, state.ElBlockHash.Hex(), block.ExecutionBlockHash.Hex())
+	}
+	return nil
+}
+
+// Save a snapshot of the provided state, then remove the oldest snapshots beyond the retention limit
+func (s *SnapshotStore) Save(state *NetworkState) error {
+	err := os.MkdirAll(s.path, 0755)
+	if err != nil {
+		return fmt.Errorf("error creating snapshot folder: %w", err)
+	}
+
+	// Write to a temporary file first so a crash can't leave a partial snapshot behind
+	path := s.getSnapshotPath(state.BeaconSlotNumber)
+	tempPath := path + ".tmp"
+	file, err := os.OpenFile(tempPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("error creating snapshot file: %w", err)
+	}
+	hash := sha256.New()
+	writer := gzip.NewWriter(io.MultiWriter(file, hash))
+	err = json.NewEncoder(writer).Encode(state)
+	if err == nil {
+		err = writer.Close()
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tempPath)
+		return fmt.Errorf("error writing snapshot for slot %d: %w", state.BeaconSlotNumber, err)
+	}
+
+	// Write the checksum before the snapshot is moved into place so a snapshot never exists without one
+	checksumPath := s.getChecksumPath(state.BeaconSlotNumber)
+	err = os.WriteFile(checksumPath+".tmp", []byte(hex.EncodeToString(hash.Sum(nil))), 0644)
+	if err == nil {
+		err = os.Rename(checksumPath+".tmp", checksumPath)
+	}
+	if err != nil {
+		os.Remove(tempPath)
+		return fmt.Errorf("error writing checksum of snapshot for slot %d: %w", state.BeaconSlotNumber, err)
+	}
+	err = os.Rename(tempPath, path)
+	if err != nil {
+		return fmt.Errorf("error saving snapshot for slot %d: %w", state.BeaconSlotNumber, err)
+	}
+
+	return s.prune()
+}
+
+// Get the slots that have snapshots, in ascending order
+func (s *SnapshotStore) List() ([]uint64, error) {
+	entries, err := os.ReadDir(s.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return []uint64{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading snapshot folder: %w", err)
+	}
+
+	slots := []uint64{}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, snapshotFilePrefix) || !strings.HasSuffix(name, snapshotFileSuffix) {
+			continue
+		}
+		slot, err := strconv.ParseUint(strings.TrimSuffix(strings.TrimPrefix(name, snapshotFilePrefix), snapshotFileSuffix), 10, 64)
+		if err != nil {
+			continue
+		}
+		slots = append(slots, slot)
+	}
+	sort.Slice(slots, func(i, j int) bool {
+		return slots[i] < slots[j]
+	})
+	return slots, nil
+}
+
+// Remove the oldest snapshots beyond the retention limit
+func (s *SnapshotStore) prune() error {
+	if s.retention == 0 {
+		return nil
+	}
+	slots, err := s.List()
+	if err != nil {
+		return err
+	}
+	for len(slots) > int(s.retention) {
+		err = os.Remove(s.getSnapshotPath(slots[0]))
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("error removing snapshot for slot %d: %w", slots[0], err)
+		}
+		err = os.Remove(s.getChecksumPath(slots[0]))
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("error removing checksum of snapshot for slot %d: %w", slots[0], err)
+		}
+		slots = slots[1:]
+	}
+	return nil
+}
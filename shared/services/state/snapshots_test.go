@@ -0,0 +1,164 @@
+package state
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/smartnode/bindings/megapool"
+	rpstate "github.com/rocket-pool/smartnode/bindings/utils/state"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+)
+
+func TestSnapshotStoreRoundTrip(t *testing.T) {
+	store := NewSnapshotStore(filepath.Join(t.TempDir(), "snapshots"), 0)
+	megapoolAddress := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	state := &NetworkState{
+		ElBlockNumber:    100,
+		BeaconSlotNumber: 3200,
+		BeaconConfig:     testBeaconConfig,
+		MegapoolDetails: map[common.Address]rpstate.NativeMegapoolDetails{
+			megapoolAddress: {Address: megapoolAddress, Deployed: true},
+		},
+		MegapoolValidatorGlobalIndex: []megapool.ValidatorInfoFromGlobalIndex{
+			{MegapoolAddress: megapoolAddress, ValidatorId: 0, Pubkey: []byte{0x01}},
+		},
+	}
+
+	_, exists, err := store.Load(state.BeaconSlotNumber)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exists || store.Has(state.BeaconSlotNumber) {
+		t.Fatal("expected no snapshot before saving")
+	}
+
+	if err := store.Save(state); err != nil {
+		t.Fatal(err)
+	}
+	loaded, exists, err := store.Load(state.BeaconSlotNumber)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !exists {
+		t.Fatal("expected the snapshot to exist after saving")
+	}
+	if loaded.ElBlockNumber != state.ElBlockNumber || loaded.BeaconSlotNumber != state.BeaconSlotNumber {
+		t.Errorf("expected block %d and slot %d, got %d and %d", state.ElBlockNumber, state.BeaconSlotNumber, loaded.ElBlockNumber, loaded.BeaconSlotNumber)
+	}
+	if loaded.BeaconConfig.GenesisTime != state.BeaconConfig.GenesisTime || loaded.BeaconConfig.SlotsPerEpoch != state.BeaconConfig.SlotsPerEpoch {
+		t.Errorf("expected beacon config %+v, got %+v", state.BeaconConfig, loaded.BeaconConfig)
+	}
+	if _, exists := loaded.MegapoolDetails[megapoolAddress]; !exists {
+		t.Error("expected the megapool details to survive the round trip")
+	}
+	if len(loaded.MegapoolToPubkeysMap[megapoolAddress]) != 1 {
+		t.Error("expected the megapool pubkey map to be rebuilt")
+	}
+}
+
+func TestSnapshotStoreRetention(t *testing.T) {
+	path := t.TempDir()
+	store := NewSnapshotStore(path, 2)
+
+	// Unrelated files in the folder should be ignored
+	if err := os.WriteFile(filepath.Join(path, "notes.txt"), []byte{}, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, slot := range []uint64{64, 32, 96} {
+		if err := store.Save(&NetworkState{BeaconSlotNumber: slot}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	slots, err := store.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(slots, []uint64{64, 96}) {
+		t.Errorf("expected the two newest snapshots to be kept, got %v", slots)
+	}
+}
+
+func TestSnapshotStoreListMissingFolder(t *testing.T) {
+	store := NewSnapshotStore(filepath.Join(t.TempDir(), "missing"), 0)
+	slots, err := store.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(slots) != 0 {
+		t.Errorf("expected no snapshots, got %v", slots)
+	}
+}
+
+func TestSnapshotStoreChecksum(t *testing.T) {
+	path := t.TempDir()
+	store := NewSnapshotStore(path, 0)
+	if err := store.Save(&NetworkState{BeaconSlotNumber: 32}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Corrupt the snapshot
+	snapshotPath := store.getSnapshotPath(32)
+	data, err := os.ReadFile(snapshotPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data[len(data)-1] ^= 0xff
+	if err := os.WriteFile(snapshotPath, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := store.Load(32); err == nil {
+		t.Error("expected a corrupted snapshot to fail to load")
+	}
+
+	// Snapshots without a checksum can't be trusted either
+	if err := store.Save(&NetworkState{BeaconSlotNumber: 64}); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Remove(store.getChecksumPath(64)); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := store.Load(64); err == nil {
+		t.Error("expected a snapshot without a checksum to fail to load")
+	}
+}
+
+func TestSnapshotStoreWrongSlot(t *testing.T) {
+	store := NewSnapshotStore(t.TempDir(), 0)
+	if err := store.Save(&NetworkState{BeaconSlotNumber: 32}); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Rename(store.getSnapshotPath(32), store.getSnapshotPath(64)); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Rename(store.getChecksumPath(32), store.getChecksumPath(64)); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := store.Load(64); err == nil {
+		t.Error("expected a snapshot for another slot to fail to load")
+	}
+}
+
+func TestValidateSnapshot(t *testing.T) {
+	hash := common.HexToHash("0x01")
+	state := &NetworkState{BeaconSlotNumber: 32, ElBlockNumber: 10, ElBlockHash: hash}
+	block := beacon.BeaconBlock{Slot: 32, ExecutionBlockNumber: 10, ExecutionBlockHash: hash}
+	if err := ValidateSnapshot(state, block); err != nil {
+		t.Errorf("expected a matching snapshot to be valid, got %s", err.Error())
+	}
+
+	block.ExecutionBlockHash = common.HexToHash("0x02")
+	if err := ValidateSnapshot(state, block); err == nil {
+		t.Error("expected a snapshot with another EL block hash to be invalid")
+	}
+
+	block.ExecutionBlockHash = hash
+	block.ExecutionBlockNumber = 11
+	if err := ValidateSnapshot(state, block); err == nil {
+		t.Error("expected a snapshot with another EL block number to be invalid")
+	}
+}
@@ -0,0 +1,412 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	// How long to wait for another process (e.g. the watchtower or an API call) to release the database
+	DefaultLockTimeout time.Duration = 10 * time.Second
+
+	// How long a submitted transaction can be missing from the mempool before it's considered dropped
+	DefaultDropTimeout time.Duration = 30 * time.Minute
+)
+
+// The outcome of a duty attempt
+type Outcome string
+
+const (
+	Outcome_Pending   Outcome = "pending"
+	Outcome_Succeeded Outcome = "succeeded"
+	Outcome_Failed    Outcome = "failed"
+	Outcome_Dropped   Outcome = "dropped"
+)
+
+// The record of a duty performed by one of the daemons, such as distributing a minipool or defending a challenge
+type DutyRecord struct {
+	Duty         string      `json:"duty"`
+	Key          string      `json:"key"`
+	TxHash       common.Hash `json:"txHash"`
+	Attempts     uint64      `json:"attempts"`
+	Outcome      Outcome     `json:"outcome"`
+	LastError    string      `json:"lastError,omitempty"`
+	FirstAttempt time.Time   `json:"firstAttempt"`
+	LastAttempt  time.Time   `json:"lastAttempt"`
+	UpdatedAt    time.Time   `json:"updatedAt"`
+}
+
+// The subset of the execution client used to check on pending transactions
+type TransactionReader interface {
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+	TransactionByHash(ctx context.Context, hash common.Hash) (tx *types.Transaction, isPending bool, err error)
+}
+
+// An on-disk store of duty records, shared by the node and watchtower daemons.
+// The database is only held open for the duration of each operation so multiple processes can use it.
+type DutyStore struct {
+	path        string
+	lockTimeout time.Duration
+	dropTimeout time.Duration
+	lock        sync.Mutex
+}
+
+// Create a new duty store backed by the database at the provided path; the file is created on first use
+func NewDutyStore(path string) *DutyStore {
+	return &DutyStore{
+		path:        path,
+		lockTimeout: DefaultLockTimeout,
+		dropTimeout: DefaultDropTimeout,
+	}
+}
+
+// Get the path of the underlying database
+func (s *DutyStore) GetPath() string {
+	return s.path
+}
+
+// Get the record for a duty, or nil if the duty hasn't been attempted yet
+func (s *DutyStore) Get(duty string, key string) (*DutyRecord, error) {
+	var record *DutyRecord
+	err := s.view(func(tx *bolt.Tx) error {
+		var err error
+		record, err = getRecord(tx, duty, key)
+		return err
+	})
+	return record, err
+}
+
+// Record that a transaction was submitted for a duty
+func (s *DutyStore) RecordSubmission(duty string, key string, txHash common.Hash) error {
+	return s.update(duty, key, func(record *DutyRecord) {
+		now := time.Now()
+		if record.Attempts == 0 {
+			record.FirstAttempt = now
+		}
+		record.Attempts++
+		record.LastAttempt = now
+		record.TxHash = txHash
+		record.Outcome = Outcome_Pending
+		record.LastError = ""
+	})
+}
+
+// Record the outcome of a duty; a nil error means it succeeded.
+// A duty that only stopped waiting because its run was cancelled still has its transaction in flight, so it stays pending.
+func (s *DutyStore) RecordOutcome(duty string, key string, dutyErr error) error {
+	return s.update(duty, key, func(record *DutyRecord) {
+		if record.Outcome == Outcome_Pending && (errors.Is(dutyErr, context.Canceled) || errors.Is(dutyErr, context.DeadlineExceeded)) {
+			return
+		}
+		if record.Attempts == 0 {
+			// The duty failed before a transaction could be submitted
+			now := time.Now()
+			record.Attempts = 1
+			record.FirstAttempt = now
+			record.LastAttempt = now
+		}
+		if dutyErr == nil {
+			record.Outcome = Outcome_Succeeded
+			record.LastError = ""
+		} else {
+			record.Outcome = Outcome_Failed
+			record.LastError = dutyErr.Error()
+		}
+	})
+}
+
+// Record that a transaction was submitted for a duty, logging a warning instead of failing the duty if the store can't be updated
+func (s *DutyStore) TryRecordSubmission(logger *log.ColorLogger, duty string, key string, txHash common.Hash) {
+	err := s.RecordSubmission(duty, key, txHash)
+	if err != nil {
+		logger.Printlnf("WARNING: couldn't save transaction %s for %s [%s] to the daemon state: %s", txHash.Hex(), duty, key, err.Error())
+	}
+}
+
+// Point the pending duties that are waiting on a transaction at its replacement, such as a copy with bumped fees,
+// so their outcome is still found once the replacement is mined. Returns the number of records that were updated.
+func (s *DutyStore) RecordReplacement(oldHash common.Hash, newHash common.Hash) (int, error) {
+	count := 0
+	err := s.write(func(tx *bolt.Tx) error {
+		// Buckets can't be modified while they're being iterated, so find the records first
+		updates := map[string]map[string]*DutyRecord{}
+		err := tx.ForEach(func(name []byte, bucket *bolt.Bucket) error {
+			return bucket.ForEach(func(k []byte, v []byte) error {
+				var record DutyRecord
+				err := json.Unmarshal(v, &record)
+				if err != nil {
+					return fmt.Errorf("error deserializing record [%s/%s]: %w", string(name), string(k), err)
+				}
+				if record.Outcome != Outcome_Pending || record.TxHash != oldHash {
+					return nil
+				}
+				if updates[string(name)] == nil {
+					updates[string(name)] = map[string]*DutyRecord{}
+				}
+				updates[string(name)][string(k)] = &record
+				return nil
+			})
+		})
+		if err != nil {
+			return err
+		}
+
+		for duty, records := range updates {
+			bucket := tx.Bucket([]byte(duty))
+			for key, record := range records {
+				record.TxHash = newHash
+				record.UpdatedAt = time.Now()
+				bytes, err := json.Marshal(record)
+				if err != nil {
+					return fmt.Errorf("error serializing record [%s/%s]: %w", duty, key, err)
+				}
+				err = bucket.Put([]byte(key), bytes)
+				if err != nil {
+					return err
+				}
+				count++
+			}
+		}
+		return nil
+	})
+	return count, err
+}
+
+// Record the outcome of a duty, logging a warning instead of failing the duty if the store can't be updated
+func (s *DutyStore) TryRecordOutcome(logger *log.ColorLogger, duty string, key string, dutyErr error) {
+	err := s.RecordOutcome(duty, key, dutyErr)
+	if err != nil {
+		logger.Printlnf("WARNING: couldn't save the outcome of %s [%s] to the daemon state: %s", duty, key, err.Error())
+	}
+}
+
+// Check if a duty still has a transaction in flight. If the transaction has since been mined or dropped,
+// the record is updated accordingly. Returns the (possibly updated) record, or nil if the duty hasn't been attempted.
+func (s *DutyStore) ResolvePending(client TransactionReader, duty string, key string) (*DutyRecord, error) {
+	record, err := s.Get(duty, key)
+	if err != nil {
+		return nil, err
+	}
+	if record == nil || record.Outcome != Outcome_Pending || record.TxHash == (common.Hash{}) {
+		return record, nil
+	}
+
+	// Check if the transaction was mined
+	receipt, err := client.TransactionReceipt(context.Background(), record.TxHash)
+	if err == nil {
+		outcome := Outcome_Succeeded
+		lastError := ""
+		if receipt.Status == types.ReceiptStatusFailed {
+			outcome = Outcome_Failed
+			lastError = fmt.Sprintf("transaction %s reverted", record.TxHash.Hex())
+		}
+		return s.setOutcome(duty, key, outcome, lastError)
+	}
+	if !errors.Is(err, ethereum.NotFound) {
+		return nil, fmt.Errorf("error getting receipt for transaction %s: %w", record.TxHash.Hex(), err)
+	}
+
+	// Check if it's still waiting in the mempool
+	_, _, err = client.TransactionByHash(context.Background(), record.TxHash)
+	if err == nil {
+		return record, nil
+	}
+	if !errors.Is(err, ethereum.NotFound) {
+		return nil, fmt.Errorf("error getting transaction %s: %w", record.TxHash.Hex(), err)
+	}
+
+	// Give nodes a grace period to see the transaction before declaring it dropped
+	if time.Since(record.LastAttempt) < s.dropTimeout {
+		return record, nil
+	}
+	return s.setOutcome(duty, key, Outcome_Dropped, fmt.Sprintf("transaction %s was dropped from the mempool", record.TxHash.Hex()))
+}
+
+// Check if a duty has a transaction that's still in flight, in which case it shouldn't be attempted again
+func (s *DutyStore) IsPending(client TransactionReader, duty string, key string) (bool, error) {
+	record, err := s.ResolvePending(client, duty, key)
+	if err != nil {
+		return false, err
+	}
+	return record != nil && record.Outcome == Outcome_Pending, nil
+}
+
+// Get all of the records for a duty, or for every duty if the name is blank
+func (s *DutyStore) List(duty string) ([]DutyRecord, error) {
+	records := []DutyRecord{}
+	err := s.view(func(tx *bolt.Tx) error {
+		return tx.ForEach(func(name []byte, bucket *bolt.Bucket) error {
+			if duty != "" && string(name) != duty {
+				return nil
+			}
+			return bucket.ForEach(func(k []byte, v []byte) error {
+				var record DutyRecord
+				err := json.Unmarshal(v, &record)
+				if err != nil {
+					return fmt.Errorf("error deserializing record [%s/%s]: %w", string(name), string(k), err)
+				}
+				records = append(records, record)
+				return nil
+			})
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(records, func(i int, j int) bool {
+		if records[i].Duty != records[j].Duty {
+			return records[i].Duty < records[j].Duty
+		}
+		return records[i].Key < records[j].Key
+	})
+	return records, nil
+}
+
+// Delete records from the store. A blank key deletes every record for the duty, and a blank duty deletes everything.
+// Returns the number of records that were deleted.
+func (s *DutyStore) Clear(duty string, key string) (int, error) {
+	count := 0
+	err := s.write(func(tx *bolt.Tx) error {
+		// Delete a single record
+		if duty != "" && key != "" {
+			bucket := tx.Bucket([]byte(duty))
+			if bucket == nil || bucket.Get([]byte(key)) == nil {
+				return nil
+			}
+			count = 1
+			return bucket.Delete([]byte(key))
+		}
+
+		// Delete entire duties
+		names := [][]byte{}
+		err := tx.ForEach(func(name []byte, bucket *bolt.Bucket) error {
+			if duty == "" || string(name) == duty {
+				names = append(names, append([]byte{}, name...))
+				count += bucket.Stats().KeyN
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for _, name := range names {
+			err = tx.DeleteBucket(name)
+			if err != nil {
+				return fmt.Errorf("error deleting duty [%s]: %w", string(name), err)
+			}
+		}
+		return nil
+	})
+	return count, err
+}
+
+// Overwrite the outcome of a record and return it
+func (s *DutyStore) setOutcome(duty string, key string, outcome Outcome, lastError string) (*DutyRecord, error) {
+	var updated DutyRecord
+	err := s.update(duty, key, func(record *DutyRecord) {
+		record.Outcome = outcome
+		record.LastError = lastError
+		updated = *record
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// Load a record, modify it, and save it back
+func (s *DutyStore) update(duty string, key string, modify func(record *DutyRecord)) error {
+	return s.write(func(tx *bolt.Tx) error {
+		record, err := getRecord(tx, duty, key)
+		if err != nil {
+			return err
+		}
+		if record == nil {
+			record = &DutyRecord{
+				Duty: duty,
+				Key:  key,
+			}
+		}
+		modify(record)
+		record.UpdatedAt = time.Now()
+
+		bucket, err := tx.CreateBucketIfNotExists([]byte(duty))
+		if err != nil {
+			return fmt.Errorf("error creating bucket for duty [%s]: %w", duty, err)
+		}
+		bytes, err := json.Marshal(record)
+		if err != nil {
+			return fmt.Errorf("error serializing record [%s/%s]: %w", duty, key, err)
+		}
+		return bucket.Put([]byte(key), bytes)
+	})
+}
+
+// Run a read-only transaction; a missing database is treated as empty
+func (s *DutyStore) view(fn func(tx *bolt.Tx) error) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	_, err := os.Stat(s.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+
+	db, err := bolt.Open(s.path, 0600, &bolt.Options{Timeout: s.lockTimeout, ReadOnly: true})
+	if err != nil {
+		return fmt.Errorf("error opening daemon state database [%s]: %w", s.path, err)
+	}
+	defer db.Close()
+	return db.View(fn)
+}
+
+// Run a read-write transaction, creating the database if it doesn't exist
+func (s *DutyStore) write(fn func(tx *bolt.Tx) error) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	err := os.MkdirAll(filepath.Dir(s.path), 0755)
+	if err != nil {
+		return fmt.Errorf("error creating folder for daemon state database [%s]: %w", s.path, err)
+	}
+
+	db, err := bolt.Open(s.path, 0600, &bolt.Options{Timeout: s.lockTimeout})
+	if err != nil {
+		return fmt.Errorf("error opening daemon state database [%s]: %w", s.path, err)
+	}
+	defer db.Close()
+	return db.Update(fn)
+}
+
+// Get a record within a transaction, or nil if it doesn't exist
+func getRecord(tx *bolt.Tx, duty string, key string) (*DutyRecord, error) {
+	bucket := tx.Bucket([]byte(duty))
+	if bucket == nil {
+		return nil, nil
+	}
+	bytes := bucket.Get([]byte(key))
+	if bytes == nil {
+		return nil, nil
+	}
+
+	var record DutyRecord
+	err := json.Unmarshal(bytes, &record)
+	if err != nil {
+		return nil, fmt.Errorf("error deserializing record [%s/%s]: %w", duty, key, err)
+	}
+	return &record, nil
+}
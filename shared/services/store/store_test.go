@@ -0,0 +1,214 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+type fakeTransactionReader struct {
+	receipts map[common.Hash]*types.Receipt
+	mempool  map[common.Hash]bool
+}
+
+func (r *fakeTransactionReader) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	receipt, exists := r.receipts[txHash]
+	if !exists {
+		return nil, ethereum.NotFound
+	}
+	return receipt, nil
+}
+
+func (r *fakeTransactionReader) TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error) {
+	if !r.mempool[hash] {
+		return nil, false, ethereum.NotFound
+	}
+	return &types.Transaction{}, true, nil
+}
+
+func newTestStore(t *testing.T) *DutyStore {
+	return NewDutyStore(filepath.Join(t.TempDir(), "data", "daemon-state.db"))
+}
+
+func TestMissingDatabaseIsEmpty(t *testing.T) {
+	store := newTestStore(t)
+	record, err := store.Get("distribute-minipools", "0x01")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if record != nil {
+		t.Fatalf("expected no record, got %+v", record)
+	}
+	records, err := store.List("")
+	if err != nil || len(records) != 0 {
+		t.Fatalf("expected no records, got %v (err %v)", records, err)
+	}
+}
+
+func TestRecordSubmissionAndOutcome(t *testing.T) {
+	store := newTestStore(t)
+	hash := common.HexToHash("0xabc")
+
+	if err := store.RecordSubmission("distribute-minipools", "0x01", hash); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if err := store.RecordOutcome("distribute-minipools", "0x01", errors.New("reverted")); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if err := store.RecordSubmission("distribute-minipools", "0x01", hash); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	record, err := store.Get("distribute-minipools", "0x01")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if record.Attempts != 2 || record.Outcome != Outcome_Pending || record.TxHash != hash || record.LastError != "" {
+		t.Fatalf("unexpected record: %+v", record)
+	}
+
+	// A cancelled wait leaves the transaction in flight
+	if err := store.RecordOutcome("distribute-minipools", "0x01", fmt.Errorf("stopped waiting: %w", context.DeadlineExceeded)); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	record, _ = store.Get("distribute-minipools", "0x01")
+	if record.Outcome != Outcome_Pending {
+		t.Fatalf("expected the record to stay pending after a cancelled wait, got %s", record.Outcome)
+	}
+
+	if err := store.RecordOutcome("distribute-minipools", "0x01", nil); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	record, _ = store.Get("distribute-minipools", "0x01")
+	if record.Outcome != Outcome_Succeeded {
+		t.Fatalf("expected the record to succeed, got %s", record.Outcome)
+	}
+}
+
+func TestResolvePending(t *testing.T) {
+	store := newTestStore(t)
+	mined := common.HexToHash("0x01")
+	reverted := common.HexToHash("0x02")
+	waiting := common.HexToHash("0x03")
+	dropped := common.HexToHash("0x04")
+	client := &fakeTransactionReader{
+		receipts: map[common.Hash]*types.Receipt{
+			mined:    {Status: types.ReceiptStatusSuccessful},
+			reverted: {Status: types.ReceiptStatusFailed},
+		},
+		mempool: map[common.Hash]bool{
+			waiting: true,
+		},
+	}
+	store.RecordSubmission("duty", "mined", mined)
+	store.RecordSubmission("duty", "reverted", reverted)
+	store.RecordSubmission("duty", "waiting", waiting)
+	store.RecordSubmission("duty", "dropped", dropped)
+
+	expected := map[string]Outcome{
+		"mined":    Outcome_Succeeded,
+		"reverted": Outcome_Failed,
+		"waiting":  Outcome_Pending,
+		"dropped":  Outcome_Pending,
+	}
+	for key, outcome := range expected {
+		record, err := store.ResolvePending(client, "duty", key)
+		if err != nil {
+			t.Fatalf("unexpected error for %s: %s", key, err.Error())
+		}
+		if record.Outcome != outcome {
+			t.Errorf("expected %s to be %s, got %s", key, outcome, record.Outcome)
+		}
+	}
+
+	// Once the grace period passes, transactions missing from the mempool are dropped
+	store.dropTimeout = 0
+	time.Sleep(time.Millisecond)
+	pending, err := store.IsPending(client, "duty", "dropped")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if pending {
+		t.Fatal("expected the dropped transaction to no longer be pending")
+	}
+	record, _ := store.Get("duty", "dropped")
+	if record.Outcome != Outcome_Dropped {
+		t.Fatalf("expected the record to be dropped, got %s", record.Outcome)
+	}
+}
+
+func TestRecordReplacement(t *testing.T) {
+	store := newTestStore(t)
+	original := common.HexToHash("0x01")
+	replacement := common.HexToHash("0x02")
+	store.RecordSubmission("duty", "bumped", original)
+	store.RecordSubmission("other", "bumped", original)
+	store.RecordSubmission("duty", "unrelated", common.HexToHash("0x03"))
+	store.RecordSubmission("duty", "finished", original)
+	store.RecordOutcome("duty", "finished", nil)
+
+	count, err := store.RecordReplacement(original, replacement)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if count != 2 {
+		t.Fatalf("expected 2 records to be updated, got %d", count)
+	}
+
+	// Once the replacement is mined, the duty is resolved from it instead of being dropped
+	client := &fakeTransactionReader{
+		receipts: map[common.Hash]*types.Receipt{
+			replacement: {Status: types.ReceiptStatusSuccessful},
+		},
+	}
+	store.dropTimeout = 0
+	record, err := store.ResolvePending(client, "duty", "bumped")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if record.Outcome != Outcome_Succeeded || record.TxHash != replacement {
+		t.Errorf("expected the duty to succeed with the replacement, got %s with %s", record.Outcome, record.TxHash.Hex())
+	}
+	record, _ = store.Get("duty", "finished")
+	if record.TxHash != original {
+		t.Errorf("expected resolved duties to keep their transaction, got %s", record.TxHash.Hex())
+	}
+	record, _ = store.Get("duty", "unrelated")
+	if record.TxHash != common.HexToHash("0x03") {
+		t.Errorf("expected other transactions to be untouched, got %s", record.TxHash.Hex())
+	}
+}
+
+func TestClear(t *testing.T) {
+	store := newTestStore(t)
+	store.RecordOutcome("a", "1", nil)
+	store.RecordOutcome("a", "2", nil)
+	store.RecordOutcome("b", "1", nil)
+	store.RecordOutcome("c", "1", nil)
+
+	count, err := store.Clear("a", "2")
+	if err != nil || count != 1 {
+		t.Fatalf("expected to delete 1 record, deleted %d (err %v)", count, err)
+	}
+	count, err = store.Clear("b", "")
+	if err != nil || count != 1 {
+		t.Fatalf("expected to delete 1 record, deleted %d (err %v)", count, err)
+	}
+
+	records, _ := store.List("")
+	if len(records) != 2 || records[0].Duty != "a" || records[1].Duty != "c" {
+		t.Fatalf("unexpected records left: %+v", records)
+	}
+
+	count, err = store.Clear("", "")
+	if err != nil || count != 2 {
+		t.Fatalf("expected to delete 2 records, deleted %d (err %v)", count, err)
+	}
+}
@@ -0,0 +1,610 @@
+package txmanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/rocket-pool/smartnode/bindings/utils/eth"
+
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/gas/policy"
+	"github.com/rocket-pool/smartnode/shared/services/store"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+const (
+	// Execution clients won't accept a replacement unless both fees are raised by at least this percentage
+	MinBumpPercent uint64 = 10
+
+	// How long a transaction can be missing from the mempool before it's rebroadcast, and then considered dropped
+	DefaultDropTimeout time.Duration = 5 * time.Minute
+
+	// How many resolved transactions to keep in the queue for reporting
+	DefaultHistoryLimit int = 50
+
+	// How often to check on a transaction that a task is waiting for
+	waitPollInterval time.Duration = 6 * time.Second
+)
+
+// The subset of the execution client used by the transaction manager
+type executionClient interface {
+	PendingNonceAt(ctx context.Context, account common.Address) (uint64, error)
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+	TransactionByHash(ctx context.Context, hash common.Hash) (tx *types.Transaction, isPending bool, err error)
+	SendTransaction(ctx context.Context, tx *types.Transaction) error
+}
+
+// Owns the node account's nonce for the daemon's automatic transactions, and keeps track of them until they're mined.
+// Transactions that are stuck are replaced with copies that pay higher fees, and transactions that fall out of the
+// mempool are rebroadcast or reported as dropped.
+type TransactionManager struct {
+	ec            executionClient
+	getTransactor func() (*bind.TransactOpts, error)
+	log           *log.ColorLogger
+	path          string
+	ledger        *policy.Ledger
+	dutyStore     *store.DutyStore
+	cfg           *config.RocketPoolConfig
+
+	bumpInterval time.Duration
+	bumpPercent  uint64
+	maxFeeCap    *big.Int
+	dropTimeout  time.Duration
+	historyLimit int
+
+	queue      TransactionQueue
+	nextNonce  uint64
+	nonceKnown bool
+	reserved   map[uint64]bool
+	released   []uint64
+	lock       sync.Mutex
+}
+
+// Create a new transaction manager, picking up any transactions that were still pending when the daemon last stopped.
+// Duties in the duty store that are waiting on a transaction are moved to its replacement whenever its fees are bumped.
+func NewTransactionManager(cfg *config.RocketPoolConfig, ec executionClient, getTransactor func() (*bind.TransactOpts, error), dutyStore *store.DutyStore, logger *log.ColorLogger) (*TransactionManager, error) {
+	path := cfg.Smartnode.GetTxQueuePath()
+	queue, err := LoadTransactionQueue(path)
+	if err != nil {
+		return nil, err
+	}
+
+	bumpPercent := cfg.Smartnode.TxBumpPercent.Value.(uint64)
+	if bumpPercent < MinBumpPercent {
+		bumpPercent = MinBumpPercent
+	}
+	var maxFeeCap *big.Int
+	maxFeeCapGwei := cfg.Smartnode.TxBumpMaxFee.Value.(float64)
+	if maxFeeCapGwei > 0 {
+		maxFeeCap = eth.GweiToWei(maxFeeCapGwei)
+	}
+
+	return &TransactionManager{
+		ec:            ec,
+		getTransactor: getTransactor,
+		log:           logger,
+		path:          path,
+		ledger:        policy.NewLedger(cfg.Smartnode.GetGasLedgerPath()),
+		dutyStore:     dutyStore,
+		cfg:           cfg,
+		bumpInterval:  time.Duration(cfg.Smartnode.TxBumpInterval.Value.(uint64)) * time.Minute,
+		bumpPercent:   bumpPercent,
+		maxFeeCap:     maxFeeCap,
+		dropTimeout:   DefaultDropTimeout,
+		historyLimit:  DefaultHistoryLimit,
+		queue:         *queue,
+		reserved:      map[uint64]bool{},
+	}, nil
+}
+
+// Get a transactor for the node account with a nonce reserved for it, so concurrent tasks never share one.
+// Once the transaction has been submitted, it must be passed to TrackTransaction; if it never is, the transactor
+// must be passed to ReleaseTransactor so its nonce can be used by the next one.
+func (m *TransactionManager) GetTransactor() (*bind.TransactOpts, error) {
+	opts, err := m.getTransactor()
+	if err != nil {
+		return nil, err
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	pendingNonce, err := m.ec.PendingNonceAt(context.Background(), opts.From)
+	if err != nil {
+		return nil, fmt.Errorf("error getting pending nonce for %s: %w", opts.From.Hex(), err)
+	}
+	nonce := m.reserveNonce(pendingNonce)
+	opts.Nonce = new(big.Int).SetUint64(nonce)
+	return opts, nil
+}
+
+// Give back the nonce of a transactor from GetTransactor that wasn't used to submit a transaction.
+// This does nothing if its transaction is already being tracked, so it can be deferred right after getting the transactor.
+func (m *TransactionManager) ReleaseTransactor(opts *bind.TransactOpts) {
+	if opts == nil || opts.Nonce == nil {
+		return
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	nonce := opts.Nonce.Uint64()
+	if !m.reserved[nonce] {
+		return
+	}
+	delete(m.reserved, nonce)
+	m.releaseNonce(nonce)
+}
+
+// Reserve the next nonce, filling any gaps left by released nonces first; the caller must hold the lock
+func (m *TransactionManager) reserveNonce(pendingNonce uint64) uint64 {
+	// Released nonces the client has already seen used by other transactions can't be used again
+	for len(m.released) > 0 {
+		nonce := m.released[0]
+		m.released = m.released[1:]
+		if nonce >= pendingNonce {
+			m.reserved[nonce] = true
+			return nonce
+		}
+	}
+
+	// Use the client's pending nonce unless it hasn't seen one of our transactions yet
+	if !m.nonceKnown || m.nextNonce < pendingNonce {
+		m.nextNonce = pendingNonce
+		m.nonceKnown = true
+	}
+	nonce := m.nextNonce
+	m.nextNonce++
+	m.reserved[nonce] = true
+	return nonce
+}
+
+// Make a nonce available to the next transactor; the caller must hold the lock
+func (m *TransactionManager) releaseNonce(nonce uint64) {
+	if !m.nonceKnown || nonce >= m.nextNonce {
+		return
+	}
+
+	// Hand the nonce back if it's the newest one, otherwise keep it so the gap gets filled
+	if nonce+1 == m.nextNonce {
+		m.nextNonce--
+		for len(m.released) > 0 && m.released[len(m.released)-1]+1 == m.nextNonce {
+			m.released = m.released[:len(m.released)-1]
+			m.nextNonce--
+		}
+		return
+	}
+	i := sort.Search(len(m.released), func(i int) bool { return m.released[i] >= nonce })
+	if i < len(m.released) && m.released[i] == nonce {
+		return
+	}
+	m.released = append(m.released, 0)
+	copy(m.released[i+1:], m.released[i:])
+	m.released[i] = nonce
+}
+
+// Start tracking a transaction that was submitted with a transactor from GetTransactor
+func (m *TransactionManager) TrackTransaction(task string, opts *bind.TransactOpts, hash common.Hash) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	now := time.Now()
+	record := &ManagedTransaction{
+		Task:            task,
+		From:            opts.From,
+		Hashes:          []common.Hash{hash},
+		Status:          TransactionStatus_Pending,
+		SubmittedAt:     now,
+		LastBroadcastAt: now,
+	}
+
+	// Keep a copy of the signed transaction so it can be rebroadcast or replaced later
+	tx, _, err := m.ec.TransactionByHash(context.Background(), hash)
+	if err == nil {
+		record.setTransaction(tx)
+		record.MaxFeeCap = m.getFeeLimit(task, tx.GasFeeCap())
+	} else {
+		m.log.Printlnf("WARNING: couldn't retrieve transaction %s, so its fees can't be bumped if it gets stuck: %s", hash.Hex(), err.Error())
+		if opts.Nonce != nil {
+			record.Nonce = opts.Nonce.Uint64()
+		}
+	}
+
+	m.queue.Transactions = append(m.queue.Transactions, record)
+	delete(m.reserved, record.Nonce)
+	if !m.nonceKnown || m.nextNonce < record.Nonce+1 {
+		m.nextNonce = record.Nonce + 1
+		m.nonceKnown = true
+	}
+	return m.save()
+}
+
+// Wait for a tracked transaction to be resolved, bumping its fees along the way if it gets stuck.
+// Returns an error if it reverted, was dropped, or its nonce was taken by a different transaction.
+func (m *TransactionManager) WaitForTransaction(cfg *config.RocketPoolConfig, hash common.Hash, logger *log.ColorLogger) error {
+	return m.WaitForTransactionContext(context.Background(), cfg, hash, logger)
+}
+
+// Wait for a tracked transaction to be resolved until the context is cancelled.
+// A transaction that's still pending when the context is cancelled stays in the queue, so it keeps being bumped and rebroadcast.
+func (m *TransactionManager) WaitForTransactionContext(ctx context.Context, cfg *config.RocketPoolConfig, hash common.Hash, logger *log.ColorLogger) error {
+	txWatchUrl := cfg.Smartnode.GetTxWatchUrl()
+	hashString := hash.String()
+	logger.Printlnf("Transaction has been submitted with hash %s.", hashString)
+	if txWatchUrl != "" {
+		logger.Printlnf("You may follow its progress by visiting:")
+		logger.Printlnf("%s/%s\n", txWatchUrl, hashString)
+	}
+	logger.Println("Waiting for the transaction to be validated...")
+
+	for {
+		record, err := m.refreshTransaction(hash)
+		if err != nil {
+			logger.Printlnf("WARNING: error checking on transaction %s: %s", hashString, err.Error())
+		} else if record == nil {
+			return fmt.Errorf("transaction %s is not being tracked", hashString)
+		} else {
+			switch record.Status {
+			case TransactionStatus_Confirmed:
+				return nil
+			case TransactionStatus_Pending:
+			default:
+				return fmt.Errorf("transaction %s was %s: %s", hashString, record.Status, record.Error)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("stopped waiting for transaction %s, which is still pending: %w", hashString, ctx.Err())
+		case <-time.After(waitPollInterval):
+		}
+	}
+}
+
+// Start tracking a submitted transaction and wait for it to be resolved.
+// Failing to save the queue isn't fatal, but the transaction won't be picked up again if the daemon restarts.
+func (m *TransactionManager) TrackAndWait(cfg *config.RocketPoolConfig, task string, opts *bind.TransactOpts, hash common.Hash, logger *log.ColorLogger) error {
+	return m.TrackAndWaitContext(context.Background(), cfg, task, opts, hash, logger)
+}
+
+// Start tracking a submitted transaction and wait for it to be resolved until the context is cancelled
+func (m *TransactionManager) TrackAndWaitContext(ctx context.Context, cfg *config.RocketPoolConfig, task string, opts *bind.TransactOpts, hash common.Hash, logger *log.ColorLogger) error {
+	err := m.TrackTransaction(task, opts, hash)
+	if err != nil {
+		logger.Printlnf("WARNING: couldn't save transaction %s to the transaction queue: %s", hash.Hex(), err.Error())
+	}
+	return m.WaitForTransactionContext(ctx, cfg, hash, logger)
+}
+
+// Check on every pending transaction, bumping or rebroadcasting them as needed
+func (m *TransactionManager) ProcessQueue() error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	errs := []error{}
+	for _, record := range m.queue.Transactions {
+		if record.Status != TransactionStatus_Pending {
+			continue
+		}
+		err := m.refresh(record)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error checking on transaction with nonce %d: %w", record.Nonce, err))
+		}
+	}
+
+	m.prune()
+	err := m.save()
+	if err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// Get a copy of the queue
+func (m *TransactionManager) GetQueue() TransactionQueue {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	queue := TransactionQueue{
+		UpdatedAt:    m.queue.UpdatedAt,
+		Transactions: make([]*ManagedTransaction, len(m.queue.Transactions)),
+	}
+	for i, record := range m.queue.Transactions {
+		recordCopy := *record
+		queue.Transactions[i] = &recordCopy
+	}
+	return queue
+}
+
+// Refresh the transaction that has the provided hash and return a copy of it
+func (m *TransactionManager) refreshTransaction(hash common.Hash) (*ManagedTransaction, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	record := m.queue.findByHash(hash)
+	if record == nil {
+		return nil, nil
+	}
+	if record.Status == TransactionStatus_Pending {
+		err := m.refresh(record)
+		if err != nil {
+			return nil, err
+		}
+		err = m.save()
+		if err != nil {
+			m.log.Printlnf("WARNING: %s", err.Error())
+		}
+	}
+
+	recordCopy := *record
+	return &recordCopy, nil
+}
+
+// Update the status of a pending transaction; the caller must hold the lock
+func (m *TransactionManager) refresh(record *ManagedTransaction) error {
+	ctx := context.Background()
+
+	// Check if any version of it was mined
+	mined, err := m.checkReceipts(ctx, record)
+	if err != nil || mined {
+		return err
+	}
+
+	// Check if the nonce was consumed by a transaction we don't know about
+	latestNonce, err := m.ec.NonceAt(ctx, record.From, nil)
+	if err != nil {
+		return fmt.Errorf("error getting nonce for %s: %w", record.From.Hex(), err)
+	}
+	if latestNonce > record.Nonce {
+		// One of our versions may have been mined since the receipts were checked, so look again before giving up on it
+		mined, err = m.checkReceipts(ctx, record)
+		if err != nil || mined {
+			return err
+		}
+		record.Status = TransactionStatus_Replaced
+		record.Error = fmt.Sprintf("nonce %d was used by a different transaction", record.Nonce)
+		record.ResolvedAt = time.Now()
+		return nil
+	}
+
+	// Check if it's still in the mempool
+	latestHash := record.GetLatestHash()
+	_, _, err = m.ec.TransactionByHash(ctx, latestHash)
+	if err != nil && !isNotFound(err) {
+		return fmt.Errorf("error getting transaction %s: %w", latestHash.Hex(), err)
+	}
+	if err != nil {
+		if time.Since(record.LastBroadcastAt) < m.dropTimeout {
+			return nil
+		}
+		return m.rebroadcast(record)
+	}
+
+	// Bump the fees if it's been waiting too long
+	if m.bumpInterval > 0 && time.Since(record.LastBroadcastAt) >= m.bumpInterval {
+		return m.bump(record)
+	}
+	return nil
+}
+
+// Look for a receipt for any version of a transaction, newest first, and resolve it if one was mined
+func (m *TransactionManager) checkReceipts(ctx context.Context, record *ManagedTransaction) (bool, error) {
+	for i := len(record.Hashes) - 1; i >= 0; i-- {
+		hash := record.Hashes[i]
+		receipt, err := m.ec.TransactionReceipt(ctx, hash)
+		if err == nil {
+			record.MinedHash = hash
+			record.BlockNumber = receipt.BlockNumber.Uint64()
+			record.ResolvedAt = time.Now()
+			if receipt.Status == types.ReceiptStatusFailed {
+				record.Status = TransactionStatus_Reverted
+				record.Error = "the transaction was mined but reverted"
+			} else {
+				record.Status = TransactionStatus_Confirmed
+			}
+			m.recordSpending(record, receipt)
+			return true, nil
+		}
+		if !isNotFound(err) {
+			return false, fmt.Errorf("error getting receipt for %s: %w", hash.Hex(), err)
+		}
+	}
+	return false, nil
+}
+
+// Get the highest max fee a task's transaction can be bumped to.
+// This is the lowest of the stuck TX cap, the manual max fee, and the fee limit of the task's gas policy: its threshold
+// if the transaction was sent under it, or its deadline max fee if it was sent past its deadline.
+// Returns nil if there's no limit.
+func (m *TransactionManager) getFeeLimit(task string, feeCap *big.Int) *big.Int {
+	limit := m.maxFeeCap
+	lower := func(gwei float64) {
+		if gwei <= 0 {
+			return
+		}
+		wei := eth.GweiToWei(gwei)
+		if limit == nil || wei.Cmp(limit) < 0 {
+			limit = wei
+		}
+	}
+	if m.cfg == nil {
+		return limit
+	}
+
+	lower(m.cfg.Smartnode.ManualMaxFee.Value.(float64))
+	taskPolicy, err := policy.GetPolicy(m.cfg, task)
+	if err != nil {
+		m.log.Printlnf("WARNING: couldn't get the gas policy for %s, using the default one to cap its fees: %s", task, err.Error())
+	}
+	if taskPolicy.ThresholdGwei > 0 && feeCap.Cmp(eth.GweiToWei(taskPolicy.ThresholdGwei)) <= 0 {
+		lower(taskPolicy.ThresholdGwei)
+	} else {
+		lower(taskPolicy.DeadlineMaxFeeGwei)
+	}
+	return limit
+}
+
+// Send a transaction that fell out of the mempool again, marking it as dropped if that fails
+func (m *TransactionManager) rebroadcast(record *ManagedTransaction) error {
+	tx, err := record.getTransaction()
+	if err == nil {
+		m.log.Printlnf("Transaction %s (nonce %d) is missing from the mempool, rebroadcasting it...", tx.Hash().Hex(), record.Nonce)
+		err = m.ec.SendTransaction(context.Background(), tx)
+		if err == nil {
+			record.LastBroadcastAt = time.Now()
+			return nil
+		}
+	}
+
+	// Give up on it and let the next transaction take its nonce so later transactions don't get stuck behind the gap
+	record.Status = TransactionStatus_Dropped
+	record.Error = fmt.Sprintf("the transaction was dropped from the mempool and couldn't be rebroadcast: %s", err.Error())
+	record.ResolvedAt = time.Now()
+	m.releaseNonce(record.Nonce)
+	return nil
+}
+
+// Replace a stuck transaction with a copy that pays higher fees
+func (m *TransactionManager) bump(record *ManagedTransaction) error {
+	tx, err := record.getTransaction()
+	if err != nil {
+		return err
+	}
+
+	// Raise both fees by the configured percentage
+	tipCap := BumpFee(tx.GasTipCap(), m.bumpPercent)
+	feeCap := BumpFee(tx.GasFeeCap(), m.bumpPercent)
+	if feeCap.Cmp(tipCap) < 0 {
+		feeCap = tipCap
+	}
+	limit := record.MaxFeeCap
+	if limit == nil {
+		// Transactions tracked before their limit was saved fall back to the stuck TX cap
+		limit = m.maxFeeCap
+	}
+	if limit != nil && feeCap.Cmp(limit) > 0 {
+		if !record.AtFeeCap {
+			m.log.Printlnf("Transaction %s (nonce %d) is still pending but its fees can't be raised above the cap of %.2f gwei.", tx.Hash().Hex(), record.Nonce, eth.WeiToGwei(limit))
+			record.AtFeeCap = true
+		}
+		return nil
+	}
+
+	// Sign and send the replacement
+	replacement := types.NewTx(&types.DynamicFeeTx{
+		ChainID:    tx.ChainId(),
+		Nonce:      tx.Nonce(),
+		GasTipCap:  tipCap,
+		GasFeeCap:  feeCap,
+		Gas:        tx.Gas(),
+		To:         tx.To(),
+		Value:      tx.Value(),
+		Data:       tx.Data(),
+		AccessList: tx.AccessList(),
+	})
+	opts, err := m.getTransactor()
+	if err != nil {
+		return err
+	}
+	signed, err := opts.Signer(record.From, replacement)
+	if err != nil {
+		return fmt.Errorf("error signing replacement transaction: %w", err)
+	}
+	err = m.ec.SendTransaction(context.Background(), signed)
+	if err != nil {
+		return fmt.Errorf("error sending replacement transaction: %w", err)
+	}
+
+	m.log.Printlnf("Transaction %s (nonce %d) has been pending for too long; replaced it with %s (max fee %.2f gwei, priority fee %.2f gwei).",
+		tx.Hash().Hex(), record.Nonce, signed.Hash().Hex(), eth.WeiToGwei(feeCap), eth.WeiToGwei(tipCap))
+	record.Hashes = append(record.Hashes, signed.Hash())
+	record.setTransaction(signed)
+	record.Bumps++
+	record.LastBroadcastAt = time.Now()
+
+	// The duty store only knows the hash a duty was submitted with, so it has to follow the replacement
+	if m.dutyStore != nil {
+		_, err = m.dutyStore.RecordReplacement(tx.Hash(), signed.Hash())
+		if err != nil {
+			m.log.Printlnf("WARNING: couldn't update the duties waiting on transaction %s to its replacement %s: %s", tx.Hash().Hex(), signed.Hash().Hex(), err.Error())
+		}
+	}
+	return nil
+}
+
+// Add the cost of a mined transaction to its task's gas spending; reverted transactions still have to pay for their gas
+func (m *TransactionManager) recordSpending(record *ManagedTransaction, receipt *types.Receipt) {
+	price := receipt.EffectiveGasPrice
+	if price == nil {
+		// Older clients don't report the price that was paid, so fall back to the most it could have been
+		price = record.GasFeeCap
+	}
+	record.GasUsed = receipt.GasUsed
+	if price != nil {
+		record.FeePaid = new(big.Int).Mul(price, new(big.Int).SetUint64(receipt.GasUsed))
+	}
+
+	if m.ledger == nil || record.FeePaid == nil {
+		return
+	}
+	err := m.ledger.Record(record.Task, record.GasUsed, record.FeePaid, record.ResolvedAt)
+	if err != nil {
+		m.log.Printlnf("WARNING: couldn't add the cost of transaction %s to the gas ledger: %s", record.MinedHash.Hex(), err.Error())
+	}
+}
+
+// Get the ledger of how much gas the tasks have spent
+func (m *TransactionManager) GetLedger() *policy.Ledger {
+	return m.ledger
+}
+
+// Remove the oldest resolved transactions once there are too many; the caller must hold the lock
+func (m *TransactionManager) prune() {
+	resolved := 0
+	for _, record := range m.queue.Transactions {
+		if record.Status != TransactionStatus_Pending {
+			resolved++
+		}
+	}
+
+	kept := []*ManagedTransaction{}
+	for _, record := range m.queue.Transactions {
+		if record.Status != TransactionStatus_Pending && resolved > m.historyLimit {
+			resolved--
+			continue
+		}
+		kept = append(kept, record)
+	}
+	m.queue.Transactions = kept
+}
+
+// Save the queue to disk; the caller must hold the lock
+func (m *TransactionManager) save() error {
+	m.queue.UpdatedAt = time.Now()
+	return m.queue.save(m.path)
+}
+
+// Raise a fee by a percentage, rounding up so the result is always strictly larger
+func BumpFee(fee *big.Int, percent uint64) *big.Int {
+	bumped := new(big.Int).Mul(fee, new(big.Int).SetUint64(100+percent))
+	bumped.Add(bumped, big.NewInt(99))
+	bumped.Div(bumped, big.NewInt(100))
+	if bumped.Cmp(fee) <= 0 {
+		bumped.Add(fee, big.NewInt(1))
+	}
+	return bumped
+}
+
+// Check if an error from the execution client means the item wasn't found
+func isNotFound(err error) bool {
+	return errors.Is(err, ethereum.NotFound) || err.Error() == ethereum.NotFound.Error()
+}
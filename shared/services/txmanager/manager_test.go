@@ -0,0 +1,445 @@
+package txmanager
+
+import (
+	"context"
+	"math/big"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/rocket-pool/smartnode/bindings/utils/eth"
+
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/gas/policy"
+	"github.com/rocket-pool/smartnode/shared/services/store"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+type fakeClient struct {
+	pendingNonce uint64
+	latestNonce  uint64
+	mempool      map[common.Hash]*types.Transaction
+	receipts     map[common.Hash]*types.Receipt
+	sent         []*types.Transaction
+	onNonceAt    func()
+}
+
+func newFakeClient() *fakeClient {
+	return &fakeClient{
+		mempool:  map[common.Hash]*types.Transaction{},
+		receipts: map[common.Hash]*types.Receipt{},
+	}
+}
+
+func (c *fakeClient) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	return c.pendingNonce, nil
+}
+
+func (c *fakeClient) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+	if c.onNonceAt != nil {
+		c.onNonceAt()
+	}
+	return c.latestNonce, nil
+}
+
+func (c *fakeClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	receipt, exists := c.receipts[txHash]
+	if !exists {
+		return nil, ethereum.NotFound
+	}
+	return receipt, nil
+}
+
+func (c *fakeClient) TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error) {
+	tx, exists := c.mempool[hash]
+	if !exists {
+		return nil, false, ethereum.NotFound
+	}
+	return tx, true, nil
+}
+
+func (c *fakeClient) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	c.sent = append(c.sent, tx)
+	c.mempool[tx.Hash()] = tx
+	return nil
+}
+
+func newTestManager(t *testing.T, client *fakeClient) *TransactionManager {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("error generating key: %s", err.Error())
+	}
+	logger := log.NewColorLogger(0)
+	return &TransactionManager{
+		ec: client,
+		getTransactor: func() (*bind.TransactOpts, error) {
+			return bind.NewKeyedTransactorWithChainID(key, big.NewInt(1))
+		},
+		log:          &logger,
+		path:         filepath.Join(t.TempDir(), "tx-queue.json"),
+		ledger:       policy.NewLedger(filepath.Join(t.TempDir(), "gas-ledger.json")),
+		dutyStore:    store.NewDutyStore(filepath.Join(t.TempDir(), "daemon-state.db")),
+		bumpInterval: time.Hour,
+		bumpPercent:  MinBumpPercent,
+		dropTimeout:  time.Hour,
+		historyLimit: DefaultHistoryLimit,
+		queue:        TransactionQueue{Transactions: []*ManagedTransaction{}},
+		reserved:     map[uint64]bool{},
+	}
+}
+
+// Sign and "submit" a transaction the way a binding would
+func submitTestTransaction(t *testing.T, m *TransactionManager, client *fakeClient) (*bind.TransactOpts, *types.Transaction) {
+	opts, err := m.GetTransactor()
+	if err != nil {
+		t.Fatalf("error getting transactor: %s", err.Error())
+	}
+	to := common.HexToAddress("0x01")
+	tx := types.NewTx(&types.DynamicFeeTx{
+		ChainID:   big.NewInt(1),
+		Nonce:     opts.Nonce.Uint64(),
+		GasTipCap: big.NewInt(1e9),
+		GasFeeCap: big.NewInt(20e9),
+		Gas:       21000,
+		To:        &to,
+	})
+	signed, err := opts.Signer(opts.From, tx)
+	if err != nil {
+		t.Fatalf("error signing transaction: %s", err.Error())
+	}
+	client.mempool[signed.Hash()] = signed
+	err = m.TrackTransaction("test", opts, signed.Hash())
+	if err != nil {
+		t.Fatalf("error tracking transaction: %s", err.Error())
+	}
+	return opts, signed
+}
+
+func TestBumpFee(t *testing.T) {
+	if BumpFee(big.NewInt(100), 10).Cmp(big.NewInt(110)) != 0 {
+		t.Error("expected 100 bumped by 10% to be 110")
+	}
+	if BumpFee(big.NewInt(101), 10).Cmp(big.NewInt(112)) != 0 {
+		t.Error("expected 101 bumped by 10% to round up to 112")
+	}
+	if BumpFee(big.NewInt(0), 10).Cmp(big.NewInt(1)) != 0 {
+		t.Error("expected a zero fee to be bumped to 1")
+	}
+}
+
+func TestNonceTracking(t *testing.T) {
+	client := newFakeClient()
+	client.pendingNonce = 5
+	m := newTestManager(t, client)
+
+	_, first := submitTestTransaction(t, m, client)
+	if first.Nonce() != 5 {
+		t.Fatalf("expected the first nonce to be 5, got %d", first.Nonce())
+	}
+
+	// The client hasn't seen the first transaction yet, so the manager has to supply the next nonce
+	_, second := submitTestTransaction(t, m, client)
+	if second.Nonce() != 6 {
+		t.Fatalf("expected the second nonce to be 6, got %d", second.Nonce())
+	}
+
+	// The queue survives a restart
+	queue, err := LoadTransactionQueue(m.path)
+	if err != nil {
+		t.Fatalf("error loading queue: %s", err.Error())
+	}
+	if len(queue.Transactions) != 2 || queue.Transactions[1].Nonce != 6 {
+		t.Fatalf("unexpected saved queue: %+v", queue)
+	}
+}
+
+func TestConcurrentTransactors(t *testing.T) {
+	client := newFakeClient()
+	client.pendingNonce = 3
+	m := newTestManager(t, client)
+
+	// Tasks that get transactors before either of them submits must not share a nonce
+	const callers = 20
+	nonces := make(chan uint64, callers)
+	var wg sync.WaitGroup
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			opts, err := m.GetTransactor()
+			if err != nil {
+				t.Errorf("error getting transactor: %s", err.Error())
+				return
+			}
+			nonces <- opts.Nonce.Uint64()
+		}()
+	}
+	wg.Wait()
+	close(nonces)
+
+	seen := map[uint64]bool{}
+	for nonce := range nonces {
+		if seen[nonce] {
+			t.Fatalf("nonce %d was given to more than one transactor", nonce)
+		}
+		seen[nonce] = true
+	}
+	for nonce := uint64(3); nonce < 3+callers; nonce++ {
+		if !seen[nonce] {
+			t.Errorf("expected nonce %d to be used", nonce)
+		}
+	}
+}
+
+func TestReleaseTransactor(t *testing.T) {
+	client := newFakeClient()
+	m := newTestManager(t, client)
+	getNonce := func() *bind.TransactOpts {
+		opts, err := m.GetTransactor()
+		if err != nil {
+			t.Fatalf("error getting transactor: %s", err.Error())
+		}
+		return opts
+	}
+	first, second, third := getNonce(), getNonce(), getNonce()
+
+	// A nonce in the middle is reused before new ones are handed out
+	m.ReleaseTransactor(second)
+	if opts := getNonce(); opts.Nonce.Uint64() != 1 {
+		t.Fatalf("expected the released nonce 1 to be reused, got %d", opts.Nonce.Uint64())
+	}
+
+	// The newest nonce is handed back, and releasing a transactor twice does nothing
+	m.ReleaseTransactor(third)
+	m.ReleaseTransactor(third)
+	if opts := getNonce(); opts.Nonce.Uint64() != 2 {
+		t.Fatalf("expected the released nonce 2 to be reused, got %d", opts.Nonce.Uint64())
+	}
+	if opts := getNonce(); opts.Nonce.Uint64() != 3 {
+		t.Fatalf("expected the next nonce to be 3, got %d", opts.Nonce.Uint64())
+	}
+
+	// Released nonces the client has seen used elsewhere are skipped
+	m.ReleaseTransactor(first)
+	client.pendingNonce = 10
+	if opts := getNonce(); opts.Nonce.Uint64() != 10 {
+		t.Fatalf("expected the client's pending nonce 10, got %d", opts.Nonce.Uint64())
+	}
+}
+
+func TestTrackOlderTransaction(t *testing.T) {
+	client := newFakeClient()
+	m := newTestManager(t, client)
+	older, err := m.GetTransactor()
+	if err != nil {
+		t.Fatalf("error getting transactor: %s", err.Error())
+	}
+	newer, err := m.GetTransactor()
+	if err != nil {
+		t.Fatalf("error getting transactor: %s", err.Error())
+	}
+
+	// Tracking the older transaction last mustn't move the nonce backwards
+	for _, opts := range []*bind.TransactOpts{newer, older} {
+		err = m.TrackTransaction("test", opts, common.Hash{byte(opts.Nonce.Uint64() + 1)})
+		if err != nil {
+			t.Fatalf("error tracking transaction: %s", err.Error())
+		}
+	}
+	opts, err := m.GetTransactor()
+	if err != nil {
+		t.Fatalf("error getting transactor: %s", err.Error())
+	}
+	if opts.Nonce.Uint64() != 2 {
+		t.Errorf("expected the next nonce to be 2, got %d", opts.Nonce.Uint64())
+	}
+
+	// Tracked transactors can't be released
+	m.ReleaseTransactor(newer)
+	if len(m.released) > 0 || m.nextNonce != 3 {
+		t.Errorf("expected releasing a tracked transactor to do nothing, got next nonce %d and released %v", m.nextNonce, m.released)
+	}
+}
+
+func TestBumpAndConfirm(t *testing.T) {
+	client := newFakeClient()
+	m := newTestManager(t, client)
+	_, original := submitTestTransaction(t, m, client)
+	err := m.dutyStore.RecordSubmission("test", "key", original.Hash())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+
+	// Force a bump
+	m.bumpInterval = time.Nanosecond
+	err = m.ProcessQueue()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if len(client.sent) != 1 {
+		t.Fatalf("expected a replacement to be sent, got %d transactions", len(client.sent))
+	}
+	replacement := client.sent[0]
+	if replacement.Nonce() != original.Nonce() ||
+		replacement.GasTipCap().Cmp(BumpFee(original.GasTipCap(), MinBumpPercent)) != 0 ||
+		replacement.GasFeeCap().Cmp(BumpFee(original.GasFeeCap(), MinBumpPercent)) != 0 {
+		t.Fatalf("unexpected replacement: nonce %d, tip %s, fee cap %s", replacement.Nonce(), replacement.GasTipCap(), replacement.GasFeeCap())
+	}
+
+	// The duty that was waiting on the original now waits on the replacement
+	duty, err := m.dutyStore.Get("test", "key")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if duty.TxHash != replacement.Hash() {
+		t.Fatalf("expected the duty to move to the replacement %s, got %s", replacement.Hash().Hex(), duty.TxHash.Hex())
+	}
+
+	// The original gets mined anyway; waiting on either hash should see it
+	m.bumpInterval = time.Hour
+	client.receipts[original.Hash()] = &types.Receipt{Status: types.ReceiptStatusSuccessful, BlockNumber: big.NewInt(10), GasUsed: 21000, EffectiveGasPrice: big.NewInt(15e9)}
+	record, err := m.refreshTransaction(replacement.Hash())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if record.Status != TransactionStatus_Confirmed || record.MinedHash != original.Hash() || record.Bumps != 1 {
+		t.Fatalf("unexpected record: %+v", record)
+	}
+
+	// What it paid counts against the task's gas spending
+	expectedCost := big.NewInt(21000 * 15e9)
+	if record.GasUsed != 21000 || record.FeePaid.Cmp(expectedCost) != 0 {
+		t.Fatalf("expected the record to have used 21000 gas for %s wei, got %d gas for %s wei", expectedCost, record.GasUsed, record.FeePaid)
+	}
+	spending, err := m.GetLedger().GetMonthlySpending(time.Now())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if len(spending.Duties) != 1 || spending.Duties[0].Duty != "test" || spending.Duties[0].Transactions != 1 || spending.TotalWei.Cmp(expectedCost) != 0 {
+		t.Fatalf("unexpected gas spending: %+v", spending)
+	}
+}
+
+func TestFeeCap(t *testing.T) {
+	client := newFakeClient()
+	m := newTestManager(t, client)
+	m.maxFeeCap = big.NewInt(21e9)
+	submitTestTransaction(t, m, client)
+
+	m.bumpInterval = time.Nanosecond
+	err := m.ProcessQueue()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if len(client.sent) != 0 {
+		t.Fatal("expected the fee cap to prevent a replacement")
+	}
+	if !m.GetQueue().Transactions[0].AtFeeCap {
+		t.Fatal("expected the transaction to be flagged as being at the fee cap")
+	}
+}
+
+func TestFeeLimit(t *testing.T) {
+	client := newFakeClient()
+	m := newTestManager(t, client)
+	m.maxFeeCap = eth.GweiToWei(300)
+	m.cfg = config.NewRocketPoolConfig(t.TempDir(), false)
+	m.cfg.Smartnode.AutoTxGasThreshold.Value = float64(150)
+	m.cfg.Smartnode.AutoTxDeadlineMaxFee.Value = float64(0)
+	m.cfg.Smartnode.AutoTxGasPolicies.Value = "distribute-minipools=10,stake-prelaunch-minipools=40:200"
+
+	tests := []struct {
+		task     string
+		feeCap   float64
+		expected float64
+	}{
+		{task: "promote-minipools", feeCap: 100, expected: 150},
+		{task: "distribute-minipools", feeCap: 5, expected: 10},
+		{task: "stake-prelaunch-minipools", feeCap: 30, expected: 40},
+		{task: "stake-prelaunch-minipools", feeCap: 60, expected: 200},
+		{task: "promote-minipools", feeCap: 160, expected: 300},
+	}
+	for _, test := range tests {
+		limit := m.getFeeLimit(test.task, eth.GweiToWei(test.feeCap))
+		if limit == nil || limit.Cmp(eth.GweiToWei(test.expected)) != 0 {
+			t.Errorf("expected %s at %.0f gwei to be limited to %.0f gwei, got %v", test.task, test.feeCap, test.expected, limit)
+		}
+	}
+
+	m.cfg.Smartnode.ManualMaxFee.Value = float64(20)
+	limit := m.getFeeLimit("promote-minipools", eth.GweiToWei(20))
+	if limit.Cmp(eth.GweiToWei(20)) != 0 {
+		t.Errorf("expected the manual max fee to limit bumps to 20 gwei, got %v", limit)
+	}
+}
+
+func TestMinedBeforeNonceCheck(t *testing.T) {
+	client := newFakeClient()
+	m := newTestManager(t, client)
+	_, tx := submitTestTransaction(t, m, client)
+
+	// The transaction gets mined between the receipt check and the nonce check
+	client.onNonceAt = func() {
+		client.latestNonce = tx.Nonce() + 1
+		client.receipts[tx.Hash()] = &types.Receipt{
+			Status:      types.ReceiptStatusSuccessful,
+			BlockNumber: big.NewInt(100),
+			GasUsed:     21000,
+		}
+	}
+
+	err := m.ProcessQueue()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	record := m.GetQueue().Transactions[0]
+	if record.Status != TransactionStatus_Confirmed {
+		t.Fatalf("expected the transaction to be confirmed, got %s", record.Status)
+	}
+	if record.FeePaid == nil {
+		t.Error("expected the transaction's cost to be recorded")
+	}
+}
+
+func TestReplacedAndDropped(t *testing.T) {
+	client := newFakeClient()
+	m := newTestManager(t, client)
+	_, replaced := submitTestTransaction(t, m, client)
+	_, dropped := submitTestTransaction(t, m, client)
+
+	// Another transaction took the first nonce
+	client.latestNonce = replaced.Nonce() + 1
+	delete(client.mempool, replaced.Hash())
+
+	// The second one fell out of the mempool and can't be rebroadcast
+	delete(client.mempool, dropped.Hash())
+	m.queue.Transactions[1].RawTx = nil
+	m.dropTimeout = 0
+
+	err := m.ProcessQueue()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	queue := m.GetQueue()
+	if queue.Transactions[0].Status != TransactionStatus_Replaced {
+		t.Errorf("expected the first transaction to be replaced, got %s", queue.Transactions[0].Status)
+	}
+	if queue.Transactions[1].Status != TransactionStatus_Dropped {
+		t.Errorf("expected the second transaction to be dropped, got %s", queue.Transactions[1].Status)
+	}
+
+	// The dropped transaction's nonce goes to the next one so later transactions don't get stuck behind it
+	opts, err := m.GetTransactor()
+	if err != nil {
+		t.Fatalf("error getting transactor: %s", err.Error())
+	}
+	if opts.Nonce.Uint64() != dropped.Nonce() {
+		t.Errorf("expected the dropped nonce %d to be reused, got %d", dropped.Nonce(), opts.Nonce.Uint64())
+	}
+}
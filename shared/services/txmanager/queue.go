@@ -0,0 +1,139 @@
+package txmanager
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// The status of a managed transaction
+type TransactionStatus string
+
+const (
+	TransactionStatus_Pending   TransactionStatus = "pending"
+	TransactionStatus_Confirmed TransactionStatus = "confirmed"
+	TransactionStatus_Reverted  TransactionStatus = "reverted"
+	TransactionStatus_Dropped   TransactionStatus = "dropped"
+	TransactionStatus_Replaced  TransactionStatus = "replaced"
+)
+
+// A transaction submitted by one of the daemon's tasks
+type ManagedTransaction struct {
+	Task            string            `json:"task"`
+	From            common.Address    `json:"from"`
+	Nonce           uint64            `json:"nonce"`
+	Hashes          []common.Hash     `json:"hashes"`
+	RawTx           hexutil.Bytes     `json:"rawTx,omitempty"`
+	GasFeeCap       *big.Int          `json:"gasFeeCap"`
+	GasTipCap       *big.Int          `json:"gasTipCap"`
+	MaxFeeCap       *big.Int          `json:"maxFeeCap,omitempty"`
+	Bumps           uint64            `json:"bumps"`
+	AtFeeCap        bool              `json:"atFeeCap"`
+	Status          TransactionStatus `json:"status"`
+	Error           string            `json:"error,omitempty"`
+	MinedHash       common.Hash       `json:"minedHash"`
+	BlockNumber     uint64            `json:"blockNumber"`
+	GasUsed         uint64            `json:"gasUsed,omitempty"`
+	FeePaid         *big.Int          `json:"feePaid,omitempty"`
+	SubmittedAt     time.Time         `json:"submittedAt"`
+	LastBroadcastAt time.Time         `json:"lastBroadcastAt"`
+	ResolvedAt      time.Time         `json:"resolvedAt"`
+}
+
+// The transactions the daemon is tracking, as saved to disk
+type TransactionQueue struct {
+	UpdatedAt    time.Time             `json:"updatedAt"`
+	Transactions []*ManagedTransaction `json:"transactions"`
+}
+
+// Get the hash of the most recently broadcast version of the transaction
+func (t *ManagedTransaction) GetLatestHash() common.Hash {
+	if len(t.Hashes) == 0 {
+		return common.Hash{}
+	}
+	return t.Hashes[len(t.Hashes)-1]
+}
+
+// Store the signed transaction and its details
+func (t *ManagedTransaction) setTransaction(tx *types.Transaction) {
+	raw, err := tx.MarshalBinary()
+	if err == nil {
+		t.RawTx = raw
+	}
+	t.Nonce = tx.Nonce()
+	t.GasFeeCap = tx.GasFeeCap()
+	t.GasTipCap = tx.GasTipCap()
+}
+
+// Decode the stored signed transaction
+func (t *ManagedTransaction) getTransaction() (*types.Transaction, error) {
+	if len(t.RawTx) == 0 {
+		return nil, fmt.Errorf("the signed transaction for nonce %d wasn't saved", t.Nonce)
+	}
+	tx := new(types.Transaction)
+	err := tx.UnmarshalBinary(t.RawTx)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding the signed transaction for nonce %d: %w", t.Nonce, err)
+	}
+	return tx, nil
+}
+
+// Find the transaction that includes the provided hash
+func (q *TransactionQueue) findByHash(hash common.Hash) *ManagedTransaction {
+	for _, record := range q.Transactions {
+		for _, recordHash := range record.Hashes {
+			if recordHash == hash {
+				return record
+			}
+		}
+	}
+	return nil
+}
+
+// Load the transaction queue from disk; a missing file is treated as an empty queue
+func LoadTransactionQueue(path string) (*TransactionQueue, error) {
+	queue := &TransactionQueue{
+		Transactions: []*ManagedTransaction{},
+	}
+	bytes, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return queue, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading transaction queue [%s]: %w", path, err)
+	}
+	err = json.Unmarshal(bytes, queue)
+	if err != nil {
+		return nil, fmt.Errorf("error deserializing transaction queue [%s]: %w", path, err)
+	}
+	return queue, nil
+}
+
+// Save the transaction queue to disk, replacing the old file atomically
+func (q *TransactionQueue) save(path string) error {
+	bytes, err := json.Marshal(q)
+	if err != nil {
+		return fmt.Errorf("error serializing transaction queue: %w", err)
+	}
+	err = os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return fmt.Errorf("error creating folder for transaction queue [%s]: %w", path, err)
+	}
+	tempPath := path + ".tmp"
+	err = os.WriteFile(tempPath, bytes, 0644)
+	if err != nil {
+		return fmt.Errorf("error writing transaction queue [%s]: %w", tempPath, err)
+	}
+	err = os.Rename(tempPath, path)
+	if err != nil {
+		return fmt.Errorf("error replacing transaction queue [%s]: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,210 @@
+package web3signer
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/rocket-pool/smartnode/bindings/types"
+	hexutil "github.com/rocket-pool/smartnode/shared/utils/hex"
+)
+
+// Config
+const (
+	RequestKeystoresPath = "/eth/v1/keystores"
+	RequestSignPath      = "/api/v1/eth2/sign/%s"
+
+	ImportStatus_Imported  string = "imported"
+	ImportStatus_Duplicate string = "duplicate"
+	ImportStatus_Error     string = "error"
+
+	requestTimeout = 30 * time.Second
+)
+
+// A client for a Web3Signer-compatible remote signer, using its keymanager API to import keys and its signing API to sign messages
+type Client struct {
+	url        string
+	authToken  string
+	httpClient *http.Client
+}
+
+// The fork the signer should sign for
+type ForkInfo struct {
+	PreviousVersion       []byte
+	CurrentVersion        []byte
+	Epoch                 uint64
+	GenesisValidatorsRoot []byte
+}
+
+// The result of importing a single keystore
+type ImportStatus struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+type importKeystoresRequest struct {
+	Keystores []string `json:"keystores"`
+	Passwords []string `json:"passwords"`
+}
+type importKeystoresResponse struct {
+	Data []ImportStatus `json:"data"`
+}
+type listKeystoresResponse struct {
+	Data []struct {
+		ValidatingPubkey string `json:"validating_pubkey"`
+	} `json:"data"`
+}
+type forkInfoRequest struct {
+	Fork struct {
+		PreviousVersion string `json:"previous_version"`
+		CurrentVersion  string `json:"current_version"`
+		Epoch           string `json:"epoch"`
+	} `json:"fork"`
+	GenesisValidatorsRoot string `json:"genesis_validators_root"`
+}
+type voluntaryExitSignRequest struct {
+	Type          string          `json:"type"`
+	ForkInfo      forkInfoRequest `json:"fork_info"`
+	SigningRoot   string          `json:"signingRoot"`
+	VoluntaryExit struct {
+		Epoch          string `json:"epoch"`
+		ValidatorIndex string `json:"validator_index"`
+	} `json:"voluntary_exit"`
+}
+type signResponse struct {
+	Signature string `json:"signature"`
+}
+
+// Create a new remote signer client; the auth token is optional
+func NewClient(url string, authToken string) *Client {
+	return &Client{
+		url:       strings.TrimSuffix(url, "/"),
+		authToken: authToken,
+		httpClient: &http.Client{
+			Timeout: requestTimeout,
+		},
+	}
+}
+
+// Get the signer's URL
+func (c *Client) GetUrl() string {
+	return c.url
+}
+
+// Import EIP-2335 keystores into the signer, returning the status of each one
+func (c *Client) ImportKeystores(keystores []string, passwords []string) ([]ImportStatus, error) {
+	if len(keystores) != len(passwords) {
+		return nil, fmt.Errorf("got %d keystores but %d passwords", len(keystores), len(passwords))
+	}
+
+	var response importKeystoresResponse
+	err := c.request(http.MethodPost, RequestKeystoresPath, importKeystoresRequest{
+		Keystores: keystores,
+		Passwords: passwords,
+	}, &response)
+	if err != nil {
+		return nil, fmt.Errorf("error importing keystores: %w", err)
+	}
+	if len(response.Data) != len(keystores) {
+		return nil, fmt.Errorf("imported %d keystores but the signer returned %d statuses", len(keystores), len(response.Data))
+	}
+	return response.Data, nil
+}
+
+// Get the pubkeys of the keys the signer holds
+func (c *Client) ListKeystores() ([]types.ValidatorPubkey, error) {
+	var response listKeystoresResponse
+	err := c.request(http.MethodGet, RequestKeystoresPath, nil, &response)
+	if err != nil {
+		return nil, fmt.Errorf("error listing keystores: %w", err)
+	}
+
+	pubkeys := make([]types.ValidatorPubkey, 0, len(response.Data))
+	for _, keystore := range response.Data {
+		pubkey, err := types.HexToValidatorPubkey(hexutil.RemovePrefix(keystore.ValidatingPubkey))
+		if err != nil {
+			return nil, fmt.Errorf("signer returned an invalid pubkey [%s]: %w", keystore.ValidatingPubkey, err)
+		}
+		pubkeys = append(pubkeys, pubkey)
+	}
+	return pubkeys, nil
+}
+
+// Have the signer sign a voluntary exit for one of its validators.
+// The signing root is computed locally so the signer doesn't need to know about the EIP-7044 exit domain.
+func (c *Client) SignVoluntaryExit(pubkey types.ValidatorPubkey, forkInfo ForkInfo, signingRoot []byte, epoch uint64, validatorIndex uint64) (types.ValidatorSignature, error) {
+	request := voluntaryExitSignRequest{
+		Type:        "VOLUNTARY_EXIT",
+		SigningRoot: hexutil.EncodeToString(signingRoot),
+	}
+	request.ForkInfo.Fork.PreviousVersion = hexutil.EncodeToString(forkInfo.PreviousVersion)
+	request.ForkInfo.Fork.CurrentVersion = hexutil.EncodeToString(forkInfo.CurrentVersion)
+	request.ForkInfo.Fork.Epoch = fmt.Sprint(forkInfo.Epoch)
+	request.ForkInfo.GenesisValidatorsRoot = hexutil.EncodeToString(forkInfo.GenesisValidatorsRoot)
+	request.VoluntaryExit.Epoch = fmt.Sprint(epoch)
+	request.VoluntaryExit.ValidatorIndex = fmt.Sprint(validatorIndex)
+
+	var response signResponse
+	err := c.request(http.MethodPost, fmt.Sprintf(RequestSignPath, hexutil.AddPrefix(pubkey.Hex())), request, &response)
+	if err != nil {
+		return types.ValidatorSignature{}, fmt.Errorf("error signing voluntary exit for validator %s: %w", pubkey.Hex(), err)
+	}
+
+	signature, err := hex.DecodeString(hexutil.RemovePrefix(response.Signature))
+	if err != nil || len(signature) != types.ValidatorSignatureLength {
+		return types.ValidatorSignature{}, fmt.Errorf("signer returned an invalid signature [%s] for validator %s", response.Signature, pubkey.Hex())
+	}
+	return types.BytesToValidatorSignature(signature), nil
+}
+
+// Send a request to the signer and decode its JSON response
+func (c *Client) request(method string, path string, body interface{}, response interface{}) error {
+	var bodyReader io.Reader
+	if body != nil {
+		bodyBytes, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("error encoding request: %w", err)
+		}
+		bodyReader = bytes.NewReader(bodyBytes)
+	}
+
+	request, err := http.NewRequest(method, c.url+path, bodyReader)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Accept", "application/json")
+	if body != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+	if c.authToken != "" {
+		request.Header.Set("Authorization", "Bearer "+c.authToken)
+	}
+
+	httpResponse, err := c.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer httpResponse.Body.Close()
+	responseBytes, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return fmt.Errorf("error reading response: %w", err)
+	}
+	if httpResponse.StatusCode != http.StatusOK {
+		return fmt.Errorf("HTTP status %d; response body: '%s'", httpResponse.StatusCode, string(responseBytes))
+	}
+
+	// Some signers answer signing requests with the bare signature instead of JSON
+	if signature, ok := response.(*signResponse); ok && !bytes.HasPrefix(bytes.TrimSpace(responseBytes), []byte("{")) {
+		signature.Signature = strings.TrimSpace(string(responseBytes))
+		return nil
+	}
+	if err := json.Unmarshal(responseBytes, response); err != nil {
+		return fmt.Errorf("error decoding response: %w", err)
+	}
+	return nil
+}
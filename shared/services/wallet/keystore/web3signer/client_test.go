@@ -0,0 +1,191 @@
+package web3signer
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/rocket-pool/smartnode/bindings/types"
+	eth2types "github.com/wealdtech/go-eth2-types/v2"
+	eth2ks "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+	"gopkg.in/yaml.v2"
+
+	"github.com/rocket-pool/smartnode/shared/services/keymanager"
+	hexutil "github.com/rocket-pool/smartnode/shared/utils/hex"
+)
+
+const testAuthToken = "test-token"
+
+// A mock remote signer that keeps imported keys in memory
+type mockSigner struct {
+	server *httptest.Server
+	lock   sync.Mutex
+	keys   map[string]*eth2types.BLSPrivateKey
+}
+
+func newMockSigner(t *testing.T) *mockSigner {
+	if err := eth2types.InitBLS(); err != nil {
+		t.Fatal(err)
+	}
+
+	signer := &mockSigner{keys: map[string]*eth2types.BLSPrivateKey{}}
+	signer.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+testAuthToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		signer.lock.Lock()
+		defer signer.lock.Unlock()
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == RequestKeystoresPath:
+			var request importKeystoresRequest
+			if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			statuses := []ImportStatus{}
+			for i, keystoreJson := range request.Keystores {
+				statuses = append(statuses, signer.importKeystore(keystoreJson, request.Passwords[i]))
+			}
+			json.NewEncoder(w).Encode(importKeystoresResponse{Data: statuses})
+
+		case r.Method == http.MethodGet && r.URL.Path == RequestKeystoresPath:
+			var response listKeystoresResponse
+			for pubkey := range signer.keys {
+				response.Data = append(response.Data, struct {
+					ValidatingPubkey string `json:"validating_pubkey"`
+				}{ValidatingPubkey: pubkey})
+			}
+			json.NewEncoder(w).Encode(response)
+
+		case r.Method == http.MethodPost && strings.HasPrefix(r.URL.Path, "/api/v1/eth2/sign/"):
+			key, exists := signer.keys[strings.TrimPrefix(r.URL.Path, "/api/v1/eth2/sign/")]
+			if !exists {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			var request voluntaryExitSignRequest
+			if err := json.NewDecoder(r.Body).Decode(&request); err != nil || request.Type != "VOLUNTARY_EXIT" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			root, _ := hex.DecodeString(hexutil.RemovePrefix(request.SigningRoot))
+			json.NewEncoder(w).Encode(signResponse{Signature: hexutil.EncodeToString(key.Sign(root).Marshal())})
+
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(signer.server.Close)
+	return signer
+}
+
+func (s *mockSigner) importKeystore(keystoreJson string, password string) ImportStatus {
+	var keystore keymanager.ValidatorKeystore
+	if err := json.Unmarshal([]byte(keystoreJson), &keystore); err != nil {
+		return ImportStatus{Status: ImportStatus_Error, Message: err.Error()}
+	}
+	keyBytes, err := eth2ks.New().Decrypt(keystore.Crypto, password)
+	if err != nil {
+		return ImportStatus{Status: ImportStatus_Error, Message: err.Error()}
+	}
+	key, err := eth2types.BLSPrivateKeyFromBytes(keyBytes)
+	if err != nil {
+		return ImportStatus{Status: ImportStatus_Error, Message: err.Error()}
+	}
+	pubkey := hexutil.EncodeToString(key.PublicKey().Marshal())
+	if _, exists := s.keys[pubkey]; exists {
+		return ImportStatus{Status: ImportStatus_Duplicate}
+	}
+	s.keys[pubkey] = key
+	return ImportStatus{Status: ImportStatus_Imported}
+}
+
+func TestKeystoreImportsIntoSigner(t *testing.T) {
+	signer := newMockSigner(t)
+	client := NewClient(signer.server.URL+"/", testAuthToken)
+	keystorePath := t.TempDir()
+	ks := NewKeystore(keystorePath, client)
+
+	key, err := eth2types.GenerateBLSPrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubkey := types.BytesToValidatorPubkey(key.PublicKey().Marshal())
+
+	// Importing the same key twice is fine
+	for i := 0; i < 2; i++ {
+		if err := ks.StoreValidatorKey(key, "m/12381/3600/0/0/0"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The signer has the key
+	pubkeys, err := client.ListKeystores()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pubkeys) != 1 || pubkeys[0] != pubkey {
+		t.Fatalf("expected the signer to hold %s, got %v", pubkey.Hex(), pubkeys)
+	}
+
+	// The key can't be loaded back
+	loaded, err := ks.LoadValidatorKey(pubkey)
+	if loaded != nil || err != nil {
+		t.Fatalf("expected no key and no error, got %v and %v", loaded, err)
+	}
+
+	// Lighthouse has a single definition for it
+	bytes, err := os.ReadFile(filepath.Join(keystorePath, LighthouseDefinitionsPath))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var definitions []lighthouseDefinition
+	if err := yaml.Unmarshal(bytes, &definitions); err != nil {
+		t.Fatal(err)
+	}
+	if len(definitions) != 1 || definitions[0].VotingPublicKey != hexutil.AddPrefix(pubkey.Hex()) || definitions[0].Url != signer.server.URL {
+		t.Fatalf("unexpected Lighthouse definitions: %+v", definitions)
+	}
+}
+
+func TestSignVoluntaryExit(t *testing.T) {
+	signer := newMockSigner(t)
+	client := NewClient(signer.server.URL, testAuthToken)
+
+	key, err := eth2types.GenerateBLSPrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := NewKeystore(t.TempDir(), client).StoreValidatorKey(key, ""); err != nil {
+		t.Fatal(err)
+	}
+	pubkey := types.BytesToValidatorPubkey(key.PublicKey().Marshal())
+
+	root := make([]byte, 32)
+	root[0] = 1
+	signature, err := client.SignVoluntaryExit(pubkey, ForkInfo{}, root, 100, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := types.BytesToValidatorSignature(key.Sign(root).Marshal())
+	if signature != expected {
+		t.Fatalf("expected signature %s, got %s", expected.Hex(), signature.Hex())
+	}
+
+	// Unknown keys and bad tokens are reported as errors
+	otherKey, _ := eth2types.GenerateBLSPrivateKey()
+	if _, err := client.SignVoluntaryExit(types.BytesToValidatorPubkey(otherKey.PublicKey().Marshal()), ForkInfo{}, root, 100, 5); err == nil {
+		t.Fatal("expected an error for a key the signer doesn't hold")
+	}
+	if _, err := NewClient(signer.server.URL, "wrong").SignVoluntaryExit(pubkey, ForkInfo{}, root, 100, 5); err == nil {
+		t.Fatal("expected an error for a bad auth token")
+	}
+}
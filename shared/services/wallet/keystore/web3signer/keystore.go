@@ -0,0 +1,141 @@
+package web3signer
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/rocket-pool/smartnode/bindings/types"
+	eth2types "github.com/wealdtech/go-eth2-types/v2"
+	"gopkg.in/yaml.v2"
+
+	"github.com/rocket-pool/smartnode/shared/services/keymanager"
+	hexutil "github.com/rocket-pool/smartnode/shared/utils/hex"
+)
+
+// Config
+const (
+	KeystoreDir = "web3signer"
+
+	// Lighthouse can't discover the keys in a remote signer on its own, so it needs a definition for each one
+	LighthouseDefinitionsPath = "lighthouse/validators/validator_definitions.yml"
+
+	DirMode  = 0770
+	FileMode = 0640
+)
+
+// Remote signer keystore; keys are imported into the signer instead of being written to disk
+type Keystore struct {
+	keystorePath string
+	client       *Client
+}
+
+// A Lighthouse validator definition for a key held by a remote signer
+type lighthouseDefinition struct {
+	Enabled         bool   `yaml:"enabled"`
+	VotingPublicKey string `yaml:"voting_public_key"`
+	Type            string `yaml:"type"`
+	Url             string `yaml:"url"`
+}
+
+// Create new remote signer keystore
+func NewKeystore(keystorePath string, client *Client) *Keystore {
+	return &Keystore{
+		keystorePath: keystorePath,
+		client:       client,
+	}
+}
+
+// Get the keystore directory
+func (ks *Keystore) GetKeystoreDir() string {
+	return filepath.Join(ks.keystorePath, KeystoreDir)
+}
+
+// Import a validator key into the remote signer
+func (ks *Keystore) StoreValidatorKey(key *eth2types.BLSPrivateKey, derivationPath string) error {
+
+	// Get validator pubkey
+	pubkey := types.BytesToValidatorPubkey(key.PublicKey().Marshal())
+
+	// Encrypt it for the import
+	keystoreJson, password, err := keymanager.EncryptValidatorKey(key, derivationPath)
+	if err != nil {
+		return err
+	}
+
+	// Import it
+	statuses, err := ks.client.ImportKeystores([]string{keystoreJson}, []string{password})
+	if err != nil {
+		return fmt.Errorf("Could not import validator key into the remote signer: %w", err)
+	}
+	status := statuses[0]
+	if status.Status != ImportStatus_Imported && status.Status != ImportStatus_Duplicate {
+		return fmt.Errorf("The remote signer could not import validator key %s: %s (%s)", pubkey.Hex(), status.Status, status.Message)
+	}
+
+	// Register it with Lighthouse
+	if err := ks.addLighthouseDefinition(pubkey); err != nil {
+		return fmt.Errorf("Could not add the Lighthouse definition for validator key %s: %w", pubkey.Hex(), err)
+	}
+
+	// Return
+	return nil
+
+}
+
+// Keys in the remote signer can't be loaded
+func (ks *Keystore) LoadValidatorKey(pubkey types.ValidatorPubkey) (*eth2types.BLSPrivateKey, error) {
+	return nil, nil
+}
+
+// Add a remote signer definition for a key to Lighthouse's validator definitions, if it isn't there already
+func (ks *Keystore) addLighthouseDefinition(pubkey types.ValidatorPubkey) error {
+
+	// Load the existing definitions; Lighthouse adds its own fields, so keep them as generic maps
+	path := filepath.Join(ks.keystorePath, LighthouseDefinitionsPath)
+	definitions := []map[string]interface{}{}
+	bytes, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if err == nil {
+		if err := yaml.Unmarshal(bytes, &definitions); err != nil {
+			return fmt.Errorf("error deserializing %s: %w", path, err)
+		}
+	}
+
+	// Check if the key is already defined
+	votingPubkey := hexutil.AddPrefix(pubkey.Hex())
+	for _, definition := range definitions {
+		if definition["voting_public_key"] == votingPubkey {
+			return nil
+		}
+	}
+
+	// Add the definition
+	definitionBytes, err := yaml.Marshal(lighthouseDefinition{
+		Enabled:         true,
+		VotingPublicKey: votingPubkey,
+		Type:            "web3signer",
+		Url:             ks.client.GetUrl(),
+	})
+	if err != nil {
+		return err
+	}
+	var definition map[string]interface{}
+	if err := yaml.Unmarshal(definitionBytes, &definition); err != nil {
+		return err
+	}
+	definitions = append(definitions, definition)
+
+	// Save the definitions
+	bytes, err = yaml.Marshal(definitions)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), DirMode); err != nil {
+		return err
+	}
+	return os.WriteFile(path, bytes, FileMode)
+
+}
@@ -36,6 +36,15 @@ func (w *masqueradeWallet) GetNodeAccountTransactor() (*bind.TransactOpts, error
 		return nil, err
 	}
 
+	// Capture transactions for offline signing if requested; this is how a watch-only node prepares them
+	if w.txCapture != nil {
+		transactor := newCaptureTransactor(account.Address, w.txCapture)
+		transactor.GasFeeCap = w.maxFee
+		transactor.GasTipCap = w.maxPriorityFee
+		transactor.GasLimit = w.gasLimit
+		return transactor, nil
+	}
+
 	// Create & return transactor
 	transactor := &bind.TransactOpts{}
 	transactor.GasFeeCap = w.maxFee
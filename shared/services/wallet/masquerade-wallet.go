@@ -14,6 +14,7 @@ import (
 	"github.com/goccy/go-json"
 	"github.com/rocket-pool/smartnode/shared/services/passwords"
 	"github.com/rocket-pool/smartnode/shared/services/wallet/keystore"
+	"github.com/rocket-pool/smartnode/shared/services/wallet/signer"
 	eth2ks "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
 )
 
@@ -40,6 +41,9 @@ type masqueradeWallet struct {
 	nodeKey     *ecdsa.PrivateKey
 	nodeKeyPath string
 
+	// Receives node account transactions for offline signing
+	txCapture TransactionCapture
+
 	// Desired gas price & limit from config
 	maxFee         *big.Int
 	maxPriorityFee *big.Int
@@ -99,6 +103,16 @@ func (w *masqueradeWallet) AddKeystore(name string, ks keystore.Keystore) {
 	return
 }
 
+// Masquerading wallets can't sign for the node account, so there is nothing to do
+func (w *masqueradeWallet) SetNodeSigner(nodeSigner signer.Signer) {
+	return
+}
+
+// Capture node account transactions for offline signing
+func (w *masqueradeWallet) SetTransactionCapture(capture TransactionCapture) {
+	w.txCapture = capture
+}
+
 // Always return true as we're masquerading
 func (w *masqueradeWallet) IsInitialized() bool {
 	return true
@@ -183,6 +197,10 @@ func (w *masqueradeWallet) loadStore() (bool, error) {
 
 	// Get wallet password
 	password, err := w.pm.GetPassword()
+	if errors.Is(err, passwords.ErrWalletLocked) {
+		// The wallet can't be loaded until it's unlocked
+		return false, nil
+	}
 	if err != nil {
 		return false, fmt.Errorf("Could not get wallet password: %w", err)
 	}
@@ -9,9 +9,16 @@ import (
 	"github.com/btcsuite/btcd/btcutil/hdkeychain"
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/rocket-pool/smartnode/shared/services/wallet/offline"
+	"github.com/rocket-pool/smartnode/shared/services/wallet/signer"
 )
 
+var ErrExternalSigner = errors.New("The node account is held by an external signer, so its private key is not available.")
+
 // Get the node account
 func (w *hdWallet) GetNodeAccount() (accounts.Account, error) {
 
@@ -20,6 +27,17 @@ func (w *hdWallet) GetNodeAccount() (accounts.Account, error) {
 		return accounts.Account{}, errors.New("Wallet is not initialized")
 	}
 
+	// Use the external signer's account if there is one
+	if w.nodeSigner != nil {
+		address, err := w.nodeSigner.GetAddress()
+		if err != nil {
+			return accounts.Account{}, err
+		}
+		return accounts.Account{
+			Address: address,
+		}, nil
+	}
+
 	// Get private key
 	privateKey, path, err := w.getNodePrivateKey()
 	if err != nil {
@@ -52,22 +70,67 @@ func (w *hdWallet) GetNodeAccountTransactor() (*bind.TransactOpts, error) {
 		return nil, errors.New("Wallet is not initialized")
 	}
 
-	// Get private key
-	privateKey, _, err := w.getNodePrivateKey()
+	// Capture transactions for offline signing if requested
+	if w.txCapture != nil {
+		account, err := w.GetNodeAccount()
+		if err != nil {
+			return nil, err
+		}
+		transactor := newCaptureTransactor(account.Address, w.txCapture)
+		transactor.GasFeeCap = w.maxFee
+		transactor.GasTipCap = w.maxPriorityFee
+		transactor.GasLimit = w.gasLimit
+		return transactor, nil
+	}
+
+	// Get signer
+	nodeSigner, err := w.getNodeSigner()
+	if err != nil {
+		return nil, err
+	}
+	from, err := nodeSigner.GetAddress()
 	if err != nil {
 		return nil, err
 	}
 
 	// Create & return transactor
-	transactor, err := bind.NewKeyedTransactorWithChainID(privateKey, w.chainID)
+	chainID := w.GetChainID()
+	transactor := &bind.TransactOpts{
+		From: from,
+		Signer: func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			if address != from {
+				return nil, bind.ErrNotAuthorized
+			}
+			return nodeSigner.SignTx(tx, chainID)
+		},
+	}
 	transactor.GasFeeCap = w.maxFee
 	transactor.GasTipCap = w.maxPriorityFee
 	transactor.GasLimit = w.gasLimit
 	transactor.Context = context.Background()
-	return transactor, err
+	return transactor, nil
 
 }
 
+// Create a transactor that hands its transactions to a capture instead of signing them.
+// The capture aborts each transaction so it's never submitted, even by callers that ignore NoSend.
+func newCaptureTransactor(from common.Address, capture TransactionCapture) *bind.TransactOpts {
+	return &bind.TransactOpts{
+		From: from,
+		Signer: func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			if address != from {
+				return nil, bind.ErrNotAuthorized
+			}
+			if err := capture(from, tx); err != nil {
+				return nil, err
+			}
+			return nil, offline.ErrTransactionCaptured
+		},
+		Context: context.Background(),
+		NoSend:  true,
+	}
+}
+
 // Get the node account private key bytes
 func (w *hdWallet) GetNodePrivateKeyBytes() ([]byte, error) {
 
@@ -76,6 +139,11 @@ func (w *hdWallet) GetNodePrivateKeyBytes() ([]byte, error) {
 		return nil, errors.New("Wallet is not initialized")
 	}
 
+	// The key never leaves an external signer
+	if w.nodeSigner != nil {
+		return nil, ErrExternalSigner
+	}
+
 	// Get private key
 	privateKey, _, err := w.getNodePrivateKey()
 	if err != nil {
@@ -87,6 +155,18 @@ func (w *hdWallet) GetNodePrivateKeyBytes() ([]byte, error) {
 
 }
 
+// Get the signer for the node account; this is the external signer if one is set, or the node private key otherwise
+func (w *hdWallet) getNodeSigner() (signer.Signer, error) {
+	if w.nodeSigner != nil {
+		return w.nodeSigner, nil
+	}
+	privateKey, _, err := w.getNodePrivateKey()
+	if err != nil {
+		return nil, err
+	}
+	return signer.NewLocalSigner(privateKey), nil
+}
+
 // Get the node private key
 func (w *hdWallet) getNodePrivateKey() (*ecdsa.PrivateKey, string, error) {
 
@@ -0,0 +1,141 @@
+package offline
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/rocket-pool/smartnode/bindings/utils/eth"
+)
+
+// A contract that transactions can be decoded against
+type KnownContract struct {
+	Name    string
+	Address *common.Address
+	ABI     *abi.ABI
+}
+
+// A human-readable description of a transaction
+type Summary struct {
+	Contract  string   `json:"contract"`
+	Method    string   `json:"method,omitempty"`
+	Arguments []string `json:"arguments,omitempty"`
+	Value     string   `json:"value"`
+}
+
+// Describe a transaction, decoding its calldata against the known contracts.
+// The contract it's sent to is used if it's known; otherwise the method is looked up by its selector in all of them,
+// which covers contracts like megapools that share a delegate's ABI.
+func Summarize(tx *types.Transaction, contracts []KnownContract) Summary {
+	summary := Summary{
+		Value: fmt.Sprintf("%.6f ETH", eth.WeiToEth(tx.Value())),
+	}
+
+	// Find the destination
+	var target *KnownContract
+	if tx.To() == nil {
+		summary.Contract = "(contract creation)"
+	} else {
+		summary.Contract = tx.To().Hex()
+		for i, contract := range contracts {
+			if contract.Address != nil && *contract.Address == *tx.To() {
+				target = &contracts[i]
+				summary.Contract = fmt.Sprintf("%s (%s)", contract.Name, tx.To().Hex())
+				break
+			}
+		}
+	}
+
+	// Decode the method
+	data := tx.Data()
+	if len(data) == 0 {
+		return summary
+	}
+	if len(data) < 4 {
+		summary.Method = fmt.Sprintf("(unknown, calldata %s)", hexutil.Encode(data))
+		return summary
+	}
+	candidates := contracts
+	if target != nil {
+		candidates = []KnownContract{*target}
+	}
+	for _, contract := range candidates {
+		if contract.ABI == nil {
+			continue
+		}
+		method, err := contract.ABI.MethodById(data[:4])
+		if err != nil {
+			continue
+		}
+		summary.Method = method.Sig
+		values, err := method.Inputs.Unpack(data[4:])
+		if err != nil {
+			summary.Arguments = []string{fmt.Sprintf("(could not decode arguments: %s)", err.Error())}
+			return summary
+		}
+		for i, value := range values {
+			summary.Arguments = append(summary.Arguments, fmt.Sprintf("%s = %s", method.Inputs[i].Name, formatArgument(value)))
+		}
+		return summary
+	}
+	summary.Method = fmt.Sprintf("(unknown selector %s)", hexutil.Encode(data[:4]))
+	return summary
+}
+
+// Get the summary as printable lines
+func (s Summary) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "\tTo:     %s\n", s.Contract)
+	fmt.Fprintf(&b, "\tValue:  %s\n", s.Value)
+	if s.Method != "" {
+		fmt.Fprintf(&b, "\tMethod: %s\n", s.Method)
+	}
+	for _, argument := range s.Arguments {
+		fmt.Fprintf(&b, "\t\t%s\n", argument)
+	}
+	return b.String()
+}
+
+// Format a decoded argument so byte arrays come out as hex instead of lists of numbers
+func formatArgument(value interface{}) string {
+	switch v := value.(type) {
+	case []byte:
+		return hexutil.Encode(v)
+	case *big.Int:
+		return v.String()
+	case common.Address:
+		return v.Hex()
+	case fmt.Stringer:
+		return v.String()
+	}
+
+	r := reflect.ValueOf(value)
+	switch r.Kind() {
+	case reflect.Array:
+		if r.Type().Elem().Kind() == reflect.Uint8 {
+			b := make([]byte, r.Len())
+			reflect.Copy(reflect.ValueOf(b), r)
+			return hexutil.Encode(b)
+		}
+		fallthrough
+	case reflect.Slice:
+		var b bytes.Buffer
+		b.WriteString("[")
+		for i := 0; i < r.Len(); i++ {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			b.WriteString(formatArgument(r.Index(i).Interface()))
+		}
+		b.WriteString("]")
+		return b.String()
+	}
+	return fmt.Sprintf("%v", value)
+}
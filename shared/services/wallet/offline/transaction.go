@@ -0,0 +1,198 @@
+package offline
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/goccy/go-json"
+)
+
+// Config
+const (
+	FileVersion int = 1
+	FileMode        = 0644
+)
+
+// Returned by the node account transactor after it captures a transaction for offline signing, so it's never submitted
+var ErrTransactionCaptured = errors.New("The transaction was saved for offline signing instead of being submitted.")
+
+// An unsigned EIP-1559 transaction for the node account, along with a human-readable summary of what it does
+type UnsignedTransaction struct {
+	ChainID              *hexutil.Big    `json:"chainId"`
+	From                 common.Address  `json:"from"`
+	To                   *common.Address `json:"to"`
+	Nonce                hexutil.Uint64  `json:"nonce"`
+	Gas                  hexutil.Uint64  `json:"gas"`
+	MaxFeePerGas         *hexutil.Big    `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *hexutil.Big    `json:"maxPriorityFeePerGas"`
+	Value                *hexutil.Big    `json:"value"`
+	Data                 hexutil.Bytes   `json:"data"`
+	Summary              Summary         `json:"summary"`
+}
+
+// A signed transaction that's ready to be broadcast
+type SignedTransaction struct {
+	Hash    common.Hash    `json:"hash"`
+	From    common.Address `json:"from"`
+	Nonce   hexutil.Uint64 `json:"nonce"`
+	Raw     hexutil.Bytes  `json:"raw"`
+	Summary Summary        `json:"summary"`
+}
+
+// A file of unsigned transactions, in the order they need to be submitted
+type UnsignedTransactionFile struct {
+	Version      int                   `json:"version"`
+	Transactions []UnsignedTransaction `json:"transactions"`
+}
+
+// A file of signed transactions, in the order they need to be submitted
+type SignedTransactionFile struct {
+	Version      int                 `json:"version"`
+	Transactions []SignedTransaction `json:"transactions"`
+}
+
+// Create an unsigned transaction from one built by a transactor
+func NewUnsignedTransaction(from common.Address, chainID *big.Int, tx *types.Transaction) (UnsignedTransaction, error) {
+	if tx.Type() != types.DynamicFeeTxType {
+		return UnsignedTransaction{}, fmt.Errorf("only EIP-1559 transactions can be signed offline, but this is a type %d transaction", tx.Type())
+	}
+	return UnsignedTransaction{
+		ChainID:              (*hexutil.Big)(new(big.Int).Set(chainID)),
+		From:                 from,
+		To:                   tx.To(),
+		Nonce:                hexutil.Uint64(tx.Nonce()),
+		Gas:                  hexutil.Uint64(tx.Gas()),
+		MaxFeePerGas:         (*hexutil.Big)(tx.GasFeeCap()),
+		MaxPriorityFeePerGas: (*hexutil.Big)(tx.GasTipCap()),
+		Value:                (*hexutil.Big)(tx.Value()),
+		Data:                 tx.Data(),
+	}, nil
+}
+
+// Build the EIP-1559 transaction that needs to be signed
+func (u UnsignedTransaction) ToTransaction() (*types.Transaction, error) {
+	if u.ChainID == nil || u.MaxFeePerGas == nil || u.MaxPriorityFeePerGas == nil {
+		return nil, errors.New("the transaction is missing its chain ID or fees")
+	}
+	value := big.NewInt(0)
+	if u.Value != nil {
+		value = u.Value.ToInt()
+	}
+	return types.NewTx(&types.DynamicFeeTx{
+		ChainID:    u.ChainID.ToInt(),
+		Nonce:      uint64(u.Nonce),
+		GasTipCap:  u.MaxPriorityFeePerGas.ToInt(),
+		GasFeeCap:  u.MaxFeePerGas.ToInt(),
+		Gas:        uint64(u.Gas),
+		To:         u.To,
+		Value:      value,
+		Data:       u.Data,
+		AccessList: types.AccessList{},
+	}), nil
+}
+
+// Check that a signed transaction is the unsigned one, signed by its sender, and package it for broadcasting
+func (u UnsignedTransaction) VerifySigned(raw []byte) (SignedTransaction, error) {
+	signedTx := new(types.Transaction)
+	if err := signedTx.UnmarshalBinary(raw); err != nil {
+		return SignedTransaction{}, fmt.Errorf("error decoding signed transaction: %w", err)
+	}
+	tx, err := u.ToTransaction()
+	if err != nil {
+		return SignedTransaction{}, err
+	}
+
+	// The signing hash only covers the transaction's fields, so it matches if nothing was changed
+	txSigner := types.LatestSignerForChainID(u.ChainID.ToInt())
+	if txSigner.Hash(signedTx) != txSigner.Hash(tx) {
+		return SignedTransaction{}, errors.New("the signed transaction doesn't match the unsigned transaction")
+	}
+	sender, err := types.Sender(txSigner, signedTx)
+	if err != nil {
+		return SignedTransaction{}, fmt.Errorf("error recovering the signer of the transaction: %w", err)
+	}
+	if sender != u.From {
+		return SignedTransaction{}, fmt.Errorf("the transaction was signed by %s instead of %s", sender.Hex(), u.From.Hex())
+	}
+
+	return SignedTransaction{
+		Hash:    signedTx.Hash(),
+		From:    sender,
+		Nonce:   u.Nonce,
+		Raw:     raw,
+		Summary: u.Summary,
+	}, nil
+}
+
+// Load a file of unsigned transactions
+func LoadUnsignedTransactions(path string) (UnsignedTransactionFile, error) {
+	file := UnsignedTransactionFile{}
+	if err := loadFile(path, &file); err != nil {
+		return file, err
+	}
+	if file.Version != FileVersion {
+		return file, fmt.Errorf("unsupported unsigned transaction file version %d", file.Version)
+	}
+	if len(file.Transactions) == 0 {
+		return file, fmt.Errorf("[%s] doesn't contain any transactions", path)
+	}
+	return file, nil
+}
+
+// Save a file of unsigned transactions
+func SaveUnsignedTransactions(path string, transactions []UnsignedTransaction) error {
+	return saveFile(path, UnsignedTransactionFile{
+		Version:      FileVersion,
+		Transactions: transactions,
+	})
+}
+
+// Load a file of signed transactions
+func LoadSignedTransactions(path string) (SignedTransactionFile, error) {
+	file := SignedTransactionFile{}
+	if err := loadFile(path, &file); err != nil {
+		return file, err
+	}
+	if file.Version != FileVersion {
+		return file, fmt.Errorf("unsupported signed transaction file version %d", file.Version)
+	}
+	if len(file.Transactions) == 0 {
+		return file, fmt.Errorf("[%s] doesn't contain any transactions", path)
+	}
+	return file, nil
+}
+
+// Save a file of signed transactions
+func SaveSignedTransactions(path string, transactions []SignedTransaction) error {
+	return saveFile(path, SignedTransactionFile{
+		Version:      FileVersion,
+		Transactions: transactions,
+	})
+}
+
+func loadFile(path string, file interface{}) error {
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("error reading [%s]: %w", path, err)
+	}
+	if err := json.Unmarshal(bytes, file); err != nil {
+		return fmt.Errorf("error decoding [%s]: %w", path, err)
+	}
+	return nil
+}
+
+func saveFile(path string, file interface{}) error {
+	bytes, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error encoding transaction file: %w", err)
+	}
+	if err := os.WriteFile(path, bytes, FileMode); err != nil {
+		return fmt.Errorf("error writing [%s]: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package offline
+
+import (
+	"math/big"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const testAbi = `[{"type":"function","name":"stakeRPL","inputs":[{"name":"_amount","type":"uint256"}],"outputs":[]}]`
+
+var testChainID = big.NewInt(17000)
+
+func newTestTransaction(t *testing.T, to common.Address) *types.Transaction {
+	parsed, err := abi.JSON(strings.NewReader(testAbi))
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := parsed.Pack("stakeRPL", big.NewInt(1000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return types.NewTx(&types.DynamicFeeTx{
+		Nonce:     3,
+		GasTipCap: big.NewInt(1e9),
+		GasFeeCap: big.NewInt(30e9),
+		Gas:       200000,
+		To:        &to,
+		Value:     big.NewInt(0),
+		Data:      data,
+	})
+}
+
+func TestOfflineSigning(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	to := common.HexToAddress("0xd4E96eF8eee8678dBFf4d535E033Ed1a4F7605b7")
+
+	// Capture a transaction the way a transactor builds it, before it has a chain ID, and decode it
+	parsed, err := abi.JSON(strings.NewReader(testAbi))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx := newTestTransaction(t, to)
+	unsignedTx, err := NewUnsignedTransaction(from, testChainID, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	unsignedTx.Summary = Summarize(tx, []KnownContract{{Name: "rocketNodeStaking", Address: &to, ABI: &parsed}})
+	if unsignedTx.Summary.Method != "stakeRPL(uint256)" || len(unsignedTx.Summary.Arguments) != 1 || unsignedTx.Summary.Arguments[0] != "_amount = 1000" {
+		t.Fatalf("unexpected summary: %+v", unsignedTx.Summary)
+	}
+	if !strings.HasPrefix(unsignedTx.Summary.Contract, "rocketNodeStaking") {
+		t.Fatalf("expected the contract to be recognized, got %s", unsignedTx.Summary.Contract)
+	}
+
+	// It survives a round trip through a file
+	unsignedPath := filepath.Join(t.TempDir(), "unsigned.json")
+	if err := SaveUnsignedTransactions(unsignedPath, []UnsignedTransaction{unsignedTx}); err != nil {
+		t.Fatal(err)
+	}
+	unsignedFile, err := LoadUnsignedTransactions(unsignedPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	loadedTx := unsignedFile.Transactions[0]
+
+	// Sign it offline
+	txToSign, err := loadedTx.ToTransaction()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if txToSign.ChainId().Cmp(testChainID) != 0 || txToSign.Nonce() != tx.Nonce() || string(txToSign.Data()) != string(tx.Data()) {
+		t.Fatalf("the loaded transaction doesn't match the original: %+v", txToSign)
+	}
+	signedTx, err := types.SignTx(txToSign, types.LatestSignerForChainID(testChainID), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, err := signedTx.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	signed, err := loadedTx.VerifySigned(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if signed.Hash != signedTx.Hash() || signed.From != from {
+		t.Fatalf("unexpected signed transaction: %+v", signed)
+	}
+
+	// It's rejected if it was signed by a different key, or if it was changed before signing
+	otherKey, _ := crypto.GenerateKey()
+	wrongTx, _ := types.SignTx(txToSign, types.LatestSignerForChainID(testChainID), otherKey)
+	wrongRaw, _ := wrongTx.MarshalBinary()
+	if _, err := loadedTx.VerifySigned(wrongRaw); err == nil {
+		t.Fatal("expected an error for a transaction signed by the wrong key")
+	}
+	changedTx := loadedTx
+	changedTx.Nonce++
+	if _, err := changedTx.VerifySigned(raw); err == nil {
+		t.Fatal("expected an error for a transaction that doesn't match")
+	}
+}
+
+func TestSummarizeUnknownContract(t *testing.T) {
+	parsed, err := abi.JSON(strings.NewReader(testAbi))
+	if err != nil {
+		t.Fatal(err)
+	}
+	delegate := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	to := common.HexToAddress("0x2222222222222222222222222222222222222222")
+
+	// Contracts that aren't known by address are decoded by selector
+	summary := Summarize(newTestTransaction(t, to), []KnownContract{{Name: "rocketMegapoolDelegate", Address: &delegate, ABI: &parsed}})
+	if summary.Contract != to.Hex() || summary.Method != "stakeRPL(uint256)" {
+		t.Fatalf("unexpected summary: %+v", summary)
+	}
+
+	// Unknown selectors are reported as such
+	summary = Summarize(newTestTransaction(t, to), nil)
+	if !strings.HasPrefix(summary.Method, "(unknown selector") {
+		t.Fatalf("unexpected summary: %+v", summary)
+	}
+}
@@ -0,0 +1,51 @@
+package signer
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// A signer that holds the node account's private key in memory
+type LocalSigner struct {
+	key *ecdsa.PrivateKey
+}
+
+// Create a new local signer
+func NewLocalSigner(key *ecdsa.PrivateKey) *LocalSigner {
+	return &LocalSigner{
+		key: key,
+	}
+}
+
+// Get the address of the signer's key
+func (s *LocalSigner) GetAddress() (common.Address, error) {
+	return crypto.PubkeyToAddress(s.key.PublicKey), nil
+}
+
+// Sign a transaction with the signer's key
+func (s *LocalSigner) SignTx(tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	signedTx, err := types.SignTx(tx, types.LatestSignerForChainID(chainID), s.key)
+	if err != nil {
+		return nil, fmt.Errorf("Error signing TX: %w", err)
+	}
+	return signedTx, nil
+}
+
+// Sign a message with the signer's key
+func (s *LocalSigner) SignMessage(message []byte) ([]byte, error) {
+	messageHash := accounts.TextHash(message)
+	signedMessage, err := crypto.Sign(messageHash, s.key)
+	if err != nil {
+		return nil, fmt.Errorf("Error signing message: %w", err)
+	}
+
+	// fix the ECDSA 'v' (see https://medium.com/mycrypto/the-magic-of-digital-signatures-on-ethereum-98fe184dc9c7#:~:text=The%20version%20number,2%E2%80%9D%20was%20introduced)
+	signedMessage[crypto.RecoveryIDOffset] += 27
+	return signedMessage, nil
+}
@@ -0,0 +1,154 @@
+package signer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// How long to wait for an external signer; signers may ask a human to approve each request, so this is generous
+const requestTimeout = 2 * time.Minute
+
+// The JSON-RPC methods a remote signer backend uses
+type remoteMethods struct {
+	listAccounts    string
+	signTransaction string
+	signMessage     func(address common.Address, message []byte) (string, []interface{})
+}
+
+// A signer that keeps the node account's key outside of the Smartnode and asks for signatures over JSON-RPC
+type RemoteSigner struct {
+	client  *rpc.Client
+	methods remoteMethods
+	address common.Address
+	lock    sync.Mutex
+}
+
+// Create a signer for a Clef-style external signer, using its account_* API.
+// If the address is empty, the signer's first account is used.
+func NewClefSigner(url string, authToken string, address common.Address) (*RemoteSigner, error) {
+	return newRemoteSigner(url, authToken, address, remoteMethods{
+		listAccounts:    "account_list",
+		signTransaction: "account_signTransaction",
+		signMessage: func(address common.Address, message []byte) (string, []interface{}) {
+			return "account_signData", []interface{}{"text/plain", address, hexutil.Bytes(message)}
+		},
+	})
+}
+
+// Create a signer for an EIP-1193-style remote signer, using the standard eth_accounts, eth_signTransaction, and personal_sign methods.
+// If the address is empty, the signer's first account is used.
+func NewEip1193Signer(url string, authToken string, address common.Address) (*RemoteSigner, error) {
+	return newRemoteSigner(url, authToken, address, remoteMethods{
+		listAccounts:    "eth_accounts",
+		signTransaction: "eth_signTransaction",
+		signMessage: func(address common.Address, message []byte) (string, []interface{}) {
+			return "personal_sign", []interface{}{hexutil.Bytes(message), address}
+		},
+	})
+}
+
+func newRemoteSigner(url string, authToken string, address common.Address, methods remoteMethods) (*RemoteSigner, error) {
+	options := []rpc.ClientOption{}
+	if authToken != "" {
+		options = append(options, rpc.WithHeader("Authorization", "Bearer "+authToken))
+	}
+	client, err := rpc.DialOptions(context.Background(), url, options...)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to the node signer at %s: %w", url, err)
+	}
+	return &RemoteSigner{
+		client:  client,
+		methods: methods,
+		address: address,
+	}, nil
+}
+
+// Get the address of the account the signer signs for, asking the signer for its accounts if one wasn't provided
+func (s *RemoteSigner) GetAddress() (common.Address, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.address != (common.Address{}) {
+		return s.address, nil
+	}
+
+	var accounts []common.Address
+	if err := s.call(&accounts, s.methods.listAccounts); err != nil {
+		return common.Address{}, fmt.Errorf("error getting the node signer's accounts: %w", err)
+	}
+	if len(accounts) == 0 {
+		return common.Address{}, errors.New("the node signer doesn't have any accounts")
+	}
+	s.address = accounts[0]
+	return s.address, nil
+}
+
+// Have the signer sign a transaction
+func (s *RemoteSigner) SignTx(tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	from, err := s.GetAddress()
+	if err != nil {
+		return nil, err
+	}
+
+	// Signers either return the raw transaction or an object with it, like geth's {raw, tx}
+	var result json.RawMessage
+	if err := s.call(&result, s.methods.signTransaction, newTxArgs(from, tx, chainID)); err != nil {
+		return nil, fmt.Errorf("Error signing TX: %w", err)
+	}
+	var raw hexutil.Bytes
+	if err := json.Unmarshal(result, &raw); err != nil {
+		var signed struct {
+			Raw hexutil.Bytes `json:"raw"`
+		}
+		if err := json.Unmarshal(result, &signed); err != nil || len(signed.Raw) == 0 {
+			return nil, fmt.Errorf("the node signer returned an unexpected response: %s", string(result))
+		}
+		raw = signed.Raw
+	}
+
+	signedTx := new(types.Transaction)
+	if err := signedTx.UnmarshalBinary(raw); err != nil {
+		return nil, fmt.Errorf("Error unmarshalling signed TX: %w", err)
+	}
+	if err := verifySignedTx(from, tx, signedTx, chainID); err != nil {
+		return nil, err
+	}
+	return signedTx, nil
+}
+
+// Have the signer sign a message
+func (s *RemoteSigner) SignMessage(message []byte) ([]byte, error) {
+	from, err := s.GetAddress()
+	if err != nil {
+		return nil, err
+	}
+
+	method, args := s.methods.signMessage(from, message)
+	var signature hexutil.Bytes
+	if err := s.call(&signature, method, args...); err != nil {
+		return nil, fmt.Errorf("Error signing message: %w", err)
+	}
+	return normalizeSignature(signature)
+}
+
+// Close the connection to the signer
+func (s *RemoteSigner) Close() {
+	s.client.Close()
+}
+
+// Call a method on the signer
+func (s *RemoteSigner) call(result interface{}, method string, args ...interface{}) error {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	return s.client.CallContext(ctx, result, method, args...)
+}
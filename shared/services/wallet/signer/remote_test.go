@@ -0,0 +1,225 @@
+package signer
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const testAuthToken = "test-token"
+
+var testChainID = big.NewInt(17000)
+
+// A fake external signer that serves both the Clef and EIP-1193 APIs with a local key
+type fakeSigner struct {
+	key *ecdsa.PrivateKey
+
+	// The key the signer actually signs with, to simulate a misbehaving signer
+	signingKey *ecdsa.PrivateKey
+}
+
+func (s *fakeSigner) address() common.Address {
+	return crypto.PubkeyToAddress(s.key.PublicKey)
+}
+
+func (s *fakeSigner) signTx(args txArgs) (hexutil.Bytes, error) {
+	tx := types.NewTx(&types.DynamicFeeTx{
+		ChainID:   (*big.Int)(args.ChainID),
+		Nonce:     uint64(args.Nonce),
+		GasTipCap: (*big.Int)(args.MaxPriorityFeePerGas),
+		GasFeeCap: (*big.Int)(args.MaxFeePerGas),
+		Gas:       uint64(args.Gas),
+		To:        args.To,
+		Value:     (*big.Int)(args.Value),
+		Data:      args.Data,
+	})
+	signedTx, err := types.SignTx(tx, types.LatestSignerForChainID((*big.Int)(args.ChainID)), s.signingKey)
+	if err != nil {
+		return nil, err
+	}
+	return signedTx.MarshalBinary()
+}
+
+func (s *fakeSigner) signText(data []byte) (hexutil.Bytes, error) {
+	// Return a 'v' of 0 or 1 like some signers do
+	return crypto.Sign(accounts.TextHash(data), s.signingKey)
+}
+
+// The Clef account_* API
+type fakeAccountApi struct{ s *fakeSigner }
+
+func (a *fakeAccountApi) List() []common.Address {
+	return []common.Address{a.s.address()}
+}
+
+func (a *fakeAccountApi) SignTransaction(args txArgs) (map[string]interface{}, error) {
+	raw, err := a.s.signTx(args)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"raw": raw}, nil
+}
+
+func (a *fakeAccountApi) SignData(contentType string, address common.Address, data hexutil.Bytes) (hexutil.Bytes, error) {
+	return a.s.signText(data)
+}
+
+// The EIP-1193 eth_* and personal_* APIs
+type fakeEthApi struct{ s *fakeSigner }
+
+func (a *fakeEthApi) Accounts() []common.Address {
+	return []common.Address{a.s.address()}
+}
+
+func (a *fakeEthApi) SignTransaction(args txArgs) (hexutil.Bytes, error) {
+	return a.s.signTx(args)
+}
+
+type fakePersonalApi struct{ s *fakeSigner }
+
+func (a *fakePersonalApi) Sign(data hexutil.Bytes, address common.Address) (hexutil.Bytes, error) {
+	return a.s.signText(data)
+}
+
+func newFakeSigner(t *testing.T) (*fakeSigner, string) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &fakeSigner{key: key, signingKey: key}
+
+	server := rpc.NewServer()
+	for namespace, service := range map[string]interface{}{
+		"account":  &fakeAccountApi{s},
+		"eth":      &fakeEthApi{s},
+		"personal": &fakePersonalApi{s},
+	} {
+		if err := server.RegisterName(namespace, service); err != nil {
+			t.Fatal(err)
+		}
+	}
+	httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+testAuthToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		server.ServeHTTP(w, r)
+	}))
+	t.Cleanup(func() {
+		httpServer.Close()
+		server.Stop()
+	})
+	return s, httpServer.URL
+}
+
+func newTestTx() *types.Transaction {
+	to := common.HexToAddress("0xd4E96eF8eee8678dBFf4d535E033Ed1a4F7605b7")
+	return types.NewTx(&types.DynamicFeeTx{
+		ChainID:   testChainID,
+		Nonce:     5,
+		GasTipCap: big.NewInt(1e9),
+		GasFeeCap: big.NewInt(30e9),
+		Gas:       100000,
+		To:        &to,
+		Value:     big.NewInt(1e18),
+		Data:      []byte{0x12, 0x34},
+	})
+}
+
+func TestRemoteSigners(t *testing.T) {
+	for name, newSigner := range map[string]func(string, string, common.Address) (*RemoteSigner, error){
+		"clef":    NewClefSigner,
+		"eip1193": NewEip1193Signer,
+	} {
+		t.Run(name, func(t *testing.T) {
+			fake, url := newFakeSigner(t)
+			s, err := newSigner(url, testAuthToken, common.Address{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer s.Close()
+
+			// The signer's first account is used
+			address, err := s.GetAddress()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if address != fake.address() {
+				t.Fatalf("expected address %s, got %s", fake.address().Hex(), address.Hex())
+			}
+
+			// Transactions are signed by the node account
+			tx := newTestTx()
+			signedTx, err := s.SignTx(tx, testChainID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			sender, err := types.Sender(types.LatestSignerForChainID(testChainID), signedTx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if sender != fake.address() || signedTx.Hash() == tx.Hash() || signedTx.Nonce() != tx.Nonce() {
+				t.Fatalf("unexpected signed transaction from %s: %+v", sender.Hex(), signedTx)
+			}
+
+			// Message signatures are normalized and recover to the node account
+			message := []byte("hello rocket pool")
+			signature, err := s.SignMessage(message)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if signature[64] != 27 && signature[64] != 28 {
+				t.Fatalf("expected a 'v' of 27 or 28, got %d", signature[64])
+			}
+			recoverable := append([]byte{}, signature...)
+			recoverable[64] -= 27
+			pubkey, err := crypto.SigToPub(accounts.TextHash(message), recoverable)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if crypto.PubkeyToAddress(*pubkey) != fake.address() {
+				t.Fatalf("message signature recovered to %s instead of %s", crypto.PubkeyToAddress(*pubkey).Hex(), fake.address().Hex())
+			}
+
+			// A signer that signs with a different account is caught
+			fake.signingKey, _ = crypto.GenerateKey()
+			if _, err := s.SignTx(tx, testChainID); err == nil {
+				t.Fatal("expected an error for a transaction signed by the wrong account")
+			}
+		})
+	}
+}
+
+func TestRemoteSignerAuth(t *testing.T) {
+	fake, url := newFakeSigner(t)
+
+	// A bad token is rejected
+	s, err := NewEip1193Signer(url, "wrong", common.Address{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	if _, err := s.GetAddress(); err == nil {
+		t.Fatal("expected an error for a bad auth token")
+	}
+
+	// An explicit address is used as-is, even if the signer doesn't hold it
+	other := common.HexToAddress("0xd4E96eF8eee8678dBFf4d535E033Ed1a4F7605b7")
+	s, err = NewEip1193Signer(url, testAuthToken, other)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	if _, err := s.SignTx(newTestTx(), testChainID); err == nil {
+		t.Fatalf("expected an error signing for %s with a signer that holds %s", other.Hex(), fake.address().Hex())
+	}
+}
@@ -0,0 +1,88 @@
+package signer
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// A signer for the node account
+type Signer interface {
+	// Get the address of the account the signer signs for
+	GetAddress() (common.Address, error)
+
+	// Sign a transaction for the given chain
+	SignTx(tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+
+	// Sign a message with the EIP-191 personal message prefix, returning a signature with a 'v' of 27 or 28
+	SignMessage(message []byte) ([]byte, error)
+}
+
+// The transaction arguments external signers expect, in the same format as eth_sendTransaction
+type txArgs struct {
+	From                 common.Address    `json:"from"`
+	To                   *common.Address   `json:"to,omitempty"`
+	Gas                  hexutil.Uint64    `json:"gas"`
+	GasPrice             *hexutil.Big      `json:"gasPrice,omitempty"`
+	MaxFeePerGas         *hexutil.Big      `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas *hexutil.Big      `json:"maxPriorityFeePerGas,omitempty"`
+	Value                *hexutil.Big      `json:"value"`
+	Nonce                hexutil.Uint64    `json:"nonce"`
+	Data                 hexutil.Bytes     `json:"data"`
+	AccessList           *types.AccessList `json:"accessList,omitempty"`
+	ChainID              *hexutil.Big      `json:"chainId"`
+}
+
+// Convert a transaction into the arguments for an external signer
+func newTxArgs(from common.Address, tx *types.Transaction, chainID *big.Int) txArgs {
+	args := txArgs{
+		From:    from,
+		To:      tx.To(),
+		Gas:     hexutil.Uint64(tx.Gas()),
+		Value:   (*hexutil.Big)(tx.Value()),
+		Nonce:   hexutil.Uint64(tx.Nonce()),
+		Data:    tx.Data(),
+		ChainID: (*hexutil.Big)(chainID),
+	}
+	switch tx.Type() {
+	case types.LegacyTxType:
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+	default:
+		args.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+		accessList := tx.AccessList()
+		args.AccessList = &accessList
+	}
+	return args
+}
+
+// Make sure an external signer signed the transaction it was asked to, with the account it was asked to
+func verifySignedTx(from common.Address, tx *types.Transaction, signedTx *types.Transaction, chainID *big.Int) error {
+	txSigner := types.LatestSignerForChainID(chainID)
+	if txSigner.Hash(tx) != txSigner.Hash(signedTx) {
+		return errors.New("the signer returned a different transaction than the one it was asked to sign")
+	}
+	sender, err := types.Sender(txSigner, signedTx)
+	if err != nil {
+		return fmt.Errorf("error recovering the signed transaction's sender: %w", err)
+	}
+	if sender != from {
+		return fmt.Errorf("the signer signed the transaction with %s instead of %s", sender.Hex(), from.Hex())
+	}
+	return nil
+}
+
+// Normalize a message signature's 'v' to 27 or 28, since some signers return 0 or 1
+func normalizeSignature(signature []byte) ([]byte, error) {
+	if len(signature) != 65 {
+		return nil, fmt.Errorf("the signer returned a signature of %d bytes instead of 65", len(signature))
+	}
+	if signature[64] < 27 {
+		signature[64] += 27
+	}
+	return signature, nil
+}
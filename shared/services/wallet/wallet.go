@@ -13,7 +13,6 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/goccy/go-json"
 	"github.com/google/uuid"
 	"github.com/tyler-smith/go-bip39"
@@ -23,6 +22,7 @@ import (
 	rptypes "github.com/rocket-pool/smartnode/bindings/types"
 	"github.com/rocket-pool/smartnode/shared/services/passwords"
 	"github.com/rocket-pool/smartnode/shared/services/wallet/keystore"
+	"github.com/rocket-pool/smartnode/shared/services/wallet/signer"
 )
 
 // Config
@@ -34,6 +34,9 @@ const (
 	MyEtherWalletNodeKeyPath = "m/44'/60'/0'/%d"
 )
 
+// Receives an unsigned transaction from the node account transactor, which then aborts it with offline.ErrTransactionCaptured
+type TransactionCapture func(from common.Address, tx *types.Transaction) error
+
 type Wallet interface {
 	AddKeystore(name string, ks keystore.Keystore)
 	CreateValidatorKey() (*eth2types.BLSPrivateKey, error)
@@ -57,6 +60,8 @@ type Wallet interface {
 	Reload() error
 	Save() error
 	SaveValidatorKey(key ValidatorKey) error
+	SetNodeSigner(nodeSigner signer.Signer)
+	SetTransactionCapture(capture TransactionCapture)
 	Sign(serializedTx []byte) ([]byte, error)
 	SignMessage(message string) ([]byte, error)
 	StoreValidatorKey(key *eth2types.BLSPrivateKey, path string) error
@@ -90,6 +95,12 @@ type hdWallet struct {
 	nodeKey     *ecdsa.PrivateKey
 	nodeKeyPath string
 
+	// External node account signer
+	nodeSigner signer.Signer
+
+	// Receives node account transactions instead of them being signed, for offline signing
+	txCapture TransactionCapture
+
 	// Validator key caches
 	validatorKeys map[uint]*eth2types.BLSPrivateKey
 
@@ -385,10 +396,10 @@ func (w *hdWallet) Delete() error {
 
 }
 
-// Signs a serialized TX using the wallet's private key
+// Signs a serialized TX using the node account's signer
 func (w *hdWallet) Sign(serializedTx []byte) ([]byte, error) {
-	// Get private key
-	privateKey, _, err := w.getNodePrivateKey()
+	// Get signer
+	nodeSigner, err := w.getNodeSigner()
 	if err != nil {
 		return nil, err
 	}
@@ -399,10 +410,9 @@ func (w *hdWallet) Sign(serializedTx []byte) ([]byte, error) {
 		return nil, fmt.Errorf("Error unmarshalling TX: %w", err)
 	}
 
-	signer := types.NewLondonSigner(w.chainID)
-	signedTx, err := types.SignTx(&tx, signer, privateKey)
+	signedTx, err := nodeSigner.SignTx(&tx, w.chainID)
 	if err != nil {
-		return nil, fmt.Errorf("Error signing TX: %w", err)
+		return nil, err
 	}
 
 	signedData, err := signedTx.MarshalBinary()
@@ -413,23 +423,26 @@ func (w *hdWallet) Sign(serializedTx []byte) ([]byte, error) {
 	return signedData, nil
 }
 
-// Signs an arbitrary message using the wallet's private key
+// Signs an arbitrary message using the node account's signer
 func (w *hdWallet) SignMessage(message string) ([]byte, error) {
-	// Get the wallet's private key
-	privateKey, _, err := w.getNodePrivateKey()
+	// Get signer
+	nodeSigner, err := w.getNodeSigner()
 	if err != nil {
 		return nil, err
 	}
 
-	messageHash := accounts.TextHash([]byte(message))
-	signedMessage, err := crypto.Sign(messageHash, privateKey)
-	if err != nil {
-		return nil, fmt.Errorf("Error signing message: %w", err)
-	}
+	return nodeSigner.SignMessage([]byte(message))
+}
 
-	// fix the ECDSA 'v' (see https://medium.com/mycrypto/the-magic-of-digital-signatures-on-ethereum-98fe184dc9c7#:~:text=The%20version%20number,2%E2%80%9D%20was%20introduced)
-	signedMessage[crypto.RecoveryIDOffset] += 27
-	return signedMessage, nil
+// Use an external signer for the node account instead of the key derived from the wallet's mnemonic.
+// Validator keys are still derived from the mnemonic.
+func (w *hdWallet) SetNodeSigner(nodeSigner signer.Signer) {
+	w.nodeSigner = nodeSigner
+}
+
+// Capture node account transactions for offline signing instead of signing them
+func (w *hdWallet) SetTransactionCapture(capture TransactionCapture) {
+	w.txCapture = capture
 }
 
 // Reloads wallet from disk
@@ -438,6 +451,31 @@ func (w *hdWallet) Reload() error {
 	return err
 }
 
+// Check that a password can decrypt the wallet stored on disk; any password is accepted if there isn't a wallet yet
+func CheckPassword(walletPath string, password string) error {
+
+	// Read wallet store from disk
+	wsBytes, err := os.ReadFile(walletPath)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("Could not read wallet from disk: %w", err)
+	}
+
+	// Decode wallet store
+	ws := new(walletStore)
+	if err = json.Unmarshal(wsBytes, ws); err != nil {
+		return fmt.Errorf("Could not decode wallet: %w", err)
+	}
+
+	// Decrypt seed
+	if _, err := eth2ks.New().Decrypt(ws.Crypto, password); err != nil {
+		return errors.New("The password is incorrect")
+	}
+	return nil
+
+}
+
 // Load the wallet store from disk and decrypt it
 func (w *hdWallet) loadStore() (bool, error) {
 
@@ -460,6 +498,10 @@ func (w *hdWallet) loadStore() (bool, error) {
 
 	// Get wallet password
 	password, err := w.pm.GetPassword()
+	if errors.Is(err, passwords.ErrWalletLocked) {
+		// The wallet can't be loaded until it's unlocked
+		return false, nil
+	}
 	if err != nil {
 		return false, fmt.Errorf("Could not get wallet password: %w", err)
 	}
@@ -0,0 +1,15 @@
+package api
+
+// A call to one of the API commands through the API server.
+// The command can be given in the URL path (e.g. /v1/api/node/status) with its arguments here, or entirely in Args.
+type ApiServerRequest struct {
+	Args            []string `json:"args"`
+	MaxFee          float64  `json:"maxFee,omitempty"`
+	MaxPrioFee      float64  `json:"maxPrioFee,omitempty"`
+	GasLimit        uint64   `json:"gasLimit,omitempty"`
+	Nonce           string   `json:"nonce,omitempty"`
+	IgnoreSyncCheck bool     `json:"ignoreSyncCheck,omitempty"`
+	ForceFallbacks  bool     `json:"forceFallbacks,omitempty"`
+	UseProtectedApi bool     `json:"useProtectedApi,omitempty"`
+	Unsigned        bool     `json:"unsigned,omitempty"`
+}
@@ -1,6 +1,16 @@
 package api
 
+import "github.com/rocket-pool/smartnode/shared/services/wallet/offline"
+
+// The field that transactions captured for offline signing are added to in a command's response
+const UnsignedTransactionsField string = "unsignedTxs"
+
 type APIResponse struct {
 	Status string `json:"status"`
 	Error  string `json:"error"`
 }
+
+// The transactions a command prepared for offline signing instead of submitting them
+type UnsignedTransactionsResponse struct {
+	UnsignedTxs []offline.UnsignedTransaction `json:"unsignedTxs"`
+}
@@ -0,0 +1,18 @@
+package api
+
+import (
+	"github.com/rocket-pool/smartnode/shared/services/store"
+)
+
+type DaemonStateResponse struct {
+	Status  string             `json:"status"`
+	Error   string             `json:"error"`
+	Path    string             `json:"path"`
+	Records []store.DutyRecord `json:"records"`
+}
+
+type ClearDaemonStateResponse struct {
+	Status  string `json:"status"`
+	Error   string `json:"error"`
+	Deleted int    `json:"deleted"`
+}
@@ -0,0 +1,15 @@
+package api
+
+import (
+	"github.com/rocket-pool/smartnode/shared/services/state"
+)
+
+type DebugStateResponse struct {
+	Status       string              `json:"status"`
+	Error        string              `json:"error"`
+	Path         string              `json:"path"`
+	Snapshots    []uint64            `json:"snapshots"`
+	Slot         uint64              `json:"slot"`
+	FromSnapshot bool                `json:"fromSnapshot"`
+	State        *state.NetworkState `json:"state,omitempty"`
+}
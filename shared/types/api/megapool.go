@@ -11,6 +11,7 @@ import (
 	"github.com/rocket-pool/smartnode/bindings/tokens"
 	"github.com/rocket-pool/smartnode/bindings/types"
 	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/state"
 )
 
 type MegapoolStatusResponse struct {
@@ -51,27 +52,28 @@ type MegapoolDetails struct {
 }
 
 type MegapoolValidatorDetails struct {
-	ValidatorId        uint32                 `json:"validatorId"`
-	PubKey             types.ValidatorPubkey  `json:"pubKey"`
-	LastAssignmentTime time.Time              `json:"lastAssignmentTime"`
-	LastRequestedValue uint32                 `json:"lastRequestedValue"`
-	LastRequestedBond  uint32                 `json:"lastRequestedBond"`
-	DepositValue       uint32                 `json:"DepositValue"`
-	Staked             bool                   `json:"staked"`
-	Exited             bool                   `json:"exited"`
-	InQueue            bool                   `json:"inQueue"`
-	QueuePosition      *big.Int               `json:"queuePosition"`
-	InPrestake         bool                   `json:"inPrestake"`
-	ExpressUsed        bool                   `json:"expressUsed"`
-	Dissolved          bool                   `json:"dissolved"`
-	Exiting            bool                   `json:"exiting"`
-	Locked             bool                   `json:"locked"`
-	ValidatorIndex     uint64                 `json:"validatorIndex"`
-	ExitBalance        uint64                 `json:"exitBalance"`
-	WithdrawableEpoch  uint64                 `json:"withdrawableEpoch"`
-	LockedSlot         uint64                 `json:"lockedSlot"`
-	Activated          bool                   `json:"activated"`
-	BeaconStatus       beacon.ValidatorStatus `json:"beaconStatus"`
+	ValidatorId        uint32                  `json:"validatorId"`
+	PubKey             types.ValidatorPubkey   `json:"pubKey"`
+	LastAssignmentTime time.Time               `json:"lastAssignmentTime"`
+	LastRequestedValue uint32                  `json:"lastRequestedValue"`
+	LastRequestedBond  uint32                  `json:"lastRequestedBond"`
+	DepositValue       uint32                  `json:"DepositValue"`
+	Staked             bool                    `json:"staked"`
+	Exited             bool                    `json:"exited"`
+	InQueue            bool                    `json:"inQueue"`
+	QueuePosition      *big.Int                `json:"queuePosition"`
+	InPrestake         bool                    `json:"inPrestake"`
+	ExpressUsed        bool                    `json:"expressUsed"`
+	Dissolved          bool                    `json:"dissolved"`
+	Exiting            bool                    `json:"exiting"`
+	Locked             bool                    `json:"locked"`
+	ValidatorIndex     uint64                  `json:"validatorIndex"`
+	ExitBalance        uint64                  `json:"exitBalance"`
+	WithdrawableEpoch  uint64                  `json:"withdrawableEpoch"`
+	LockedSlot         uint64                  `json:"lockedSlot"`
+	Activated          bool                    `json:"activated"`
+	BeaconStatus       beacon.ValidatorStatus  `json:"beaconStatus"`
+	Deadline           *state.MegapoolDeadline `json:"deadline,omitempty"`
 }
 
 type MegapoolValidatorMapAndRewardsResponse struct {
@@ -113,8 +113,9 @@ type ChangeWithdrawalCredentialsResponse struct {
 }
 
 type ImportKeyResponse struct {
-	Status string `json:"status"`
-	Error  string `json:"error"`
+	Status    string `json:"status"`
+	Error     string `json:"error"`
+	KeyLoaded bool   `json:"keyLoaded"`
 }
 
 type CanProcessWithdrawalResponse struct {
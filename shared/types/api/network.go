@@ -22,6 +22,20 @@ type RplPriceResponse struct {
 	RplPriceBlock uint64   `json:"rplPriceBlock"`
 }
 
+type GasSuggestion struct {
+	MaxFeeWei         *big.Int `json:"maxFeeWei"`
+	MaxPriorityFeeWei *big.Int `json:"maxPriorityFeeWei"`
+}
+
+type GasSuggestionsResponse struct {
+	Status     string        `json:"status"`
+	Error      string        `json:"error"`
+	BaseFeeWei *big.Int      `json:"baseFeeWei"`
+	Slow       GasSuggestion `json:"slow"`
+	Standard   GasSuggestion `json:"standard"`
+	Fast       GasSuggestion `json:"fast"`
+}
+
 type NetworkStatsResponse struct {
 	Status                    string         `json:"status"`
 	Error                     string         `json:"error"`
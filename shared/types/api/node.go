@@ -10,6 +10,7 @@ import (
 	"github.com/rocket-pool/smartnode/bindings/rocketpool"
 	"github.com/rocket-pool/smartnode/bindings/tokens"
 	rptypes "github.com/rocket-pool/smartnode/bindings/types"
+	"github.com/rocket-pool/smartnode/shared/services/gas/policy"
 	"github.com/rocket-pool/smartnode/shared/services/rewards"
 	"github.com/rocket-pool/smartnode/shared/utils/rp"
 )
@@ -98,6 +99,13 @@ type NodeStatusResponse struct {
 	LatestBlockTime              time.Time         `json:"latestBlockTime"`
 	UnclaimedRewards             *big.Int          `json:"unclaimedRewards"`
 	ReducedBond                  *big.Int          `json:"reducedBond"`
+	GasSpending                  NodeGasSpending   `json:"gasSpending"`
+}
+
+// The gas spent by the node's automatic transactions this month
+type NodeGasSpending struct {
+	policy.MonthlySpending
+	BudgetWei *big.Int `json:"budgetWei"`
 }
 
 type NodeAlert struct {
@@ -573,6 +581,12 @@ type NodeSignResponse struct {
 	SignedData string `json:"signedData"`
 }
 
+type NodeBroadcastTransactionResponse struct {
+	Status string      `json:"status"`
+	Error  string      `json:"error"`
+	TxHash common.Hash `json:"txHash"`
+}
+
 type NodeIsFeeDistributorInitializedResponse struct {
 	Status        string `json:"status"`
 	Error         string `json:"error"`
@@ -8,6 +8,8 @@ import (
 	"github.com/rocket-pool/smartnode/bindings/dao/protocol"
 	"github.com/rocket-pool/smartnode/bindings/rocketpool"
 	"github.com/rocket-pool/smartnode/bindings/types"
+
+	"github.com/rocket-pool/smartnode/shared/services/governance"
 )
 
 type PDAOProposalWithNodeVoteDirection struct {
@@ -394,6 +396,51 @@ type PDAOFinalizeProposalResponse struct {
 	TxHash common.Hash `json:"txHash"`
 }
 
+type PDAOTreeNodeMismatch struct {
+	Index          uint64               `json:"index"`
+	NodeAddress    *common.Address      `json:"nodeAddress,omitempty"`
+	Local          types.VotingTreeNode `json:"local"`
+	Submitted      types.VotingTreeNode `json:"submitted"`
+	ChallengeState types.ChallengeState `json:"challengeState"`
+}
+type PDAORootSubmissionAudit struct {
+	Index         uint64                 `json:"index"`
+	Submitter     common.Address         `json:"submitter"`
+	Time          time.Time              `json:"time"`
+	NodeAddress   *common.Address        `json:"nodeAddress,omitempty"`
+	LocalRoot     types.VotingTreeNode   `json:"localRoot"`
+	SubmittedRoot types.VotingTreeNode   `json:"submittedRoot"`
+	Mismatches    []PDAOTreeNodeMismatch `json:"mismatches"`
+}
+type PDAOAuditProposalResponse struct {
+	Status          string                         `json:"status"`
+	Error           string                         `json:"error"`
+	DoesNotExist    bool                           `json:"doesNotExist"`
+	ProposerAddress common.Address                 `json:"proposerAddress"`
+	TargetBlock     uint32                         `json:"targetBlock"`
+	State           types.ProtocolDaoProposalState `json:"state"`
+	DepthPerRound   uint64                         `json:"depthPerRound"`
+	Submissions     []PDAORootSubmissionAudit      `json:"submissions"`
+}
+
+type PDAOGovernanceFeedResponse struct {
+	Status    string                       `json:"status"`
+	Error     string                       `json:"error"`
+	UpdatedAt time.Time                    `json:"updatedAt"`
+	Proposals []governance.TrackedProposal `json:"proposals"`
+	Events    []governance.Event           `json:"events"`
+}
+
+type PDAOTestVotingPolicyResponse struct {
+	Status       string                     `json:"status"`
+	Error        string                     `json:"error"`
+	PolicyPath   string                     `json:"policyPath"`
+	PolicyExists bool                       `json:"policyExists"`
+	Enabled      bool                       `json:"enabled"`
+	DoesNotExist bool                       `json:"doesNotExist"`
+	Votes        []governance.AutomaticVote `json:"votes"`
+}
+
 type PDAOCanSetVotingDelegateResponse struct {
 	Status  string             `json:"status"`
 	Error   string             `json:"error"`
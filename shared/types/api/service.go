@@ -1,6 +1,10 @@
 package api
 
-import "github.com/ethereum/go-ethereum/common"
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
 
 type TerminateDataFolderResponse struct {
 	Status        string `json:"status"`
@@ -23,11 +27,25 @@ type ClientStatus struct {
 	Error        string  `json:"error"`
 }
 
+// The health of a single client endpoint in a manager's pool
+type ClientEndpointStatus struct {
+	ClientStatus
+	Name         string        `json:"name"`
+	Selected     bool          `json:"selected"`
+	Demoted      bool          `json:"demoted"`
+	Score        float64       `json:"score"`
+	SyncDistance uint64        `json:"syncDistance"`
+	HeadLag      uint64        `json:"headLag"`
+	ErrorRate    float64       `json:"errorRate"`
+	Latency      time.Duration `json:"latency"`
+}
+
 // This is a wrapper for the manager's overall status report
 type ClientManagerStatus struct {
-	PrimaryClientStatus  ClientStatus `json:"primaryEcStatus"`
-	FallbackEnabled      bool         `json:"fallbackEnabled"`
-	FallbackClientStatus ClientStatus `json:"fallbackEcStatus"`
+	PrimaryClientStatus  ClientStatus           `json:"primaryEcStatus"`
+	FallbackEnabled      bool                   `json:"fallbackEnabled"`
+	FallbackClientStatus ClientStatus           `json:"fallbackEcStatus"`
+	Endpoints            []ClientEndpointStatus `json:"endpoints,omitempty"`
 }
 
 type ClientStatusResponse struct {
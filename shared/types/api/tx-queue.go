@@ -0,0 +1,14 @@
+package api
+
+import (
+	"time"
+
+	"github.com/rocket-pool/smartnode/shared/services/txmanager"
+)
+
+type TxQueueResponse struct {
+	Status       string                          `json:"status"`
+	Error        string                          `json:"error"`
+	UpdatedAt    time.Time                       `json:"updatedAt"`
+	Transactions []*txmanager.ManagedTransaction `json:"transactions"`
+}
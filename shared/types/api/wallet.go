@@ -2,6 +2,7 @@ package api
 
 import (
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/google/uuid"
 	"github.com/rocket-pool/smartnode/bindings/rocketpool"
 	"github.com/rocket-pool/smartnode/bindings/types"
@@ -26,8 +27,10 @@ type WalletStatusResponse struct {
 	// When using a normal wallet, AccountAddress represents the address derived from the wallet stored on disk
 	AccountAddress common.Address `json:"accountAddress"`
 	// NodeAddress always represents the address drived from the wallet stored on disk
-	NodeAddress    common.Address `json:"nodeAddress"`
-	IsMasquerading bool           `json:"isMasquerading"`
+	NodeAddress     common.Address `json:"nodeAddress"`
+	IsMasquerading  bool           `json:"isMasquerading"`
+	PasswordStorage string         `json:"passwordStorage"`
+	WalletLocked    bool           `json:"walletLocked"`
 }
 
 type SetPasswordResponse struct {
@@ -35,6 +38,19 @@ type SetPasswordResponse struct {
 	Error  string `json:"error"`
 }
 
+type UnlockWalletResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+}
+
+type SignTransactionResponse struct {
+	Status   string         `json:"status"`
+	Error    string         `json:"error"`
+	SignedTx hexutil.Bytes  `json:"signedTx"`
+	TxHash   common.Hash    `json:"txHash"`
+	From     common.Address `json:"from"`
+}
+
 type InitWalletResponse struct {
 	Status         string         `json:"status"`
 	Error          string         `json:"error"`
@@ -43,10 +59,11 @@ type InitWalletResponse struct {
 }
 
 type RecoverWalletResponse struct {
-	Status         string                  `json:"status"`
-	Error          string                  `json:"error"`
-	AccountAddress common.Address          `json:"accountAddress"`
-	ValidatorKeys  []types.ValidatorPubkey `json:"validatorKeys"`
+	Status              string                  `json:"status"`
+	Error               string                  `json:"error"`
+	AccountAddress      common.Address          `json:"accountAddress"`
+	ValidatorKeys       []types.ValidatorPubkey `json:"validatorKeys"`
+	ValidatorKeysLoaded bool                    `json:"validatorKeysLoaded"`
 }
 
 type SearchAndRecoverWalletResponse struct {
@@ -57,6 +74,8 @@ type SearchAndRecoverWalletResponse struct {
 	DerivationPath string                  `json:"derivationPath"`
 	Index          uint                    `json:"index"`
 	ValidatorKeys  []types.ValidatorPubkey `json:"validatorKeys"`
+
+	ValidatorKeysLoaded bool `json:"validatorKeysLoaded"`
 }
 
 type RebuildWalletResponse struct {
@@ -20,6 +20,7 @@ type Parameter struct {
 	AffectsContainers     []ContainerID           `yaml:"affectsContainers,omitempty"`
 	CanBeBlank            bool                    `yaml:"canBeBlank,omitempty"`
 	OverwriteOnUpgrade    bool                    `yaml:"overwriteOnUpgrade,omitempty"`
+	Secret                bool                    `yaml:"secret,omitempty"`
 	Options               []ParameterOption       `yaml:"options,omitempty"`
 	Value                 interface{}             `yaml:"-"`
 	DescriptionsByNetwork map[Network]string      `yaml:"-"`
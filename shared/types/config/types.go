@@ -11,6 +11,7 @@ type MevRelayID string
 type MevSelectionMode string
 type NimbusPruningMode string
 type PBSubmissionRef int
+type PushNotificationService string
 
 // Enum to describe which container(s) a parameter impacts, so the Smartnode knows which
 // ones to restart upon a settings change
@@ -95,6 +96,12 @@ const (
 	PBSubmission_6AM PBSubmissionRef = 1713420000
 )
 
+// Enum to describe the push notification services the Smartnode can send alerts to
+const (
+	PushNotificationService_Ntfy   PushNotificationService = "ntfy"
+	PushNotificationService_Gotify PushNotificationService = "gotify"
+)
+
 // Enum to identify MEV-boost relays
 const (
 	MevRelayID_Unknown            MevRelayID = ""
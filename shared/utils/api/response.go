@@ -3,28 +3,82 @@ package api
 import (
 	"errors"
 	"fmt"
+	"io"
 	"math/big"
+	"os"
 	"reflect"
 
 	"github.com/goccy/go-json"
+	"github.com/urfave/cli"
 
+	"github.com/rocket-pool/smartnode/shared/services/wallet/offline"
 	"github.com/rocket-pool/smartnode/shared/types/api"
 )
 
+// The key an app's response writer is stored under in its metadata
+const responseWriterKey string = "apiResponseWriter"
+
+// Transactions captured for offline signing while running the current command
+var unsignedTxs []offline.UnsignedTransaction
+
+// Prints API responses to a writer
+type ResponsePrinter struct {
+	writer io.Writer
+}
+
+// Create a printer for API responses that prints them to the provided writer
+func NewResponsePrinter(w io.Writer) *ResponsePrinter {
+	return &ResponsePrinter{writer: w}
+}
+
+// Set where the responses of the commands run by an app are printed.
+// The API server gives each request its own writer, so commands that run at the same time don't mix up their responses.
+func SetResponseWriter(app *cli.App, w io.Writer) {
+	if app.Metadata == nil {
+		app.Metadata = map[string]interface{}{}
+	}
+	app.Metadata[responseWriterKey] = w
+}
+
+// Get the printer for the responses of the command being run with the provided context
+func Output(c *cli.Context) *ResponsePrinter {
+	writer, ok := c.App.Metadata[responseWriterKey].(io.Writer)
+	if !ok {
+		writer = os.Stdout
+	}
+	return NewResponsePrinter(writer)
+}
+
+// Add a transaction captured for offline signing to the current command's response
+func AddUnsignedTransaction(tx offline.UnsignedTransaction) {
+	unsignedTxs = append(unsignedTxs, tx)
+}
+
 func ZeroIfNil(in **big.Int) {
 	if *in == nil {
 		*in = big.NewInt(0)
 	}
 }
 
-// Print an API response
+// Print an API response to stdout
 // response must be a pointer to a struct type with Error and Status string fields
 func PrintResponse(response interface{}, responseError error) {
+	NewResponsePrinter(os.Stdout).PrintResponse(response, responseError)
+}
+
+// Print an API error response to stdout
+func PrintErrorResponse(err error) {
+	PrintResponse(&api.APIResponse{}, err)
+}
+
+// Print an API response
+// response must be a pointer to a struct type with Error and Status string fields
+func (p *ResponsePrinter) PrintResponse(response interface{}, responseError error) {
 
 	// Check response type
 	r := reflect.ValueOf(response)
 	if !(r.Kind() == reflect.Ptr && r.Type().Elem().Kind() == reflect.Struct) {
-		PrintErrorResponse(errors.New("Invalid API response"))
+		p.PrintErrorResponse(errors.New("Invalid API response"))
 		return
 	}
 
@@ -38,7 +92,7 @@ func PrintResponse(response interface{}, responseError error) {
 	sf := r.Elem().FieldByName("Status")
 	ef := r.Elem().FieldByName("Error")
 	if !(sf.IsValid() && sf.CanSet() && sf.Kind() == reflect.String && ef.IsValid() && ef.CanSet() && ef.Kind() == reflect.String) {
-		PrintErrorResponse(errors.New("Invalid API response"))
+		p.PrintErrorResponse(errors.New("Invalid API response"))
 		return
 	}
 
@@ -57,16 +111,46 @@ func PrintResponse(response interface{}, responseError error) {
 	// Encode
 	responseBytes, err := json.Marshal(response)
 	if err != nil {
-		PrintErrorResponse(fmt.Errorf("Could not encode API response: %w", err))
+		p.PrintErrorResponse(fmt.Errorf("Could not encode API response: %w", err))
 		return
 	}
 
+	// Attach any transactions that were captured for offline signing
+	if len(unsignedTxs) > 0 {
+		txs := unsignedTxs
+		unsignedTxs = nil
+		responseBytes, err = addUnsignedTransactions(responseBytes, txs)
+		if err != nil {
+			p.PrintErrorResponse(err)
+			return
+		}
+	}
+
 	// Print
-	fmt.Println(string(responseBytes))
+	fmt.Fprintln(p.writer, string(responseBytes))
 
 }
 
+// Add captured transactions to an encoded response
+func addUnsignedTransactions(responseBytes []byte, txs []offline.UnsignedTransaction) ([]byte, error) {
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(responseBytes, &fields); err != nil {
+		return nil, fmt.Errorf("Could not decode API response: %w", err)
+	}
+	txBytes, err := json.Marshal(txs)
+	if err != nil {
+		return nil, fmt.Errorf("Could not encode unsigned transactions: %w", err)
+	}
+	fields[api.UnsignedTransactionsField] = txBytes
+	return json.Marshal(fields)
+}
+
 // Print an API error response
-func PrintErrorResponse(err error) {
-	PrintResponse(&api.APIResponse{}, err)
+func (p *ResponsePrinter) PrintErrorResponse(err error) {
+	p.PrintResponse(&api.APIResponse{}, err)
+}
+
+// Print raw output, for commands that print something other than a JSON response
+func (p *ResponsePrinter) Printf(format string, a ...interface{}) {
+	fmt.Fprintf(p.writer, format, a...)
 }
@@ -43,7 +43,12 @@ func PrintAndCheckGasInfo(gasInfo rocketpool.GasInfo, checkThreshold bool, gasTh
 		logger.Println("This transaction does not check the gas threshold limit, continuing...")
 	}
 
-	// Print the total TX cost
+	PrintGasInfo(gasInfo, logger, maxFeeWei, gasLimit)
+	return true
+}
+
+// Print the total cost of a TX to the logger
+func PrintGasInfo(gasInfo rocketpool.GasInfo, logger *log.ColorLogger, maxFeeWei *big.Int, gasLimit uint64) {
 	var gas *big.Int
 	var safeGas *big.Int
 	if gasLimit != 0 {
@@ -59,8 +64,6 @@ func PrintAndCheckGasInfo(gasInfo rocketpool.GasInfo, checkThreshold bool, gasTh
 		eth.WeiToGwei(maxFeeWei),
 		math.RoundDown(eth.WeiToEth(totalGasWei), 6),
 		math.RoundDown(eth.WeiToEth(totalSafeGasWei), 6))
-
-	return true
 }
 
 // Print a TX's details to the logger and waits for it to validated.
@@ -88,19 +91,36 @@ func PrintAndWaitForTransaction(cfg *config.RocketPoolConfig, hash common.Hash,
 // True if a transaction is due and needs to bypass the gas threshold
 func IsTransactionDue(rp *rocketpool.RocketPool, startTime time.Time) (bool, time.Duration, error) {
 
-	// Get the dissolve timeout
-	timeout, err := protocol.GetMinipoolLaunchTimeout(rp, nil)
+	// Get the deadline
+	deadline, err := GetTransactionDeadline(rp, startTime)
 	if err != nil {
 		return false, 0, err
 	}
 
-	dueTime := timeout / time.Duration(TimeoutSafetyFactor)
-	isDue := time.Since(startTime) > dueTime
-	timeUntilDue := time.Until(startTime.Add(dueTime))
+	isDue := time.Now().After(deadline)
+	timeUntilDue := time.Until(deadline)
 	return isDue, timeUntilDue, nil
 
 }
 
+// Get the time after which a minipool transaction is due and needs to bypass the gas threshold
+func GetTransactionDeadline(rp *rocketpool.RocketPool, startTime time.Time) (time.Time, error) {
+
+	// Get the dissolve timeout
+	timeout, err := protocol.GetMinipoolLaunchTimeout(rp, nil)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return GetSafeDeadline(startTime, timeout), nil
+
+}
+
+// Get the point in a timeout window after which a transaction is due, leaving a safety margin before the window ends
+func GetSafeDeadline(startTime time.Time, timeout time.Duration) time.Time {
+	return startTime.Add(timeout / time.Duration(TimeoutSafetyFactor))
+}
+
 //  Expects a 129 byte 0x-prefixed EIP-712 signature and returns v/r/s as v uint8 and r, s [32]byte
 
 func ParseEIP712(signature string) (*EIP712Components, error) {
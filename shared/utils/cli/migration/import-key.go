@@ -33,13 +33,20 @@ func ImportKey(c *cli.Context, rp *rocketpool.Client, minipoolAddress common.Add
 
 	// Import the key
 	fmt.Printf("Importing validator key... ")
-	_, err := rp.ImportKey(minipoolAddress, mnemonic)
+	response, err := rp.ImportKey(minipoolAddress, mnemonic)
 	if err != nil {
 		fmt.Printf("error importing validator key: %s\n", err.Error())
 		return false
 	}
 	fmt.Println("done!")
 
+	// The key was loaded without restarting the VC
+	if response.KeyLoaded {
+		fmt.Println("Your Validator Client has loaded your validator's key.")
+		fmt.Println()
+		return true
+	}
+
 	// Restart the VC if necessary
 	if c.Bool("no-restart") {
 		return true
@@ -0,0 +1,172 @@
+package validator
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/docker/docker/client"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/smartnode/bindings/types"
+	eth2types "github.com/wealdtech/go-eth2-types/v2"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/keymanager"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+// Anything that can provide validator keys, such as the node wallet
+type ValidatorKeyProvider interface {
+	GetValidatorKeyByPubkey(pubkey types.ValidatorPubkey) (*eth2types.BLSPrivateKey, error)
+}
+
+// Load new validator keys into the validator client through its Keymanager API, restarting it instead if that isn't possible
+func LoadValidatorKeys(cfg *config.RocketPoolConfig, bc beacon.Client, km *keymanager.Client, log *log.ColorLogger, d *client.Client, w ValidatorKeyProvider, pubkeys []types.ValidatorPubkey) error {
+
+	if km != nil {
+		err := ImportValidatorKeys(cfg, km, w, pubkeys)
+		if err == nil {
+			if log != nil {
+				log.Printlnf("Loaded %d validator key(s) into the validator client without restarting it.", len(pubkeys))
+			}
+			return nil
+		}
+		if log != nil {
+			log.Printlnf("WARNING: Couldn't load the validator keys through the Keymanager API: %s", err.Error())
+		}
+	}
+
+	// Fall back to a restart
+	return RestartValidator(cfg, bc, log, d)
+
+}
+
+// Set the fee recipient for every key the validator client has loaded through its Keymanager API, restarting it instead if that isn't possible.
+// The fee recipient file must already be up to date, since that's what the validator client uses for keys it loads when it starts.
+func UpdateFeeRecipient(cfg *config.RocketPoolConfig, bc beacon.Client, km *keymanager.Client, log *log.ColorLogger, d *client.Client, feeRecipient common.Address) error {
+
+	if km != nil {
+		err := setFeeRecipientForAllKeys(cfg, km, feeRecipient)
+		if err == nil {
+			if log != nil {
+				log.Printlnf("Set the fee recipient to %s without restarting the validator client.", feeRecipient.Hex())
+			}
+			return nil
+		}
+		if log != nil {
+			log.Printlnf("WARNING: Couldn't set the fee recipient through the Keymanager API: %s", err.Error())
+		}
+	}
+
+	// Fall back to a restart
+	return RestartValidator(cfg, bc, log, d)
+
+}
+
+// Import validator keys into the validator client through its Keymanager API and set their fee recipient and graffiti.
+// Keys held by a remote signer are registered with the validator client instead.
+func ImportValidatorKeys(cfg *config.RocketPoolConfig, km *keymanager.Client, w ValidatorKeyProvider, pubkeys []types.ValidatorPubkey) error {
+
+	if km == nil {
+		return errors.New("the validator client's Keymanager API isn't available")
+	}
+	if len(pubkeys) == 0 {
+		return nil
+	}
+
+	// Get the settings for the new keys
+	feeRecipient, err := getFeeRecipient(cfg)
+	if err != nil {
+		return err
+	}
+	graffiti := ""
+	if !cfg.GraffitiWallWriter.GetEnabledParameter().Value.(bool) {
+		graffiti, err = cfg.Graffiti()
+		if err != nil {
+			return fmt.Errorf("error getting graffiti: %w", err)
+		}
+	}
+
+	// Import the keys
+	var statuses []keymanager.Status
+	if signerUrl := cfg.RemoteSignerUrl(); signerUrl != "" {
+		statuses, err = km.ImportRemoteKeys(pubkeys, signerUrl)
+	} else {
+		keystores := make([]string, len(pubkeys))
+		passwords := make([]string, len(pubkeys))
+		for i, pubkey := range pubkeys {
+			key, err := w.GetValidatorKeyByPubkey(pubkey)
+			if err != nil {
+				return err
+			}
+			keystores[i], passwords[i], err = keymanager.EncryptValidatorKey(key, "")
+			if err != nil {
+				return err
+			}
+		}
+		statuses, err = km.ImportKeystores(keystores, passwords, "")
+	}
+	if err != nil {
+		return err
+	}
+	for i, status := range statuses {
+		if status.Status != keymanager.ImportStatus_Imported && status.Status != keymanager.ImportStatus_Duplicate {
+			return fmt.Errorf("the validator client could not import validator key %s: %s (%s)", pubkeys[i].Hex(), status.Status, status.Message)
+		}
+	}
+
+	// Set their fee recipient and graffiti
+	for _, pubkey := range pubkeys {
+		if err := km.SetFeeRecipient(pubkey, feeRecipient); err != nil {
+			return err
+		}
+		if graffiti != "" {
+			if err := km.SetGraffiti(pubkey, graffiti); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+
+}
+
+// Set the fee recipient for every key the validator client has loaded
+func setFeeRecipientForAllKeys(cfg *config.RocketPoolConfig, km *keymanager.Client, feeRecipient common.Address) error {
+
+	var pubkeys []types.ValidatorPubkey
+	var err error
+	if cfg.RemoteSignerUrl() != "" {
+		pubkeys, err = km.ListRemoteKeys()
+	} else {
+		pubkeys, err = km.ListKeystores()
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, pubkey := range pubkeys {
+		if err := km.SetFeeRecipient(pubkey, feeRecipient); err != nil {
+			return err
+		}
+	}
+	return nil
+
+}
+
+// Get the fee recipient from the fee recipient file the validator client uses
+func getFeeRecipient(cfg *config.RocketPoolConfig) (common.Address, error) {
+	bytes, err := os.ReadFile(cfg.Smartnode.GetFeeRecipientFilePath())
+	if err != nil {
+		return common.Address{}, fmt.Errorf("error reading fee recipient file: %w", err)
+	}
+
+	// Native mode uses an environment file
+	contents := strings.TrimPrefix(strings.TrimSpace(string(bytes)), "FEE_RECIPIENT=")
+	if !common.IsHexAddress(contents) {
+		return common.Address{}, fmt.Errorf("fee recipient file contains an invalid address [%s]", contents)
+	}
+	return common.HexToAddress(contents), nil
+}
@@ -5,6 +5,7 @@ import (
 	"strconv"
 
 	"github.com/rocket-pool/smartnode/bindings/types"
+	"github.com/rocket-pool/smartnode/shared/services/wallet/keystore/web3signer"
 	"github.com/rocket-pool/smartnode/shared/types/eth2/generic"
 	eth2types "github.com/wealdtech/go-eth2-types/v2"
 )
@@ -12,10 +13,41 @@ import (
 // Get a voluntary exit message signature for a given validator key and index
 func GetSignedExitMessage(validatorKey *eth2types.BLSPrivateKey, validatorIndex string, epoch uint64, signatureDomain []byte) (types.ValidatorSignature, error) {
 
+	// Get the signing root
+	srHash, _, err := getExitSigningRoot(validatorIndex, epoch, signatureDomain)
+	if err != nil {
+		return types.ValidatorSignature{}, err
+	}
+
+	// Sign message
+	signature := validatorKey.Sign(srHash[:]).Marshal()
+
+	// Return
+	return types.BytesToValidatorSignature(signature), nil
+
+}
+
+// Get a voluntary exit message signature for a given validator from a remote signer
+func GetRemoteSignedExitMessage(signer *web3signer.Client, forkInfo web3signer.ForkInfo, validatorPubkey types.ValidatorPubkey, validatorIndex string, epoch uint64, signatureDomain []byte) (types.ValidatorSignature, error) {
+
+	// Get the signing root
+	srHash, indexNum, err := getExitSigningRoot(validatorIndex, epoch, signatureDomain)
+	if err != nil {
+		return types.ValidatorSignature{}, err
+	}
+
+	// Sign message
+	return signer.SignVoluntaryExit(validatorPubkey, forkInfo, srHash[:], epoch, indexNum)
+
+}
+
+// Get the signing root of a voluntary exit message, along with the parsed validator index
+func getExitSigningRoot(validatorIndex string, epoch uint64, signatureDomain []byte) ([32]byte, uint64, error) {
+
 	// Parse the validator index
 	indexNum, err := strconv.ParseUint(validatorIndex, 10, 64)
 	if err != nil {
-		return types.ValidatorSignature{}, fmt.Errorf("error parsing validator index (%s): %w", validatorIndex, err)
+		return [32]byte{}, 0, fmt.Errorf("error parsing validator index (%s): %w", validatorIndex, err)
 	}
 
 	// Build voluntary exit message
@@ -27,7 +59,7 @@ func GetSignedExitMessage(validatorKey *eth2types.BLSPrivateKey, validatorIndex
 	// Get object root
 	or, err := exitMessage.HashTreeRoot()
 	if err != nil {
-		return types.ValidatorSignature{}, err
+		return [32]byte{}, 0, err
 	}
 
 	// Get signing root
@@ -38,13 +70,8 @@ func GetSignedExitMessage(validatorKey *eth2types.BLSPrivateKey, validatorIndex
 
 	srHash, err := sr.HashTreeRoot()
 	if err != nil {
-		return types.ValidatorSignature{}, err
+		return [32]byte{}, 0, err
 	}
-
-	// Sign message
-	signature := validatorKey.Sign(srHash[:]).Marshal()
-
-	// Return
-	return types.BytesToValidatorSignature(signature), nil
+	return srHash, indexNum, nil
 
 }